@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the middleware that writes the access log of the
+// server.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AccessLogBuilder contains the configuration and logic needed to create the middleware that
+// writes the access log of the server. Don't create instances of this type directly, use the
+// NewAccessLogBuilder function instead.
+type AccessLogBuilder struct {
+	logger Logger
+}
+
+// accessLogHandler is the HTTP handler that implements the access log middleware.
+type accessLogHandler struct {
+	logger Logger
+	next   http.Handler
+}
+
+// NewAccessLogBuilder creates a builder that knows how to create access log middleware.
+func NewAccessLogBuilder() *AccessLogBuilder {
+	return new(AccessLogBuilder)
+}
+
+// Logger sets the logger that will be used to write the access log. Each request will be written
+// as an information message containing `key=value` pairs, for example:
+//
+//	method=GET path=/api/clusters_mgmt/v1/clusters/123 code=200 bytes=1234 duration=12ms remote=127.0.0.1:54321
+//
+// By default it uses the Go `log` package, with the debug level disabled and the rest enabled.
+func (b *AccessLogBuilder) Logger(logger Logger) *AccessLogBuilder {
+	b.logger = logger
+	return b
+}
+
+// Build uses the configuration stored in the builder to create the access log middleware. The
+// result can be passed to the Logging method of the server builder, or used directly to wrap any
+// other handler, for example the root server adapter of a specific service.
+func (b *AccessLogBuilder) Build() (result Middleware, err error) {
+	// Create the default logger, if needed:
+	logger := b.logger
+	if logger == nil {
+		logger, err = NewGoLoggerBuilder().
+			Debug(false).
+			Info(true).
+			Warn(true).
+			Error(true).
+			Build()
+		if err != nil {
+			err = fmt.Errorf("can't create default logger: %v", err)
+			return
+		}
+	}
+
+	result = func(next http.Handler) http.Handler {
+		return &accessLogHandler{
+			logger: logger,
+			next:   next,
+		}
+	}
+	return
+}
+
+// ServeHTTP is the implementation of the http.Handler interface.
+func (h *accessLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.logger.InfoEnabled() {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	start := time.Now()
+	recorder := newResponseRecorder(w)
+	defer func() {
+		h.logger.Info(
+			r.Context(),
+			"method=%s path=%s code=%d bytes=%d duration=%s remote=%s",
+			r.Method, r.URL.Path, recorder.code, recorder.bytes, time.Since(start), r.RemoteAddr,
+		)
+	}()
+	h.next.ServeHTTP(recorder, r)
+}
@@ -19,10 +19,15 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AccessTokenBuilder contains the data and logic needed to build 'access_token' objects.
 //
 //
 type AccessTokenBuilder struct {
+	extra map[string]interface{}
 }
 
 // NewAccessToken creates a new builder of 'access_token' objects.
@@ -30,8 +35,28 @@ func NewAccessToken() *AccessTokenBuilder {
 	return new(AccessTokenBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'access_token' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *AccessToken) Builder() *AccessTokenBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(AccessTokenBuilder)
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
+// Extra sets the attributes that don't correspond to attributes of the 'access_token' type, and
+// that will be written as they are when the object is encoded.
+func (b *AccessTokenBuilder) Extra(value map[string]interface{}) *AccessTokenBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'access_token' object using the configuration stored in the builder.
 func (b *AccessTokenBuilder) Build() (object *AccessToken, err error) {
 	object = new(AccessToken)
+	object.extra = helpers.CopyExtra(b.extra)
 	return
 }
@@ -30,6 +30,19 @@ func NewAccessTokenList() *AccessTokenListBuilder {
 	return new(AccessTokenListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *AccessTokenList) Builder() *AccessTokenListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(AccessTokenListBuilder)
+	b.items = make([]*AccessTokenBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *AccessTokenListBuilder) Items(values ...*AccessTokenBuilder) *AccessTokenListBuilder {
 	b.items = make([]*AccessTokenBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// accessTokenListData is type used internally to marshal and unmarshal lists of objects
-// of type 'access_token'.
-type accessTokenListData []*accessTokenData
-
 // UnmarshalAccessTokenList reads a list of values of the 'access_token'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalAccessTokenList(source interface{}) (list *AccessTokenList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalAccessTokenList(source interface{}, options ...helpers.DecodingOptions) (list *AccessTokenList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data accessTokenListData
-	err = decoder.Decode(&data)
+	list = readAccessTokenList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'access_token' value to a JSON document.
-func (l *AccessTokenList) wrap() (data accessTokenListData, err error) {
-	if l == nil {
-		return
+// MarshalAccessTokenList writes a list of values of the 'access_token' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalAccessTokenList(list *AccessTokenList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(accessTokenListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeAccessTokenList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *AccessTokenList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAccessTokenList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAccessTokenList function,
+// using the default decoding options.
+func (l *AccessTokenList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalAccessTokenList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = AccessTokenList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'access_token' type.
-func (d accessTokenListData) unwrap() (list *AccessTokenList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *AccessTokenList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *AccessTokenList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeAccessTokenList writes a list of values of the 'access_token' type to the given
+// stream, as a JSON array.
+func writeAccessTokenList(list *AccessTokenList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*AccessToken, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeAccessToken(item, stream)
 	}
-	list = new(AccessTokenList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readAccessTokenList reads a list of values of the 'access_token' type from the given
+// iterator, as a JSON array.
+func readAccessTokenList(iterator *helpers.Iterator) *AccessTokenList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(AccessTokenList)
+	list.items = []*AccessToken{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readAccessToken(iterator))
+	}
+	return list
 }
@@ -23,57 +23,110 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// accessTokenData is the data structure used internally to marshal and unmarshal
-// objects of type 'access_token'.
-type accessTokenData struct {
-}
-
 // MarshalAccessToken writes a value of the 'access_token' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalAccessToken(object *AccessToken, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeAccessToken(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'access_token'
-// value to a JSON document.
-func (o *AccessToken) wrap() (data *accessTokenData, err error) {
-	if o == nil {
+// writeAccessToken writes a value of the 'access_token' type to the given stream.
+func writeAccessToken(object *AccessToken, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(accessTokenData)
-	return
+	stream.WriteObjectStart()
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalAccessToken reads a value of the 'access_token' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalAccessToken(source interface{}) (object *AccessToken, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalAccessToken(source interface{}, options ...helpers.DecodingOptions) (object *AccessToken, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(accessTokenData)
-	err = decoder.Decode(data)
+	object = readAccessToken(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(AccessToken)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'access_token' type.
-func (d *accessTokenData) unwrap() (object *AccessToken, err error) {
-	if d == nil {
-		return
+// readAccessToken reads a value of the 'access_token' type from the given iterator.
+func readAccessToken(iterator *helpers.Iterator) *AccessToken {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(AccessToken)
-	return
+	object := new(AccessToken)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalAccessToken function.
+func (o *AccessToken) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAccessToken(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAccessToken function, using the
+// default decoding options.
+func (o *AccessToken) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalAccessToken(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = AccessToken{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *AccessToken) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *AccessToken) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
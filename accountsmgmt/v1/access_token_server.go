@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // AccessTokenServer represents the interface the manages the 'access_token' resource.
@@ -70,66 +72,104 @@ func (r *AccessTokenPostServerResponse) SetStatusCode(status int) *AccessTokenPo
 // marshall is the method used internally to marshal responses for the
 // 'post' method.
 func (r *AccessTokenPostServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeAccessToken(r.body, stream)
+	return stream.Flush()
 }
 
-// AccessTokenServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// AccessTokenServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type AccessTokenServerAdapter struct {
 	server AccessTokenServer
 	router *mux.Router
+	prefix string
 }
 
+// NewAccessTokenServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewAccessTokenServerAdapter(server AccessTokenServer, router *mux.Router) *AccessTokenServerAdapter {
 	adapter := new(AccessTokenServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("POST").HandlerFunc(adapter.postHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *AccessTokenServerAdapter) readAccessTokenPostServerRequest(r *http.Request) (*AccessTokenPostServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *AccessTokenServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchAccessToken(w, r, a.server, segments)
+}
+
+// dispatchAccessToken navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchAccessToken(w http.ResponseWriter, r *http.Request, server AccessTokenServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodPost:
+			adaptAccessTokenPostRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readAccessTokenPostServerRequest(r *http.Request) (*AccessTokenPostServerRequest, error) {
 	result := new(AccessTokenPostServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *AccessTokenServerAdapter) writeAccessTokenPostServerResponse(w http.ResponseWriter, r *AccessTokenPostServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeAccessTokenPostServerResponse(w http.ResponseWriter, r *AccessTokenPostServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *AccessTokenServerAdapter) postHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readAccessTokenPostServerRequest(r)
+func adaptAccessTokenPostRequest(w http.ResponseWriter, r *http.Request, server AccessTokenServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readAccessTokenPostServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(AccessTokenPostServerResponse)
-	err = a.server.Post(r.Context(), req, resp)
+	err = server.Post(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Post: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Post", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeAccessTokenPostServerResponse(w, resp)
+	err = writeAccessTokenPostServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
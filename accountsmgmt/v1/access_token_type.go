@@ -19,10 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AccessToken represents the values of the 'access_token' type.
 //
 //
 type AccessToken struct {
+	extra map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -30,6 +37,140 @@ func (o *AccessToken) Empty() bool {
 	return o == nil || (true)
 }
 
+// Metadata returns the description of the 'access_token' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *AccessToken) Metadata() *helpers.TypeMetadata {
+	return accessTokenMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *AccessToken) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAccessToken(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *AccessToken) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAccessToken(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *AccessToken) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAccessToken(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *AccessToken) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAccessToken(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'access_token' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *AccessToken) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
+// Copy returns a deep copy of the 'access_token' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *AccessToken) Copy() *AccessToken {
+	if o == nil {
+		return nil
+	}
+	result := new(AccessToken)
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'access_token' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *AccessToken) Equal(other *AccessToken) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'access_token' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *AccessToken) Diff(other *AccessToken) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'access_token' object and the given one, using the given path as prefix.
+func (o *AccessToken) diff(path string, other *AccessToken, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'access_token' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *AccessToken) Changes(modified *AccessToken) *AccessToken {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(AccessToken)
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printAccessToken prints a value of the 'access_token' type to the given printer.
+func printAccessToken(object *AccessToken, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.AccessToken")
+	printer.PrintObjectEnd()
+}
+
 // AccessTokenList is a list of values of the 'access_token' type.
 type AccessTokenList struct {
 	items []*AccessToken
@@ -101,3 +242,105 @@ func (l *AccessTokenList) Range(f func(index int, item *AccessToken) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *AccessTokenList) Copy() *AccessTokenList {
+	if l == nil {
+		return nil
+	}
+	result := new(AccessTokenList)
+	if l.items != nil {
+		result.items = make([]*AccessToken, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *AccessTokenList) Equal(other *AccessTokenList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *AccessTokenList) Diff(other *AccessTokenList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *AccessTokenList) diff(path string, other *AccessTokenList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *AccessTokenList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAccessTokenList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *AccessTokenList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAccessTokenList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *AccessTokenList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAccessTokenList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *AccessTokenList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAccessTokenList(l, printer)
+	}
+}
+
+// printAccessTokenList prints a list of values of the 'access_token' type to the given printer.
+func printAccessTokenList(list *AccessTokenList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printAccessToken(item, printer)
+	}
+	printer.PrintListEnd()
+}
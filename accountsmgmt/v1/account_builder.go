@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AccountBuilder contains the data and logic needed to build 'account' objects.
 //
 //
@@ -34,6 +38,7 @@ type AccountBuilder struct {
 	banned         *bool
 	banDescription *string
 	organization   *OrganizationBuilder
+	extra          map[string]interface{}
 }
 
 // NewAccount creates a new builder of 'account' objects.
@@ -41,6 +46,31 @@ func NewAccount() *AccountBuilder {
 	return new(AccountBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'account' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Account) Builder() *AccountBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(AccountBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	b.username = o.username
+	b.email = o.email
+	b.firstName = o.firstName
+	b.lastName = o.lastName
+	b.banned = o.banned
+	b.banDescription = o.banDescription
+	if o.organization != nil {
+		b.organization = o.organization.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *AccountBuilder) ID(value string) *AccountBuilder {
 	b.id = &value
@@ -131,9 +161,17 @@ func (b *AccountBuilder) Organization(value *OrganizationBuilder) *AccountBuilde
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'account' type, and
+// that will be written as they are when the object is encoded.
+func (b *AccountBuilder) Extra(value map[string]interface{}) *AccountBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'account' object using the configuration stored in the builder.
 func (b *AccountBuilder) Build() (object *Account, err error) {
 	object = new(Account)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
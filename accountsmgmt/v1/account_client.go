@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -81,6 +81,7 @@ type AccountGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -95,6 +96,17 @@ func (r *AccountGetRequest) Header(name string, value interface{}) *AccountGetRe
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *AccountGetRequest) Decoding(value helpers.DecodingOptions) *AccountGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -128,14 +140,14 @@ func (r *AccountGetRequest) SendContext(ctx context.Context) (result *AccountGet
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -189,29 +201,26 @@ func (r *AccountGetResponse) GetBody() (value *Account, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *AccountGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountData)
-	err = decoder.Decode(data)
+func (r *AccountGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readAccount(iterator)
+	return iterator.Error()
 }
 
 // AccountUpdateRequest is the request for the 'update' method.
 type AccountUpdateRequest struct {
-	transport http.RoundTripper
-	path      string
-	metric    string
-	query     url.Values
-	header    http.Header
-	body      *Account
+	transport  http.RoundTripper
+	path       string
+	metric     string
+	query      url.Values
+	header     http.Header
+	body       *Account
+	decoding   helpers.DecodingOptions
+	original   *Account
+	mergePatch bool
 }
 
 // Parameter adds a query parameter.
@@ -226,11 +235,45 @@ func (r *AccountUpdateRequest) Header(name string, value interface{}) *AccountUp
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *AccountUpdateRequest) Decoding(value helpers.DecodingOptions) *AccountUpdateRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 //
 func (r *AccountUpdateRequest) Body(value *Account) *AccountUpdateRequest {
 	r.body = value
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// Changes sets the body of the request to the attributes of the modified object that have
+// values different to the values in the original object, as calculated by the Changes method
+// of the type.
+func (r *AccountUpdateRequest) Changes(original, modified *Account) *AccountUpdateRequest {
+	r.body = original.Changes(modified)
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// MergePatch sets the body of the request to the JSON merge patch, as described in RFC 7396,
+// that transforms the original object into the modified one. The request will be sent with the
+// `application/merge-patch+json` content type. Attributes removed in the modified object will
+// be set to null in the patch.
+func (r *AccountUpdateRequest) MergePatch(original, modified *Account) *AccountUpdateRequest {
+	r.body = modified
+	r.original = original
+	r.mergePatch = true
 	return r
 }
 
@@ -246,6 +289,9 @@ func (r *AccountUpdateRequest) Send() (result *AccountUpdateResponse, err error)
 func (r *AccountUpdateRequest) SendContext(ctx context.Context) (result *AccountUpdateResponse, err error) {
 	query := helpers.CopyQuery(r.query)
 	header := helpers.SetHeader(r.header, r.metric)
+	if r.mergePatch {
+		header.Set("Content-Type", helpers.MergePatchContentType)
+	}
 	buffer := new(bytes.Buffer)
 	err = r.marshal(buffer)
 	if err != nil {
@@ -273,14 +319,14 @@ func (r *AccountUpdateRequest) SendContext(ctx context.Context) (result *Account
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -291,13 +337,31 @@ func (r *AccountUpdateRequest) SendContext(ctx context.Context) (result *Account
 // 'update' method.
 func (r *AccountUpdateRequest) marshal(writer io.Writer) error {
 	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	if r.mergePatch {
+		original := new(bytes.Buffer)
+		err = MarshalAccount(r.original, original)
+		if err != nil {
+			return err
+		}
+		modified := new(bytes.Buffer)
+		err = MarshalAccount(r.body, modified)
+		if err != nil {
+			return err
+		}
+		var patch []byte
+		patch, err = helpers.MergePatch(original.Bytes(), modified.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(patch)
+		return err
+	}
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeAccount(r.body, stream)
+	return stream.Flush()
 }
 
 // AccountUpdateResponse is the response for the 'update' method.
@@ -347,17 +411,30 @@ func (r *AccountUpdateResponse) GetBody() (value *Account, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'update' method.
-func (r *AccountUpdateResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountData)
-	err = decoder.Decode(data)
+func (r *AccountUpdateResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readAccount(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *AccountClient) Metadata() *helpers.ResourceMetadata {
+	return accountResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *AccountClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
 }
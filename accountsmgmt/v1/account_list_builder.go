@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'account' objects.
 type AccountListBuilder struct {
 	items []*AccountBuilder
+	link  bool
+	href  *string
 }
 
 // NewAccountList creates a new builder of 'account' objects.
@@ -30,8 +32,25 @@ func NewAccountList() *AccountListBuilder {
 	return new(AccountListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *AccountList) Builder() *AccountListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(AccountListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*AccountBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *AccountListBuilder) Items(values ...*AccountBuilder) *AccountListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*AccountBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *AccountListBuilder) Build() (list *AccountList, err error) {
 		}
 	}
 	list = new(AccountList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// accountListData is type used internally to marshal and unmarshal lists of objects
-// of type 'account'.
-type accountListData []*accountData
-
 // UnmarshalAccountList reads a list of values of the 'account'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalAccountList(source interface{}) (list *AccountList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalAccountList(source interface{}, options ...helpers.DecodingOptions) (list *AccountList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data accountListData
-	err = decoder.Decode(&data)
+	list = readAccountList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'account' value to a JSON document.
-func (l *AccountList) wrap() (data accountListData, err error) {
-	if l == nil {
+// MarshalAccountList writes a list of values of the 'account' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalAccountList(list *AccountList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeAccountList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *AccountList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAccountListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalAccountList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *AccountList) UnmarshalJSON(data []byte) error {
+	var list *AccountList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalAccountList(data)
+	} else {
+		list, err = unmarshalAccountListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = AccountList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *AccountList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *AccountList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalAccountListLink reads a list of values of the 'account' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalAccountListLink(source interface{}, options ...helpers.DecodingOptions) (list *AccountList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(accountListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readAccountListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'account' type.
-func (d accountListData) unwrap() (list *AccountList, err error) {
-	if d == nil {
+// writeAccountList writes a list of values of the 'account' type to the given
+// stream, as a JSON array.
+func writeAccountList(list *AccountList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Account, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeAccount(item, stream)
 	}
-	list = new(AccountList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// accountListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'account'.
-type accountListLinkData struct {
-	Kind  *string        "json:\"kind,omitempty\""
-	HREF  *string        "json:\"href,omitempty\""
-	Items []*accountData "json:\"items,omitempty\""
+// readAccountList reads a list of values of the 'account' type from the given
+// iterator, as a JSON array.
+func readAccountList(iterator *helpers.Iterator) *AccountList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(AccountList)
+	list.items = []*Account{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readAccount(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'account' value to a link.
-func (l *AccountList) wrapLink() (data *accountListLinkData, err error) {
-	if l == nil {
+// writeAccountListLink writes a list of values of the 'account' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeAccountListLink(list *AccountList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*accountData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(accountListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = AccountListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(AccountListLinkKind)
 	} else {
-		*data.Kind = AccountListKind
+		stream.WriteString(AccountListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeAccountList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'account' type to a list.
-func (d *accountListLinkData) unwrapLink() (list *AccountList, err error) {
-	if d == nil {
-		return
+// readAccountListLink reads a list of values of the 'account' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readAccountListLink(iterator *helpers.Iterator) *AccountList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Account, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(AccountList)
+	list.items = []*Account{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(AccountList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case AccountListKind:
-			list.link = false
-		case AccountListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(AccountListKind, AccountListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readAccountList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				AccountListKind,
-				AccountListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
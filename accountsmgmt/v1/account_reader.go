@@ -20,121 +20,208 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// accountData is the data structure used internally to marshal and unmarshal
-// objects of type 'account'.
-type accountData struct {
-	Kind           *string           "json:\"kind,omitempty\""
-	ID             *string           "json:\"id,omitempty\""
-	HREF           *string           "json:\"href,omitempty\""
-	Name           *string           "json:\"name,omitempty\""
-	Username       *string           "json:\"username,omitempty\""
-	Email          *string           "json:\"email,omitempty\""
-	FirstName      *string           "json:\"first_name,omitempty\""
-	LastName       *string           "json:\"last_name,omitempty\""
-	Banned         *bool             "json:\"banned,omitempty\""
-	BanDescription *string           "json:\"ban_description,omitempty\""
-	Organization   *organizationData "json:\"organization,omitempty\""
-}
-
 // MarshalAccount writes a value of the 'account' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalAccount(object *Account, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeAccount(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'account'
-// value to a JSON document.
-func (o *Account) wrap() (data *accountData, err error) {
-	if o == nil {
+// writeAccount writes a value of the 'account' type to the given stream.
+func writeAccount(object *Account, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(accountData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = AccountLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(AccountLinkKind)
 	} else {
-		*data.Kind = AccountKind
-	}
-	data.Name = o.name
-	data.Username = o.username
-	data.Email = o.email
-	data.FirstName = o.firstName
-	data.LastName = o.lastName
-	data.Banned = o.banned
-	data.BanDescription = o.banDescription
-	data.Organization, err = o.organization.wrap()
-	if err != nil {
-		return
+		stream.WriteString(AccountKind)
 	}
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.username != nil {
+		stream.WriteObjectField("username")
+		stream.WriteString(*object.username)
+	}
+	if object.email != nil {
+		stream.WriteObjectField("email")
+		stream.WriteString(*object.email)
+	}
+	if object.firstName != nil {
+		stream.WriteObjectField("first_name")
+		stream.WriteString(*object.firstName)
+	}
+	if object.lastName != nil {
+		stream.WriteObjectField("last_name")
+		stream.WriteString(*object.lastName)
+	}
+	if object.banned != nil {
+		stream.WriteObjectField("banned")
+		stream.WriteBool(*object.banned)
+	}
+	if object.banDescription != nil {
+		stream.WriteObjectField("ban_description")
+		stream.WriteString(*object.banDescription)
+	}
+	if object.organization != nil {
+		stream.WriteObjectField("organization")
+		writeOrganization(object.organization, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalAccount reads a value of the 'account' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalAccount(source interface{}) (object *Account, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalAccount(source interface{}, options ...helpers.DecodingOptions) (object *Account, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(accountData)
-	err = decoder.Decode(data)
+	object = readAccount(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Account)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'account' type.
-func (d *accountData) unwrap() (object *Account, err error) {
-	if d == nil {
-		return
+// readAccount reads a value of the 'account' type from the given iterator.
+func readAccount(iterator *helpers.Iterator) *Account {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Account)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case AccountKind:
-			object.link = false
-		case AccountLinkKind:
-			object.link = true
+	object := new(Account)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(AccountKind, AccountLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "username":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.username = &value
+			}
+		case "email":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.email = &value
+			}
+		case "first_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.firstName = &value
+			}
+		case "last_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.lastName = &value
+			}
+		case "banned":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.banned = &value
+			}
+		case "ban_description":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.banDescription = &value
+			}
+		case "organization":
+			object.organization = readOrganization(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				AccountKind,
-				AccountLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	object.username = d.Username
-	object.email = d.Email
-	object.firstName = d.FirstName
-	object.lastName = d.LastName
-	object.banned = d.Banned
-	object.banDescription = d.BanDescription
-	object.organization, err = d.Organization.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalAccount function.
+func (o *Account) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAccount(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAccount function, using the
+// default decoding options.
+func (o *Account) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalAccount(data)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if object == nil {
+		*o = Account{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Account) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Account) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // AccountServer represents the interface the manages the 'account' resource.
@@ -75,14 +77,12 @@ func (r *AccountGetServerResponse) SetStatusCode(status int) *AccountGetServerRe
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *AccountGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeAccount(r.body, stream)
+	return stream.Flush()
 }
 
 // AccountUpdateServerRequest is the request for the 'update' method.
@@ -117,18 +117,12 @@ func (r *AccountUpdateServerRequest) GetBody() (value *Account, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'update' method.
 func (r *AccountUpdateServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readAccount(iterator)
+	return iterator.Error()
 }
 
 // AccountUpdateServerResponse is the response for the 'update' method.
@@ -155,67 +149,106 @@ func (r *AccountUpdateServerResponse) SetStatusCode(status int) *AccountUpdateSe
 // marshall is the method used internally to marshal responses for the
 // 'update' method.
 func (r *AccountUpdateServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeAccount(r.body, stream)
+	return stream.Flush()
 }
 
-// AccountServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// AccountServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type AccountServerAdapter struct {
 	server AccountServer
 	router *mux.Router
+	prefix string
 }
 
+// NewAccountServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewAccountServerAdapter(server AccountServer, router *mux.Router) *AccountServerAdapter {
 	adapter := new(AccountServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("PATCH").HandlerFunc(adapter.updateHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *AccountServerAdapter) readAccountGetServerRequest(r *http.Request) (*AccountGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *AccountServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchAccount(w, r, a.server, segments)
+}
+
+// dispatchAccount navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchAccount(w http.ResponseWriter, r *http.Request, server AccountServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptAccountGetRequest(w, r, server)
+		case http.MethodPatch:
+			adaptAccountUpdateRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readAccountGetServerRequest(r *http.Request) (*AccountGetServerRequest, error) {
 	result := new(AccountGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *AccountServerAdapter) writeAccountGetServerResponse(w http.ResponseWriter, r *AccountGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeAccountGetServerResponse(w http.ResponseWriter, r *AccountGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *AccountServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readAccountGetServerRequest(r)
+func adaptAccountGetRequest(w http.ResponseWriter, r *http.Request, server AccountServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readAccountGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(AccountGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeAccountGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeAccountGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -225,9 +258,9 @@ func (a *AccountServerAdapter) getHandler(w http.ResponseWriter, r *http.Request
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *AccountServerAdapter) readAccountUpdateServerRequest(r *http.Request) (*AccountUpdateServerRequest, error) {
+func readAccountUpdateServerRequest(r *http.Request) (*AccountUpdateServerRequest, error) {
 	result := new(AccountUpdateServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -235,36 +268,42 @@ func (a *AccountServerAdapter) readAccountUpdateServerRequest(r *http.Request) (
 	}
 	return result, nil
 }
-func (a *AccountServerAdapter) writeAccountUpdateServerResponse(w http.ResponseWriter, r *AccountUpdateServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeAccountUpdateServerResponse(w http.ResponseWriter, r *AccountUpdateServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *AccountServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readAccountUpdateServerRequest(r)
+func adaptAccountUpdateRequest(w http.ResponseWriter, r *http.Request, server AccountServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readAccountUpdateServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(AccountUpdateServerResponse)
-	err = a.server.Update(r.Context(), req, resp)
+	err = server.Update(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Update: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Update", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeAccountUpdateServerResponse(w, resp)
+	err = writeAccountUpdateServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
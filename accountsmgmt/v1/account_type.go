@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AccountKind is the name of the type used to represent objects
 // of type 'account'.
 const AccountKind = "Account"
@@ -46,6 +54,7 @@ type Account struct {
 	banned         *bool
 	banDescription *string
 	organization   *Organization
+	extra          map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -100,6 +109,27 @@ func (o *Account) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete account that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Account) Resolve(ctx context.Context, transport http.RoundTripper) (result *Account, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to account '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewAccountClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Account) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -114,6 +144,59 @@ func (o *Account) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'account' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Account) Metadata() *helpers.TypeMetadata {
+	return accountMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Account) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAccount(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Account) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAccount(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Account) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAccount(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Account) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAccount(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'account' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Account) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -310,6 +393,327 @@ const AccountListLinkKind = "AccountListLink"
 // objects of type 'account'.
 const AccountListNilKind = "AccountListNil"
 
+// Copy returns a deep copy of the 'account' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Account) Copy() *Account {
+	if o == nil {
+		return nil
+	}
+	result := new(Account)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	if o.username != nil {
+		result.username = new(string)
+		*result.username = *o.username
+	}
+	if o.email != nil {
+		result.email = new(string)
+		*result.email = *o.email
+	}
+	if o.firstName != nil {
+		result.firstName = new(string)
+		*result.firstName = *o.firstName
+	}
+	if o.lastName != nil {
+		result.lastName = new(string)
+		*result.lastName = *o.lastName
+	}
+	if o.banned != nil {
+		result.banned = new(bool)
+		*result.banned = *o.banned
+	}
+	if o.banDescription != nil {
+		result.banDescription = new(string)
+		*result.banDescription = *o.banDescription
+	}
+	result.organization = o.organization.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'account' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Account) Equal(other *Account) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if (o.username == nil) != (other.username == nil) ||
+		(o.username != nil && *o.username != *other.username) {
+		return false
+	}
+	if (o.email == nil) != (other.email == nil) ||
+		(o.email != nil && *o.email != *other.email) {
+		return false
+	}
+	if (o.firstName == nil) != (other.firstName == nil) ||
+		(o.firstName != nil && *o.firstName != *other.firstName) {
+		return false
+	}
+	if (o.lastName == nil) != (other.lastName == nil) ||
+		(o.lastName != nil && *o.lastName != *other.lastName) {
+		return false
+	}
+	if (o.banned == nil) != (other.banned == nil) ||
+		(o.banned != nil && *o.banned != *other.banned) {
+		return false
+	}
+	if (o.banDescription == nil) != (other.banDescription == nil) ||
+		(o.banDescription != nil && *o.banDescription != *other.banDescription) {
+		return false
+	}
+	if !o.organization.Equal(other.organization) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'account' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Account) Diff(other *Account) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'account' object and the given one, using the given path as prefix.
+func (o *Account) diff(path string, other *Account, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	if (o.username == nil) != (other.username == nil) ||
+		(o.username != nil && *o.username != *other.username) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "username"),
+			Old:  helpers.Value(o.username),
+			New:  helpers.Value(other.username),
+		})
+	}
+	if (o.email == nil) != (other.email == nil) ||
+		(o.email != nil && *o.email != *other.email) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "email"),
+			Old:  helpers.Value(o.email),
+			New:  helpers.Value(other.email),
+		})
+	}
+	if (o.firstName == nil) != (other.firstName == nil) ||
+		(o.firstName != nil && *o.firstName != *other.firstName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "first_name"),
+			Old:  helpers.Value(o.firstName),
+			New:  helpers.Value(other.firstName),
+		})
+	}
+	if (o.lastName == nil) != (other.lastName == nil) ||
+		(o.lastName != nil && *o.lastName != *other.lastName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "last_name"),
+			Old:  helpers.Value(o.lastName),
+			New:  helpers.Value(other.lastName),
+		})
+	}
+	if (o.banned == nil) != (other.banned == nil) ||
+		(o.banned != nil && *o.banned != *other.banned) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "banned"),
+			Old:  helpers.Value(o.banned),
+			New:  helpers.Value(other.banned),
+		})
+	}
+	if (o.banDescription == nil) != (other.banDescription == nil) ||
+		(o.banDescription != nil && *o.banDescription != *other.banDescription) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "ban_description"),
+			Old:  helpers.Value(o.banDescription),
+			New:  helpers.Value(other.banDescription),
+		})
+	}
+	result = o.organization.diff(helpers.Path(path, "organization"), other.organization, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'account' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Account) Changes(modified *Account) *Account {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Account)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.username != nil && (o.username == nil || *o.username != *modified.username) {
+		result.username = new(string)
+		*result.username = *modified.username
+	}
+	if modified.email != nil && (o.email == nil || *o.email != *modified.email) {
+		result.email = new(string)
+		*result.email = *modified.email
+	}
+	if modified.firstName != nil && (o.firstName == nil || *o.firstName != *modified.firstName) {
+		result.firstName = new(string)
+		*result.firstName = *modified.firstName
+	}
+	if modified.lastName != nil && (o.lastName == nil || *o.lastName != *modified.lastName) {
+		result.lastName = new(string)
+		*result.lastName = *modified.lastName
+	}
+	if modified.banned != nil && (o.banned == nil || *o.banned != *modified.banned) {
+		result.banned = new(bool)
+		*result.banned = *modified.banned
+	}
+	if modified.banDescription != nil && (o.banDescription == nil || *o.banDescription != *modified.banDescription) {
+		result.banDescription = new(string)
+		*result.banDescription = *modified.banDescription
+	}
+	if modified.organization != nil && !o.organization.Equal(modified.organization) {
+		result.organization = o.organization.Changes(modified.organization)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printAccount prints a value of the 'account' type to the given printer.
+func printAccount(object *Account, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Account")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.username != nil {
+		printer.PrintObjectField("Username")
+		printer.PrintString(*object.username)
+	}
+	if object.email != nil {
+		printer.PrintObjectField("Email")
+		printer.PrintString(*object.email)
+	}
+	if object.firstName != nil {
+		printer.PrintObjectField("FirstName")
+		printer.PrintString(*object.firstName)
+	}
+	if object.lastName != nil {
+		printer.PrintObjectField("LastName")
+		printer.PrintString(*object.lastName)
+	}
+	if object.banned != nil {
+		printer.PrintObjectField("Banned")
+		printer.PrintBool(*object.banned)
+	}
+	if object.banDescription != nil {
+		printer.PrintObjectField("BanDescription")
+		printer.PrintString(*object.banDescription)
+	}
+	if object.organization != nil {
+		printer.PrintObjectField("Organization")
+		printOrganization(object.organization, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // AccountList is a list of values of the 'account' type.
 type AccountList struct {
 	href  *string
@@ -417,3 +821,146 @@ func (l *AccountList) Range(f func(index int, item *Account) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *AccountList) Copy() *AccountList {
+	if l == nil {
+		return nil
+	}
+	result := new(AccountList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Account, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *AccountList) Equal(other *AccountList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *AccountList) Diff(other *AccountList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *AccountList) diff(path string, other *AccountList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *AccountList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAccountListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *AccountList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAccountListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *AccountList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAccountListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *AccountList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAccountListLink(l, printer)
+	}
+}
+
+// printAccountList prints a list of values of the 'account' type to the given printer.
+func printAccountList(list *AccountList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printAccount(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printAccountListLink prints a list of values of the 'account' type to the
+// given printer, including the link to the list.
+func printAccountListLink(list *AccountList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.AccountList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printAccountList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -22,7 +22,6 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -95,7 +94,11 @@ type AccountsListRequest struct {
 	header    http.Header
 	page      *int
 	size      *int
+	order     *string
 	total     *int
+	decoding  helpers.DecodingOptions
+	stream    func(item *Account) bool
+	channel   chan<- *Account
 }
 
 // Parameter adds a query parameter.
@@ -110,6 +113,42 @@ func (r *AccountsListRequest) Header(name string, value interface{}) *AccountsLi
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *AccountsListRequest) Decoding(value helpers.DecodingOptions) *AccountsListRequest {
+	r.decoding = value
+	return r
+}
+
+// Stream sets a function that will be called for each item of the response as soon as it is
+// read, instead of collecting all the items in memory, so that the memory used doesn't depend
+// on the size of the page. If the function returns false the rest of the response isn't read,
+// so the page, size and total of the response will be missing if the server sends them after the
+// items. When this is used the Items method of the response returns nil.
+//
+// Note that when the debug log of the connection is enabled the complete response is still read
+// in memory in order to write it to the log.
+func (r *AccountsListRequest) Stream(handler func(item *Account) bool) *AccountsListRequest {
+	r.stream = handler
+	r.channel = nil
+	return r
+}
+
+// StreamTo is like Stream, but sends each item of the response to the given channel. The
+// channel isn't closed when the response ends. If the context used to send the request is
+// cancelled while waiting to send an item the rest of the response is ignored and the SendContext
+// method returns the error of the context. If all the items have been sent the context isn't
+// checked again.
+func (r *AccountsListRequest) StreamTo(channel chan<- *Account) *AccountsListRequest {
+	r.stream = nil
+	r.channel = channel
+	return r
+}
+
 // Page sets the value of the 'page' parameter.
 //
 // Index of the requested page, where one corresponds to the first page.
@@ -130,6 +169,29 @@ func (r *AccountsListRequest) Size(value int) *AccountsListRequest {
 	return r
 }
 
+// Order sets the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the account
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *AccountsListRequest) Order(value string) *AccountsListRequest {
+	r.order = &value
+	return r
+}
+
 // Total sets the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -156,6 +218,13 @@ func (r *AccountsListRequest) SendContext(ctx context.Context) (result *Accounts
 	if r.size != nil {
 		helpers.AddValue(&query, "size", *r.size)
 	}
+	if r.order != nil {
+		_, err = helpers.ParseOrder(url.Values{"order": {*r.order}}, "order", accountsListOrderAttributes)
+		if err != nil {
+			return
+		}
+		helpers.AddValue(&query, "order", *r.order)
+	}
 	if r.total != nil {
 		helpers.AddValue(&query, "total", *r.total)
 	}
@@ -181,17 +250,46 @@ func (r *AccountsListRequest) SendContext(ctx context.Context) (result *Accounts
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	handler, stopped := r.handler(ctx)
+	err = result.unmarshal(response.Body, r.decoding, handler)
 	if err != nil {
 		return
 	}
+	err = *stopped
+	return
+}
+
+// handler returns the function that should be called for each item of the response, or nil if
+// the items should be collected in the list returned by the Items method of the response. When
+// the items are sent to a channel, the second result is set to the error of the context if it is
+// cancelled before all the items have been sent.
+func (r *AccountsListRequest) handler(ctx context.Context) (handler func(item *Account) bool, stopped *error) {
+	stopped = new(error)
+	if r.channel == nil {
+		handler = r.stream
+		return
+	}
+	channel := r.channel
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	handler = func(item *Account) bool {
+		select {
+		case channel <- item:
+			return true
+		case <-done:
+			*stopped = ctx.Err()
+			return false
+		}
+	}
 	return
 }
 
@@ -320,32 +418,59 @@ func (r *AccountsListResponse) GetItems() (value *AccountList, ok bool) {
 }
 
 // unmarshal is the method used internally to unmarshal responses to the
-// 'list' method.
-func (r *AccountsListResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountsListResponseData)
-	err = decoder.Decode(data)
+// 'list' method. If the stream function isn't nil it is called for each item,
+// instead of collecting the items in memory.
+func (r *AccountsListResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions,
+	stream func(item *Account) bool) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.page = data.Page
-	r.size = data.Size
-	r.total = data.Total
-	r.items, err = data.Items.unwrap()
-	if err != nil {
-		return err
+	if !iterator.ReadObjectStart() {
+		return iterator.Error()
 	}
-	return err
-}
-
-// accountsListResponseData is the structure used internally to unmarshal
-// the response of the 'list' method.
-type accountsListResponseData struct {
-	Page  *int            "json:\"page,omitempty\""
-	Size  *int            "json:\"size,omitempty\""
-	Total *int            "json:\"total,omitempty\""
-	Items accountListData "json:\"items,omitempty\""
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			iterator.Skip()
+		case "page":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.page = &value
+			}
+		case "size":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.size = &value
+			}
+		case "total":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.total = &value
+			}
+		case "items":
+			if stream == nil {
+				r.items = readAccountList(iterator)
+				break
+			}
+			if !iterator.ReadArrayStart() {
+				break
+			}
+			for iterator.ReadArrayNext() {
+				item := readAccount(iterator)
+				if iterator.Error() != nil || !stream(item) {
+					return iterator.Error()
+				}
+			}
+		default:
+			iterator.SkipField(field)
+		}
+	}
+	return iterator.Error()
 }
 
 // AccountsAddRequest is the request for the 'add' method.
@@ -356,6 +481,7 @@ type AccountsAddRequest struct {
 	query     url.Values
 	header    http.Header
 	body      *Account
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -370,6 +496,17 @@ func (r *AccountsAddRequest) Header(name string, value interface{}) *AccountsAdd
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *AccountsAddRequest) Decoding(value helpers.DecodingOptions) *AccountsAddRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 // Account data.
@@ -417,14 +554,14 @@ func (r *AccountsAddRequest) SendContext(ctx context.Context) (result *AccountsA
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -434,14 +571,12 @@ func (r *AccountsAddRequest) SendContext(ctx context.Context) (result *AccountsA
 // marshall is the method used internally to marshal requests for the
 // 'add' method.
 func (r *AccountsAddRequest) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeAccount(r.body, stream)
+	return stream.Flush()
 }
 
 // AccountsAddResponse is the response for the 'add' method.
@@ -491,17 +626,29 @@ func (r *AccountsAddResponse) GetBody() (value *Account, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'add' method.
-func (r *AccountsAddResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountData)
-	err = decoder.Decode(data)
+func (r *AccountsAddResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readAccount(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *AccountsClient) Metadata() *helpers.ResourceMetadata {
+	return accountsResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *AccountsClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	return c.Account(segments[0]).route(segments[1:], append(ids, segments[0]))
 }
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAuthorizationRequestBuilder contains the data and logic needed to build 'cluster_authorization_request' objects.
 //
 //
@@ -30,6 +34,7 @@ type ClusterAuthorizationRequestBuilder struct {
 	byoc             *bool
 	availabilityZone *string
 	resources        []*ReservedResourceBuilder
+	extra            map[string]interface{}
 }
 
 // NewClusterAuthorizationRequest creates a new builder of 'cluster_authorization_request' objects.
@@ -37,6 +42,30 @@ func NewClusterAuthorizationRequest() *ClusterAuthorizationRequestBuilder {
 	return new(ClusterAuthorizationRequestBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_authorization_request' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterAuthorizationRequest) Builder() *ClusterAuthorizationRequestBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterAuthorizationRequestBuilder)
+	b.clusterID = o.clusterID
+	b.accountUsername = o.accountUsername
+	b.managed = o.managed
+	b.reserve = o.reserve
+	b.byoc = o.byoc
+	b.availabilityZone = o.availabilityZone
+	if o.resources != nil {
+		b.resources = make([]*ReservedResourceBuilder, len(o.resources.items))
+		for i, item := range o.resources.items {
+			b.resources[i] = item.Builder()
+		}
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ClusterID sets the value of the 'cluster_ID' attribute
 // to the given value.
 //
@@ -101,9 +130,17 @@ func (b *ClusterAuthorizationRequestBuilder) Resources(values ...*ReservedResour
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_authorization_request' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterAuthorizationRequestBuilder) Extra(value map[string]interface{}) *ClusterAuthorizationRequestBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_authorization_request' object using the configuration stored in the builder.
 func (b *ClusterAuthorizationRequestBuilder) Build() (object *ClusterAuthorizationRequest, err error) {
 	object = new(ClusterAuthorizationRequest)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.clusterID != nil {
 		object.clusterID = b.clusterID
 	}
@@ -30,6 +30,19 @@ func NewClusterAuthorizationRequestList() *ClusterAuthorizationRequestListBuilde
 	return new(ClusterAuthorizationRequestListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterAuthorizationRequestList) Builder() *ClusterAuthorizationRequestListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterAuthorizationRequestListBuilder)
+	b.items = make([]*ClusterAuthorizationRequestBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterAuthorizationRequestListBuilder) Items(values ...*ClusterAuthorizationRequestBuilder) *ClusterAuthorizationRequestListBuilder {
 	b.items = make([]*ClusterAuthorizationRequestBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAuthorizationRequestListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_authorization_request'.
-type clusterAuthorizationRequestListData []*clusterAuthorizationRequestData
-
 // UnmarshalClusterAuthorizationRequestList reads a list of values of the 'cluster_authorization_request'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterAuthorizationRequestList(source interface{}) (list *ClusterAuthorizationRequestList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterAuthorizationRequestList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterAuthorizationRequestList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterAuthorizationRequestListData
-	err = decoder.Decode(&data)
+	list = readClusterAuthorizationRequestList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_authorization_request' value to a JSON document.
-func (l *ClusterAuthorizationRequestList) wrap() (data clusterAuthorizationRequestListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterAuthorizationRequestList writes a list of values of the 'cluster_authorization_request' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterAuthorizationRequestList(list *ClusterAuthorizationRequestList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterAuthorizationRequestListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterAuthorizationRequestList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterAuthorizationRequestList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAuthorizationRequestList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAuthorizationRequestList function,
+// using the default decoding options.
+func (l *ClusterAuthorizationRequestList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterAuthorizationRequestList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterAuthorizationRequestList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_authorization_request' type.
-func (d clusterAuthorizationRequestListData) unwrap() (list *ClusterAuthorizationRequestList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterAuthorizationRequestList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterAuthorizationRequestList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterAuthorizationRequestList writes a list of values of the 'cluster_authorization_request' type to the given
+// stream, as a JSON array.
+func writeClusterAuthorizationRequestList(list *ClusterAuthorizationRequestList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterAuthorizationRequest, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterAuthorizationRequest(item, stream)
 	}
-	list = new(ClusterAuthorizationRequestList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterAuthorizationRequestList reads a list of values of the 'cluster_authorization_request' type from the given
+// iterator, as a JSON array.
+func readClusterAuthorizationRequestList(iterator *helpers.Iterator) *ClusterAuthorizationRequestList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterAuthorizationRequestList)
+	list.items = []*ClusterAuthorizationRequest{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterAuthorizationRequest(iterator))
+	}
+	return list
 }
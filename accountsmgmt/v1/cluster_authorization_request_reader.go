@@ -23,84 +23,170 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAuthorizationRequestData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_authorization_request'.
-type clusterAuthorizationRequestData struct {
-	ClusterID        *string                  "json:\"cluster_id,omitempty\""
-	AccountUsername  *string                  "json:\"account_username,omitempty\""
-	Managed          *bool                    "json:\"managed,omitempty\""
-	Reserve          *bool                    "json:\"reserve,omitempty\""
-	BYOC             *bool                    "json:\"byoc,omitempty\""
-	AvailabilityZone *string                  "json:\"availability_zone,omitempty\""
-	Resources        reservedResourceListData "json:\"resources,omitempty\""
-}
-
 // MarshalClusterAuthorizationRequest writes a value of the 'cluster_authorization_request' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterAuthorizationRequest(object *ClusterAuthorizationRequest, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterAuthorizationRequest(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_authorization_request'
-// value to a JSON document.
-func (o *ClusterAuthorizationRequest) wrap() (data *clusterAuthorizationRequestData, err error) {
-	if o == nil {
+// writeClusterAuthorizationRequest writes a value of the 'cluster_authorization_request' type to the given stream.
+func writeClusterAuthorizationRequest(object *ClusterAuthorizationRequest, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterAuthorizationRequestData)
-	data.ClusterID = o.clusterID
-	data.AccountUsername = o.accountUsername
-	data.Managed = o.managed
-	data.Reserve = o.reserve
-	data.BYOC = o.byoc
-	data.AvailabilityZone = o.availabilityZone
-	data.Resources, err = o.resources.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.clusterID != nil {
+		stream.WriteObjectField("cluster_id")
+		stream.WriteString(*object.clusterID)
 	}
-	return
+	if object.accountUsername != nil {
+		stream.WriteObjectField("account_username")
+		stream.WriteString(*object.accountUsername)
+	}
+	if object.managed != nil {
+		stream.WriteObjectField("managed")
+		stream.WriteBool(*object.managed)
+	}
+	if object.reserve != nil {
+		stream.WriteObjectField("reserve")
+		stream.WriteBool(*object.reserve)
+	}
+	if object.byoc != nil {
+		stream.WriteObjectField("byoc")
+		stream.WriteBool(*object.byoc)
+	}
+	if object.availabilityZone != nil {
+		stream.WriteObjectField("availability_zone")
+		stream.WriteString(*object.availabilityZone)
+	}
+	if object.resources != nil && len(object.resources.items) > 0 {
+		stream.WriteObjectField("resources")
+		writeReservedResourceList(object.resources, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterAuthorizationRequest reads a value of the 'cluster_authorization_request' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterAuthorizationRequest(source interface{}) (object *ClusterAuthorizationRequest, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterAuthorizationRequest(source interface{}, options ...helpers.DecodingOptions) (object *ClusterAuthorizationRequest, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterAuthorizationRequestData)
-	err = decoder.Decode(data)
+	object = readClusterAuthorizationRequest(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterAuthorizationRequest)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_authorization_request' type.
-func (d *clusterAuthorizationRequestData) unwrap() (object *ClusterAuthorizationRequest, err error) {
-	if d == nil {
-		return
+// readClusterAuthorizationRequest reads a value of the 'cluster_authorization_request' type from the given iterator.
+func readClusterAuthorizationRequest(iterator *helpers.Iterator) *ClusterAuthorizationRequest {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(ClusterAuthorizationRequest)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "cluster_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clusterID = &value
+			}
+		case "account_username":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.accountUsername = &value
+			}
+		case "managed":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.managed = &value
+			}
+		case "reserve":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.reserve = &value
+			}
+		case "byoc":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.byoc = &value
+			}
+		case "availability_zone":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.availabilityZone = &value
+			}
+		case "resources":
+			object.resources = readReservedResourceList(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
 	}
-	object = new(ClusterAuthorizationRequest)
-	object.clusterID = d.ClusterID
-	object.accountUsername = d.AccountUsername
-	object.managed = d.Managed
-	object.reserve = d.Reserve
-	object.byoc = d.BYOC
-	object.availabilityZone = d.AvailabilityZone
-	object.resources, err = d.Resources.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterAuthorizationRequest function.
+func (o *ClusterAuthorizationRequest) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAuthorizationRequest(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAuthorizationRequest function, using the
+// default decoding options.
+func (o *ClusterAuthorizationRequest) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterAuthorizationRequest(data)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if object == nil {
+		*o = ClusterAuthorizationRequest{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterAuthorizationRequest) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterAuthorizationRequest) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAuthorizationRequest represents the values of the 'cluster_authorization_request' type.
 //
 //
@@ -30,6 +36,7 @@ type ClusterAuthorizationRequest struct {
 	byoc             *bool
 	availabilityZone *string
 	resources        *ReservedResourceList
+	extra            map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -44,6 +51,59 @@ func (o *ClusterAuthorizationRequest) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_authorization_request' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterAuthorizationRequest) Metadata() *helpers.TypeMetadata {
+	return clusterAuthorizationRequestMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterAuthorizationRequest) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAuthorizationRequest(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterAuthorizationRequest) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAuthorizationRequest(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterAuthorizationRequest) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAuthorizationRequest(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterAuthorizationRequest) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAuthorizationRequest(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_authorization_request' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterAuthorizationRequest) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // ClusterID returns the value of the 'cluster_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -205,6 +265,243 @@ func (o *ClusterAuthorizationRequest) GetResources() (value *ReservedResourceLis
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_authorization_request' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterAuthorizationRequest) Copy() *ClusterAuthorizationRequest {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterAuthorizationRequest)
+	if o.clusterID != nil {
+		result.clusterID = new(string)
+		*result.clusterID = *o.clusterID
+	}
+	if o.accountUsername != nil {
+		result.accountUsername = new(string)
+		*result.accountUsername = *o.accountUsername
+	}
+	if o.managed != nil {
+		result.managed = new(bool)
+		*result.managed = *o.managed
+	}
+	if o.reserve != nil {
+		result.reserve = new(bool)
+		*result.reserve = *o.reserve
+	}
+	if o.byoc != nil {
+		result.byoc = new(bool)
+		*result.byoc = *o.byoc
+	}
+	if o.availabilityZone != nil {
+		result.availabilityZone = new(string)
+		*result.availabilityZone = *o.availabilityZone
+	}
+	result.resources = o.resources.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_authorization_request' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterAuthorizationRequest) Equal(other *ClusterAuthorizationRequest) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		return false
+	}
+	if (o.accountUsername == nil) != (other.accountUsername == nil) ||
+		(o.accountUsername != nil && *o.accountUsername != *other.accountUsername) {
+		return false
+	}
+	if (o.managed == nil) != (other.managed == nil) ||
+		(o.managed != nil && *o.managed != *other.managed) {
+		return false
+	}
+	if (o.reserve == nil) != (other.reserve == nil) ||
+		(o.reserve != nil && *o.reserve != *other.reserve) {
+		return false
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		return false
+	}
+	if (o.availabilityZone == nil) != (other.availabilityZone == nil) ||
+		(o.availabilityZone != nil && *o.availabilityZone != *other.availabilityZone) {
+		return false
+	}
+	if !o.resources.Equal(other.resources) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_authorization_request' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterAuthorizationRequest) Diff(other *ClusterAuthorizationRequest) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_authorization_request' object and the given one, using the given path as prefix.
+func (o *ClusterAuthorizationRequest) diff(path string, other *ClusterAuthorizationRequest, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "cluster_id"),
+			Old:  helpers.Value(o.clusterID),
+			New:  helpers.Value(other.clusterID),
+		})
+	}
+	if (o.accountUsername == nil) != (other.accountUsername == nil) ||
+		(o.accountUsername != nil && *o.accountUsername != *other.accountUsername) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "account_username"),
+			Old:  helpers.Value(o.accountUsername),
+			New:  helpers.Value(other.accountUsername),
+		})
+	}
+	if (o.managed == nil) != (other.managed == nil) ||
+		(o.managed != nil && *o.managed != *other.managed) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "managed"),
+			Old:  helpers.Value(o.managed),
+			New:  helpers.Value(other.managed),
+		})
+	}
+	if (o.reserve == nil) != (other.reserve == nil) ||
+		(o.reserve != nil && *o.reserve != *other.reserve) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "reserve"),
+			Old:  helpers.Value(o.reserve),
+			New:  helpers.Value(other.reserve),
+		})
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "byoc"),
+			Old:  helpers.Value(o.byoc),
+			New:  helpers.Value(other.byoc),
+		})
+	}
+	if (o.availabilityZone == nil) != (other.availabilityZone == nil) ||
+		(o.availabilityZone != nil && *o.availabilityZone != *other.availabilityZone) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "availability_zone"),
+			Old:  helpers.Value(o.availabilityZone),
+			New:  helpers.Value(other.availabilityZone),
+		})
+	}
+	result = o.resources.diff(helpers.Path(path, "resources"), other.resources, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_authorization_request' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterAuthorizationRequest) Changes(modified *ClusterAuthorizationRequest) *ClusterAuthorizationRequest {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterAuthorizationRequest)
+	if modified.clusterID != nil && (o.clusterID == nil || *o.clusterID != *modified.clusterID) {
+		result.clusterID = new(string)
+		*result.clusterID = *modified.clusterID
+	}
+	if modified.accountUsername != nil && (o.accountUsername == nil || *o.accountUsername != *modified.accountUsername) {
+		result.accountUsername = new(string)
+		*result.accountUsername = *modified.accountUsername
+	}
+	if modified.managed != nil && (o.managed == nil || *o.managed != *modified.managed) {
+		result.managed = new(bool)
+		*result.managed = *modified.managed
+	}
+	if modified.reserve != nil && (o.reserve == nil || *o.reserve != *modified.reserve) {
+		result.reserve = new(bool)
+		*result.reserve = *modified.reserve
+	}
+	if modified.byoc != nil && (o.byoc == nil || *o.byoc != *modified.byoc) {
+		result.byoc = new(bool)
+		*result.byoc = *modified.byoc
+	}
+	if modified.availabilityZone != nil && (o.availabilityZone == nil || *o.availabilityZone != *modified.availabilityZone) {
+		result.availabilityZone = new(string)
+		*result.availabilityZone = *modified.availabilityZone
+	}
+	if modified.resources != nil && !o.resources.Equal(modified.resources) {
+		result.resources = modified.resources.Copy()
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterAuthorizationRequest prints a value of the 'cluster_authorization_request' type to the given printer.
+func printClusterAuthorizationRequest(object *ClusterAuthorizationRequest, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterAuthorizationRequest")
+	if object.clusterID != nil {
+		printer.PrintObjectField("ClusterID")
+		printer.PrintString(*object.clusterID)
+	}
+	if object.accountUsername != nil {
+		printer.PrintObjectField("AccountUsername")
+		printer.PrintString(*object.accountUsername)
+	}
+	if object.managed != nil {
+		printer.PrintObjectField("Managed")
+		printer.PrintBool(*object.managed)
+	}
+	if object.reserve != nil {
+		printer.PrintObjectField("Reserve")
+		printer.PrintBool(*object.reserve)
+	}
+	if object.byoc != nil {
+		printer.PrintObjectField("BYOC")
+		printer.PrintBool(*object.byoc)
+	}
+	if object.availabilityZone != nil {
+		printer.PrintObjectField("AvailabilityZone")
+		printer.PrintString(*object.availabilityZone)
+	}
+	if object.resources != nil {
+		printer.PrintObjectField("Resources")
+		printReservedResourceList(object.resources, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterAuthorizationRequestList is a list of values of the 'cluster_authorization_request' type.
 type ClusterAuthorizationRequestList struct {
 	items []*ClusterAuthorizationRequest
@@ -276,3 +573,105 @@ func (l *ClusterAuthorizationRequestList) Range(f func(index int, item *ClusterA
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterAuthorizationRequestList) Copy() *ClusterAuthorizationRequestList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterAuthorizationRequestList)
+	if l.items != nil {
+		result.items = make([]*ClusterAuthorizationRequest, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterAuthorizationRequestList) Equal(other *ClusterAuthorizationRequestList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterAuthorizationRequestList) Diff(other *ClusterAuthorizationRequestList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterAuthorizationRequestList) diff(path string, other *ClusterAuthorizationRequestList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterAuthorizationRequestList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAuthorizationRequestList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterAuthorizationRequestList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAuthorizationRequestList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterAuthorizationRequestList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAuthorizationRequestList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterAuthorizationRequestList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAuthorizationRequestList(l, printer)
+	}
+}
+
+// printClusterAuthorizationRequestList prints a list of values of the 'cluster_authorization_request' type to the given printer.
+func printClusterAuthorizationRequestList(list *ClusterAuthorizationRequestList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterAuthorizationRequest(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAuthorizationResponseBuilder contains the data and logic needed to build 'cluster_authorization_response' objects.
 //
 //
@@ -26,6 +30,7 @@ type ClusterAuthorizationResponseBuilder struct {
 	allowed         *bool
 	excessResources []*ReservedResourceBuilder
 	subscription    *SubscriptionBuilder
+	extra           map[string]interface{}
 }
 
 // NewClusterAuthorizationResponse creates a new builder of 'cluster_authorization_response' objects.
@@ -33,6 +38,28 @@ func NewClusterAuthorizationResponse() *ClusterAuthorizationResponseBuilder {
 	return new(ClusterAuthorizationResponseBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_authorization_response' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterAuthorizationResponse) Builder() *ClusterAuthorizationResponseBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterAuthorizationResponseBuilder)
+	b.allowed = o.allowed
+	if o.excessResources != nil {
+		b.excessResources = make([]*ReservedResourceBuilder, len(o.excessResources.items))
+		for i, item := range o.excessResources.items {
+			b.excessResources[i] = item.Builder()
+		}
+	}
+	if o.subscription != nil {
+		b.subscription = o.subscription.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Allowed sets the value of the 'allowed' attribute
 // to the given value.
 //
@@ -61,9 +88,17 @@ func (b *ClusterAuthorizationResponseBuilder) Subscription(value *SubscriptionBu
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_authorization_response' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterAuthorizationResponseBuilder) Extra(value map[string]interface{}) *ClusterAuthorizationResponseBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_authorization_response' object using the configuration stored in the builder.
 func (b *ClusterAuthorizationResponseBuilder) Build() (object *ClusterAuthorizationResponse, err error) {
 	object = new(ClusterAuthorizationResponse)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.allowed != nil {
 		object.allowed = b.allowed
 	}
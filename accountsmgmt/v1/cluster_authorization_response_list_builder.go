@@ -30,6 +30,19 @@ func NewClusterAuthorizationResponseList() *ClusterAuthorizationResponseListBuil
 	return new(ClusterAuthorizationResponseListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterAuthorizationResponseList) Builder() *ClusterAuthorizationResponseListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterAuthorizationResponseListBuilder)
+	b.items = make([]*ClusterAuthorizationResponseBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterAuthorizationResponseListBuilder) Items(values ...*ClusterAuthorizationResponseBuilder) *ClusterAuthorizationResponseListBuilder {
 	b.items = make([]*ClusterAuthorizationResponseBuilder, len(values))
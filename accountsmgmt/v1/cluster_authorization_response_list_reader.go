@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAuthorizationResponseListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_authorization_response'.
-type clusterAuthorizationResponseListData []*clusterAuthorizationResponseData
-
 // UnmarshalClusterAuthorizationResponseList reads a list of values of the 'cluster_authorization_response'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterAuthorizationResponseList(source interface{}) (list *ClusterAuthorizationResponseList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterAuthorizationResponseList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterAuthorizationResponseList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterAuthorizationResponseListData
-	err = decoder.Decode(&data)
+	list = readClusterAuthorizationResponseList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_authorization_response' value to a JSON document.
-func (l *ClusterAuthorizationResponseList) wrap() (data clusterAuthorizationResponseListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterAuthorizationResponseList writes a list of values of the 'cluster_authorization_response' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterAuthorizationResponseList(list *ClusterAuthorizationResponseList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterAuthorizationResponseListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterAuthorizationResponseList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterAuthorizationResponseList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAuthorizationResponseList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAuthorizationResponseList function,
+// using the default decoding options.
+func (l *ClusterAuthorizationResponseList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterAuthorizationResponseList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterAuthorizationResponseList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_authorization_response' type.
-func (d clusterAuthorizationResponseListData) unwrap() (list *ClusterAuthorizationResponseList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterAuthorizationResponseList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterAuthorizationResponseList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterAuthorizationResponseList writes a list of values of the 'cluster_authorization_response' type to the given
+// stream, as a JSON array.
+func writeClusterAuthorizationResponseList(list *ClusterAuthorizationResponseList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterAuthorizationResponse, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterAuthorizationResponse(item, stream)
 	}
-	list = new(ClusterAuthorizationResponseList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterAuthorizationResponseList reads a list of values of the 'cluster_authorization_response' type from the given
+// iterator, as a JSON array.
+func readClusterAuthorizationResponseList(iterator *helpers.Iterator) *ClusterAuthorizationResponseList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterAuthorizationResponseList)
+	list.items = []*ClusterAuthorizationResponse{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterAuthorizationResponse(iterator))
+	}
+	return list
 }
@@ -23,78 +23,131 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAuthorizationResponseData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_authorization_response'.
-type clusterAuthorizationResponseData struct {
-	Allowed         *bool                    "json:\"allowed,omitempty\""
-	ExcessResources reservedResourceListData "json:\"excess_resources,omitempty\""
-	Subscription    *subscriptionData        "json:\"subscription,omitempty\""
-}
-
 // MarshalClusterAuthorizationResponse writes a value of the 'cluster_authorization_response' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterAuthorizationResponse(object *ClusterAuthorizationResponse, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterAuthorizationResponse(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_authorization_response'
-// value to a JSON document.
-func (o *ClusterAuthorizationResponse) wrap() (data *clusterAuthorizationResponseData, err error) {
-	if o == nil {
+// writeClusterAuthorizationResponse writes a value of the 'cluster_authorization_response' type to the given stream.
+func writeClusterAuthorizationResponse(object *ClusterAuthorizationResponse, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterAuthorizationResponseData)
-	data.Allowed = o.allowed
-	data.ExcessResources, err = o.excessResources.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.allowed != nil {
+		stream.WriteObjectField("allowed")
+		stream.WriteBool(*object.allowed)
 	}
-	data.Subscription, err = o.subscription.wrap()
-	if err != nil {
-		return
+	if object.excessResources != nil && len(object.excessResources.items) > 0 {
+		stream.WriteObjectField("excess_resources")
+		writeReservedResourceList(object.excessResources, stream)
 	}
-	return
+	if object.subscription != nil {
+		stream.WriteObjectField("subscription")
+		writeSubscription(object.subscription, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterAuthorizationResponse reads a value of the 'cluster_authorization_response' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterAuthorizationResponse(source interface{}) (object *ClusterAuthorizationResponse, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterAuthorizationResponse(source interface{}, options ...helpers.DecodingOptions) (object *ClusterAuthorizationResponse, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterAuthorizationResponseData)
-	err = decoder.Decode(data)
+	object = readClusterAuthorizationResponse(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterAuthorizationResponse)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_authorization_response' type.
-func (d *clusterAuthorizationResponseData) unwrap() (object *ClusterAuthorizationResponse, err error) {
-	if d == nil {
-		return
+// readClusterAuthorizationResponse reads a value of the 'cluster_authorization_response' type from the given iterator.
+func readClusterAuthorizationResponse(iterator *helpers.Iterator) *ClusterAuthorizationResponse {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(ClusterAuthorizationResponse)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "allowed":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.allowed = &value
+			}
+		case "excess_resources":
+			object.excessResources = readReservedResourceList(iterator)
+		case "subscription":
+			object.subscription = readSubscription(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterAuthorizationResponse function.
+func (o *ClusterAuthorizationResponse) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAuthorizationResponse(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAuthorizationResponse function, using the
+// default decoding options.
+func (o *ClusterAuthorizationResponse) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterAuthorizationResponse(data)
+	if err != nil {
+		return err
 	}
-	object = new(ClusterAuthorizationResponse)
-	object.allowed = d.Allowed
-	object.excessResources, err = d.ExcessResources.unwrap()
+	if object == nil {
+		*o = ClusterAuthorizationResponse{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterAuthorizationResponse) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.subscription, err = d.Subscription.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterAuthorizationResponse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
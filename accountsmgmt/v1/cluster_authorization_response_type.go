@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAuthorizationResponse represents the values of the 'cluster_authorization_response' type.
 //
 //
@@ -26,6 +32,7 @@ type ClusterAuthorizationResponse struct {
 	allowed         *bool
 	excessResources *ReservedResourceList
 	subscription    *Subscription
+	extra           map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -36,6 +43,59 @@ func (o *ClusterAuthorizationResponse) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_authorization_response' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterAuthorizationResponse) Metadata() *helpers.TypeMetadata {
+	return clusterAuthorizationResponseMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterAuthorizationResponse) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAuthorizationResponse(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterAuthorizationResponse) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAuthorizationResponse(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterAuthorizationResponse) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAuthorizationResponse(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterAuthorizationResponse) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAuthorizationResponse(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_authorization_response' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterAuthorizationResponse) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Allowed returns the value of the 'allowed' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -105,6 +165,135 @@ func (o *ClusterAuthorizationResponse) GetSubscription() (value *Subscription, o
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_authorization_response' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterAuthorizationResponse) Copy() *ClusterAuthorizationResponse {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterAuthorizationResponse)
+	if o.allowed != nil {
+		result.allowed = new(bool)
+		*result.allowed = *o.allowed
+	}
+	result.excessResources = o.excessResources.Copy()
+	result.subscription = o.subscription.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_authorization_response' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterAuthorizationResponse) Equal(other *ClusterAuthorizationResponse) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		return false
+	}
+	if !o.excessResources.Equal(other.excessResources) {
+		return false
+	}
+	if !o.subscription.Equal(other.subscription) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_authorization_response' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterAuthorizationResponse) Diff(other *ClusterAuthorizationResponse) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_authorization_response' object and the given one, using the given path as prefix.
+func (o *ClusterAuthorizationResponse) diff(path string, other *ClusterAuthorizationResponse, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "allowed"),
+			Old:  helpers.Value(o.allowed),
+			New:  helpers.Value(other.allowed),
+		})
+	}
+	result = o.excessResources.diff(helpers.Path(path, "excess_resources"), other.excessResources, result)
+	result = o.subscription.diff(helpers.Path(path, "subscription"), other.subscription, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_authorization_response' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterAuthorizationResponse) Changes(modified *ClusterAuthorizationResponse) *ClusterAuthorizationResponse {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterAuthorizationResponse)
+	if modified.allowed != nil && (o.allowed == nil || *o.allowed != *modified.allowed) {
+		result.allowed = new(bool)
+		*result.allowed = *modified.allowed
+	}
+	if modified.excessResources != nil && !o.excessResources.Equal(modified.excessResources) {
+		result.excessResources = modified.excessResources.Copy()
+	}
+	if modified.subscription != nil && !o.subscription.Equal(modified.subscription) {
+		result.subscription = o.subscription.Changes(modified.subscription)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterAuthorizationResponse prints a value of the 'cluster_authorization_response' type to the given printer.
+func printClusterAuthorizationResponse(object *ClusterAuthorizationResponse, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterAuthorizationResponse")
+	if object.allowed != nil {
+		printer.PrintObjectField("Allowed")
+		printer.PrintBool(*object.allowed)
+	}
+	if object.excessResources != nil {
+		printer.PrintObjectField("ExcessResources")
+		printReservedResourceList(object.excessResources, printer)
+	}
+	if object.subscription != nil {
+		printer.PrintObjectField("Subscription")
+		printSubscription(object.subscription, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterAuthorizationResponseList is a list of values of the 'cluster_authorization_response' type.
 type ClusterAuthorizationResponseList struct {
 	items []*ClusterAuthorizationResponse
@@ -176,3 +365,105 @@ func (l *ClusterAuthorizationResponseList) Range(f func(index int, item *Cluster
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterAuthorizationResponseList) Copy() *ClusterAuthorizationResponseList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterAuthorizationResponseList)
+	if l.items != nil {
+		result.items = make([]*ClusterAuthorizationResponse, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterAuthorizationResponseList) Equal(other *ClusterAuthorizationResponseList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterAuthorizationResponseList) Diff(other *ClusterAuthorizationResponseList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterAuthorizationResponseList) diff(path string, other *ClusterAuthorizationResponseList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterAuthorizationResponseList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAuthorizationResponseList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterAuthorizationResponseList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAuthorizationResponseList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterAuthorizationResponseList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAuthorizationResponseList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterAuthorizationResponseList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAuthorizationResponseList(l, printer)
+	}
+}
+
+// printClusterAuthorizationResponseList prints a list of values of the 'cluster_authorization_response' type to the given printer.
+func printClusterAuthorizationResponseList(list *ClusterAuthorizationResponseList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterAuthorizationResponse(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -71,6 +71,7 @@ type ClusterAuthorizationsPostRequest struct {
 	query     url.Values
 	header    http.Header
 	request   *ClusterAuthorizationRequest
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -85,6 +86,17 @@ func (r *ClusterAuthorizationsPostRequest) Header(name string, value interface{}
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ClusterAuthorizationsPostRequest) Decoding(value helpers.DecodingOptions) *ClusterAuthorizationsPostRequest {
+	r.decoding = value
+	return r
+}
+
 // Request sets the value of the 'request' parameter.
 //
 //
@@ -132,14 +144,14 @@ func (r *ClusterAuthorizationsPostRequest) SendContext(ctx context.Context) (res
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -149,14 +161,12 @@ func (r *ClusterAuthorizationsPostRequest) SendContext(ctx context.Context) (res
 // marshall is the method used internally to marshal requests for the
 // 'post' method.
 func (r *ClusterAuthorizationsPostRequest) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.request.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeClusterAuthorizationRequest(r.request, stream)
+	return stream.Flush()
 }
 
 // ClusterAuthorizationsPostResponse is the response for the 'post' method.
@@ -206,17 +216,30 @@ func (r *ClusterAuthorizationsPostResponse) GetResponse() (value *ClusterAuthori
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'post' method.
-func (r *ClusterAuthorizationsPostResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterAuthorizationResponseData)
-	err = decoder.Decode(data)
+func (r *ClusterAuthorizationsPostResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.response, err = data.unwrap()
-	if err != nil {
-		return err
+	r.response = readClusterAuthorizationResponse(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *ClusterAuthorizationsClient) Metadata() *helpers.ResourceMetadata {
+	return clusterAuthorizationsResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *ClusterAuthorizationsClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
 }
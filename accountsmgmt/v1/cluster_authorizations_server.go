@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterAuthorizationsServer represents the interface the manages the 'cluster_authorizations' resource.
@@ -72,18 +74,12 @@ func (r *ClusterAuthorizationsPostServerRequest) GetRequest() (value *ClusterAut
 // unmarshal is the method used internally to unmarshal request to the
 // 'post' method.
 func (r *ClusterAuthorizationsPostServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterAuthorizationRequestData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.request, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.request = readClusterAuthorizationRequest(iterator)
+	return iterator.Error()
 }
 
 // ClusterAuthorizationsPostServerResponse is the response for the 'post' method.
@@ -110,33 +106,65 @@ func (r *ClusterAuthorizationsPostServerResponse) SetStatusCode(status int) *Clu
 // marshall is the method used internally to marshal responses for the
 // 'post' method.
 func (r *ClusterAuthorizationsPostServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.response.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeClusterAuthorizationResponse(r.response, stream)
+	return stream.Flush()
 }
 
-// ClusterAuthorizationsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// ClusterAuthorizationsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type ClusterAuthorizationsServerAdapter struct {
 	server ClusterAuthorizationsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewClusterAuthorizationsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewClusterAuthorizationsServerAdapter(server ClusterAuthorizationsServer, router *mux.Router) *ClusterAuthorizationsServerAdapter {
 	adapter := new(ClusterAuthorizationsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("POST").HandlerFunc(adapter.postHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *ClusterAuthorizationsServerAdapter) readClusterAuthorizationsPostServerRequest(r *http.Request) (*ClusterAuthorizationsPostServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *ClusterAuthorizationsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchClusterAuthorizations(w, r, a.server, segments)
+}
+
+// dispatchClusterAuthorizations navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchClusterAuthorizations(w http.ResponseWriter, r *http.Request, server ClusterAuthorizationsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodPost:
+			adaptClusterAuthorizationsPostRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readClusterAuthorizationsPostServerRequest(r *http.Request) (*ClusterAuthorizationsPostServerRequest, error) {
 	result := new(ClusterAuthorizationsPostServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -144,36 +172,42 @@ func (a *ClusterAuthorizationsServerAdapter) readClusterAuthorizationsPostServer
 	}
 	return result, nil
 }
-func (a *ClusterAuthorizationsServerAdapter) writeClusterAuthorizationsPostServerResponse(w http.ResponseWriter, r *ClusterAuthorizationsPostServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClusterAuthorizationsPostServerResponse(w http.ResponseWriter, r *ClusterAuthorizationsPostServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClusterAuthorizationsServerAdapter) postHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClusterAuthorizationsPostServerRequest(r)
+func adaptClusterAuthorizationsPostRequest(w http.ResponseWriter, r *http.Request, server ClusterAuthorizationsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClusterAuthorizationsPostServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClusterAuthorizationsPostServerResponse)
-	err = a.server.Post(r.Context(), req, resp)
+	err = server.Post(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Post: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Post", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeClusterAuthorizationsPostServerResponse(w, resp)
+	err = writeClusterAuthorizationsPostServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistrationRequestBuilder contains the data and logic needed to build 'cluster_registration_request' objects.
 //
 //
 type ClusterRegistrationRequestBuilder struct {
 	clusterID          *string
 	authorizationToken *string
+	extra              map[string]interface{}
 }
 
 // NewClusterRegistrationRequest creates a new builder of 'cluster_registration_request' objects.
@@ -32,6 +37,20 @@ func NewClusterRegistrationRequest() *ClusterRegistrationRequestBuilder {
 	return new(ClusterRegistrationRequestBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_registration_request' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterRegistrationRequest) Builder() *ClusterRegistrationRequestBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationRequestBuilder)
+	b.clusterID = o.clusterID
+	b.authorizationToken = o.authorizationToken
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ClusterID sets the value of the 'cluster_ID' attribute
 // to the given value.
 //
@@ -50,9 +69,17 @@ func (b *ClusterRegistrationRequestBuilder) AuthorizationToken(value string) *Cl
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_registration_request' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterRegistrationRequestBuilder) Extra(value map[string]interface{}) *ClusterRegistrationRequestBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_registration_request' object using the configuration stored in the builder.
 func (b *ClusterRegistrationRequestBuilder) Build() (object *ClusterRegistrationRequest, err error) {
 	object = new(ClusterRegistrationRequest)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.clusterID != nil {
 		object.clusterID = b.clusterID
 	}
@@ -30,6 +30,19 @@ func NewClusterRegistrationRequestList() *ClusterRegistrationRequestListBuilder
 	return new(ClusterRegistrationRequestListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterRegistrationRequestList) Builder() *ClusterRegistrationRequestListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationRequestListBuilder)
+	b.items = make([]*ClusterRegistrationRequestBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterRegistrationRequestListBuilder) Items(values ...*ClusterRegistrationRequestBuilder) *ClusterRegistrationRequestListBuilder {
 	b.items = make([]*ClusterRegistrationRequestBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationRequestListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_registration_request'.
-type clusterRegistrationRequestListData []*clusterRegistrationRequestData
-
 // UnmarshalClusterRegistrationRequestList reads a list of values of the 'cluster_registration_request'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterRegistrationRequestList(source interface{}) (list *ClusterRegistrationRequestList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterRegistrationRequestList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterRegistrationRequestList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterRegistrationRequestListData
-	err = decoder.Decode(&data)
+	list = readClusterRegistrationRequestList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_registration_request' value to a JSON document.
-func (l *ClusterRegistrationRequestList) wrap() (data clusterRegistrationRequestListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterRegistrationRequestList writes a list of values of the 'cluster_registration_request' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterRegistrationRequestList(list *ClusterRegistrationRequestList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterRegistrationRequestListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterRegistrationRequestList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterRegistrationRequestList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistrationRequestList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistrationRequestList function,
+// using the default decoding options.
+func (l *ClusterRegistrationRequestList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterRegistrationRequestList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterRegistrationRequestList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_registration_request' type.
-func (d clusterRegistrationRequestListData) unwrap() (list *ClusterRegistrationRequestList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterRegistrationRequestList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterRegistrationRequestList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterRegistrationRequestList writes a list of values of the 'cluster_registration_request' type to the given
+// stream, as a JSON array.
+func writeClusterRegistrationRequestList(list *ClusterRegistrationRequestList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterRegistrationRequest, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterRegistrationRequest(item, stream)
 	}
-	list = new(ClusterRegistrationRequestList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterRegistrationRequestList reads a list of values of the 'cluster_registration_request' type from the given
+// iterator, as a JSON array.
+func readClusterRegistrationRequestList(iterator *helpers.Iterator) *ClusterRegistrationRequestList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterRegistrationRequestList)
+	list.items = []*ClusterRegistrationRequest{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterRegistrationRequest(iterator))
+	}
+	return list
 }
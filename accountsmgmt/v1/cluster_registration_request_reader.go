@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationRequestData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_registration_request'.
-type clusterRegistrationRequestData struct {
-	ClusterID          *string "json:\"cluster_id,omitempty\""
-	AuthorizationToken *string "json:\"authorization_token,omitempty\""
-}
-
 // MarshalClusterRegistrationRequest writes a value of the 'cluster_registration_request' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterRegistrationRequest(object *ClusterRegistrationRequest, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterRegistrationRequest(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_registration_request'
-// value to a JSON document.
-func (o *ClusterRegistrationRequest) wrap() (data *clusterRegistrationRequestData, err error) {
-	if o == nil {
+// writeClusterRegistrationRequest writes a value of the 'cluster_registration_request' type to the given stream.
+func writeClusterRegistrationRequest(object *ClusterRegistrationRequest, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterRegistrationRequestData)
-	data.ClusterID = o.clusterID
-	data.AuthorizationToken = o.authorizationToken
-	return
+	stream.WriteObjectStart()
+	if object.clusterID != nil {
+		stream.WriteObjectField("cluster_id")
+		stream.WriteString(*object.clusterID)
+	}
+	if object.authorizationToken != nil {
+		stream.WriteObjectField("authorization_token")
+		stream.WriteString(*object.authorizationToken)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterRegistrationRequest reads a value of the 'cluster_registration_request' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterRegistrationRequest(source interface{}) (object *ClusterRegistrationRequest, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterRegistrationRequest(source interface{}, options ...helpers.DecodingOptions) (object *ClusterRegistrationRequest, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterRegistrationRequestData)
-	err = decoder.Decode(data)
+	object = readClusterRegistrationRequest(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterRegistrationRequest)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_registration_request' type.
-func (d *clusterRegistrationRequestData) unwrap() (object *ClusterRegistrationRequest, err error) {
-	if d == nil {
-		return
+// readClusterRegistrationRequest reads a value of the 'cluster_registration_request' type from the given iterator.
+func readClusterRegistrationRequest(iterator *helpers.Iterator) *ClusterRegistrationRequest {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterRegistrationRequest)
-	object.clusterID = d.ClusterID
-	object.authorizationToken = d.AuthorizationToken
-	return
+	object := new(ClusterRegistrationRequest)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "cluster_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clusterID = &value
+			}
+		case "authorization_token":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.authorizationToken = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterRegistrationRequest function.
+func (o *ClusterRegistrationRequest) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistrationRequest(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistrationRequest function, using the
+// default decoding options.
+func (o *ClusterRegistrationRequest) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterRegistrationRequest(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterRegistrationRequest{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterRegistrationRequest) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterRegistrationRequest) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,12 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistrationRequest represents the values of the 'cluster_registration_request' type.
 //
 //
 type ClusterRegistrationRequest struct {
 	clusterID          *string
 	authorizationToken *string
+	extra              map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -34,6 +41,59 @@ func (o *ClusterRegistrationRequest) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_registration_request' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterRegistrationRequest) Metadata() *helpers.TypeMetadata {
+	return clusterRegistrationRequestMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterRegistrationRequest) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistrationRequest(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterRegistrationRequest) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistrationRequest(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterRegistrationRequest) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistrationRequest(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterRegistrationRequest) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistrationRequest(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_registration_request' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterRegistrationRequest) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // ClusterID returns the value of the 'cluster_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -80,6 +140,135 @@ func (o *ClusterRegistrationRequest) GetAuthorizationToken() (value string, ok b
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_registration_request' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterRegistrationRequest) Copy() *ClusterRegistrationRequest {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterRegistrationRequest)
+	if o.clusterID != nil {
+		result.clusterID = new(string)
+		*result.clusterID = *o.clusterID
+	}
+	if o.authorizationToken != nil {
+		result.authorizationToken = new(string)
+		*result.authorizationToken = *o.authorizationToken
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_registration_request' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterRegistrationRequest) Equal(other *ClusterRegistrationRequest) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		return false
+	}
+	if (o.authorizationToken == nil) != (other.authorizationToken == nil) ||
+		(o.authorizationToken != nil && *o.authorizationToken != *other.authorizationToken) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_registration_request' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterRegistrationRequest) Diff(other *ClusterRegistrationRequest) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_registration_request' object and the given one, using the given path as prefix.
+func (o *ClusterRegistrationRequest) diff(path string, other *ClusterRegistrationRequest, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "cluster_id"),
+			Old:  helpers.Value(o.clusterID),
+			New:  helpers.Value(other.clusterID),
+		})
+	}
+	if (o.authorizationToken == nil) != (other.authorizationToken == nil) ||
+		(o.authorizationToken != nil && *o.authorizationToken != *other.authorizationToken) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "authorization_token"),
+			Old:  helpers.Value(o.authorizationToken),
+			New:  helpers.Value(other.authorizationToken),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_registration_request' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterRegistrationRequest) Changes(modified *ClusterRegistrationRequest) *ClusterRegistrationRequest {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterRegistrationRequest)
+	if modified.clusterID != nil && (o.clusterID == nil || *o.clusterID != *modified.clusterID) {
+		result.clusterID = new(string)
+		*result.clusterID = *modified.clusterID
+	}
+	if modified.authorizationToken != nil && (o.authorizationToken == nil || *o.authorizationToken != *modified.authorizationToken) {
+		result.authorizationToken = new(string)
+		*result.authorizationToken = *modified.authorizationToken
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterRegistrationRequest prints a value of the 'cluster_registration_request' type to the given printer.
+func printClusterRegistrationRequest(object *ClusterRegistrationRequest, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterRegistrationRequest")
+	if object.clusterID != nil {
+		printer.PrintObjectField("ClusterID")
+		printer.PrintString(*object.clusterID)
+	}
+	if object.authorizationToken != nil {
+		printer.PrintObjectField("AuthorizationToken")
+		printer.PrintSensitive(*object.authorizationToken)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterRegistrationRequestList is a list of values of the 'cluster_registration_request' type.
 type ClusterRegistrationRequestList struct {
 	items []*ClusterRegistrationRequest
@@ -151,3 +340,105 @@ func (l *ClusterRegistrationRequestList) Range(f func(index int, item *ClusterRe
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterRegistrationRequestList) Copy() *ClusterRegistrationRequestList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterRegistrationRequestList)
+	if l.items != nil {
+		result.items = make([]*ClusterRegistrationRequest, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterRegistrationRequestList) Equal(other *ClusterRegistrationRequestList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterRegistrationRequestList) Diff(other *ClusterRegistrationRequestList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterRegistrationRequestList) diff(path string, other *ClusterRegistrationRequestList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterRegistrationRequestList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistrationRequestList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterRegistrationRequestList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistrationRequestList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterRegistrationRequestList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistrationRequestList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterRegistrationRequestList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistrationRequestList(l, printer)
+	}
+}
+
+// printClusterRegistrationRequestList prints a list of values of the 'cluster_registration_request' type to the given printer.
+func printClusterRegistrationRequestList(list *ClusterRegistrationRequestList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterRegistrationRequest(item, printer)
+	}
+	printer.PrintListEnd()
+}
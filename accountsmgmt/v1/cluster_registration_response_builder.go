@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistrationResponseBuilder contains the data and logic needed to build 'cluster_registration_response' objects.
 //
 //
@@ -27,6 +31,7 @@ type ClusterRegistrationResponseBuilder struct {
 	authorizationToken *string
 	accountID          *string
 	expiresAt          *string
+	extra              map[string]interface{}
 }
 
 // NewClusterRegistrationResponse creates a new builder of 'cluster_registration_response' objects.
@@ -34,6 +39,22 @@ func NewClusterRegistrationResponse() *ClusterRegistrationResponseBuilder {
 	return new(ClusterRegistrationResponseBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_registration_response' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterRegistrationResponse) Builder() *ClusterRegistrationResponseBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationResponseBuilder)
+	b.clusterID = o.clusterID
+	b.authorizationToken = o.authorizationToken
+	b.accountID = o.accountID
+	b.expiresAt = o.expiresAt
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ClusterID sets the value of the 'cluster_ID' attribute
 // to the given value.
 //
@@ -70,9 +91,17 @@ func (b *ClusterRegistrationResponseBuilder) ExpiresAt(value string) *ClusterReg
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_registration_response' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterRegistrationResponseBuilder) Extra(value map[string]interface{}) *ClusterRegistrationResponseBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_registration_response' object using the configuration stored in the builder.
 func (b *ClusterRegistrationResponseBuilder) Build() (object *ClusterRegistrationResponse, err error) {
 	object = new(ClusterRegistrationResponse)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.clusterID != nil {
 		object.clusterID = b.clusterID
 	}
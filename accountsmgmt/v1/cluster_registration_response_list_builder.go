@@ -30,6 +30,19 @@ func NewClusterRegistrationResponseList() *ClusterRegistrationResponseListBuilde
 	return new(ClusterRegistrationResponseListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterRegistrationResponseList) Builder() *ClusterRegistrationResponseListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationResponseListBuilder)
+	b.items = make([]*ClusterRegistrationResponseBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterRegistrationResponseListBuilder) Items(values ...*ClusterRegistrationResponseBuilder) *ClusterRegistrationResponseListBuilder {
 	b.items = make([]*ClusterRegistrationResponseBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationResponseListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_registration_response'.
-type clusterRegistrationResponseListData []*clusterRegistrationResponseData
-
 // UnmarshalClusterRegistrationResponseList reads a list of values of the 'cluster_registration_response'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterRegistrationResponseList(source interface{}) (list *ClusterRegistrationResponseList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterRegistrationResponseList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterRegistrationResponseList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterRegistrationResponseListData
-	err = decoder.Decode(&data)
+	list = readClusterRegistrationResponseList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_registration_response' value to a JSON document.
-func (l *ClusterRegistrationResponseList) wrap() (data clusterRegistrationResponseListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterRegistrationResponseList writes a list of values of the 'cluster_registration_response' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterRegistrationResponseList(list *ClusterRegistrationResponseList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterRegistrationResponseListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterRegistrationResponseList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterRegistrationResponseList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistrationResponseList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistrationResponseList function,
+// using the default decoding options.
+func (l *ClusterRegistrationResponseList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterRegistrationResponseList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterRegistrationResponseList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_registration_response' type.
-func (d clusterRegistrationResponseListData) unwrap() (list *ClusterRegistrationResponseList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterRegistrationResponseList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterRegistrationResponseList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterRegistrationResponseList writes a list of values of the 'cluster_registration_response' type to the given
+// stream, as a JSON array.
+func writeClusterRegistrationResponseList(list *ClusterRegistrationResponseList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterRegistrationResponse, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterRegistrationResponse(item, stream)
 	}
-	list = new(ClusterRegistrationResponseList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterRegistrationResponseList reads a list of values of the 'cluster_registration_response' type from the given
+// iterator, as a JSON array.
+func readClusterRegistrationResponseList(iterator *helpers.Iterator) *ClusterRegistrationResponseList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterRegistrationResponseList)
+	list.items = []*ClusterRegistrationResponse{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterRegistrationResponse(iterator))
+	}
+	return list
 }
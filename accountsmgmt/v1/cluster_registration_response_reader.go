@@ -23,69 +23,146 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationResponseData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_registration_response'.
-type clusterRegistrationResponseData struct {
-	ClusterID          *string "json:\"cluster_id,omitempty\""
-	AuthorizationToken *string "json:\"authorization_token,omitempty\""
-	AccountID          *string "json:\"account_id,omitempty\""
-	ExpiresAt          *string "json:\"expires_at,omitempty\""
-}
-
 // MarshalClusterRegistrationResponse writes a value of the 'cluster_registration_response' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterRegistrationResponse(object *ClusterRegistrationResponse, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterRegistrationResponse(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_registration_response'
-// value to a JSON document.
-func (o *ClusterRegistrationResponse) wrap() (data *clusterRegistrationResponseData, err error) {
-	if o == nil {
+// writeClusterRegistrationResponse writes a value of the 'cluster_registration_response' type to the given stream.
+func writeClusterRegistrationResponse(object *ClusterRegistrationResponse, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterRegistrationResponseData)
-	data.ClusterID = o.clusterID
-	data.AuthorizationToken = o.authorizationToken
-	data.AccountID = o.accountID
-	data.ExpiresAt = o.expiresAt
-	return
+	stream.WriteObjectStart()
+	if object.clusterID != nil {
+		stream.WriteObjectField("cluster_id")
+		stream.WriteString(*object.clusterID)
+	}
+	if object.authorizationToken != nil {
+		stream.WriteObjectField("authorization_token")
+		stream.WriteString(*object.authorizationToken)
+	}
+	if object.accountID != nil {
+		stream.WriteObjectField("account_id")
+		stream.WriteString(*object.accountID)
+	}
+	if object.expiresAt != nil {
+		stream.WriteObjectField("expires_at")
+		stream.WriteString(*object.expiresAt)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterRegistrationResponse reads a value of the 'cluster_registration_response' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterRegistrationResponse(source interface{}) (object *ClusterRegistrationResponse, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterRegistrationResponse(source interface{}, options ...helpers.DecodingOptions) (object *ClusterRegistrationResponse, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterRegistrationResponseData)
-	err = decoder.Decode(data)
+	object = readClusterRegistrationResponse(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterRegistrationResponse)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_registration_response' type.
-func (d *clusterRegistrationResponseData) unwrap() (object *ClusterRegistrationResponse, err error) {
-	if d == nil {
-		return
+// readClusterRegistrationResponse reads a value of the 'cluster_registration_response' type from the given iterator.
+func readClusterRegistrationResponse(iterator *helpers.Iterator) *ClusterRegistrationResponse {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterRegistrationResponse)
-	object.clusterID = d.ClusterID
-	object.authorizationToken = d.AuthorizationToken
-	object.accountID = d.AccountID
-	object.expiresAt = d.ExpiresAt
-	return
+	object := new(ClusterRegistrationResponse)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "cluster_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clusterID = &value
+			}
+		case "authorization_token":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.authorizationToken = &value
+			}
+		case "account_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.accountID = &value
+			}
+		case "expires_at":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.expiresAt = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterRegistrationResponse function.
+func (o *ClusterRegistrationResponse) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistrationResponse(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistrationResponse function, using the
+// default decoding options.
+func (o *ClusterRegistrationResponse) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterRegistrationResponse(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterRegistrationResponse{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterRegistrationResponse) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterRegistrationResponse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistrationResponse represents the values of the 'cluster_registration_response' type.
 //
 //
@@ -27,6 +33,7 @@ type ClusterRegistrationResponse struct {
 	authorizationToken *string
 	accountID          *string
 	expiresAt          *string
+	extra              map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +45,59 @@ func (o *ClusterRegistrationResponse) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_registration_response' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterRegistrationResponse) Metadata() *helpers.TypeMetadata {
+	return clusterRegistrationResponseMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterRegistrationResponse) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistrationResponse(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterRegistrationResponse) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistrationResponse(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterRegistrationResponse) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistrationResponse(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterRegistrationResponse) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistrationResponse(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_registration_response' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterRegistrationResponse) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // ClusterID returns the value of the 'cluster_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -130,6 +190,183 @@ func (o *ClusterRegistrationResponse) GetExpiresAt() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_registration_response' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterRegistrationResponse) Copy() *ClusterRegistrationResponse {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterRegistrationResponse)
+	if o.clusterID != nil {
+		result.clusterID = new(string)
+		*result.clusterID = *o.clusterID
+	}
+	if o.authorizationToken != nil {
+		result.authorizationToken = new(string)
+		*result.authorizationToken = *o.authorizationToken
+	}
+	if o.accountID != nil {
+		result.accountID = new(string)
+		*result.accountID = *o.accountID
+	}
+	if o.expiresAt != nil {
+		result.expiresAt = new(string)
+		*result.expiresAt = *o.expiresAt
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_registration_response' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterRegistrationResponse) Equal(other *ClusterRegistrationResponse) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		return false
+	}
+	if (o.authorizationToken == nil) != (other.authorizationToken == nil) ||
+		(o.authorizationToken != nil && *o.authorizationToken != *other.authorizationToken) {
+		return false
+	}
+	if (o.accountID == nil) != (other.accountID == nil) ||
+		(o.accountID != nil && *o.accountID != *other.accountID) {
+		return false
+	}
+	if (o.expiresAt == nil) != (other.expiresAt == nil) ||
+		(o.expiresAt != nil && *o.expiresAt != *other.expiresAt) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_registration_response' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterRegistrationResponse) Diff(other *ClusterRegistrationResponse) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_registration_response' object and the given one, using the given path as prefix.
+func (o *ClusterRegistrationResponse) diff(path string, other *ClusterRegistrationResponse, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "cluster_id"),
+			Old:  helpers.Value(o.clusterID),
+			New:  helpers.Value(other.clusterID),
+		})
+	}
+	if (o.authorizationToken == nil) != (other.authorizationToken == nil) ||
+		(o.authorizationToken != nil && *o.authorizationToken != *other.authorizationToken) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "authorization_token"),
+			Old:  helpers.Value(o.authorizationToken),
+			New:  helpers.Value(other.authorizationToken),
+		})
+	}
+	if (o.accountID == nil) != (other.accountID == nil) ||
+		(o.accountID != nil && *o.accountID != *other.accountID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "account_id"),
+			Old:  helpers.Value(o.accountID),
+			New:  helpers.Value(other.accountID),
+		})
+	}
+	if (o.expiresAt == nil) != (other.expiresAt == nil) ||
+		(o.expiresAt != nil && *o.expiresAt != *other.expiresAt) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "expires_at"),
+			Old:  helpers.Value(o.expiresAt),
+			New:  helpers.Value(other.expiresAt),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_registration_response' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterRegistrationResponse) Changes(modified *ClusterRegistrationResponse) *ClusterRegistrationResponse {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterRegistrationResponse)
+	if modified.clusterID != nil && (o.clusterID == nil || *o.clusterID != *modified.clusterID) {
+		result.clusterID = new(string)
+		*result.clusterID = *modified.clusterID
+	}
+	if modified.authorizationToken != nil && (o.authorizationToken == nil || *o.authorizationToken != *modified.authorizationToken) {
+		result.authorizationToken = new(string)
+		*result.authorizationToken = *modified.authorizationToken
+	}
+	if modified.accountID != nil && (o.accountID == nil || *o.accountID != *modified.accountID) {
+		result.accountID = new(string)
+		*result.accountID = *modified.accountID
+	}
+	if modified.expiresAt != nil && (o.expiresAt == nil || *o.expiresAt != *modified.expiresAt) {
+		result.expiresAt = new(string)
+		*result.expiresAt = *modified.expiresAt
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterRegistrationResponse prints a value of the 'cluster_registration_response' type to the given printer.
+func printClusterRegistrationResponse(object *ClusterRegistrationResponse, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterRegistrationResponse")
+	if object.clusterID != nil {
+		printer.PrintObjectField("ClusterID")
+		printer.PrintString(*object.clusterID)
+	}
+	if object.authorizationToken != nil {
+		printer.PrintObjectField("AuthorizationToken")
+		printer.PrintSensitive(*object.authorizationToken)
+	}
+	if object.accountID != nil {
+		printer.PrintObjectField("AccountID")
+		printer.PrintString(*object.accountID)
+	}
+	if object.expiresAt != nil {
+		printer.PrintObjectField("ExpiresAt")
+		printer.PrintString(*object.expiresAt)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterRegistrationResponseList is a list of values of the 'cluster_registration_response' type.
 type ClusterRegistrationResponseList struct {
 	items []*ClusterRegistrationResponse
@@ -201,3 +438,105 @@ func (l *ClusterRegistrationResponseList) Range(f func(index int, item *ClusterR
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterRegistrationResponseList) Copy() *ClusterRegistrationResponseList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterRegistrationResponseList)
+	if l.items != nil {
+		result.items = make([]*ClusterRegistrationResponse, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterRegistrationResponseList) Equal(other *ClusterRegistrationResponseList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterRegistrationResponseList) Diff(other *ClusterRegistrationResponseList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterRegistrationResponseList) diff(path string, other *ClusterRegistrationResponseList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterRegistrationResponseList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistrationResponseList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterRegistrationResponseList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistrationResponseList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterRegistrationResponseList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistrationResponseList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterRegistrationResponseList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistrationResponseList(l, printer)
+	}
+}
+
+// printClusterRegistrationResponseList prints a list of values of the 'cluster_registration_response' type to the given printer.
+func printClusterRegistrationResponseList(list *ClusterRegistrationResponseList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterRegistrationResponse(item, printer)
+	}
+	printer.PrintListEnd()
+}
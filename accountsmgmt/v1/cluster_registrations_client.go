@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -72,6 +72,7 @@ type ClusterRegistrationsPostRequest struct {
 	query     url.Values
 	header    http.Header
 	request   *ClusterRegistrationRequest
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -86,6 +87,17 @@ func (r *ClusterRegistrationsPostRequest) Header(name string, value interface{})
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ClusterRegistrationsPostRequest) Decoding(value helpers.DecodingOptions) *ClusterRegistrationsPostRequest {
+	r.decoding = value
+	return r
+}
+
 // Request sets the value of the 'request' parameter.
 //
 //
@@ -133,14 +145,14 @@ func (r *ClusterRegistrationsPostRequest) SendContext(ctx context.Context) (resu
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -150,14 +162,12 @@ func (r *ClusterRegistrationsPostRequest) SendContext(ctx context.Context) (resu
 // marshall is the method used internally to marshal requests for the
 // 'post' method.
 func (r *ClusterRegistrationsPostRequest) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.request.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeClusterRegistrationRequest(r.request, stream)
+	return stream.Flush()
 }
 
 // ClusterRegistrationsPostResponse is the response for the 'post' method.
@@ -207,17 +217,30 @@ func (r *ClusterRegistrationsPostResponse) GetResponse() (value *ClusterRegistra
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'post' method.
-func (r *ClusterRegistrationsPostResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterRegistrationResponseData)
-	err = decoder.Decode(data)
+func (r *ClusterRegistrationsPostResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.response, err = data.unwrap()
-	if err != nil {
-		return err
+	r.response = readClusterRegistrationResponse(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *ClusterRegistrationsClient) Metadata() *helpers.ResourceMetadata {
+	return clusterRegistrationsResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *ClusterRegistrationsClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
 }
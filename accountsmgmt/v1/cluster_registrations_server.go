@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterRegistrationsServer represents the interface the manages the 'cluster_registrations' resource.
@@ -73,18 +75,12 @@ func (r *ClusterRegistrationsPostServerRequest) GetRequest() (value *ClusterRegi
 // unmarshal is the method used internally to unmarshal request to the
 // 'post' method.
 func (r *ClusterRegistrationsPostServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterRegistrationRequestData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.request, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.request = readClusterRegistrationRequest(iterator)
+	return iterator.Error()
 }
 
 // ClusterRegistrationsPostServerResponse is the response for the 'post' method.
@@ -111,33 +107,65 @@ func (r *ClusterRegistrationsPostServerResponse) SetStatusCode(status int) *Clus
 // marshall is the method used internally to marshal responses for the
 // 'post' method.
 func (r *ClusterRegistrationsPostServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.response.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeClusterRegistrationResponse(r.response, stream)
+	return stream.Flush()
 }
 
-// ClusterRegistrationsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// ClusterRegistrationsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type ClusterRegistrationsServerAdapter struct {
 	server ClusterRegistrationsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewClusterRegistrationsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewClusterRegistrationsServerAdapter(server ClusterRegistrationsServer, router *mux.Router) *ClusterRegistrationsServerAdapter {
 	adapter := new(ClusterRegistrationsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("POST").HandlerFunc(adapter.postHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *ClusterRegistrationsServerAdapter) readClusterRegistrationsPostServerRequest(r *http.Request) (*ClusterRegistrationsPostServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *ClusterRegistrationsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchClusterRegistrations(w, r, a.server, segments)
+}
+
+// dispatchClusterRegistrations navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchClusterRegistrations(w http.ResponseWriter, r *http.Request, server ClusterRegistrationsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodPost:
+			adaptClusterRegistrationsPostRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readClusterRegistrationsPostServerRequest(r *http.Request) (*ClusterRegistrationsPostServerRequest, error) {
 	result := new(ClusterRegistrationsPostServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -145,36 +173,42 @@ func (a *ClusterRegistrationsServerAdapter) readClusterRegistrationsPostServerRe
 	}
 	return result, nil
 }
-func (a *ClusterRegistrationsServerAdapter) writeClusterRegistrationsPostServerResponse(w http.ResponseWriter, r *ClusterRegistrationsPostServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClusterRegistrationsPostServerResponse(w http.ResponseWriter, r *ClusterRegistrationsPostServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClusterRegistrationsServerAdapter) postHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClusterRegistrationsPostServerRequest(r)
+func adaptClusterRegistrationsPostRequest(w http.ResponseWriter, r *http.Request, server ClusterRegistrationsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClusterRegistrationsPostServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClusterRegistrationsPostServerResponse)
-	err = a.server.Post(r.Context(), req, resp)
+	err = server.Post(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Post: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Post", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeClusterRegistrationsPostServerResponse(w, resp)
+	err = writeClusterRegistrationsPostServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -21,7 +21,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -68,6 +68,7 @@ type CurrentAccountGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -82,6 +83,17 @@ func (r *CurrentAccountGetRequest) Header(name string, value interface{}) *Curre
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *CurrentAccountGetRequest) Decoding(value helpers.DecodingOptions) *CurrentAccountGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -115,14 +127,14 @@ func (r *CurrentAccountGetRequest) SendContext(ctx context.Context) (result *Cur
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -176,17 +188,30 @@ func (r *CurrentAccountGetResponse) GetBody() (value *Account, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *CurrentAccountGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(accountData)
-	err = decoder.Decode(data)
+func (r *CurrentAccountGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readAccount(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *CurrentAccountClient) Metadata() *helpers.ResourceMetadata {
+	return currentAccountResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *CurrentAccountClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
 }
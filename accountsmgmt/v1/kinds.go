@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
+
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// kinds contains the names of the kinds of objects supported by this package,
+// sorted alphabetically.
+var kinds = []string{
+	AccountKind,
+	AccountLinkKind,
+	AccountListKind,
+	AccountListLinkKind,
+	OrganizationKind,
+	OrganizationLinkKind,
+	OrganizationListKind,
+	OrganizationListLinkKind,
+	PermissionKind,
+	PermissionLinkKind,
+	PermissionListKind,
+	PermissionListLinkKind,
+	PlanKind,
+	PlanLinkKind,
+	PlanListKind,
+	PlanListLinkKind,
+	RegistryKind,
+	RegistryCredentialKind,
+	RegistryCredentialLinkKind,
+	RegistryCredentialListKind,
+	RegistryCredentialListLinkKind,
+	RegistryLinkKind,
+	RegistryListKind,
+	RegistryListLinkKind,
+	ResourceQuotaKind,
+	ResourceQuotaLinkKind,
+	ResourceQuotaListKind,
+	ResourceQuotaListLinkKind,
+	RoleKind,
+	RoleBindingKind,
+	RoleBindingLinkKind,
+	RoleBindingListKind,
+	RoleBindingListLinkKind,
+	RoleLinkKind,
+	RoleListKind,
+	RoleListLinkKind,
+	SubscriptionKind,
+	SubscriptionLinkKind,
+	SubscriptionListKind,
+	SubscriptionListLinkKind,
+}
+
+// Kinds returns the names of the kinds of objects supported by this package,
+// sorted alphabetically. This includes the kinds used for links and for lists.
+func Kinds() []string {
+	result := make([]string, len(kinds))
+	copy(result, kinds)
+	return result
+}
+
+// UnmarshalKind reads from the given source, which can be a slice of bytes, a
+// string, an io.Reader or a json.Decoder, an object of the type that
+// corresponds to the given kind. For example, if the kind is 'Cluster' or
+// 'ClusterLink' the result will be a *Cluster, and if the kind is
+// 'ClusterList' or 'ClusterListLink' the result will be a *ClusterList. The
+// optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled. An error is returned if the kind isn't
+// supported by this package.
+func UnmarshalKind(kind string, source interface{}, options ...helpers.DecodingOptions) (object interface{}, err error) {
+	switch kind {
+	case AccountKind, AccountLinkKind:
+		object, err = UnmarshalAccount(source, options...)
+	case AccountListKind, AccountListLinkKind:
+		object, err = unmarshalAccountListLink(source, options...)
+	case OrganizationKind, OrganizationLinkKind:
+		object, err = UnmarshalOrganization(source, options...)
+	case OrganizationListKind, OrganizationListLinkKind:
+		object, err = unmarshalOrganizationListLink(source, options...)
+	case PermissionKind, PermissionLinkKind:
+		object, err = UnmarshalPermission(source, options...)
+	case PermissionListKind, PermissionListLinkKind:
+		object, err = unmarshalPermissionListLink(source, options...)
+	case PlanKind, PlanLinkKind:
+		object, err = UnmarshalPlan(source, options...)
+	case PlanListKind, PlanListLinkKind:
+		object, err = unmarshalPlanListLink(source, options...)
+	case RegistryKind, RegistryLinkKind:
+		object, err = UnmarshalRegistry(source, options...)
+	case RegistryListKind, RegistryListLinkKind:
+		object, err = unmarshalRegistryListLink(source, options...)
+	case RegistryCredentialKind, RegistryCredentialLinkKind:
+		object, err = UnmarshalRegistryCredential(source, options...)
+	case RegistryCredentialListKind, RegistryCredentialListLinkKind:
+		object, err = unmarshalRegistryCredentialListLink(source, options...)
+	case ResourceQuotaKind, ResourceQuotaLinkKind:
+		object, err = UnmarshalResourceQuota(source, options...)
+	case ResourceQuotaListKind, ResourceQuotaListLinkKind:
+		object, err = unmarshalResourceQuotaListLink(source, options...)
+	case RoleKind, RoleLinkKind:
+		object, err = UnmarshalRole(source, options...)
+	case RoleListKind, RoleListLinkKind:
+		object, err = unmarshalRoleListLink(source, options...)
+	case RoleBindingKind, RoleBindingLinkKind:
+		object, err = UnmarshalRoleBinding(source, options...)
+	case RoleBindingListKind, RoleBindingListLinkKind:
+		object, err = unmarshalRoleBindingListLink(source, options...)
+	case SubscriptionKind, SubscriptionLinkKind:
+		object, err = UnmarshalSubscription(source, options...)
+	case SubscriptionListKind, SubscriptionListLinkKind:
+		object, err = unmarshalSubscriptionListLink(source, options...)
+	default:
+		err = fmt.Errorf("kind '%s' isn't supported", kind)
+	}
+	if err != nil {
+		object = nil
+	}
+	return
+}
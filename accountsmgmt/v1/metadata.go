@@ -0,0 +1,1112 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Descriptions of the types of this package. The attributes are populated in the
+// init function because the types can reference each other.
+var (
+	accessTokenMetadata = &helpers.TypeMetadata{
+		Name: "AccessToken",
+	}
+	accountMetadata = &helpers.TypeMetadata{
+		Name: "Account",
+		Kind: AccountKind,
+	}
+	clusterAuthorizationRequestMetadata = &helpers.TypeMetadata{
+		Name: "ClusterAuthorizationRequest",
+	}
+	clusterAuthorizationResponseMetadata = &helpers.TypeMetadata{
+		Name: "ClusterAuthorizationResponse",
+	}
+	clusterRegistrationRequestMetadata = &helpers.TypeMetadata{
+		Name: "ClusterRegistrationRequest",
+	}
+	clusterRegistrationResponseMetadata = &helpers.TypeMetadata{
+		Name: "ClusterRegistrationResponse",
+	}
+	organizationMetadata = &helpers.TypeMetadata{
+		Name: "Organization",
+		Kind: OrganizationKind,
+	}
+	permissionMetadata = &helpers.TypeMetadata{
+		Name: "Permission",
+		Kind: PermissionKind,
+	}
+	planMetadata = &helpers.TypeMetadata{
+		Name: "Plan",
+		Kind: PlanKind,
+	}
+	quotaSummaryMetadata = &helpers.TypeMetadata{
+		Name: "QuotaSummary",
+	}
+	registryMetadata = &helpers.TypeMetadata{
+		Name: "Registry",
+		Kind: RegistryKind,
+	}
+	registryCredentialMetadata = &helpers.TypeMetadata{
+		Name: "RegistryCredential",
+		Kind: RegistryCredentialKind,
+	}
+	reservedResourceMetadata = &helpers.TypeMetadata{
+		Name: "ReservedResource",
+	}
+	resourceQuotaMetadata = &helpers.TypeMetadata{
+		Name: "ResourceQuota",
+		Kind: ResourceQuotaKind,
+	}
+	roleMetadata = &helpers.TypeMetadata{
+		Name: "Role",
+		Kind: RoleKind,
+	}
+	roleBindingMetadata = &helpers.TypeMetadata{
+		Name: "RoleBinding",
+		Kind: RoleBindingKind,
+	}
+	subscriptionMetadata = &helpers.TypeMetadata{
+		Name: "Subscription",
+		Kind: SubscriptionKind,
+	}
+)
+
+// types contains the descriptions of the types of this package, sorted by name.
+var types = []*helpers.TypeMetadata{
+	accessTokenMetadata,
+	accountMetadata,
+	clusterAuthorizationRequestMetadata,
+	clusterAuthorizationResponseMetadata,
+	clusterRegistrationRequestMetadata,
+	clusterRegistrationResponseMetadata,
+	organizationMetadata,
+	permissionMetadata,
+	planMetadata,
+	quotaSummaryMetadata,
+	registryMetadata,
+	registryCredentialMetadata,
+	reservedResourceMetadata,
+	resourceQuotaMetadata,
+	roleMetadata,
+	roleBindingMetadata,
+	subscriptionMetadata,
+}
+
+// Types returns the descriptions of the types of this package, sorted by
+// name. The descriptions can be used to inspect the attributes of objects without
+// using reflection.
+func Types() []*helpers.TypeMetadata {
+	result := make([]*helpers.TypeMetadata, len(types))
+	copy(result, types)
+	return result
+}
+
+func init() {
+	accountMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetName()
+			},
+		},
+		{
+			Name:     "Username",
+			JSONName: "username",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetUsername()
+			},
+		},
+		{
+			Name:     "Email",
+			JSONName: "email",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetEmail()
+			},
+		},
+		{
+			Name:     "FirstName",
+			JSONName: "first_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetFirstName()
+			},
+		},
+		{
+			Name:     "LastName",
+			JSONName: "last_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetLastName()
+			},
+		},
+		{
+			Name:     "Banned",
+			JSONName: "banned",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetBanned()
+			},
+		},
+		{
+			Name:     "BanDescription",
+			JSONName: "ban_description",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetBanDescription()
+			},
+		},
+		{
+			Name:     "Organization",
+			JSONName: "organization",
+			Type:     "*Organization",
+			Element:  organizationMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Account).GetOrganization()
+			},
+		},
+	}
+
+	clusterAuthorizationRequestMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ClusterID",
+			JSONName: "cluster_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetClusterID()
+			},
+		},
+		{
+			Name:     "AccountUsername",
+			JSONName: "account_username",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetAccountUsername()
+			},
+		},
+		{
+			Name:     "Managed",
+			JSONName: "managed",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetManaged()
+			},
+		},
+		{
+			Name:     "Reserve",
+			JSONName: "reserve",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetReserve()
+			},
+		},
+		{
+			Name:     "BYOC",
+			JSONName: "byoc",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetBYOC()
+			},
+		},
+		{
+			Name:     "AvailabilityZone",
+			JSONName: "availability_zone",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetAvailabilityZone()
+			},
+		},
+		{
+			Name:     "Resources",
+			JSONName: "resources",
+			Type:     "*ReservedResourceList",
+			Element:  reservedResourceMetadata,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationRequest).GetResources()
+			},
+		},
+	}
+
+	clusterAuthorizationResponseMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Allowed",
+			JSONName: "allowed",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationResponse).GetAllowed()
+			},
+		},
+		{
+			Name:     "ExcessResources",
+			JSONName: "excess_resources",
+			Type:     "*ReservedResourceList",
+			Element:  reservedResourceMetadata,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationResponse).GetExcessResources()
+			},
+		},
+		{
+			Name:     "Subscription",
+			JSONName: "subscription",
+			Type:     "*Subscription",
+			Element:  subscriptionMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAuthorizationResponse).GetSubscription()
+			},
+		},
+	}
+
+	clusterRegistrationRequestMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ClusterID",
+			JSONName: "cluster_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationRequest).GetClusterID()
+			},
+		},
+		{
+			Name:      "AuthorizationToken",
+			JSONName:  "authorization_token",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationRequest).GetAuthorizationToken()
+			},
+		},
+	}
+
+	clusterRegistrationResponseMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ClusterID",
+			JSONName: "cluster_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationResponse).GetClusterID()
+			},
+		},
+		{
+			Name:      "AuthorizationToken",
+			JSONName:  "authorization_token",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationResponse).GetAuthorizationToken()
+			},
+		},
+		{
+			Name:     "AccountID",
+			JSONName: "account_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationResponse).GetAccountID()
+			},
+		},
+		{
+			Name:     "ExpiresAt",
+			JSONName: "expires_at",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistrationResponse).GetExpiresAt()
+			},
+		},
+	}
+
+	organizationMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Organization).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Organization).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Organization).GetName()
+			},
+		},
+	}
+
+	permissionMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Permission).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Permission).GetHREF()
+			},
+		},
+		{
+			Name:     "Action",
+			JSONName: "action",
+			Type:     "Action",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Permission).GetAction()
+			},
+		},
+		{
+			Name:     "ResourceType",
+			JSONName: "resource_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Permission).GetResourceType()
+			},
+		},
+		{
+			Name:     "RoleID",
+			JSONName: "role_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Permission).GetRoleID()
+			},
+		},
+	}
+
+	planMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Plan).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Plan).GetHREF()
+			},
+		},
+	}
+
+	quotaSummaryMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "OrganizationID",
+			JSONName: "organization_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetOrganizationID()
+			},
+		},
+		{
+			Name:     "ResourceName",
+			JSONName: "resource_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetResourceName()
+			},
+		},
+		{
+			Name:     "ResourceType",
+			JSONName: "resource_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetResourceType()
+			},
+		},
+		{
+			Name:     "BYOC",
+			JSONName: "byoc",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetBYOC()
+			},
+		},
+		{
+			Name:     "AvailabilityZoneType",
+			JSONName: "availability_zone_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetAvailabilityZoneType()
+			},
+		},
+		{
+			Name:     "Allowed",
+			JSONName: "allowed",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetAllowed()
+			},
+		},
+		{
+			Name:     "Reserved",
+			JSONName: "reserved",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*QuotaSummary).GetReserved()
+			},
+		},
+	}
+
+	registryMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetName()
+			},
+		},
+		{
+			Name:     "URL",
+			JSONName: "url",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetURL()
+			},
+		},
+		{
+			Name:     "TeamName",
+			JSONName: "team_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetTeamName()
+			},
+		},
+		{
+			Name:     "OrgName",
+			JSONName: "org_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetOrgName()
+			},
+		},
+		{
+			Name:     "Type",
+			JSONName: "type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetType()
+			},
+		},
+		{
+			Name:     "CloudAlias",
+			JSONName: "cloud_alias",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Registry).GetCloudAlias()
+			},
+		},
+	}
+
+	registryCredentialMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetHREF()
+			},
+		},
+		{
+			Name:     "Username",
+			JSONName: "username",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetUsername()
+			},
+		},
+		{
+			Name:      "Token",
+			JSONName:  "token",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetToken()
+			},
+		},
+		{
+			Name:     "Registry",
+			JSONName: "registry",
+			Type:     "*Registry",
+			Element:  registryMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetRegistry()
+			},
+		},
+		{
+			Name:     "Account",
+			JSONName: "account",
+			Type:     "*Account",
+			Element:  accountMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RegistryCredential).GetAccount()
+			},
+		},
+	}
+
+	reservedResourceMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ResourceName",
+			JSONName: "resource_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ReservedResource).GetResourceName()
+			},
+		},
+		{
+			Name:     "ResourceType",
+			JSONName: "resource_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ReservedResource).GetResourceType()
+			},
+		},
+		{
+			Name:     "BYOC",
+			JSONName: "byoc",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ReservedResource).GetBYOC()
+			},
+		},
+		{
+			Name:     "AvailabilityZoneType",
+			JSONName: "availability_zone_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ReservedResource).GetAvailabilityZoneType()
+			},
+		},
+		{
+			Name:     "Count",
+			JSONName: "count",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ReservedResource).GetCount()
+			},
+		},
+	}
+
+	resourceQuotaMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetHREF()
+			},
+		},
+		{
+			Name:     "OrganizationID",
+			JSONName: "organization_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetOrganizationID()
+			},
+		},
+		{
+			Name:     "SKU",
+			JSONName: "sku",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetSKU()
+			},
+		},
+		{
+			Name:     "ResourceName",
+			JSONName: "resource_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetResourceName()
+			},
+		},
+		{
+			Name:     "ResourceType",
+			JSONName: "resource_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetResourceType()
+			},
+		},
+		{
+			Name:     "BYOC",
+			JSONName: "byoc",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetBYOC()
+			},
+		},
+		{
+			Name:     "AvailabilityZoneType",
+			JSONName: "availability_zone_type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetAvailabilityZoneType()
+			},
+		},
+		{
+			Name:     "Allowed",
+			JSONName: "allowed",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetAllowed()
+			},
+		},
+		{
+			Name:     "Reserved",
+			JSONName: "reserved",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ResourceQuota).GetReserved()
+			},
+		},
+	}
+
+	roleMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Role).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Role).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Role).GetName()
+			},
+		},
+		{
+			Name:     "Permissions",
+			JSONName: "permissions",
+			Type:     "*PermissionList",
+			Element:  permissionMetadata,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Role).GetPermissions()
+			},
+		},
+	}
+
+	roleBindingMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetHREF()
+			},
+		},
+		{
+			Name:     "Type",
+			JSONName: "type",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetType()
+			},
+		},
+		{
+			Name:     "Subscription",
+			JSONName: "subscription",
+			Type:     "*Subscription",
+			Element:  subscriptionMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetSubscription()
+			},
+		},
+		{
+			Name:     "Account",
+			JSONName: "account",
+			Type:     "*Account",
+			Element:  accountMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetAccount()
+			},
+		},
+		{
+			Name:     "Organization",
+			JSONName: "organization",
+			Type:     "*Organization",
+			Element:  organizationMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetOrganization()
+			},
+		},
+		{
+			Name:     "Role",
+			JSONName: "role",
+			Type:     "*Role",
+			Element:  roleMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*RoleBinding).GetRole()
+			},
+		},
+	}
+
+	subscriptionMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetHREF()
+			},
+		},
+		{
+			Name:     "Plan",
+			JSONName: "plan",
+			Type:     "*Plan",
+			Element:  planMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetPlan()
+			},
+		},
+		{
+			Name:     "RegistryCredential",
+			JSONName: "registry_credential",
+			Type:     "*RegistryCredential",
+			Element:  registryCredentialMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetRegistryCredential()
+			},
+		},
+		{
+			Name:     "ClusterID",
+			JSONName: "cluster_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetClusterID()
+			},
+		},
+		{
+			Name:     "ExternalClusterID",
+			JSONName: "external_cluster_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetExternalClusterID()
+			},
+		},
+		{
+			Name:     "OrganizationID",
+			JSONName: "organization_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetOrganizationID()
+			},
+		},
+		{
+			Name:     "LastTelemetryDate",
+			JSONName: "last_telemetry_date",
+			Type:     "time.Time",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetLastTelemetryDate()
+			},
+		},
+		{
+			Name:     "Creator",
+			JSONName: "creator",
+			Type:     "*Account",
+			Element:  accountMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetCreator()
+			},
+		},
+	}
+}
+
+// accessTokenResource describes the resource managed by the AccessTokenClient type.
+var accessTokenResource = &helpers.ResourceMetadata{
+	Name: "access_token",
+	Type: accessTokenMetadata,
+}
+
+// accountResource describes the resource managed by the AccountClient type.
+var accountResource = &helpers.ResourceMetadata{
+	Type: accountMetadata,
+}
+
+// accountsResource describes the resource managed by the AccountsClient type.
+var accountsResource = &helpers.ResourceMetadata{
+	Name:       "accounts",
+	Type:       accountMetadata,
+	Collection: true,
+	Item:       accountResource,
+}
+
+// clusterAuthorizationsResource describes the resource managed by the ClusterAuthorizationsClient type.
+var clusterAuthorizationsResource = &helpers.ResourceMetadata{
+	Name: "cluster_authorizations",
+	Type: clusterAuthorizationResponseMetadata,
+}
+
+// clusterRegistrationsResource describes the resource managed by the ClusterRegistrationsClient type.
+var clusterRegistrationsResource = &helpers.ResourceMetadata{
+	Name: "cluster_registrations",
+	Type: clusterRegistrationResponseMetadata,
+}
+
+// currentAccountResource describes the resource managed by the CurrentAccountClient type.
+var currentAccountResource = &helpers.ResourceMetadata{
+	Name: "current_account",
+	Type: accountMetadata,
+}
+
+// organizationResource describes the resource managed by the OrganizationClient type.
+var organizationResource = &helpers.ResourceMetadata{
+	Type: organizationMetadata,
+	Resources: []*helpers.ResourceMetadata{
+		resourceQuotasResource,
+		quotaSummaryResource,
+	},
+}
+
+// organizationsResource describes the resource managed by the OrganizationsClient type.
+var organizationsResource = &helpers.ResourceMetadata{
+	Name:       "organizations",
+	Type:       organizationMetadata,
+	Collection: true,
+	Item:       organizationResource,
+}
+
+// permissionResource describes the resource managed by the PermissionClient type.
+var permissionResource = &helpers.ResourceMetadata{
+	Type: permissionMetadata,
+}
+
+// permissionsResource describes the resource managed by the PermissionsClient type.
+var permissionsResource = &helpers.ResourceMetadata{
+	Name:       "permissions",
+	Type:       permissionMetadata,
+	Collection: true,
+	Item:       permissionResource,
+}
+
+// quotaSummaryResource describes the resource managed by the QuotaSummaryClient type.
+var quotaSummaryResource = &helpers.ResourceMetadata{
+	Name: "quota_summary",
+	Type: quotaSummaryMetadata,
+}
+
+// registriesResource describes the resource managed by the RegistriesClient type.
+var registriesResource = &helpers.ResourceMetadata{
+	Name:       "registries",
+	Type:       registryMetadata,
+	Collection: true,
+	Item:       registryResource,
+}
+
+// registryResource describes the resource managed by the RegistryClient type.
+var registryResource = &helpers.ResourceMetadata{
+	Type: registryMetadata,
+}
+
+// registryCredentialResource describes the resource managed by the RegistryCredentialClient type.
+var registryCredentialResource = &helpers.ResourceMetadata{
+	Type: registryCredentialMetadata,
+}
+
+// registryCredentialsResource describes the resource managed by the RegistryCredentialsClient type.
+var registryCredentialsResource = &helpers.ResourceMetadata{
+	Name:       "registry_credentials",
+	Type:       registryCredentialMetadata,
+	Collection: true,
+	Item:       registryCredentialResource,
+}
+
+// resourceQuotaResource describes the resource managed by the ResourceQuotaClient type.
+var resourceQuotaResource = &helpers.ResourceMetadata{
+	Type: resourceQuotaMetadata,
+}
+
+// resourceQuotasResource describes the resource managed by the ResourceQuotasClient type.
+var resourceQuotasResource = &helpers.ResourceMetadata{
+	Name:       "resource_quota",
+	Type:       resourceQuotaMetadata,
+	Collection: true,
+	Item:       resourceQuotaResource,
+}
+
+// roleBindingResource describes the resource managed by the RoleBindingClient type.
+var roleBindingResource = &helpers.ResourceMetadata{
+	Type: roleBindingMetadata,
+}
+
+// roleBindingsResource describes the resource managed by the RoleBindingsClient type.
+var roleBindingsResource = &helpers.ResourceMetadata{
+	Name:       "role_bindings",
+	Type:       roleBindingMetadata,
+	Collection: true,
+	Item:       roleBindingResource,
+}
+
+// roleResource describes the resource managed by the RoleClient type.
+var roleResource = &helpers.ResourceMetadata{
+	Type: roleMetadata,
+}
+
+// rolesResource describes the resource managed by the RolesClient type.
+var rolesResource = &helpers.ResourceMetadata{
+	Name:       "roles",
+	Type:       roleMetadata,
+	Collection: true,
+	Item:       roleResource,
+}
+
+// rootResource describes the resource managed by the RootClient type.
+var rootResource = &helpers.ResourceMetadata{
+	Resources: []*helpers.ResourceMetadata{
+		accountsResource,
+		currentAccountResource,
+		organizationsResource,
+		accessTokenResource,
+		permissionsResource,
+		registriesResource,
+		registryCredentialsResource,
+		clusterAuthorizationsResource,
+		clusterRegistrationsResource,
+		rolesResource,
+		roleBindingsResource,
+		subscriptionsResource,
+	},
+}
+
+// subscriptionResource describes the resource managed by the SubscriptionClient type.
+var subscriptionResource = &helpers.ResourceMetadata{
+	Type: subscriptionMetadata,
+}
+
+// subscriptionsResource describes the resource managed by the SubscriptionsClient type.
+var subscriptionsResource = &helpers.ResourceMetadata{
+	Name:       "subscriptions",
+	Type:       subscriptionMetadata,
+	Collection: true,
+	Item:       subscriptionResource,
+}
+
+// Register the tree of resources, so that it can be used to calculate the paths reported in
+// metrics.
+func init() {
+	helpers.RegisterService("/api/accounts_mgmt/v1", rootResource)
+}
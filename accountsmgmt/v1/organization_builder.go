@@ -19,14 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OrganizationBuilder contains the data and logic needed to build 'organization' objects.
 //
 //
 type OrganizationBuilder struct {
-	id   *string
-	href *string
-	link bool
-	name *string
+	id    *string
+	href  *string
+	link  bool
+	name  *string
+	extra map[string]interface{}
 }
 
 // NewOrganization creates a new builder of 'organization' objects.
@@ -34,6 +39,22 @@ func NewOrganization() *OrganizationBuilder {
 	return new(OrganizationBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'organization' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Organization) Builder() *OrganizationBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(OrganizationBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *OrganizationBuilder) ID(value string) *OrganizationBuilder {
 	b.id = &value
@@ -61,9 +82,17 @@ func (b *OrganizationBuilder) Name(value string) *OrganizationBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'organization' type, and
+// that will be written as they are when the object is encoded.
+func (b *OrganizationBuilder) Extra(value map[string]interface{}) *OrganizationBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'organization' object using the configuration stored in the builder.
 func (b *OrganizationBuilder) Build() (object *Organization, err error) {
 	object = new(Organization)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
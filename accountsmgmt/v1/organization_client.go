@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -106,6 +106,7 @@ type OrganizationGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -120,6 +121,17 @@ func (r *OrganizationGetRequest) Header(name string, value interface{}) *Organiz
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *OrganizationGetRequest) Decoding(value helpers.DecodingOptions) *OrganizationGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -153,14 +165,14 @@ func (r *OrganizationGetRequest) SendContext(ctx context.Context) (result *Organ
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -214,29 +226,26 @@ func (r *OrganizationGetResponse) GetBody() (value *Organization, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *OrganizationGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(organizationData)
-	err = decoder.Decode(data)
+func (r *OrganizationGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readOrganization(iterator)
+	return iterator.Error()
 }
 
 // OrganizationUpdateRequest is the request for the 'update' method.
 type OrganizationUpdateRequest struct {
-	transport http.RoundTripper
-	path      string
-	metric    string
-	query     url.Values
-	header    http.Header
-	body      *Organization
+	transport  http.RoundTripper
+	path       string
+	metric     string
+	query      url.Values
+	header     http.Header
+	body       *Organization
+	decoding   helpers.DecodingOptions
+	original   *Organization
+	mergePatch bool
 }
 
 // Parameter adds a query parameter.
@@ -251,11 +260,45 @@ func (r *OrganizationUpdateRequest) Header(name string, value interface{}) *Orga
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *OrganizationUpdateRequest) Decoding(value helpers.DecodingOptions) *OrganizationUpdateRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 //
 func (r *OrganizationUpdateRequest) Body(value *Organization) *OrganizationUpdateRequest {
 	r.body = value
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// Changes sets the body of the request to the attributes of the modified object that have
+// values different to the values in the original object, as calculated by the Changes method
+// of the type.
+func (r *OrganizationUpdateRequest) Changes(original, modified *Organization) *OrganizationUpdateRequest {
+	r.body = original.Changes(modified)
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// MergePatch sets the body of the request to the JSON merge patch, as described in RFC 7396,
+// that transforms the original object into the modified one. The request will be sent with the
+// `application/merge-patch+json` content type. Attributes removed in the modified object will
+// be set to null in the patch.
+func (r *OrganizationUpdateRequest) MergePatch(original, modified *Organization) *OrganizationUpdateRequest {
+	r.body = modified
+	r.original = original
+	r.mergePatch = true
 	return r
 }
 
@@ -271,6 +314,9 @@ func (r *OrganizationUpdateRequest) Send() (result *OrganizationUpdateResponse,
 func (r *OrganizationUpdateRequest) SendContext(ctx context.Context) (result *OrganizationUpdateResponse, err error) {
 	query := helpers.CopyQuery(r.query)
 	header := helpers.SetHeader(r.header, r.metric)
+	if r.mergePatch {
+		header.Set("Content-Type", helpers.MergePatchContentType)
+	}
 	buffer := new(bytes.Buffer)
 	err = r.marshal(buffer)
 	if err != nil {
@@ -298,14 +344,14 @@ func (r *OrganizationUpdateRequest) SendContext(ctx context.Context) (result *Or
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -316,13 +362,31 @@ func (r *OrganizationUpdateRequest) SendContext(ctx context.Context) (result *Or
 // 'update' method.
 func (r *OrganizationUpdateRequest) marshal(writer io.Writer) error {
 	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	if r.mergePatch {
+		original := new(bytes.Buffer)
+		err = MarshalOrganization(r.original, original)
+		if err != nil {
+			return err
+		}
+		modified := new(bytes.Buffer)
+		err = MarshalOrganization(r.body, modified)
+		if err != nil {
+			return err
+		}
+		var patch []byte
+		patch, err = helpers.MergePatch(original.Bytes(), modified.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(patch)
+		return err
+	}
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeOrganization(r.body, stream)
+	return stream.Flush()
 }
 
 // OrganizationUpdateResponse is the response for the 'update' method.
@@ -372,17 +436,37 @@ func (r *OrganizationUpdateResponse) GetBody() (value *Organization, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'update' method.
-func (r *OrganizationUpdateResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(organizationData)
-	err = decoder.Decode(data)
+func (r *OrganizationUpdateResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readOrganization(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *OrganizationClient) Metadata() *helpers.ResourceMetadata {
+	return organizationResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *OrganizationClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	switch segments[0] {
+	case "resource_quota":
+		return c.ResourceQuota().route(segments[1:], ids)
+	case "quota_summary":
+		return c.QuotaSummary().route(segments[1:], ids)
+	default:
+		err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+		return
 	}
-	return err
 }
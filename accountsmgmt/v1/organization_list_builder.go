@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'organization' objects.
 type OrganizationListBuilder struct {
 	items []*OrganizationBuilder
+	link  bool
+	href  *string
 }
 
 // NewOrganizationList creates a new builder of 'organization' objects.
@@ -30,8 +32,25 @@ func NewOrganizationList() *OrganizationListBuilder {
 	return new(OrganizationListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *OrganizationList) Builder() *OrganizationListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(OrganizationListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*OrganizationBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *OrganizationListBuilder) Items(values ...*OrganizationBuilder) *OrganizationListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*OrganizationBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *OrganizationListBuilder) Build() (list *OrganizationList, err error) {
 		}
 	}
 	list = new(OrganizationList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
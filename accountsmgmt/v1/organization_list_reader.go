@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// organizationListData is type used internally to marshal and unmarshal lists of objects
-// of type 'organization'.
-type organizationListData []*organizationData
-
 // UnmarshalOrganizationList reads a list of values of the 'organization'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalOrganizationList(source interface{}) (list *OrganizationList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalOrganizationList(source interface{}, options ...helpers.DecodingOptions) (list *OrganizationList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data organizationListData
-	err = decoder.Decode(&data)
+	list = readOrganizationList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'organization' value to a JSON document.
-func (l *OrganizationList) wrap() (data organizationListData, err error) {
-	if l == nil {
+// MarshalOrganizationList writes a list of values of the 'organization' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalOrganizationList(list *OrganizationList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeOrganizationList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *OrganizationList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOrganizationListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalOrganizationList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *OrganizationList) UnmarshalJSON(data []byte) error {
+	var list *OrganizationList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalOrganizationList(data)
+	} else {
+		list, err = unmarshalOrganizationListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = OrganizationList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *OrganizationList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *OrganizationList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalOrganizationListLink reads a list of values of the 'organization' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalOrganizationListLink(source interface{}, options ...helpers.DecodingOptions) (list *OrganizationList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(organizationListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readOrganizationListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'organization' type.
-func (d organizationListData) unwrap() (list *OrganizationList, err error) {
-	if d == nil {
+// writeOrganizationList writes a list of values of the 'organization' type to the given
+// stream, as a JSON array.
+func writeOrganizationList(list *OrganizationList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Organization, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeOrganization(item, stream)
 	}
-	list = new(OrganizationList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// organizationListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'organization'.
-type organizationListLinkData struct {
-	Kind  *string             "json:\"kind,omitempty\""
-	HREF  *string             "json:\"href,omitempty\""
-	Items []*organizationData "json:\"items,omitempty\""
+// readOrganizationList reads a list of values of the 'organization' type from the given
+// iterator, as a JSON array.
+func readOrganizationList(iterator *helpers.Iterator) *OrganizationList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(OrganizationList)
+	list.items = []*Organization{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readOrganization(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'organization' value to a link.
-func (l *OrganizationList) wrapLink() (data *organizationListLinkData, err error) {
-	if l == nil {
+// writeOrganizationListLink writes a list of values of the 'organization' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeOrganizationListLink(list *OrganizationList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*organizationData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(organizationListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = OrganizationListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(OrganizationListLinkKind)
 	} else {
-		*data.Kind = OrganizationListKind
+		stream.WriteString(OrganizationListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeOrganizationList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'organization' type to a list.
-func (d *organizationListLinkData) unwrapLink() (list *OrganizationList, err error) {
-	if d == nil {
-		return
+// readOrganizationListLink reads a list of values of the 'organization' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readOrganizationListLink(iterator *helpers.Iterator) *OrganizationList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Organization, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(OrganizationList)
+	list.items = []*Organization{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(OrganizationList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case OrganizationListKind:
-			list.link = false
-		case OrganizationListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(OrganizationListKind, OrganizationListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readOrganizationList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				OrganizationListKind,
-				OrganizationListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,94 +20,148 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// organizationData is the data structure used internally to marshal and unmarshal
-// objects of type 'organization'.
-type organizationData struct {
-	Kind *string "json:\"kind,omitempty\""
-	ID   *string "json:\"id,omitempty\""
-	HREF *string "json:\"href,omitempty\""
-	Name *string "json:\"name,omitempty\""
-}
-
 // MarshalOrganization writes a value of the 'organization' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalOrganization(object *Organization, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeOrganization(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'organization'
-// value to a JSON document.
-func (o *Organization) wrap() (data *organizationData, err error) {
-	if o == nil {
+// writeOrganization writes a value of the 'organization' type to the given stream.
+func writeOrganization(object *Organization, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(organizationData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = OrganizationLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(OrganizationLinkKind)
 	} else {
-		*data.Kind = OrganizationKind
+		stream.WriteString(OrganizationKind)
 	}
-	data.Name = o.name
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalOrganization reads a value of the 'organization' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalOrganization(source interface{}) (object *Organization, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalOrganization(source interface{}, options ...helpers.DecodingOptions) (object *Organization, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(organizationData)
-	err = decoder.Decode(data)
+	object = readOrganization(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Organization)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'organization' type.
-func (d *organizationData) unwrap() (object *Organization, err error) {
-	if d == nil {
-		return
+// readOrganization reads a value of the 'organization' type from the given iterator.
+func readOrganization(iterator *helpers.Iterator) *Organization {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Organization)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case OrganizationKind:
-			object.link = false
-		case OrganizationLinkKind:
-			object.link = true
+	object := new(Organization)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(OrganizationKind, OrganizationLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				OrganizationKind,
-				OrganizationLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalOrganization function.
+func (o *Organization) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOrganization(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOrganization function, using the
+// default decoding options.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalOrganization(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Organization{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Organization) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Organization) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // OrganizationServer represents the interface the manages the 'organization' resource.
@@ -87,14 +89,12 @@ func (r *OrganizationGetServerResponse) SetStatusCode(status int) *OrganizationG
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *OrganizationGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeOrganization(r.body, stream)
+	return stream.Flush()
 }
 
 // OrganizationUpdateServerRequest is the request for the 'update' method.
@@ -129,18 +129,12 @@ func (r *OrganizationUpdateServerRequest) GetBody() (value *Organization, ok boo
 // unmarshal is the method used internally to unmarshal request to the
 // 'update' method.
 func (r *OrganizationUpdateServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(organizationData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readOrganization(iterator)
+	return iterator.Error()
 }
 
 // OrganizationUpdateServerResponse is the response for the 'update' method.
@@ -167,81 +161,120 @@ func (r *OrganizationUpdateServerResponse) SetStatusCode(status int) *Organizati
 // marshall is the method used internally to marshal responses for the
 // 'update' method.
 func (r *OrganizationUpdateServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeOrganization(r.body, stream)
+	return stream.Flush()
 }
 
-// OrganizationServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// OrganizationServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type OrganizationServerAdapter struct {
 	server OrganizationServer
 	router *mux.Router
+	prefix string
 }
 
+// NewOrganizationServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewOrganizationServerAdapter(server OrganizationServer, router *mux.Router) *OrganizationServerAdapter {
 	adapter := new(OrganizationServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/resource_quota").HandlerFunc(adapter.resourceQuotaHandler)
-	adapter.router.PathPrefix("/quota_summary").HandlerFunc(adapter.quotaSummaryHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("PATCH").HandlerFunc(adapter.updateHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *OrganizationServerAdapter) resourceQuotaHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.ResourceQuota()
-	targetAdapter := NewResourceQuotasServerAdapter(target, a.router.PathPrefix("/resource_quota").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *OrganizationServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchOrganization(w, r, a.server, segments)
 }
-func (a *OrganizationServerAdapter) quotaSummaryHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.QuotaSummary()
-	targetAdapter := NewQuotaSummaryServerAdapter(target, a.router.PathPrefix("/quota_summary").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchOrganization navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchOrganization(w http.ResponseWriter, r *http.Request, server OrganizationServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptOrganizationGetRequest(w, r, server)
+		case http.MethodPatch:
+			adaptOrganizationUpdateRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	case "resource_quota":
+		target := server.ResourceQuota()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchResourceQuotas(w, r, target, segments[1:])
+	case "quota_summary":
+		target := server.QuotaSummary()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchQuotaSummary(w, r, target, segments[1:])
+	default:
+		errors.SendNotFound(w, r)
+	}
 }
-func (a *OrganizationServerAdapter) readOrganizationGetServerRequest(r *http.Request) (*OrganizationGetServerRequest, error) {
+func readOrganizationGetServerRequest(r *http.Request) (*OrganizationGetServerRequest, error) {
 	result := new(OrganizationGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *OrganizationServerAdapter) writeOrganizationGetServerResponse(w http.ResponseWriter, r *OrganizationGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeOrganizationGetServerResponse(w http.ResponseWriter, r *OrganizationGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *OrganizationServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readOrganizationGetServerRequest(r)
+func adaptOrganizationGetRequest(w http.ResponseWriter, r *http.Request, server OrganizationServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readOrganizationGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(OrganizationGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeOrganizationGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeOrganizationGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -251,9 +284,9 @@ func (a *OrganizationServerAdapter) getHandler(w http.ResponseWriter, r *http.Re
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *OrganizationServerAdapter) readOrganizationUpdateServerRequest(r *http.Request) (*OrganizationUpdateServerRequest, error) {
+func readOrganizationUpdateServerRequest(r *http.Request) (*OrganizationUpdateServerRequest, error) {
 	result := new(OrganizationUpdateServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -261,36 +294,42 @@ func (a *OrganizationServerAdapter) readOrganizationUpdateServerRequest(r *http.
 	}
 	return result, nil
 }
-func (a *OrganizationServerAdapter) writeOrganizationUpdateServerResponse(w http.ResponseWriter, r *OrganizationUpdateServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeOrganizationUpdateServerResponse(w http.ResponseWriter, r *OrganizationUpdateServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *OrganizationServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readOrganizationUpdateServerRequest(r)
+func adaptOrganizationUpdateRequest(w http.ResponseWriter, r *http.Request, server OrganizationServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readOrganizationUpdateServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(OrganizationUpdateServerResponse)
-	err = a.server.Update(r.Context(), req, resp)
+	err = server.Update(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Update: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Update", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeOrganizationUpdateServerResponse(w, resp)
+	err = writeOrganizationUpdateServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OrganizationKind is the name of the type used to represent objects
 // of type 'organization'.
 const OrganizationKind = "Organization"
@@ -35,10 +43,11 @@ const OrganizationNilKind = "OrganizationNil"
 //
 //
 type Organization struct {
-	id   *string
-	href *string
-	link bool
-	name *string
+	id    *string
+	href  *string
+	link  bool
+	name  *string
+	extra map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -93,6 +102,27 @@ func (o *Organization) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete organization that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Organization) Resolve(ctx context.Context, transport http.RoundTripper) (result *Organization, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to organization '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewOrganizationClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Organization) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -100,6 +130,59 @@ func (o *Organization) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'organization' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Organization) Metadata() *helpers.TypeMetadata {
+	return organizationMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Organization) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOrganization(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Organization) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOrganization(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Organization) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOrganization(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Organization) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOrganization(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'organization' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Organization) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -135,6 +218,171 @@ const OrganizationListLinkKind = "OrganizationListLink"
 // objects of type 'organization'.
 const OrganizationListNilKind = "OrganizationListNil"
 
+// Copy returns a deep copy of the 'organization' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Organization) Copy() *Organization {
+	if o == nil {
+		return nil
+	}
+	result := new(Organization)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'organization' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Organization) Equal(other *Organization) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'organization' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Organization) Diff(other *Organization) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'organization' object and the given one, using the given path as prefix.
+func (o *Organization) diff(path string, other *Organization, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'organization' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Organization) Changes(modified *Organization) *Organization {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Organization)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printOrganization prints a value of the 'organization' type to the given printer.
+func printOrganization(object *Organization, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Organization")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	printer.PrintObjectEnd()
+}
+
 // OrganizationList is a list of values of the 'organization' type.
 type OrganizationList struct {
 	href  *string
@@ -242,3 +490,146 @@ func (l *OrganizationList) Range(f func(index int, item *Organization) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *OrganizationList) Copy() *OrganizationList {
+	if l == nil {
+		return nil
+	}
+	result := new(OrganizationList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Organization, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *OrganizationList) Equal(other *OrganizationList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *OrganizationList) Diff(other *OrganizationList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *OrganizationList) diff(path string, other *OrganizationList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *OrganizationList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOrganizationListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *OrganizationList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOrganizationListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *OrganizationList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOrganizationListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *OrganizationList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOrganizationListLink(l, printer)
+	}
+}
+
+// printOrganizationList prints a list of values of the 'organization' type to the given printer.
+func printOrganizationList(list *OrganizationList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printOrganization(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printOrganizationListLink prints a list of values of the 'organization' type to the
+// given printer, including the link to the list.
+func printOrganizationListLink(list *OrganizationList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.OrganizationList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printOrganizationList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // PermissionBuilder contains the data and logic needed to build 'permission' objects.
 //
 //
@@ -29,6 +33,7 @@ type PermissionBuilder struct {
 	action       *Action
 	resourceType *string
 	roleID       *string
+	extra        map[string]interface{}
 }
 
 // NewPermission creates a new builder of 'permission' objects.
@@ -36,6 +41,24 @@ func NewPermission() *PermissionBuilder {
 	return new(PermissionBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'permission' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Permission) Builder() *PermissionBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(PermissionBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.action = o.action
+	b.resourceType = o.resourceType
+	b.roleID = o.roleID
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *PermissionBuilder) ID(value string) *PermissionBuilder {
 	b.id = &value
@@ -81,9 +104,17 @@ func (b *PermissionBuilder) RoleID(value string) *PermissionBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'permission' type, and
+// that will be written as they are when the object is encoded.
+func (b *PermissionBuilder) Extra(value map[string]interface{}) *PermissionBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'permission' object using the configuration stored in the builder.
 func (b *PermissionBuilder) Build() (object *Permission, err error) {
 	object = new(Permission)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'permission' objects.
 type PermissionListBuilder struct {
 	items []*PermissionBuilder
+	link  bool
+	href  *string
 }
 
 // NewPermissionList creates a new builder of 'permission' objects.
@@ -30,8 +32,25 @@ func NewPermissionList() *PermissionListBuilder {
 	return new(PermissionListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *PermissionList) Builder() *PermissionListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(PermissionListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*PermissionBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *PermissionListBuilder) Items(values ...*PermissionBuilder) *PermissionListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*PermissionBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *PermissionListBuilder) Build() (list *PermissionList, err error) {
 		}
 	}
 	list = new(PermissionList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// permissionListData is type used internally to marshal and unmarshal lists of objects
-// of type 'permission'.
-type permissionListData []*permissionData
-
 // UnmarshalPermissionList reads a list of values of the 'permission'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalPermissionList(source interface{}) (list *PermissionList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalPermissionList(source interface{}, options ...helpers.DecodingOptions) (list *PermissionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data permissionListData
-	err = decoder.Decode(&data)
+	list = readPermissionList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'permission' value to a JSON document.
-func (l *PermissionList) wrap() (data permissionListData, err error) {
-	if l == nil {
+// MarshalPermissionList writes a list of values of the 'permission' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalPermissionList(list *PermissionList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writePermissionList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *PermissionList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writePermissionListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalPermissionList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *PermissionList) UnmarshalJSON(data []byte) error {
+	var list *PermissionList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalPermissionList(data)
+	} else {
+		list, err = unmarshalPermissionListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = PermissionList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *PermissionList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *PermissionList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalPermissionListLink reads a list of values of the 'permission' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalPermissionListLink(source interface{}, options ...helpers.DecodingOptions) (list *PermissionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(permissionListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readPermissionListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'permission' type.
-func (d permissionListData) unwrap() (list *PermissionList, err error) {
-	if d == nil {
+// writePermissionList writes a list of values of the 'permission' type to the given
+// stream, as a JSON array.
+func writePermissionList(list *PermissionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Permission, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writePermission(item, stream)
 	}
-	list = new(PermissionList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// permissionListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'permission'.
-type permissionListLinkData struct {
-	Kind  *string           "json:\"kind,omitempty\""
-	HREF  *string           "json:\"href,omitempty\""
-	Items []*permissionData "json:\"items,omitempty\""
+// readPermissionList reads a list of values of the 'permission' type from the given
+// iterator, as a JSON array.
+func readPermissionList(iterator *helpers.Iterator) *PermissionList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(PermissionList)
+	list.items = []*Permission{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readPermission(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'permission' value to a link.
-func (l *PermissionList) wrapLink() (data *permissionListLinkData, err error) {
-	if l == nil {
+// writePermissionListLink writes a list of values of the 'permission' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writePermissionListLink(list *PermissionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*permissionData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(permissionListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = PermissionListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(PermissionListLinkKind)
 	} else {
-		*data.Kind = PermissionListKind
+		stream.WriteString(PermissionListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writePermissionList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'permission' type to a list.
-func (d *permissionListLinkData) unwrapLink() (list *PermissionList, err error) {
-	if d == nil {
-		return
+// readPermissionListLink reads a list of values of the 'permission' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readPermissionListLink(iterator *helpers.Iterator) *PermissionList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Permission, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(PermissionList)
+	list.items = []*Permission{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(PermissionList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case PermissionListKind:
-			list.link = false
-		case PermissionListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(PermissionListKind, PermissionListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readPermissionList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				PermissionListKind,
-				PermissionListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,100 +20,166 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// permissionData is the data structure used internally to marshal and unmarshal
-// objects of type 'permission'.
-type permissionData struct {
-	Kind         *string "json:\"kind,omitempty\""
-	ID           *string "json:\"id,omitempty\""
-	HREF         *string "json:\"href,omitempty\""
-	Action       *Action "json:\"action,omitempty\""
-	ResourceType *string "json:\"resource_type,omitempty\""
-	RoleID       *string "json:\"role_id,omitempty\""
-}
-
 // MarshalPermission writes a value of the 'permission' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalPermission(object *Permission, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writePermission(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'permission'
-// value to a JSON document.
-func (o *Permission) wrap() (data *permissionData, err error) {
-	if o == nil {
+// writePermission writes a value of the 'permission' type to the given stream.
+func writePermission(object *Permission, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(permissionData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = PermissionLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(PermissionLinkKind)
 	} else {
-		*data.Kind = PermissionKind
+		stream.WriteString(PermissionKind)
 	}
-	data.Action = o.action
-	data.ResourceType = o.resourceType
-	data.RoleID = o.roleID
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.action != nil {
+		stream.WriteObjectField("action")
+		stream.WriteString(string(*object.action))
+	}
+	if object.resourceType != nil {
+		stream.WriteObjectField("resource_type")
+		stream.WriteString(*object.resourceType)
+	}
+	if object.roleID != nil {
+		stream.WriteObjectField("role_id")
+		stream.WriteString(*object.roleID)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalPermission reads a value of the 'permission' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalPermission(source interface{}) (object *Permission, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalPermission(source interface{}, options ...helpers.DecodingOptions) (object *Permission, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(permissionData)
-	err = decoder.Decode(data)
+	object = readPermission(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Permission)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'permission' type.
-func (d *permissionData) unwrap() (object *Permission, err error) {
-	if d == nil {
-		return
+// readPermission reads a value of the 'permission' type from the given iterator.
+func readPermission(iterator *helpers.Iterator) *Permission {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Permission)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case PermissionKind:
-			object.link = false
-		case PermissionLinkKind:
-			object.link = true
+	object := new(Permission)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(PermissionKind, PermissionLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "action":
+			if !iterator.ReadNull() {
+				value := Action(iterator.ReadString())
+				object.action = &value
+			}
+		case "resource_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceType = &value
+			}
+		case "role_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.roleID = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				PermissionKind,
-				PermissionLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.action = d.Action
-	object.resourceType = d.ResourceType
-	object.roleID = d.RoleID
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalPermission function.
+func (o *Permission) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writePermission(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalPermission function, using the
+// default decoding options.
+func (o *Permission) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalPermission(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Permission{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Permission) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Permission) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // PermissionServer represents the interface the manages the 'permission' resource.
@@ -75,14 +77,12 @@ func (r *PermissionGetServerResponse) SetStatusCode(status int) *PermissionGetSe
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *PermissionGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writePermission(r.body, stream)
+	return stream.Flush()
 }
 
 // PermissionDeleteServerRequest is the request for the 'delete' method.
@@ -103,57 +103,98 @@ func (r *PermissionDeleteServerResponse) SetStatusCode(status int) *PermissionDe
 	return r
 }
 
-// PermissionServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// PermissionServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type PermissionServerAdapter struct {
 	server PermissionServer
 	router *mux.Router
+	prefix string
 }
 
+// NewPermissionServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewPermissionServerAdapter(server PermissionServer, router *mux.Router) *PermissionServerAdapter {
 	adapter := new(PermissionServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *PermissionServerAdapter) readPermissionGetServerRequest(r *http.Request) (*PermissionGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *PermissionServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchPermission(w, r, a.server, segments)
+}
+
+// dispatchPermission navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchPermission(w http.ResponseWriter, r *http.Request, server PermissionServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptPermissionGetRequest(w, r, server)
+		case http.MethodDelete:
+			adaptPermissionDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readPermissionGetServerRequest(r *http.Request) (*PermissionGetServerRequest, error) {
 	result := new(PermissionGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *PermissionServerAdapter) writePermissionGetServerResponse(w http.ResponseWriter, r *PermissionGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writePermissionGetServerResponse(w http.ResponseWriter, r *PermissionGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *PermissionServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readPermissionGetServerRequest(r)
+func adaptPermissionGetRequest(w http.ResponseWriter, r *http.Request, server PermissionServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readPermissionGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(PermissionGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writePermissionGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writePermissionGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -163,38 +204,38 @@ func (a *PermissionServerAdapter) getHandler(w http.ResponseWriter, r *http.Requ
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *PermissionServerAdapter) readPermissionDeleteServerRequest(r *http.Request) (*PermissionDeleteServerRequest, error) {
+func readPermissionDeleteServerRequest(r *http.Request) (*PermissionDeleteServerRequest, error) {
 	result := new(PermissionDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *PermissionServerAdapter) writePermissionDeleteServerResponse(w http.ResponseWriter, r *PermissionDeleteServerResponse) error {
+func writePermissionDeleteServerResponse(w http.ResponseWriter, r *PermissionDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *PermissionServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readPermissionDeleteServerRequest(r)
+func adaptPermissionDeleteRequest(w http.ResponseWriter, r *http.Request, server PermissionServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readPermissionDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(PermissionDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writePermissionDeleteServerResponse(w, resp)
+	err = writePermissionDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
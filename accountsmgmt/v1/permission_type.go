@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // PermissionKind is the name of the type used to represent objects
 // of type 'permission'.
 const PermissionKind = "Permission"
@@ -41,6 +49,7 @@ type Permission struct {
 	action       *Action
 	resourceType *string
 	roleID       *string
+	extra        map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -95,6 +104,27 @@ func (o *Permission) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete permission that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Permission) Resolve(ctx context.Context, transport http.RoundTripper) (result *Permission, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to permission '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewPermissionClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Permission) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -104,6 +134,59 @@ func (o *Permission) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'permission' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Permission) Metadata() *helpers.TypeMetadata {
+	return permissionMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Permission) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printPermission(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Permission) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printPermission(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Permission) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printPermission(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Permission) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printPermission(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'permission' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Permission) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Action returns the value of the 'action' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -185,6 +268,219 @@ const PermissionListLinkKind = "PermissionListLink"
 // objects of type 'permission'.
 const PermissionListNilKind = "PermissionListNil"
 
+// Copy returns a deep copy of the 'permission' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Permission) Copy() *Permission {
+	if o == nil {
+		return nil
+	}
+	result := new(Permission)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.action != nil {
+		result.action = new(Action)
+		*result.action = *o.action
+	}
+	if o.resourceType != nil {
+		result.resourceType = new(string)
+		*result.resourceType = *o.resourceType
+	}
+	if o.roleID != nil {
+		result.roleID = new(string)
+		*result.roleID = *o.roleID
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'permission' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Permission) Equal(other *Permission) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.action == nil) != (other.action == nil) ||
+		(o.action != nil && *o.action != *other.action) {
+		return false
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		return false
+	}
+	if (o.roleID == nil) != (other.roleID == nil) ||
+		(o.roleID != nil && *o.roleID != *other.roleID) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'permission' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Permission) Diff(other *Permission) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'permission' object and the given one, using the given path as prefix.
+func (o *Permission) diff(path string, other *Permission, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.action == nil) != (other.action == nil) ||
+		(o.action != nil && *o.action != *other.action) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "action"),
+			Old:  helpers.Value(o.action),
+			New:  helpers.Value(other.action),
+		})
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_type"),
+			Old:  helpers.Value(o.resourceType),
+			New:  helpers.Value(other.resourceType),
+		})
+	}
+	if (o.roleID == nil) != (other.roleID == nil) ||
+		(o.roleID != nil && *o.roleID != *other.roleID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "role_id"),
+			Old:  helpers.Value(o.roleID),
+			New:  helpers.Value(other.roleID),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'permission' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Permission) Changes(modified *Permission) *Permission {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Permission)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.action != nil && (o.action == nil || *o.action != *modified.action) {
+		result.action = new(Action)
+		*result.action = *modified.action
+	}
+	if modified.resourceType != nil && (o.resourceType == nil || *o.resourceType != *modified.resourceType) {
+		result.resourceType = new(string)
+		*result.resourceType = *modified.resourceType
+	}
+	if modified.roleID != nil && (o.roleID == nil || *o.roleID != *modified.roleID) {
+		result.roleID = new(string)
+		*result.roleID = *modified.roleID
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printPermission prints a value of the 'permission' type to the given printer.
+func printPermission(object *Permission, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Permission")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.action != nil {
+		printer.PrintObjectField("Action")
+		printer.PrintSymbol(string(*object.action))
+	}
+	if object.resourceType != nil {
+		printer.PrintObjectField("ResourceType")
+		printer.PrintString(*object.resourceType)
+	}
+	if object.roleID != nil {
+		printer.PrintObjectField("RoleID")
+		printer.PrintString(*object.roleID)
+	}
+	printer.PrintObjectEnd()
+}
+
 // PermissionList is a list of values of the 'permission' type.
 type PermissionList struct {
 	href  *string
@@ -292,3 +588,146 @@ func (l *PermissionList) Range(f func(index int, item *Permission) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *PermissionList) Copy() *PermissionList {
+	if l == nil {
+		return nil
+	}
+	result := new(PermissionList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Permission, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *PermissionList) Equal(other *PermissionList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *PermissionList) Diff(other *PermissionList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *PermissionList) diff(path string, other *PermissionList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *PermissionList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printPermissionListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *PermissionList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printPermissionListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *PermissionList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printPermissionListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *PermissionList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printPermissionListLink(l, printer)
+	}
+}
+
+// printPermissionList prints a list of values of the 'permission' type to the given printer.
+func printPermissionList(list *PermissionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printPermission(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printPermissionListLink prints a list of values of the 'permission' type to the
+// given printer, including the link to the list.
+func printPermissionListLink(list *PermissionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.PermissionList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printPermissionList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
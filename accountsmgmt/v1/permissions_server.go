@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // PermissionsServer represents the interface the manages the 'permissions' resource.
@@ -56,6 +58,7 @@ type PermissionsListServerRequest struct {
 	query url.Values
 	page  *int
 	size  *int
+	order []*helpers.OrderItem
 	total *int
 }
 
@@ -111,6 +114,58 @@ func (r *PermissionsListServerRequest) GetSize() (value int, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the permission
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `action` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// action desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *PermissionsListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the permission
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `action` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// action desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *PermissionsListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -191,27 +246,29 @@ func (r *PermissionsListServerResponse) SetStatusCode(status int) *PermissionsLi
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *PermissionsListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(permissionsListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// permissionsListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type permissionsListServerResponseData struct {
-	Page  *int               "json:\"page,omitempty\""
-	Size  *int               "json:\"size,omitempty\""
-	Total *int               "json:\"total,omitempty\""
-	Items permissionListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writePermissionList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
 // PermissionsAddServerRequest is the request for the 'add' method.
@@ -246,18 +303,12 @@ func (r *PermissionsAddServerRequest) GetBody() (value *Permission, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'add' method.
 func (r *PermissionsAddServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(permissionData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readPermission(iterator)
+	return iterator.Error()
 }
 
 // PermissionsAddServerResponse is the response for the 'add' method.
@@ -284,75 +335,155 @@ func (r *PermissionsAddServerResponse) SetStatusCode(status int) *PermissionsAdd
 // marshall is the method used internally to marshal responses for the
 // 'add' method.
 func (r *PermissionsAddServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writePermission(r.body, stream)
+	return stream.Flush()
 }
 
-// PermissionsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// PermissionsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type PermissionsServerAdapter struct {
 	server PermissionsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewPermissionsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewPermissionsServerAdapter(server PermissionsServer, router *mux.Router) *PermissionsServerAdapter {
 	adapter := new(PermissionsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.permissionHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
-	adapter.router.Methods("POST").HandlerFunc(adapter.addHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *PermissionsServerAdapter) permissionHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.Permission(id)
-	targetAdapter := NewPermissionServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *PermissionsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchPermissions(w, r, a.server, segments)
+}
+
+// dispatchPermissions navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchPermissions(w http.ResponseWriter, r *http.Request, server PermissionsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptPermissionsListRequest(w, r, server)
+		case http.MethodPost:
+			adaptPermissionsAddRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.Permission(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchPermission(w, r, target, segments[1:])
+	}
 }
-func (a *PermissionsServerAdapter) readPermissionsListServerRequest(r *http.Request) (*PermissionsListServerRequest, error) {
+
+// permissionsListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var permissionsListOrderAttributes = map[string]bool{
+	"action":        true,
+	"href":          true,
+	"id":            true,
+	"resource_type": true,
+	"role_id":       true,
+}
+
+func readPermissionsListServerRequest(r *http.Request) (*PermissionsListServerRequest, error) {
+	var err error
 	result := new(PermissionsListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", permissionsListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *PermissionsServerAdapter) writePermissionsListServerResponse(w http.ResponseWriter, r *PermissionsListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writePermissionsListServerResponse(w http.ResponseWriter, r *PermissionsListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *PermissionsServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readPermissionsListServerRequest(r)
+func adaptPermissionsListRequest(w http.ResponseWriter, r *http.Request, server PermissionsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readPermissionsListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(PermissionsListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writePermissionsListServerResponse(w, resp)
+	err = writePermissionsListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -362,9 +493,9 @@ func (a *PermissionsServerAdapter) listHandler(w http.ResponseWriter, r *http.Re
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *PermissionsServerAdapter) readPermissionsAddServerRequest(r *http.Request) (*PermissionsAddServerRequest, error) {
+func readPermissionsAddServerRequest(r *http.Request) (*PermissionsAddServerRequest, error) {
 	result := new(PermissionsAddServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -372,36 +503,42 @@ func (a *PermissionsServerAdapter) readPermissionsAddServerRequest(r *http.Reque
 	}
 	return result, nil
 }
-func (a *PermissionsServerAdapter) writePermissionsAddServerResponse(w http.ResponseWriter, r *PermissionsAddServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writePermissionsAddServerResponse(w http.ResponseWriter, r *PermissionsAddServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *PermissionsServerAdapter) addHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readPermissionsAddServerRequest(r)
+func adaptPermissionsAddRequest(w http.ResponseWriter, r *http.Request, server PermissionsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readPermissionsAddServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(PermissionsAddServerResponse)
-	err = a.server.Add(r.Context(), req, resp)
+	err = server.Add(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Add: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Add", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusCreated
 	}
-	err = a.writePermissionsAddServerResponse(w, resp)
+	err = writePermissionsAddServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,13 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // PlanBuilder contains the data and logic needed to build 'plan' objects.
 //
 //
 type PlanBuilder struct {
-	id   *string
-	href *string
-	link bool
+	id    *string
+	href  *string
+	link  bool
+	extra map[string]interface{}
 }
 
 // NewPlan creates a new builder of 'plan' objects.
@@ -33,6 +38,21 @@ func NewPlan() *PlanBuilder {
 	return new(PlanBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'plan' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Plan) Builder() *PlanBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(PlanBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *PlanBuilder) ID(value string) *PlanBuilder {
 	b.id = &value
@@ -51,9 +71,17 @@ func (b *PlanBuilder) Link(value bool) *PlanBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'plan' type, and
+// that will be written as they are when the object is encoded.
+func (b *PlanBuilder) Extra(value map[string]interface{}) *PlanBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'plan' object using the configuration stored in the builder.
 func (b *PlanBuilder) Build() (object *Plan, err error) {
 	object = new(Plan)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
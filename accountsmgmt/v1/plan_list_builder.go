@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'plan' objects.
 type PlanListBuilder struct {
 	items []*PlanBuilder
+	link  bool
+	href  *string
 }
 
 // NewPlanList creates a new builder of 'plan' objects.
@@ -30,8 +32,25 @@ func NewPlanList() *PlanListBuilder {
 	return new(PlanListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *PlanList) Builder() *PlanListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(PlanListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*PlanBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *PlanListBuilder) Items(values ...*PlanBuilder) *PlanListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*PlanBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *PlanListBuilder) Build() (list *PlanList, err error) {
 		}
 	}
 	list = new(PlanList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// planListData is type used internally to marshal and unmarshal lists of objects
-// of type 'plan'.
-type planListData []*planData
-
 // UnmarshalPlanList reads a list of values of the 'plan'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalPlanList(source interface{}) (list *PlanList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalPlanList(source interface{}, options ...helpers.DecodingOptions) (list *PlanList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data planListData
-	err = decoder.Decode(&data)
+	list = readPlanList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'plan' value to a JSON document.
-func (l *PlanList) wrap() (data planListData, err error) {
-	if l == nil {
+// MarshalPlanList writes a list of values of the 'plan' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalPlanList(list *PlanList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writePlanList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *PlanList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writePlanListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalPlanList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *PlanList) UnmarshalJSON(data []byte) error {
+	var list *PlanList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalPlanList(data)
+	} else {
+		list, err = unmarshalPlanListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = PlanList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *PlanList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *PlanList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalPlanListLink reads a list of values of the 'plan' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalPlanListLink(source interface{}, options ...helpers.DecodingOptions) (list *PlanList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(planListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readPlanListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'plan' type.
-func (d planListData) unwrap() (list *PlanList, err error) {
-	if d == nil {
+// writePlanList writes a list of values of the 'plan' type to the given
+// stream, as a JSON array.
+func writePlanList(list *PlanList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Plan, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writePlan(item, stream)
 	}
-	list = new(PlanList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// planListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'plan'.
-type planListLinkData struct {
-	Kind  *string     "json:\"kind,omitempty\""
-	HREF  *string     "json:\"href,omitempty\""
-	Items []*planData "json:\"items,omitempty\""
+// readPlanList reads a list of values of the 'plan' type from the given
+// iterator, as a JSON array.
+func readPlanList(iterator *helpers.Iterator) *PlanList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(PlanList)
+	list.items = []*Plan{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readPlan(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'plan' value to a link.
-func (l *PlanList) wrapLink() (data *planListLinkData, err error) {
-	if l == nil {
+// writePlanListLink writes a list of values of the 'plan' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writePlanListLink(list *PlanList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*planData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(planListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = PlanListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(PlanListLinkKind)
 	} else {
-		*data.Kind = PlanListKind
+		stream.WriteString(PlanListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writePlanList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'plan' type to a list.
-func (d *planListLinkData) unwrapLink() (list *PlanList, err error) {
-	if d == nil {
-		return
+// readPlanListLink reads a list of values of the 'plan' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readPlanListLink(iterator *helpers.Iterator) *PlanList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Plan, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(PlanList)
+	list.items = []*Plan{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(PlanList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case PlanListKind:
-			list.link = false
-		case PlanListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(PlanListKind, PlanListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readPlanList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				PlanListKind,
-				PlanListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
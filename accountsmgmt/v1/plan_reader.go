@@ -20,91 +20,139 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// planData is the data structure used internally to marshal and unmarshal
-// objects of type 'plan'.
-type planData struct {
-	Kind *string "json:\"kind,omitempty\""
-	ID   *string "json:\"id,omitempty\""
-	HREF *string "json:\"href,omitempty\""
-}
-
 // MarshalPlan writes a value of the 'plan' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalPlan(object *Plan, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writePlan(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'plan'
-// value to a JSON document.
-func (o *Plan) wrap() (data *planData, err error) {
-	if o == nil {
+// writePlan writes a value of the 'plan' type to the given stream.
+func writePlan(object *Plan, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(planData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = PlanLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(PlanLinkKind)
 	} else {
-		*data.Kind = PlanKind
+		stream.WriteString(PlanKind)
 	}
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalPlan reads a value of the 'plan' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalPlan(source interface{}) (object *Plan, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalPlan(source interface{}, options ...helpers.DecodingOptions) (object *Plan, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(planData)
-	err = decoder.Decode(data)
+	object = readPlan(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Plan)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'plan' type.
-func (d *planData) unwrap() (object *Plan, err error) {
-	if d == nil {
-		return
+// readPlan reads a value of the 'plan' type from the given iterator.
+func readPlan(iterator *helpers.Iterator) *Plan {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Plan)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case PlanKind:
-			object.link = false
-		case PlanLinkKind:
-			object.link = true
+	object := new(Plan)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(PlanKind, PlanLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				PlanKind,
-				PlanLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalPlan function.
+func (o *Plan) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writePlan(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalPlan function, using the
+// default decoding options.
+func (o *Plan) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalPlan(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Plan{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Plan) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Plan) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,16 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // PlanKind is the name of the type used to represent objects
 // of type 'plan'.
 const PlanKind = "Plan"
@@ -35,9 +45,10 @@ const PlanNilKind = "PlanNil"
 //
 //
 type Plan struct {
-	id   *string
-	href *string
-	link bool
+	id    *string
+	href  *string
+	link  bool
+	extra map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -92,12 +103,106 @@ func (o *Plan) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete plan that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Plan) Resolve(ctx context.Context, transport http.RoundTripper) (result *Plan, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to plan '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL: &url.URL{
+			Path: href,
+		},
+		Header: helpers.SetHeader(nil, helpers.MetricPath(href)),
+	}
+	if ctx != nil {
+		request = request.WithContext(ctx)
+	}
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 400 {
+		var failure *errors.Error
+		failure, err = errors.ReadError(response)
+		if err != nil {
+			return
+		}
+		err = failure
+		return
+	}
+	result, err = UnmarshalPlan(response.Body)
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Plan) Empty() bool {
 	return o == nil || (o.id == nil &&
 		true)
 }
 
+// Metadata returns the description of the 'plan' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Plan) Metadata() *helpers.TypeMetadata {
+	return planMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Plan) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printPlan(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Plan) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printPlan(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Plan) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printPlan(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Plan) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printPlan(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'plan' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Plan) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // PlanListKind is the name of the type used to represent list of
 // objects of type 'plan'.
 const PlanListKind = "PlanList"
@@ -110,6 +215,147 @@ const PlanListLinkKind = "PlanListLink"
 // objects of type 'plan'.
 const PlanListNilKind = "PlanListNil"
 
+// Copy returns a deep copy of the 'plan' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Plan) Copy() *Plan {
+	if o == nil {
+		return nil
+	}
+	result := new(Plan)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'plan' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Plan) Equal(other *Plan) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'plan' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Plan) Diff(other *Plan) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'plan' object and the given one, using the given path as prefix.
+func (o *Plan) diff(path string, other *Plan, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'plan' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Plan) Changes(modified *Plan) *Plan {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Plan)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printPlan prints a value of the 'plan' type to the given printer.
+func printPlan(object *Plan, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Plan")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	printer.PrintObjectEnd()
+}
+
 // PlanList is a list of values of the 'plan' type.
 type PlanList struct {
 	href  *string
@@ -217,3 +463,146 @@ func (l *PlanList) Range(f func(index int, item *Plan) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *PlanList) Copy() *PlanList {
+	if l == nil {
+		return nil
+	}
+	result := new(PlanList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Plan, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *PlanList) Equal(other *PlanList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *PlanList) Diff(other *PlanList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *PlanList) diff(path string, other *PlanList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *PlanList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printPlanListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *PlanList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printPlanListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *PlanList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printPlanListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *PlanList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printPlanListLink(l, printer)
+	}
+}
+
+// printPlanList prints a list of values of the 'plan' type to the given printer.
+func printPlanList(list *PlanList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printPlan(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printPlanListLink prints a list of values of the 'plan' type to the
+// given printer, including the link to the list.
+func printPlanListLink(list *PlanList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.PlanList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printPlanList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
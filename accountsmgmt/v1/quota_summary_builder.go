@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // QuotaSummaryBuilder contains the data and logic needed to build 'quota_summary' objects.
 //
 //
@@ -30,6 +34,7 @@ type QuotaSummaryBuilder struct {
 	availabilityZoneType *string
 	allowed              *int
 	reserved             *int
+	extra                map[string]interface{}
 }
 
 // NewQuotaSummary creates a new builder of 'quota_summary' objects.
@@ -37,6 +42,25 @@ func NewQuotaSummary() *QuotaSummaryBuilder {
 	return new(QuotaSummaryBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'quota_summary' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *QuotaSummary) Builder() *QuotaSummaryBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(QuotaSummaryBuilder)
+	b.organizationID = o.organizationID
+	b.resourceName = o.resourceName
+	b.resourceType = o.resourceType
+	b.byoc = o.byoc
+	b.availabilityZoneType = o.availabilityZoneType
+	b.allowed = o.allowed
+	b.reserved = o.reserved
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // OrganizationID sets the value of the 'organization_ID' attribute
 // to the given value.
 //
@@ -100,9 +124,17 @@ func (b *QuotaSummaryBuilder) Reserved(value int) *QuotaSummaryBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'quota_summary' type, and
+// that will be written as they are when the object is encoded.
+func (b *QuotaSummaryBuilder) Extra(value map[string]interface{}) *QuotaSummaryBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'quota_summary' object using the configuration stored in the builder.
 func (b *QuotaSummaryBuilder) Build() (object *QuotaSummary, err error) {
 	object = new(QuotaSummary)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.organizationID != nil {
 		object.organizationID = b.organizationID
 	}
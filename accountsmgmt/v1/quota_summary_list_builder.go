@@ -30,6 +30,19 @@ func NewQuotaSummaryList() *QuotaSummaryListBuilder {
 	return new(QuotaSummaryListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *QuotaSummaryList) Builder() *QuotaSummaryListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(QuotaSummaryListBuilder)
+	b.items = make([]*QuotaSummaryBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *QuotaSummaryListBuilder) Items(values ...*QuotaSummaryBuilder) *QuotaSummaryListBuilder {
 	b.items = make([]*QuotaSummaryBuilder, len(values))
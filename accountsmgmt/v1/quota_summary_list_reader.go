@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// quotaSummaryListData is type used internally to marshal and unmarshal lists of objects
-// of type 'quota_summary'.
-type quotaSummaryListData []*quotaSummaryData
-
 // UnmarshalQuotaSummaryList reads a list of values of the 'quota_summary'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalQuotaSummaryList(source interface{}) (list *QuotaSummaryList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalQuotaSummaryList(source interface{}, options ...helpers.DecodingOptions) (list *QuotaSummaryList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data quotaSummaryListData
-	err = decoder.Decode(&data)
+	list = readQuotaSummaryList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'quota_summary' value to a JSON document.
-func (l *QuotaSummaryList) wrap() (data quotaSummaryListData, err error) {
-	if l == nil {
-		return
+// MarshalQuotaSummaryList writes a list of values of the 'quota_summary' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalQuotaSummaryList(list *QuotaSummaryList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(quotaSummaryListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeQuotaSummaryList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *QuotaSummaryList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeQuotaSummaryList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalQuotaSummaryList function,
+// using the default decoding options.
+func (l *QuotaSummaryList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalQuotaSummaryList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = QuotaSummaryList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'quota_summary' type.
-func (d quotaSummaryListData) unwrap() (list *QuotaSummaryList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *QuotaSummaryList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *QuotaSummaryList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeQuotaSummaryList writes a list of values of the 'quota_summary' type to the given
+// stream, as a JSON array.
+func writeQuotaSummaryList(list *QuotaSummaryList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*QuotaSummary, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeQuotaSummary(item, stream)
 	}
-	list = new(QuotaSummaryList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readQuotaSummaryList reads a list of values of the 'quota_summary' type from the given
+// iterator, as a JSON array.
+func readQuotaSummaryList(iterator *helpers.Iterator) *QuotaSummaryList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(QuotaSummaryList)
+	list.items = []*QuotaSummary{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readQuotaSummary(iterator))
+	}
+	return list
 }
@@ -23,78 +23,173 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// quotaSummaryData is the data structure used internally to marshal and unmarshal
-// objects of type 'quota_summary'.
-type quotaSummaryData struct {
-	OrganizationID       *string "json:\"organization_id,omitempty\""
-	ResourceName         *string "json:\"resource_name,omitempty\""
-	ResourceType         *string "json:\"resource_type,omitempty\""
-	BYOC                 *bool   "json:\"byoc,omitempty\""
-	AvailabilityZoneType *string "json:\"availability_zone_type,omitempty\""
-	Allowed              *int    "json:\"allowed,omitempty\""
-	Reserved             *int    "json:\"reserved,omitempty\""
-}
-
 // MarshalQuotaSummary writes a value of the 'quota_summary' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalQuotaSummary(object *QuotaSummary, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeQuotaSummary(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'quota_summary'
-// value to a JSON document.
-func (o *QuotaSummary) wrap() (data *quotaSummaryData, err error) {
-	if o == nil {
+// writeQuotaSummary writes a value of the 'quota_summary' type to the given stream.
+func writeQuotaSummary(object *QuotaSummary, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(quotaSummaryData)
-	data.OrganizationID = o.organizationID
-	data.ResourceName = o.resourceName
-	data.ResourceType = o.resourceType
-	data.BYOC = o.byoc
-	data.AvailabilityZoneType = o.availabilityZoneType
-	data.Allowed = o.allowed
-	data.Reserved = o.reserved
-	return
+	stream.WriteObjectStart()
+	if object.organizationID != nil {
+		stream.WriteObjectField("organization_id")
+		stream.WriteString(*object.organizationID)
+	}
+	if object.resourceName != nil {
+		stream.WriteObjectField("resource_name")
+		stream.WriteString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		stream.WriteObjectField("resource_type")
+		stream.WriteString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		stream.WriteObjectField("byoc")
+		stream.WriteBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		stream.WriteObjectField("availability_zone_type")
+		stream.WriteString(*object.availabilityZoneType)
+	}
+	if object.allowed != nil {
+		stream.WriteObjectField("allowed")
+		stream.WriteInt(*object.allowed)
+	}
+	if object.reserved != nil {
+		stream.WriteObjectField("reserved")
+		stream.WriteInt(*object.reserved)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalQuotaSummary reads a value of the 'quota_summary' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalQuotaSummary(source interface{}) (object *QuotaSummary, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalQuotaSummary(source interface{}, options ...helpers.DecodingOptions) (object *QuotaSummary, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(quotaSummaryData)
-	err = decoder.Decode(data)
+	object = readQuotaSummary(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(QuotaSummary)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'quota_summary' type.
-func (d *quotaSummaryData) unwrap() (object *QuotaSummary, err error) {
-	if d == nil {
-		return
+// readQuotaSummary reads a value of the 'quota_summary' type from the given iterator.
+func readQuotaSummary(iterator *helpers.Iterator) *QuotaSummary {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(QuotaSummary)
-	object.organizationID = d.OrganizationID
-	object.resourceName = d.ResourceName
-	object.resourceType = d.ResourceType
-	object.byoc = d.BYOC
-	object.availabilityZoneType = d.AvailabilityZoneType
-	object.allowed = d.Allowed
-	object.reserved = d.Reserved
-	return
+	object := new(QuotaSummary)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "organization_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.organizationID = &value
+			}
+		case "resource_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceName = &value
+			}
+		case "resource_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceType = &value
+			}
+		case "byoc":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.byoc = &value
+			}
+		case "availability_zone_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.availabilityZoneType = &value
+			}
+		case "allowed":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.allowed = &value
+			}
+		case "reserved":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.reserved = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalQuotaSummary function.
+func (o *QuotaSummary) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeQuotaSummary(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalQuotaSummary function, using the
+// default decoding options.
+func (o *QuotaSummary) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalQuotaSummary(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = QuotaSummary{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *QuotaSummary) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *QuotaSummary) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // QuotaSummaryServer represents the interface the manages the 'quota_summary' resource.
@@ -47,6 +49,7 @@ type QuotaSummaryListServerRequest struct {
 	page   *int
 	size   *int
 	search *string
+	order  []*helpers.OrderItem
 	total  *int
 }
 
@@ -152,6 +155,58 @@ func (r *QuotaSummaryListServerRequest) GetSearch() (value string, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the quota summary
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `organization_id` and then ascending by `resource_name` the value
+// should be:
+//
+// [source,sql]
+// ----
+// organization_id desc, resource_name asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *QuotaSummaryListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the quota summary
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `organization_id` and then ascending by `resource_name` the value
+// should be:
+//
+// [source,sql]
+// ----
+// organization_id desc, resource_name asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *QuotaSummaryListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -232,79 +287,171 @@ func (r *QuotaSummaryListServerResponse) SetStatusCode(status int) *QuotaSummary
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *QuotaSummaryListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(quotaSummaryListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// quotaSummaryListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type quotaSummaryListServerResponseData struct {
-	Page  *int                 "json:\"page,omitempty\""
-	Size  *int                 "json:\"size,omitempty\""
-	Total *int                 "json:\"total,omitempty\""
-	Items quotaSummaryListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeQuotaSummaryList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
-// QuotaSummaryServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// QuotaSummaryServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type QuotaSummaryServerAdapter struct {
 	server QuotaSummaryServer
 	router *mux.Router
+	prefix string
 }
 
+// NewQuotaSummaryServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewQuotaSummaryServerAdapter(server QuotaSummaryServer, router *mux.Router) *QuotaSummaryServerAdapter {
 	adapter := new(QuotaSummaryServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *QuotaSummaryServerAdapter) readQuotaSummaryListServerRequest(r *http.Request) (*QuotaSummaryListServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *QuotaSummaryServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchQuotaSummary(w, r, a.server, segments)
+}
+
+// dispatchQuotaSummary navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchQuotaSummary(w http.ResponseWriter, r *http.Request, server QuotaSummaryServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptQuotaSummaryListRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+
+// quotaSummaryListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var quotaSummaryListOrderAttributes = map[string]bool{
+	"allowed":                true,
+	"availability_zone_type": true,
+	"byoc":                   true,
+	"organization_id":        true,
+	"reserved":               true,
+	"resource_name":          true,
+	"resource_type":          true,
+}
+
+func readQuotaSummaryListServerRequest(r *http.Request) (*QuotaSummaryListServerRequest, error) {
+	var err error
 	result := new(QuotaSummaryListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.search, err = helpers.ParseString(result.query, "search")
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", quotaSummaryListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *QuotaSummaryServerAdapter) writeQuotaSummaryListServerResponse(w http.ResponseWriter, r *QuotaSummaryListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeQuotaSummaryListServerResponse(w http.ResponseWriter, r *QuotaSummaryListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *QuotaSummaryServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readQuotaSummaryListServerRequest(r)
+func adaptQuotaSummaryListRequest(w http.ResponseWriter, r *http.Request, server QuotaSummaryServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readQuotaSummaryListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(QuotaSummaryListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeQuotaSummaryListServerResponse(w, resp)
+	err = writeQuotaSummaryListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
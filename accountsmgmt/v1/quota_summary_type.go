@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // QuotaSummary represents the values of the 'quota_summary' type.
 //
 //
@@ -30,6 +36,7 @@ type QuotaSummary struct {
 	availabilityZoneType *string
 	allowed              *int
 	reserved             *int
+	extra                map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -44,6 +51,59 @@ func (o *QuotaSummary) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'quota_summary' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *QuotaSummary) Metadata() *helpers.TypeMetadata {
+	return quotaSummaryMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *QuotaSummary) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printQuotaSummary(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *QuotaSummary) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printQuotaSummary(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *QuotaSummary) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printQuotaSummary(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *QuotaSummary) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printQuotaSummary(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'quota_summary' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *QuotaSummary) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // OrganizationID returns the value of the 'organization_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -205,6 +265,255 @@ func (o *QuotaSummary) GetReserved() (value int, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'quota_summary' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *QuotaSummary) Copy() *QuotaSummary {
+	if o == nil {
+		return nil
+	}
+	result := new(QuotaSummary)
+	if o.organizationID != nil {
+		result.organizationID = new(string)
+		*result.organizationID = *o.organizationID
+	}
+	if o.resourceName != nil {
+		result.resourceName = new(string)
+		*result.resourceName = *o.resourceName
+	}
+	if o.resourceType != nil {
+		result.resourceType = new(string)
+		*result.resourceType = *o.resourceType
+	}
+	if o.byoc != nil {
+		result.byoc = new(bool)
+		*result.byoc = *o.byoc
+	}
+	if o.availabilityZoneType != nil {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *o.availabilityZoneType
+	}
+	if o.allowed != nil {
+		result.allowed = new(int)
+		*result.allowed = *o.allowed
+	}
+	if o.reserved != nil {
+		result.reserved = new(int)
+		*result.reserved = *o.reserved
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'quota_summary' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *QuotaSummary) Equal(other *QuotaSummary) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		return false
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		return false
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		return false
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		return false
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		return false
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		return false
+	}
+	if (o.reserved == nil) != (other.reserved == nil) ||
+		(o.reserved != nil && *o.reserved != *other.reserved) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'quota_summary' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *QuotaSummary) Diff(other *QuotaSummary) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'quota_summary' object and the given one, using the given path as prefix.
+func (o *QuotaSummary) diff(path string, other *QuotaSummary, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "organization_id"),
+			Old:  helpers.Value(o.organizationID),
+			New:  helpers.Value(other.organizationID),
+		})
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_name"),
+			Old:  helpers.Value(o.resourceName),
+			New:  helpers.Value(other.resourceName),
+		})
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_type"),
+			Old:  helpers.Value(o.resourceType),
+			New:  helpers.Value(other.resourceType),
+		})
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "byoc"),
+			Old:  helpers.Value(o.byoc),
+			New:  helpers.Value(other.byoc),
+		})
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "availability_zone_type"),
+			Old:  helpers.Value(o.availabilityZoneType),
+			New:  helpers.Value(other.availabilityZoneType),
+		})
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "allowed"),
+			Old:  helpers.Value(o.allowed),
+			New:  helpers.Value(other.allowed),
+		})
+	}
+	if (o.reserved == nil) != (other.reserved == nil) ||
+		(o.reserved != nil && *o.reserved != *other.reserved) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "reserved"),
+			Old:  helpers.Value(o.reserved),
+			New:  helpers.Value(other.reserved),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'quota_summary' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *QuotaSummary) Changes(modified *QuotaSummary) *QuotaSummary {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(QuotaSummary)
+	if modified.organizationID != nil && (o.organizationID == nil || *o.organizationID != *modified.organizationID) {
+		result.organizationID = new(string)
+		*result.organizationID = *modified.organizationID
+	}
+	if modified.resourceName != nil && (o.resourceName == nil || *o.resourceName != *modified.resourceName) {
+		result.resourceName = new(string)
+		*result.resourceName = *modified.resourceName
+	}
+	if modified.resourceType != nil && (o.resourceType == nil || *o.resourceType != *modified.resourceType) {
+		result.resourceType = new(string)
+		*result.resourceType = *modified.resourceType
+	}
+	if modified.byoc != nil && (o.byoc == nil || *o.byoc != *modified.byoc) {
+		result.byoc = new(bool)
+		*result.byoc = *modified.byoc
+	}
+	if modified.availabilityZoneType != nil && (o.availabilityZoneType == nil || *o.availabilityZoneType != *modified.availabilityZoneType) {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *modified.availabilityZoneType
+	}
+	if modified.allowed != nil && (o.allowed == nil || *o.allowed != *modified.allowed) {
+		result.allowed = new(int)
+		*result.allowed = *modified.allowed
+	}
+	if modified.reserved != nil && (o.reserved == nil || *o.reserved != *modified.reserved) {
+		result.reserved = new(int)
+		*result.reserved = *modified.reserved
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printQuotaSummary prints a value of the 'quota_summary' type to the given printer.
+func printQuotaSummary(object *QuotaSummary, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.QuotaSummary")
+	if object.organizationID != nil {
+		printer.PrintObjectField("OrganizationID")
+		printer.PrintString(*object.organizationID)
+	}
+	if object.resourceName != nil {
+		printer.PrintObjectField("ResourceName")
+		printer.PrintString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		printer.PrintObjectField("ResourceType")
+		printer.PrintString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		printer.PrintObjectField("BYOC")
+		printer.PrintBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		printer.PrintObjectField("AvailabilityZoneType")
+		printer.PrintString(*object.availabilityZoneType)
+	}
+	if object.allowed != nil {
+		printer.PrintObjectField("Allowed")
+		printer.PrintInt(*object.allowed)
+	}
+	if object.reserved != nil {
+		printer.PrintObjectField("Reserved")
+		printer.PrintInt(*object.reserved)
+	}
+	printer.PrintObjectEnd()
+}
+
 // QuotaSummaryList is a list of values of the 'quota_summary' type.
 type QuotaSummaryList struct {
 	items []*QuotaSummary
@@ -276,3 +585,105 @@ func (l *QuotaSummaryList) Range(f func(index int, item *QuotaSummary) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *QuotaSummaryList) Copy() *QuotaSummaryList {
+	if l == nil {
+		return nil
+	}
+	result := new(QuotaSummaryList)
+	if l.items != nil {
+		result.items = make([]*QuotaSummary, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *QuotaSummaryList) Equal(other *QuotaSummaryList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *QuotaSummaryList) Diff(other *QuotaSummaryList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *QuotaSummaryList) diff(path string, other *QuotaSummaryList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *QuotaSummaryList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printQuotaSummaryList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *QuotaSummaryList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printQuotaSummaryList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *QuotaSummaryList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printQuotaSummaryList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *QuotaSummaryList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printQuotaSummaryList(l, printer)
+	}
+}
+
+// printQuotaSummaryList prints a list of values of the 'quota_summary' type to the given printer.
+func printQuotaSummaryList(list *QuotaSummaryList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printQuotaSummary(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -21,7 +21,6 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
 	"context"
-	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
@@ -82,7 +81,11 @@ type RegistriesListRequest struct {
 	header    http.Header
 	page      *int
 	size      *int
+	order     *string
 	total     *int
+	decoding  helpers.DecodingOptions
+	stream    func(item *Registry) bool
+	channel   chan<- *Registry
 }
 
 // Parameter adds a query parameter.
@@ -97,6 +100,42 @@ func (r *RegistriesListRequest) Header(name string, value interface{}) *Registri
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RegistriesListRequest) Decoding(value helpers.DecodingOptions) *RegistriesListRequest {
+	r.decoding = value
+	return r
+}
+
+// Stream sets a function that will be called for each item of the response as soon as it is
+// read, instead of collecting all the items in memory, so that the memory used doesn't depend
+// on the size of the page. If the function returns false the rest of the response isn't read,
+// so the page, size and total of the response will be missing if the server sends them after the
+// items. When this is used the Items method of the response returns nil.
+//
+// Note that when the debug log of the connection is enabled the complete response is still read
+// in memory in order to write it to the log.
+func (r *RegistriesListRequest) Stream(handler func(item *Registry) bool) *RegistriesListRequest {
+	r.stream = handler
+	r.channel = nil
+	return r
+}
+
+// StreamTo is like Stream, but sends each item of the response to the given channel. The
+// channel isn't closed when the response ends. If the context used to send the request is
+// cancelled while waiting to send an item the rest of the response is ignored and the SendContext
+// method returns the error of the context. If all the items have been sent the context isn't
+// checked again.
+func (r *RegistriesListRequest) StreamTo(channel chan<- *Registry) *RegistriesListRequest {
+	r.stream = nil
+	r.channel = channel
+	return r
+}
+
 // Page sets the value of the 'page' parameter.
 //
 // Index of the requested page, where one corresponds to the first page.
@@ -117,6 +156,29 @@ func (r *RegistriesListRequest) Size(value int) *RegistriesListRequest {
 	return r
 }
 
+// Order sets the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistriesListRequest) Order(value string) *RegistriesListRequest {
+	r.order = &value
+	return r
+}
+
 // Total sets the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -143,6 +205,13 @@ func (r *RegistriesListRequest) SendContext(ctx context.Context) (result *Regist
 	if r.size != nil {
 		helpers.AddValue(&query, "size", *r.size)
 	}
+	if r.order != nil {
+		_, err = helpers.ParseOrder(url.Values{"order": {*r.order}}, "order", registriesListOrderAttributes)
+		if err != nil {
+			return
+		}
+		helpers.AddValue(&query, "order", *r.order)
+	}
 	if r.total != nil {
 		helpers.AddValue(&query, "total", *r.total)
 	}
@@ -168,17 +237,46 @@ func (r *RegistriesListRequest) SendContext(ctx context.Context) (result *Regist
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	handler, stopped := r.handler(ctx)
+	err = result.unmarshal(response.Body, r.decoding, handler)
 	if err != nil {
 		return
 	}
+	err = *stopped
+	return
+}
+
+// handler returns the function that should be called for each item of the response, or nil if
+// the items should be collected in the list returned by the Items method of the response. When
+// the items are sent to a channel, the second result is set to the error of the context if it is
+// cancelled before all the items have been sent.
+func (r *RegistriesListRequest) handler(ctx context.Context) (handler func(item *Registry) bool, stopped *error) {
+	stopped = new(error)
+	if r.channel == nil {
+		handler = r.stream
+		return
+	}
+	channel := r.channel
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	handler = func(item *Registry) bool {
+		select {
+		case channel <- item:
+			return true
+		case <-done:
+			*stopped = ctx.Err()
+			return false
+		}
+	}
 	return
 }
 
@@ -307,30 +405,75 @@ func (r *RegistriesListResponse) GetItems() (value *RegistryList, ok bool) {
 }
 
 // unmarshal is the method used internally to unmarshal responses to the
-// 'list' method.
-func (r *RegistriesListResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(registriesListResponseData)
-	err = decoder.Decode(data)
+// 'list' method. If the stream function isn't nil it is called for each item,
+// instead of collecting the items in memory.
+func (r *RegistriesListResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions,
+	stream func(item *Registry) bool) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.page = data.Page
-	r.size = data.Size
-	r.total = data.Total
-	r.items, err = data.Items.unwrap()
-	if err != nil {
-		return err
+	if !iterator.ReadObjectStart() {
+		return iterator.Error()
+	}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			iterator.Skip()
+		case "page":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.page = &value
+			}
+		case "size":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.size = &value
+			}
+		case "total":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.total = &value
+			}
+		case "items":
+			if stream == nil {
+				r.items = readRegistryList(iterator)
+				break
+			}
+			if !iterator.ReadArrayStart() {
+				break
+			}
+			for iterator.ReadArrayNext() {
+				item := readRegistry(iterator)
+				if iterator.Error() != nil || !stream(item) {
+					return iterator.Error()
+				}
+			}
+		default:
+			iterator.SkipField(field)
+		}
 	}
-	return err
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *RegistriesClient) Metadata() *helpers.ResourceMetadata {
+	return registriesResource
 }
 
-// registriesListResponseData is the structure used internally to unmarshal
-// the response of the 'list' method.
-type registriesListResponseData struct {
-	Page  *int             "json:\"page,omitempty\""
-	Size  *int             "json:\"size,omitempty\""
-	Total *int             "json:\"total,omitempty\""
-	Items registryListData "json:\"items,omitempty\""
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *RegistriesClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	return c.Registry(segments[0]).route(segments[1:], append(ids, segments[0]))
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RegistriesServer represents the interface the manages the 'registries' resource.
@@ -51,6 +53,7 @@ type RegistriesListServerRequest struct {
 	query url.Values
 	page  *int
 	size  *int
+	order []*helpers.OrderItem
 	total *int
 }
 
@@ -106,6 +109,58 @@ func (r *RegistriesListServerRequest) GetSize() (value int, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistriesListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistriesListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -186,87 +241,173 @@ func (r *RegistriesListServerResponse) SetStatusCode(status int) *RegistriesList
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *RegistriesListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(registriesListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// registriesListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type registriesListServerResponseData struct {
-	Page  *int             "json:\"page,omitempty\""
-	Size  *int             "json:\"size,omitempty\""
-	Total *int             "json:\"total,omitempty\""
-	Items registryListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRegistryList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
-// RegistriesServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RegistriesServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RegistriesServerAdapter struct {
 	server RegistriesServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRegistriesServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRegistriesServerAdapter(server RegistriesServer, router *mux.Router) *RegistriesServerAdapter {
 	adapter := new(RegistriesServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.registryHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RegistriesServerAdapter) registryHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.Registry(id)
-	targetAdapter := NewRegistryServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RegistriesServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRegistries(w, r, a.server, segments)
 }
-func (a *RegistriesServerAdapter) readRegistriesListServerRequest(r *http.Request) (*RegistriesListServerRequest, error) {
+
+// dispatchRegistries navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRegistries(w http.ResponseWriter, r *http.Request, server RegistriesServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRegistriesListRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.Registry(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRegistry(w, r, target, segments[1:])
+	}
+}
+
+// registriesListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var registriesListOrderAttributes = map[string]bool{
+	"cloud_alias": true,
+	"href":        true,
+	"id":          true,
+	"name":        true,
+	"org_name":    true,
+	"team_name":   true,
+	"type":        true,
+	"url":         true,
+}
+
+func readRegistriesListServerRequest(r *http.Request) (*RegistriesListServerRequest, error) {
+	var err error
 	result := new(RegistriesListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", registriesListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *RegistriesServerAdapter) writeRegistriesListServerResponse(w http.ResponseWriter, r *RegistriesListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRegistriesListServerResponse(w http.ResponseWriter, r *RegistriesListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RegistriesServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRegistriesListServerRequest(r)
+func adaptRegistriesListRequest(w http.ResponseWriter, r *http.Request, server RegistriesServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRegistriesListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RegistriesListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRegistriesListServerResponse(w, resp)
+	err = writeRegistriesListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RegistryBuilder contains the data and logic needed to build 'registry' objects.
 //
 //
@@ -32,6 +36,7 @@ type RegistryBuilder struct {
 	orgName    *string
 	type_      *string
 	cloudAlias *bool
+	extra      map[string]interface{}
 }
 
 // NewRegistry creates a new builder of 'registry' objects.
@@ -39,6 +44,27 @@ func NewRegistry() *RegistryBuilder {
 	return new(RegistryBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'registry' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Registry) Builder() *RegistryBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(RegistryBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	b.url = o.url
+	b.teamName = o.teamName
+	b.orgName = o.orgName
+	b.type_ = o.type_
+	b.cloudAlias = o.cloudAlias
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *RegistryBuilder) ID(value string) *RegistryBuilder {
 	b.id = &value
@@ -111,9 +137,17 @@ func (b *RegistryBuilder) CloudAlias(value bool) *RegistryBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'registry' type, and
+// that will be written as they are when the object is encoded.
+func (b *RegistryBuilder) Extra(value map[string]interface{}) *RegistryBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'registry' object using the configuration stored in the builder.
 func (b *RegistryBuilder) Build() (object *Registry, err error) {
 	object = new(Registry)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RegistryCredentialBuilder contains the data and logic needed to build 'registry_credential' objects.
 //
 //
@@ -30,6 +34,7 @@ type RegistryCredentialBuilder struct {
 	token    *string
 	registry *RegistryBuilder
 	account  *AccountBuilder
+	extra    map[string]interface{}
 }
 
 // NewRegistryCredential creates a new builder of 'registry_credential' objects.
@@ -37,6 +42,29 @@ func NewRegistryCredential() *RegistryCredentialBuilder {
 	return new(RegistryCredentialBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'registry_credential' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *RegistryCredential) Builder() *RegistryCredentialBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(RegistryCredentialBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.username = o.username
+	b.token = o.token
+	if o.registry != nil {
+		b.registry = o.registry.Builder()
+	}
+	if o.account != nil {
+		b.account = o.account.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *RegistryCredentialBuilder) ID(value string) *RegistryCredentialBuilder {
 	b.id = &value
@@ -91,9 +119,17 @@ func (b *RegistryCredentialBuilder) Account(value *AccountBuilder) *RegistryCred
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'registry_credential' type, and
+// that will be written as they are when the object is encoded.
+func (b *RegistryCredentialBuilder) Extra(value map[string]interface{}) *RegistryCredentialBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'registry_credential' object using the configuration stored in the builder.
 func (b *RegistryCredentialBuilder) Build() (object *RegistryCredential, err error) {
 	object = new(RegistryCredential)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
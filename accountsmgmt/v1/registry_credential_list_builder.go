@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'registry_credential' objects.
 type RegistryCredentialListBuilder struct {
 	items []*RegistryCredentialBuilder
+	link  bool
+	href  *string
 }
 
 // NewRegistryCredentialList creates a new builder of 'registry_credential' objects.
@@ -30,8 +32,25 @@ func NewRegistryCredentialList() *RegistryCredentialListBuilder {
 	return new(RegistryCredentialListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *RegistryCredentialList) Builder() *RegistryCredentialListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(RegistryCredentialListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*RegistryCredentialBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *RegistryCredentialListBuilder) Items(values ...*RegistryCredentialBuilder) *RegistryCredentialListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*RegistryCredentialBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *RegistryCredentialListBuilder) Build() (list *RegistryCredentialList, e
 		}
 	}
 	list = new(RegistryCredentialList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
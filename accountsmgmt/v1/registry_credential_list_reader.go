@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// registryCredentialListData is type used internally to marshal and unmarshal lists of objects
-// of type 'registry_credential'.
-type registryCredentialListData []*registryCredentialData
-
 // UnmarshalRegistryCredentialList reads a list of values of the 'registry_credential'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalRegistryCredentialList(source interface{}) (list *RegistryCredentialList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalRegistryCredentialList(source interface{}, options ...helpers.DecodingOptions) (list *RegistryCredentialList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data registryCredentialListData
-	err = decoder.Decode(&data)
+	list = readRegistryCredentialList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'registry_credential' value to a JSON document.
-func (l *RegistryCredentialList) wrap() (data registryCredentialListData, err error) {
-	if l == nil {
+// MarshalRegistryCredentialList writes a list of values of the 'registry_credential' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalRegistryCredentialList(list *RegistryCredentialList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeRegistryCredentialList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *RegistryCredentialList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRegistryCredentialListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalRegistryCredentialList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *RegistryCredentialList) UnmarshalJSON(data []byte) error {
+	var list *RegistryCredentialList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalRegistryCredentialList(data)
+	} else {
+		list, err = unmarshalRegistryCredentialListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = RegistryCredentialList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *RegistryCredentialList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *RegistryCredentialList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalRegistryCredentialListLink reads a list of values of the 'registry_credential' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalRegistryCredentialListLink(source interface{}, options ...helpers.DecodingOptions) (list *RegistryCredentialList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(registryCredentialListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readRegistryCredentialListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'registry_credential' type.
-func (d registryCredentialListData) unwrap() (list *RegistryCredentialList, err error) {
-	if d == nil {
+// writeRegistryCredentialList writes a list of values of the 'registry_credential' type to the given
+// stream, as a JSON array.
+func writeRegistryCredentialList(list *RegistryCredentialList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*RegistryCredential, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeRegistryCredential(item, stream)
 	}
-	list = new(RegistryCredentialList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// registryCredentialListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'registry_credential'.
-type registryCredentialListLinkData struct {
-	Kind  *string                   "json:\"kind,omitempty\""
-	HREF  *string                   "json:\"href,omitempty\""
-	Items []*registryCredentialData "json:\"items,omitempty\""
+// readRegistryCredentialList reads a list of values of the 'registry_credential' type from the given
+// iterator, as a JSON array.
+func readRegistryCredentialList(iterator *helpers.Iterator) *RegistryCredentialList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(RegistryCredentialList)
+	list.items = []*RegistryCredential{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readRegistryCredential(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'registry_credential' value to a link.
-func (l *RegistryCredentialList) wrapLink() (data *registryCredentialListLinkData, err error) {
-	if l == nil {
+// writeRegistryCredentialListLink writes a list of values of the 'registry_credential' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeRegistryCredentialListLink(list *RegistryCredentialList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*registryCredentialData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(registryCredentialListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = RegistryCredentialListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(RegistryCredentialListLinkKind)
 	} else {
-		*data.Kind = RegistryCredentialListKind
+		stream.WriteString(RegistryCredentialListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRegistryCredentialList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'registry_credential' type to a list.
-func (d *registryCredentialListLinkData) unwrapLink() (list *RegistryCredentialList, err error) {
-	if d == nil {
-		return
+// readRegistryCredentialListLink reads a list of values of the 'registry_credential' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readRegistryCredentialListLink(iterator *helpers.Iterator) *RegistryCredentialList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*RegistryCredential, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(RegistryCredentialList)
+	list.items = []*RegistryCredential{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(RegistryCredentialList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RegistryCredentialListKind:
-			list.link = false
-		case RegistryCredentialListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(RegistryCredentialListKind, RegistryCredentialListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readRegistryCredentialList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RegistryCredentialListKind,
-				RegistryCredentialListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
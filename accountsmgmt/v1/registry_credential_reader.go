@@ -20,115 +20,169 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// registryCredentialData is the data structure used internally to marshal and unmarshal
-// objects of type 'registry_credential'.
-type registryCredentialData struct {
-	Kind     *string       "json:\"kind,omitempty\""
-	ID       *string       "json:\"id,omitempty\""
-	HREF     *string       "json:\"href,omitempty\""
-	Username *string       "json:\"username,omitempty\""
-	Token    *string       "json:\"token,omitempty\""
-	Registry *registryData "json:\"registry,omitempty\""
-	Account  *accountData  "json:\"account,omitempty\""
-}
-
 // MarshalRegistryCredential writes a value of the 'registry_credential' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalRegistryCredential(object *RegistryCredential, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeRegistryCredential(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'registry_credential'
-// value to a JSON document.
-func (o *RegistryCredential) wrap() (data *registryCredentialData, err error) {
-	if o == nil {
+// writeRegistryCredential writes a value of the 'registry_credential' type to the given stream.
+func writeRegistryCredential(object *RegistryCredential, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(registryCredentialData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = RegistryCredentialLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(RegistryCredentialLinkKind)
 	} else {
-		*data.Kind = RegistryCredentialKind
+		stream.WriteString(RegistryCredentialKind)
 	}
-	data.Username = o.username
-	data.Token = o.token
-	data.Registry, err = o.registry.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Account, err = o.account.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	return
+	if object.username != nil {
+		stream.WriteObjectField("username")
+		stream.WriteString(*object.username)
+	}
+	if object.token != nil {
+		stream.WriteObjectField("token")
+		stream.WriteString(*object.token)
+	}
+	if object.registry != nil {
+		stream.WriteObjectField("registry")
+		writeRegistry(object.registry, stream)
+	}
+	if object.account != nil {
+		stream.WriteObjectField("account")
+		writeAccount(object.account, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalRegistryCredential reads a value of the 'registry_credential' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalRegistryCredential(source interface{}) (object *RegistryCredential, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalRegistryCredential(source interface{}, options ...helpers.DecodingOptions) (object *RegistryCredential, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(registryCredentialData)
-	err = decoder.Decode(data)
+	object = readRegistryCredential(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(RegistryCredential)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'registry_credential' type.
-func (d *registryCredentialData) unwrap() (object *RegistryCredential, err error) {
-	if d == nil {
-		return
+// readRegistryCredential reads a value of the 'registry_credential' type from the given iterator.
+func readRegistryCredential(iterator *helpers.Iterator) *RegistryCredential {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(RegistryCredential)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RegistryCredentialKind:
-			object.link = false
-		case RegistryCredentialLinkKind:
-			object.link = true
+	object := new(RegistryCredential)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(RegistryCredentialKind, RegistryCredentialLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "username":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.username = &value
+			}
+		case "token":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.token = &value
+			}
+		case "registry":
+			object.registry = readRegistry(iterator)
+		case "account":
+			object.account = readAccount(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RegistryCredentialKind,
-				RegistryCredentialLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.username = d.Username
-	object.token = d.Token
-	object.registry, err = d.Registry.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalRegistryCredential function.
+func (o *RegistryCredential) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRegistryCredential(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalRegistryCredential function, using the
+// default decoding options.
+func (o *RegistryCredential) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalRegistryCredential(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.account, err = d.Account.unwrap()
+	if object == nil {
+		*o = RegistryCredential{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *RegistryCredential) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	return
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *RegistryCredential) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RegistryCredentialServer represents the interface the manages the 'registry_credential' resource.
@@ -70,66 +72,104 @@ func (r *RegistryCredentialGetServerResponse) SetStatusCode(status int) *Registr
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *RegistryCredentialGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRegistryCredential(r.body, stream)
+	return stream.Flush()
 }
 
-// RegistryCredentialServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RegistryCredentialServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RegistryCredentialServerAdapter struct {
 	server RegistryCredentialServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRegistryCredentialServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRegistryCredentialServerAdapter(server RegistryCredentialServer, router *mux.Router) *RegistryCredentialServerAdapter {
 	adapter := new(RegistryCredentialServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RegistryCredentialServerAdapter) readRegistryCredentialGetServerRequest(r *http.Request) (*RegistryCredentialGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RegistryCredentialServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRegistryCredential(w, r, a.server, segments)
+}
+
+// dispatchRegistryCredential navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRegistryCredential(w http.ResponseWriter, r *http.Request, server RegistryCredentialServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRegistryCredentialGetRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readRegistryCredentialGetServerRequest(r *http.Request) (*RegistryCredentialGetServerRequest, error) {
 	result := new(RegistryCredentialGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *RegistryCredentialServerAdapter) writeRegistryCredentialGetServerResponse(w http.ResponseWriter, r *RegistryCredentialGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRegistryCredentialGetServerResponse(w http.ResponseWriter, r *RegistryCredentialGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RegistryCredentialServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRegistryCredentialGetServerRequest(r)
+func adaptRegistryCredentialGetRequest(w http.ResponseWriter, r *http.Request, server RegistryCredentialServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRegistryCredentialGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RegistryCredentialGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRegistryCredentialGetServerResponse(w, resp)
+	err = writeRegistryCredentialGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
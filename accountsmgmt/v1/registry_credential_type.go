@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RegistryCredentialKind is the name of the type used to represent objects
 // of type 'registry_credential'.
 const RegistryCredentialKind = "RegistryCredential"
@@ -42,6 +50,7 @@ type RegistryCredential struct {
 	token    *string
 	registry *Registry
 	account  *Account
+	extra    map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -96,6 +105,27 @@ func (o *RegistryCredential) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete registry credential that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *RegistryCredential) Resolve(ctx context.Context, transport http.RoundTripper) (result *RegistryCredential, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to registry credential '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewRegistryCredentialClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *RegistryCredential) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -106,6 +136,59 @@ func (o *RegistryCredential) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'registry_credential' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *RegistryCredential) Metadata() *helpers.TypeMetadata {
+	return registryCredentialMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *RegistryCredential) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRegistryCredential(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *RegistryCredential) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRegistryCredential(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *RegistryCredential) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRegistryCredential(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *RegistryCredential) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRegistryCredential(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'registry_credential' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *RegistryCredential) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Username returns the value of the 'username' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -210,6 +293,219 @@ const RegistryCredentialListLinkKind = "RegistryCredentialListLink"
 // objects of type 'registry_credential'.
 const RegistryCredentialListNilKind = "RegistryCredentialListNil"
 
+// Copy returns a deep copy of the 'registry_credential' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *RegistryCredential) Copy() *RegistryCredential {
+	if o == nil {
+		return nil
+	}
+	result := new(RegistryCredential)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.username != nil {
+		result.username = new(string)
+		*result.username = *o.username
+	}
+	if o.token != nil {
+		result.token = new(string)
+		*result.token = *o.token
+	}
+	result.registry = o.registry.Copy()
+	result.account = o.account.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'registry_credential' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *RegistryCredential) Equal(other *RegistryCredential) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.username == nil) != (other.username == nil) ||
+		(o.username != nil && *o.username != *other.username) {
+		return false
+	}
+	if (o.token == nil) != (other.token == nil) ||
+		(o.token != nil && *o.token != *other.token) {
+		return false
+	}
+	if !o.registry.Equal(other.registry) {
+		return false
+	}
+	if !o.account.Equal(other.account) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'registry_credential' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *RegistryCredential) Diff(other *RegistryCredential) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'registry_credential' object and the given one, using the given path as prefix.
+func (o *RegistryCredential) diff(path string, other *RegistryCredential, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.username == nil) != (other.username == nil) ||
+		(o.username != nil && *o.username != *other.username) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "username"),
+			Old:  helpers.Value(o.username),
+			New:  helpers.Value(other.username),
+		})
+	}
+	if (o.token == nil) != (other.token == nil) ||
+		(o.token != nil && *o.token != *other.token) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "token"),
+			Old:  helpers.Value(o.token),
+			New:  helpers.Value(other.token),
+		})
+	}
+	result = o.registry.diff(helpers.Path(path, "registry"), other.registry, result)
+	result = o.account.diff(helpers.Path(path, "account"), other.account, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'registry_credential' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *RegistryCredential) Changes(modified *RegistryCredential) *RegistryCredential {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(RegistryCredential)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.username != nil && (o.username == nil || *o.username != *modified.username) {
+		result.username = new(string)
+		*result.username = *modified.username
+	}
+	if modified.token != nil && (o.token == nil || *o.token != *modified.token) {
+		result.token = new(string)
+		*result.token = *modified.token
+	}
+	if modified.registry != nil && !o.registry.Equal(modified.registry) {
+		result.registry = o.registry.Changes(modified.registry)
+	}
+	if modified.account != nil && !o.account.Equal(modified.account) {
+		result.account = o.account.Changes(modified.account)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printRegistryCredential prints a value of the 'registry_credential' type to the given printer.
+func printRegistryCredential(object *RegistryCredential, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.RegistryCredential")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.username != nil {
+		printer.PrintObjectField("Username")
+		printer.PrintString(*object.username)
+	}
+	if object.token != nil {
+		printer.PrintObjectField("Token")
+		printer.PrintSensitive(*object.token)
+	}
+	if object.registry != nil {
+		printer.PrintObjectField("Registry")
+		printRegistry(object.registry, printer)
+	}
+	if object.account != nil {
+		printer.PrintObjectField("Account")
+		printAccount(object.account, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // RegistryCredentialList is a list of values of the 'registry_credential' type.
 type RegistryCredentialList struct {
 	href  *string
@@ -317,3 +613,146 @@ func (l *RegistryCredentialList) Range(f func(index int, item *RegistryCredentia
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *RegistryCredentialList) Copy() *RegistryCredentialList {
+	if l == nil {
+		return nil
+	}
+	result := new(RegistryCredentialList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*RegistryCredential, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *RegistryCredentialList) Equal(other *RegistryCredentialList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *RegistryCredentialList) Diff(other *RegistryCredentialList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *RegistryCredentialList) diff(path string, other *RegistryCredentialList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *RegistryCredentialList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRegistryCredentialListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *RegistryCredentialList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRegistryCredentialListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *RegistryCredentialList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRegistryCredentialListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *RegistryCredentialList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRegistryCredentialListLink(l, printer)
+	}
+}
+
+// printRegistryCredentialList prints a list of values of the 'registry_credential' type to the given printer.
+func printRegistryCredentialList(list *RegistryCredentialList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printRegistryCredential(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printRegistryCredentialListLink prints a list of values of the 'registry_credential' type to the
+// given printer, including the link to the list.
+func printRegistryCredentialListLink(list *RegistryCredentialList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.RegistryCredentialList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printRegistryCredentialList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -22,7 +22,6 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -95,7 +94,11 @@ type RegistryCredentialsListRequest struct {
 	header    http.Header
 	page      *int
 	size      *int
+	order     *string
 	total     *int
+	decoding  helpers.DecodingOptions
+	stream    func(item *RegistryCredential) bool
+	channel   chan<- *RegistryCredential
 }
 
 // Parameter adds a query parameter.
@@ -110,6 +113,42 @@ func (r *RegistryCredentialsListRequest) Header(name string, value interface{})
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RegistryCredentialsListRequest) Decoding(value helpers.DecodingOptions) *RegistryCredentialsListRequest {
+	r.decoding = value
+	return r
+}
+
+// Stream sets a function that will be called for each item of the response as soon as it is
+// read, instead of collecting all the items in memory, so that the memory used doesn't depend
+// on the size of the page. If the function returns false the rest of the response isn't read,
+// so the page, size and total of the response will be missing if the server sends them after the
+// items. When this is used the Items method of the response returns nil.
+//
+// Note that when the debug log of the connection is enabled the complete response is still read
+// in memory in order to write it to the log.
+func (r *RegistryCredentialsListRequest) Stream(handler func(item *RegistryCredential) bool) *RegistryCredentialsListRequest {
+	r.stream = handler
+	r.channel = nil
+	return r
+}
+
+// StreamTo is like Stream, but sends each item of the response to the given channel. The
+// channel isn't closed when the response ends. If the context used to send the request is
+// cancelled while waiting to send an item the rest of the response is ignored and the SendContext
+// method returns the error of the context. If all the items have been sent the context isn't
+// checked again.
+func (r *RegistryCredentialsListRequest) StreamTo(channel chan<- *RegistryCredential) *RegistryCredentialsListRequest {
+	r.stream = nil
+	r.channel = channel
+	return r
+}
+
 // Page sets the value of the 'page' parameter.
 //
 // Index of the requested page, where one corresponds to the first page.
@@ -130,6 +169,29 @@ func (r *RegistryCredentialsListRequest) Size(value int) *RegistryCredentialsLis
 	return r
 }
 
+// Order sets the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry credential
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `username` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// username desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistryCredentialsListRequest) Order(value string) *RegistryCredentialsListRequest {
+	r.order = &value
+	return r
+}
+
 // Total sets the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -156,6 +218,13 @@ func (r *RegistryCredentialsListRequest) SendContext(ctx context.Context) (resul
 	if r.size != nil {
 		helpers.AddValue(&query, "size", *r.size)
 	}
+	if r.order != nil {
+		_, err = helpers.ParseOrder(url.Values{"order": {*r.order}}, "order", registryCredentialsListOrderAttributes)
+		if err != nil {
+			return
+		}
+		helpers.AddValue(&query, "order", *r.order)
+	}
 	if r.total != nil {
 		helpers.AddValue(&query, "total", *r.total)
 	}
@@ -181,17 +250,46 @@ func (r *RegistryCredentialsListRequest) SendContext(ctx context.Context) (resul
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	handler, stopped := r.handler(ctx)
+	err = result.unmarshal(response.Body, r.decoding, handler)
 	if err != nil {
 		return
 	}
+	err = *stopped
+	return
+}
+
+// handler returns the function that should be called for each item of the response, or nil if
+// the items should be collected in the list returned by the Items method of the response. When
+// the items are sent to a channel, the second result is set to the error of the context if it is
+// cancelled before all the items have been sent.
+func (r *RegistryCredentialsListRequest) handler(ctx context.Context) (handler func(item *RegistryCredential) bool, stopped *error) {
+	stopped = new(error)
+	if r.channel == nil {
+		handler = r.stream
+		return
+	}
+	channel := r.channel
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	handler = func(item *RegistryCredential) bool {
+		select {
+		case channel <- item:
+			return true
+		case <-done:
+			*stopped = ctx.Err()
+			return false
+		}
+	}
 	return
 }
 
@@ -320,32 +418,59 @@ func (r *RegistryCredentialsListResponse) GetItems() (value *RegistryCredentialL
 }
 
 // unmarshal is the method used internally to unmarshal responses to the
-// 'list' method.
-func (r *RegistryCredentialsListResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(registryCredentialsListResponseData)
-	err = decoder.Decode(data)
+// 'list' method. If the stream function isn't nil it is called for each item,
+// instead of collecting the items in memory.
+func (r *RegistryCredentialsListResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions,
+	stream func(item *RegistryCredential) bool) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.page = data.Page
-	r.size = data.Size
-	r.total = data.Total
-	r.items, err = data.Items.unwrap()
-	if err != nil {
-		return err
+	if !iterator.ReadObjectStart() {
+		return iterator.Error()
 	}
-	return err
-}
-
-// registryCredentialsListResponseData is the structure used internally to unmarshal
-// the response of the 'list' method.
-type registryCredentialsListResponseData struct {
-	Page  *int                       "json:\"page,omitempty\""
-	Size  *int                       "json:\"size,omitempty\""
-	Total *int                       "json:\"total,omitempty\""
-	Items registryCredentialListData "json:\"items,omitempty\""
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			iterator.Skip()
+		case "page":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.page = &value
+			}
+		case "size":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.size = &value
+			}
+		case "total":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.total = &value
+			}
+		case "items":
+			if stream == nil {
+				r.items = readRegistryCredentialList(iterator)
+				break
+			}
+			if !iterator.ReadArrayStart() {
+				break
+			}
+			for iterator.ReadArrayNext() {
+				item := readRegistryCredential(iterator)
+				if iterator.Error() != nil || !stream(item) {
+					return iterator.Error()
+				}
+			}
+		default:
+			iterator.SkipField(field)
+		}
+	}
+	return iterator.Error()
 }
 
 // RegistryCredentialsAddRequest is the request for the 'add' method.
@@ -356,6 +481,7 @@ type RegistryCredentialsAddRequest struct {
 	query     url.Values
 	header    http.Header
 	body      *RegistryCredential
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -370,6 +496,17 @@ func (r *RegistryCredentialsAddRequest) Header(name string, value interface{}) *
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RegistryCredentialsAddRequest) Decoding(value helpers.DecodingOptions) *RegistryCredentialsAddRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 // Registry credential data.
@@ -417,14 +554,14 @@ func (r *RegistryCredentialsAddRequest) SendContext(ctx context.Context) (result
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -434,14 +571,12 @@ func (r *RegistryCredentialsAddRequest) SendContext(ctx context.Context) (result
 // marshall is the method used internally to marshal requests for the
 // 'add' method.
 func (r *RegistryCredentialsAddRequest) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRegistryCredential(r.body, stream)
+	return stream.Flush()
 }
 
 // RegistryCredentialsAddResponse is the response for the 'add' method.
@@ -491,17 +626,29 @@ func (r *RegistryCredentialsAddResponse) GetBody() (value *RegistryCredential, o
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'add' method.
-func (r *RegistryCredentialsAddResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(registryCredentialData)
-	err = decoder.Decode(data)
+func (r *RegistryCredentialsAddResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readRegistryCredential(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *RegistryCredentialsClient) Metadata() *helpers.ResourceMetadata {
+	return registryCredentialsResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *RegistryCredentialsClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	return c.RegistryCredential(segments[0]).route(segments[1:], append(ids, segments[0]))
 }
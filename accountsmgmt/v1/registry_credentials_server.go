@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RegistryCredentialsServer represents the interface the manages the 'registry_credentials' resource.
@@ -56,6 +58,7 @@ type RegistryCredentialsListServerRequest struct {
 	query url.Values
 	page  *int
 	size  *int
+	order []*helpers.OrderItem
 	total *int
 }
 
@@ -111,6 +114,58 @@ func (r *RegistryCredentialsListServerRequest) GetSize() (value int, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry credential
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `username` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// username desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistryCredentialsListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the registry credential
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `username` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// username desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RegistryCredentialsListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -191,27 +246,29 @@ func (r *RegistryCredentialsListServerResponse) SetStatusCode(status int) *Regis
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *RegistryCredentialsListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(registryCredentialsListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// registryCredentialsListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type registryCredentialsListServerResponseData struct {
-	Page  *int                       "json:\"page,omitempty\""
-	Size  *int                       "json:\"size,omitempty\""
-	Total *int                       "json:\"total,omitempty\""
-	Items registryCredentialListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRegistryCredentialList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
 // RegistryCredentialsAddServerRequest is the request for the 'add' method.
@@ -246,18 +303,12 @@ func (r *RegistryCredentialsAddServerRequest) GetBody() (value *RegistryCredenti
 // unmarshal is the method used internally to unmarshal request to the
 // 'add' method.
 func (r *RegistryCredentialsAddServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(registryCredentialData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readRegistryCredential(iterator)
+	return iterator.Error()
 }
 
 // RegistryCredentialsAddServerResponse is the response for the 'add' method.
@@ -284,75 +335,170 @@ func (r *RegistryCredentialsAddServerResponse) SetStatusCode(status int) *Regist
 // marshall is the method used internally to marshal responses for the
 // 'add' method.
 func (r *RegistryCredentialsAddServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRegistryCredential(r.body, stream)
+	return stream.Flush()
 }
 
-// RegistryCredentialsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RegistryCredentialsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RegistryCredentialsServerAdapter struct {
 	server RegistryCredentialsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRegistryCredentialsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRegistryCredentialsServerAdapter(server RegistryCredentialsServer, router *mux.Router) *RegistryCredentialsServerAdapter {
 	adapter := new(RegistryCredentialsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.registryCredentialHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
-	adapter.router.Methods("POST").HandlerFunc(adapter.addHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RegistryCredentialsServerAdapter) registryCredentialHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.RegistryCredential(id)
-	targetAdapter := NewRegistryCredentialServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RegistryCredentialsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRegistryCredentials(w, r, a.server, segments)
+}
+
+// dispatchRegistryCredentials navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRegistryCredentials(w http.ResponseWriter, r *http.Request, server RegistryCredentialsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRegistryCredentialsListRequest(w, r, server)
+		case http.MethodPost:
+			adaptRegistryCredentialsAddRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.RegistryCredential(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRegistryCredential(w, r, target, segments[1:])
+	}
 }
-func (a *RegistryCredentialsServerAdapter) readRegistryCredentialsListServerRequest(r *http.Request) (*RegistryCredentialsListServerRequest, error) {
+
+// registryCredentialsListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var registryCredentialsListOrderAttributes = map[string]bool{
+	"account.ban_description": true,
+	"account.banned":          true,
+	"account.email":           true,
+	"account.first_name":      true,
+	"account.href":            true,
+	"account.id":              true,
+	"account.last_name":       true,
+	"account.name":            true,
+	"account.username":        true,
+	"href":                    true,
+	"id":                      true,
+	"registry.cloud_alias":    true,
+	"registry.href":           true,
+	"registry.id":             true,
+	"registry.name":           true,
+	"registry.org_name":       true,
+	"registry.team_name":      true,
+	"registry.type":           true,
+	"registry.url":            true,
+	"username":                true,
+}
+
+func readRegistryCredentialsListServerRequest(r *http.Request) (*RegistryCredentialsListServerRequest, error) {
+	var err error
 	result := new(RegistryCredentialsListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", registryCredentialsListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *RegistryCredentialsServerAdapter) writeRegistryCredentialsListServerResponse(w http.ResponseWriter, r *RegistryCredentialsListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRegistryCredentialsListServerResponse(w http.ResponseWriter, r *RegistryCredentialsListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RegistryCredentialsServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRegistryCredentialsListServerRequest(r)
+func adaptRegistryCredentialsListRequest(w http.ResponseWriter, r *http.Request, server RegistryCredentialsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRegistryCredentialsListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RegistryCredentialsListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRegistryCredentialsListServerResponse(w, resp)
+	err = writeRegistryCredentialsListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -362,9 +508,9 @@ func (a *RegistryCredentialsServerAdapter) listHandler(w http.ResponseWriter, r
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *RegistryCredentialsServerAdapter) readRegistryCredentialsAddServerRequest(r *http.Request) (*RegistryCredentialsAddServerRequest, error) {
+func readRegistryCredentialsAddServerRequest(r *http.Request) (*RegistryCredentialsAddServerRequest, error) {
 	result := new(RegistryCredentialsAddServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -372,36 +518,42 @@ func (a *RegistryCredentialsServerAdapter) readRegistryCredentialsAddServerReque
 	}
 	return result, nil
 }
-func (a *RegistryCredentialsServerAdapter) writeRegistryCredentialsAddServerResponse(w http.ResponseWriter, r *RegistryCredentialsAddServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRegistryCredentialsAddServerResponse(w http.ResponseWriter, r *RegistryCredentialsAddServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RegistryCredentialsServerAdapter) addHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRegistryCredentialsAddServerRequest(r)
+func adaptRegistryCredentialsAddRequest(w http.ResponseWriter, r *http.Request, server RegistryCredentialsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRegistryCredentialsAddServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RegistryCredentialsAddServerResponse)
-	err = a.server.Add(r.Context(), req, resp)
+	err = server.Add(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Add: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Add", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusCreated
 	}
-	err = a.writeRegistryCredentialsAddServerResponse(w, resp)
+	err = writeRegistryCredentialsAddServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
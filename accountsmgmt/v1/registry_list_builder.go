@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'registry' objects.
 type RegistryListBuilder struct {
 	items []*RegistryBuilder
+	link  bool
+	href  *string
 }
 
 // NewRegistryList creates a new builder of 'registry' objects.
@@ -30,8 +32,25 @@ func NewRegistryList() *RegistryListBuilder {
 	return new(RegistryListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *RegistryList) Builder() *RegistryListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(RegistryListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*RegistryBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *RegistryListBuilder) Items(values ...*RegistryBuilder) *RegistryListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*RegistryBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *RegistryListBuilder) Build() (list *RegistryList, err error) {
 		}
 	}
 	list = new(RegistryList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
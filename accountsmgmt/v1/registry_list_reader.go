@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// registryListData is type used internally to marshal and unmarshal lists of objects
-// of type 'registry'.
-type registryListData []*registryData
-
 // UnmarshalRegistryList reads a list of values of the 'registry'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalRegistryList(source interface{}) (list *RegistryList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalRegistryList(source interface{}, options ...helpers.DecodingOptions) (list *RegistryList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data registryListData
-	err = decoder.Decode(&data)
+	list = readRegistryList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'registry' value to a JSON document.
-func (l *RegistryList) wrap() (data registryListData, err error) {
-	if l == nil {
+// MarshalRegistryList writes a list of values of the 'registry' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalRegistryList(list *RegistryList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeRegistryList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *RegistryList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRegistryListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalRegistryList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *RegistryList) UnmarshalJSON(data []byte) error {
+	var list *RegistryList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalRegistryList(data)
+	} else {
+		list, err = unmarshalRegistryListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = RegistryList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *RegistryList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *RegistryList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalRegistryListLink reads a list of values of the 'registry' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalRegistryListLink(source interface{}, options ...helpers.DecodingOptions) (list *RegistryList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(registryListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readRegistryListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'registry' type.
-func (d registryListData) unwrap() (list *RegistryList, err error) {
-	if d == nil {
+// writeRegistryList writes a list of values of the 'registry' type to the given
+// stream, as a JSON array.
+func writeRegistryList(list *RegistryList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Registry, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeRegistry(item, stream)
 	}
-	list = new(RegistryList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// registryListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'registry'.
-type registryListLinkData struct {
-	Kind  *string         "json:\"kind,omitempty\""
-	HREF  *string         "json:\"href,omitempty\""
-	Items []*registryData "json:\"items,omitempty\""
+// readRegistryList reads a list of values of the 'registry' type from the given
+// iterator, as a JSON array.
+func readRegistryList(iterator *helpers.Iterator) *RegistryList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(RegistryList)
+	list.items = []*Registry{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readRegistry(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'registry' value to a link.
-func (l *RegistryList) wrapLink() (data *registryListLinkData, err error) {
-	if l == nil {
+// writeRegistryListLink writes a list of values of the 'registry' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeRegistryListLink(list *RegistryList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*registryData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(registryListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = RegistryListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(RegistryListLinkKind)
 	} else {
-		*data.Kind = RegistryListKind
+		stream.WriteString(RegistryListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRegistryList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'registry' type to a list.
-func (d *registryListLinkData) unwrapLink() (list *RegistryList, err error) {
-	if d == nil {
-		return
+// readRegistryListLink reads a list of values of the 'registry' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readRegistryListLink(iterator *helpers.Iterator) *RegistryList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Registry, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(RegistryList)
+	list.items = []*Registry{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(RegistryList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RegistryListKind:
-			list.link = false
-		case RegistryListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(RegistryListKind, RegistryListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readRegistryList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RegistryListKind,
-				RegistryListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,109 +20,193 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// registryData is the data structure used internally to marshal and unmarshal
-// objects of type 'registry'.
-type registryData struct {
-	Kind       *string "json:\"kind,omitempty\""
-	ID         *string "json:\"id,omitempty\""
-	HREF       *string "json:\"href,omitempty\""
-	Name       *string "json:\"name,omitempty\""
-	URL        *string "json:\"url,omitempty\""
-	TeamName   *string "json:\"team_name,omitempty\""
-	OrgName    *string "json:\"org_name,omitempty\""
-	Type       *string "json:\"type,omitempty\""
-	CloudAlias *bool   "json:\"cloud_alias,omitempty\""
-}
-
 // MarshalRegistry writes a value of the 'registry' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalRegistry(object *Registry, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeRegistry(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'registry'
-// value to a JSON document.
-func (o *Registry) wrap() (data *registryData, err error) {
-	if o == nil {
+// writeRegistry writes a value of the 'registry' type to the given stream.
+func writeRegistry(object *Registry, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(registryData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = RegistryLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(RegistryLinkKind)
 	} else {
-		*data.Kind = RegistryKind
-	}
-	data.Name = o.name
-	data.URL = o.url
-	data.TeamName = o.teamName
-	data.OrgName = o.orgName
-	data.Type = o.type_
-	data.CloudAlias = o.cloudAlias
-	return
+		stream.WriteString(RegistryKind)
+	}
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.url != nil {
+		stream.WriteObjectField("url")
+		stream.WriteString(*object.url)
+	}
+	if object.teamName != nil {
+		stream.WriteObjectField("team_name")
+		stream.WriteString(*object.teamName)
+	}
+	if object.orgName != nil {
+		stream.WriteObjectField("org_name")
+		stream.WriteString(*object.orgName)
+	}
+	if object.type_ != nil {
+		stream.WriteObjectField("type")
+		stream.WriteString(*object.type_)
+	}
+	if object.cloudAlias != nil {
+		stream.WriteObjectField("cloud_alias")
+		stream.WriteBool(*object.cloudAlias)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalRegistry reads a value of the 'registry' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalRegistry(source interface{}) (object *Registry, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalRegistry(source interface{}, options ...helpers.DecodingOptions) (object *Registry, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(registryData)
-	err = decoder.Decode(data)
+	object = readRegistry(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Registry)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'registry' type.
-func (d *registryData) unwrap() (object *Registry, err error) {
-	if d == nil {
-		return
+// readRegistry reads a value of the 'registry' type from the given iterator.
+func readRegistry(iterator *helpers.Iterator) *Registry {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Registry)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RegistryKind:
-			object.link = false
-		case RegistryLinkKind:
-			object.link = true
+	object := new(Registry)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(RegistryKind, RegistryLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "url":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.url = &value
+			}
+		case "team_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.teamName = &value
+			}
+		case "org_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.orgName = &value
+			}
+		case "type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.type_ = &value
+			}
+		case "cloud_alias":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.cloudAlias = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RegistryKind,
-				RegistryLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	object.url = d.URL
-	object.teamName = d.TeamName
-	object.orgName = d.OrgName
-	object.type_ = d.Type
-	object.cloudAlias = d.CloudAlias
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalRegistry function.
+func (o *Registry) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRegistry(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalRegistry function, using the
+// default decoding options.
+func (o *Registry) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalRegistry(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Registry{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Registry) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Registry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RegistryKind is the name of the type used to represent objects
 // of type 'registry'.
 const RegistryKind = "Registry"
@@ -44,6 +52,7 @@ type Registry struct {
 	orgName    *string
 	type_      *string
 	cloudAlias *bool
+	extra      map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -98,6 +107,27 @@ func (o *Registry) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete registry that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Registry) Resolve(ctx context.Context, transport http.RoundTripper) (result *Registry, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to registry '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewRegistryClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Registry) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -110,6 +140,59 @@ func (o *Registry) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'registry' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Registry) Metadata() *helpers.TypeMetadata {
+	return registryMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Registry) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRegistry(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Registry) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRegistry(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Registry) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRegistry(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Registry) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRegistry(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'registry' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Registry) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -260,6 +343,291 @@ const RegistryListLinkKind = "RegistryListLink"
 // objects of type 'registry'.
 const RegistryListNilKind = "RegistryListNil"
 
+// Copy returns a deep copy of the 'registry' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Registry) Copy() *Registry {
+	if o == nil {
+		return nil
+	}
+	result := new(Registry)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	if o.url != nil {
+		result.url = new(string)
+		*result.url = *o.url
+	}
+	if o.teamName != nil {
+		result.teamName = new(string)
+		*result.teamName = *o.teamName
+	}
+	if o.orgName != nil {
+		result.orgName = new(string)
+		*result.orgName = *o.orgName
+	}
+	if o.type_ != nil {
+		result.type_ = new(string)
+		*result.type_ = *o.type_
+	}
+	if o.cloudAlias != nil {
+		result.cloudAlias = new(bool)
+		*result.cloudAlias = *o.cloudAlias
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'registry' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Registry) Equal(other *Registry) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		return false
+	}
+	if (o.teamName == nil) != (other.teamName == nil) ||
+		(o.teamName != nil && *o.teamName != *other.teamName) {
+		return false
+	}
+	if (o.orgName == nil) != (other.orgName == nil) ||
+		(o.orgName != nil && *o.orgName != *other.orgName) {
+		return false
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		return false
+	}
+	if (o.cloudAlias == nil) != (other.cloudAlias == nil) ||
+		(o.cloudAlias != nil && *o.cloudAlias != *other.cloudAlias) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'registry' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Registry) Diff(other *Registry) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'registry' object and the given one, using the given path as prefix.
+func (o *Registry) diff(path string, other *Registry, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "url"),
+			Old:  helpers.Value(o.url),
+			New:  helpers.Value(other.url),
+		})
+	}
+	if (o.teamName == nil) != (other.teamName == nil) ||
+		(o.teamName != nil && *o.teamName != *other.teamName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "team_name"),
+			Old:  helpers.Value(o.teamName),
+			New:  helpers.Value(other.teamName),
+		})
+	}
+	if (o.orgName == nil) != (other.orgName == nil) ||
+		(o.orgName != nil && *o.orgName != *other.orgName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "org_name"),
+			Old:  helpers.Value(o.orgName),
+			New:  helpers.Value(other.orgName),
+		})
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "type"),
+			Old:  helpers.Value(o.type_),
+			New:  helpers.Value(other.type_),
+		})
+	}
+	if (o.cloudAlias == nil) != (other.cloudAlias == nil) ||
+		(o.cloudAlias != nil && *o.cloudAlias != *other.cloudAlias) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "cloud_alias"),
+			Old:  helpers.Value(o.cloudAlias),
+			New:  helpers.Value(other.cloudAlias),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'registry' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Registry) Changes(modified *Registry) *Registry {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Registry)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.url != nil && (o.url == nil || *o.url != *modified.url) {
+		result.url = new(string)
+		*result.url = *modified.url
+	}
+	if modified.teamName != nil && (o.teamName == nil || *o.teamName != *modified.teamName) {
+		result.teamName = new(string)
+		*result.teamName = *modified.teamName
+	}
+	if modified.orgName != nil && (o.orgName == nil || *o.orgName != *modified.orgName) {
+		result.orgName = new(string)
+		*result.orgName = *modified.orgName
+	}
+	if modified.type_ != nil && (o.type_ == nil || *o.type_ != *modified.type_) {
+		result.type_ = new(string)
+		*result.type_ = *modified.type_
+	}
+	if modified.cloudAlias != nil && (o.cloudAlias == nil || *o.cloudAlias != *modified.cloudAlias) {
+		result.cloudAlias = new(bool)
+		*result.cloudAlias = *modified.cloudAlias
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printRegistry prints a value of the 'registry' type to the given printer.
+func printRegistry(object *Registry, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Registry")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.url != nil {
+		printer.PrintObjectField("URL")
+		printer.PrintString(*object.url)
+	}
+	if object.teamName != nil {
+		printer.PrintObjectField("TeamName")
+		printer.PrintString(*object.teamName)
+	}
+	if object.orgName != nil {
+		printer.PrintObjectField("OrgName")
+		printer.PrintString(*object.orgName)
+	}
+	if object.type_ != nil {
+		printer.PrintObjectField("Type")
+		printer.PrintString(*object.type_)
+	}
+	if object.cloudAlias != nil {
+		printer.PrintObjectField("CloudAlias")
+		printer.PrintBool(*object.cloudAlias)
+	}
+	printer.PrintObjectEnd()
+}
+
 // RegistryList is a list of values of the 'registry' type.
 type RegistryList struct {
 	href  *string
@@ -367,3 +735,146 @@ func (l *RegistryList) Range(f func(index int, item *Registry) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *RegistryList) Copy() *RegistryList {
+	if l == nil {
+		return nil
+	}
+	result := new(RegistryList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Registry, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *RegistryList) Equal(other *RegistryList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *RegistryList) Diff(other *RegistryList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *RegistryList) diff(path string, other *RegistryList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *RegistryList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRegistryListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *RegistryList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRegistryListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *RegistryList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRegistryListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *RegistryList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRegistryListLink(l, printer)
+	}
+}
+
+// printRegistryList prints a list of values of the 'registry' type to the given printer.
+func printRegistryList(list *RegistryList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printRegistry(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printRegistryListLink prints a list of values of the 'registry' type to the
+// given printer, including the link to the list.
+func printRegistryListLink(list *RegistryList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.RegistryList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printRegistryList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ReservedResourceBuilder contains the data and logic needed to build 'reserved_resource' objects.
 //
 //
@@ -28,6 +32,7 @@ type ReservedResourceBuilder struct {
 	byoc                 *bool
 	availabilityZoneType *string
 	count                *int
+	extra                map[string]interface{}
 }
 
 // NewReservedResource creates a new builder of 'reserved_resource' objects.
@@ -35,6 +40,23 @@ func NewReservedResource() *ReservedResourceBuilder {
 	return new(ReservedResourceBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'reserved_resource' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ReservedResource) Builder() *ReservedResourceBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ReservedResourceBuilder)
+	b.resourceName = o.resourceName
+	b.resourceType = o.resourceType
+	b.byoc = o.byoc
+	b.availabilityZoneType = o.availabilityZoneType
+	b.count = o.count
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ResourceName sets the value of the 'resource_name' attribute
 // to the given value.
 //
@@ -80,9 +102,17 @@ func (b *ReservedResourceBuilder) Count(value int) *ReservedResourceBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'reserved_resource' type, and
+// that will be written as they are when the object is encoded.
+func (b *ReservedResourceBuilder) Extra(value map[string]interface{}) *ReservedResourceBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'reserved_resource' object using the configuration stored in the builder.
 func (b *ReservedResourceBuilder) Build() (object *ReservedResource, err error) {
 	object = new(ReservedResource)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.resourceName != nil {
 		object.resourceName = b.resourceName
 	}
@@ -30,6 +30,19 @@ func NewReservedResourceList() *ReservedResourceListBuilder {
 	return new(ReservedResourceListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ReservedResourceList) Builder() *ReservedResourceListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ReservedResourceListBuilder)
+	b.items = make([]*ReservedResourceBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ReservedResourceListBuilder) Items(values ...*ReservedResourceBuilder) *ReservedResourceListBuilder {
 	b.items = make([]*ReservedResourceBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// reservedResourceListData is type used internally to marshal and unmarshal lists of objects
-// of type 'reserved_resource'.
-type reservedResourceListData []*reservedResourceData
-
 // UnmarshalReservedResourceList reads a list of values of the 'reserved_resource'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalReservedResourceList(source interface{}) (list *ReservedResourceList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalReservedResourceList(source interface{}, options ...helpers.DecodingOptions) (list *ReservedResourceList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data reservedResourceListData
-	err = decoder.Decode(&data)
+	list = readReservedResourceList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'reserved_resource' value to a JSON document.
-func (l *ReservedResourceList) wrap() (data reservedResourceListData, err error) {
-	if l == nil {
-		return
+// MarshalReservedResourceList writes a list of values of the 'reserved_resource' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalReservedResourceList(list *ReservedResourceList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(reservedResourceListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeReservedResourceList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ReservedResourceList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeReservedResourceList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalReservedResourceList function,
+// using the default decoding options.
+func (l *ReservedResourceList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalReservedResourceList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ReservedResourceList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'reserved_resource' type.
-func (d reservedResourceListData) unwrap() (list *ReservedResourceList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ReservedResourceList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ReservedResourceList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeReservedResourceList writes a list of values of the 'reserved_resource' type to the given
+// stream, as a JSON array.
+func writeReservedResourceList(list *ReservedResourceList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ReservedResource, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeReservedResource(item, stream)
 	}
-	list = new(ReservedResourceList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readReservedResourceList reads a list of values of the 'reserved_resource' type from the given
+// iterator, as a JSON array.
+func readReservedResourceList(iterator *helpers.Iterator) *ReservedResourceList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ReservedResourceList)
+	list.items = []*ReservedResource{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readReservedResource(iterator))
+	}
+	return list
 }
@@ -23,72 +23,155 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// reservedResourceData is the data structure used internally to marshal and unmarshal
-// objects of type 'reserved_resource'.
-type reservedResourceData struct {
-	ResourceName         *string "json:\"resource_name,omitempty\""
-	ResourceType         *string "json:\"resource_type,omitempty\""
-	BYOC                 *bool   "json:\"byoc,omitempty\""
-	AvailabilityZoneType *string "json:\"availability_zone_type,omitempty\""
-	Count                *int    "json:\"count,omitempty\""
-}
-
 // MarshalReservedResource writes a value of the 'reserved_resource' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalReservedResource(object *ReservedResource, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeReservedResource(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'reserved_resource'
-// value to a JSON document.
-func (o *ReservedResource) wrap() (data *reservedResourceData, err error) {
-	if o == nil {
+// writeReservedResource writes a value of the 'reserved_resource' type to the given stream.
+func writeReservedResource(object *ReservedResource, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(reservedResourceData)
-	data.ResourceName = o.resourceName
-	data.ResourceType = o.resourceType
-	data.BYOC = o.byoc
-	data.AvailabilityZoneType = o.availabilityZoneType
-	data.Count = o.count
-	return
+	stream.WriteObjectStart()
+	if object.resourceName != nil {
+		stream.WriteObjectField("resource_name")
+		stream.WriteString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		stream.WriteObjectField("resource_type")
+		stream.WriteString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		stream.WriteObjectField("byoc")
+		stream.WriteBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		stream.WriteObjectField("availability_zone_type")
+		stream.WriteString(*object.availabilityZoneType)
+	}
+	if object.count != nil {
+		stream.WriteObjectField("count")
+		stream.WriteInt(*object.count)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalReservedResource reads a value of the 'reserved_resource' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalReservedResource(source interface{}) (object *ReservedResource, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalReservedResource(source interface{}, options ...helpers.DecodingOptions) (object *ReservedResource, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(reservedResourceData)
-	err = decoder.Decode(data)
+	object = readReservedResource(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ReservedResource)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'reserved_resource' type.
-func (d *reservedResourceData) unwrap() (object *ReservedResource, err error) {
-	if d == nil {
-		return
+// readReservedResource reads a value of the 'reserved_resource' type from the given iterator.
+func readReservedResource(iterator *helpers.Iterator) *ReservedResource {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ReservedResource)
-	object.resourceName = d.ResourceName
-	object.resourceType = d.ResourceType
-	object.byoc = d.BYOC
-	object.availabilityZoneType = d.AvailabilityZoneType
-	object.count = d.Count
-	return
+	object := new(ReservedResource)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "resource_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceName = &value
+			}
+		case "resource_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceType = &value
+			}
+		case "byoc":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.byoc = &value
+			}
+		case "availability_zone_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.availabilityZoneType = &value
+			}
+		case "count":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.count = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalReservedResource function.
+func (o *ReservedResource) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeReservedResource(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalReservedResource function, using the
+// default decoding options.
+func (o *ReservedResource) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalReservedResource(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ReservedResource{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ReservedResource) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ReservedResource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ReservedResource represents the values of the 'reserved_resource' type.
 //
 //
@@ -28,6 +34,7 @@ type ReservedResource struct {
 	byoc                 *bool
 	availabilityZoneType *string
 	count                *int
+	extra                map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -40,6 +47,59 @@ func (o *ReservedResource) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'reserved_resource' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ReservedResource) Metadata() *helpers.TypeMetadata {
+	return reservedResourceMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ReservedResource) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printReservedResource(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ReservedResource) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printReservedResource(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ReservedResource) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printReservedResource(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ReservedResource) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printReservedResource(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'reserved_resource' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ReservedResource) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // ResourceName returns the value of the 'resource_name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -155,6 +215,207 @@ func (o *ReservedResource) GetCount() (value int, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'reserved_resource' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ReservedResource) Copy() *ReservedResource {
+	if o == nil {
+		return nil
+	}
+	result := new(ReservedResource)
+	if o.resourceName != nil {
+		result.resourceName = new(string)
+		*result.resourceName = *o.resourceName
+	}
+	if o.resourceType != nil {
+		result.resourceType = new(string)
+		*result.resourceType = *o.resourceType
+	}
+	if o.byoc != nil {
+		result.byoc = new(bool)
+		*result.byoc = *o.byoc
+	}
+	if o.availabilityZoneType != nil {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *o.availabilityZoneType
+	}
+	if o.count != nil {
+		result.count = new(int)
+		*result.count = *o.count
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'reserved_resource' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ReservedResource) Equal(other *ReservedResource) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		return false
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		return false
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		return false
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		return false
+	}
+	if (o.count == nil) != (other.count == nil) ||
+		(o.count != nil && *o.count != *other.count) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'reserved_resource' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ReservedResource) Diff(other *ReservedResource) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'reserved_resource' object and the given one, using the given path as prefix.
+func (o *ReservedResource) diff(path string, other *ReservedResource, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_name"),
+			Old:  helpers.Value(o.resourceName),
+			New:  helpers.Value(other.resourceName),
+		})
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_type"),
+			Old:  helpers.Value(o.resourceType),
+			New:  helpers.Value(other.resourceType),
+		})
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "byoc"),
+			Old:  helpers.Value(o.byoc),
+			New:  helpers.Value(other.byoc),
+		})
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "availability_zone_type"),
+			Old:  helpers.Value(o.availabilityZoneType),
+			New:  helpers.Value(other.availabilityZoneType),
+		})
+	}
+	if (o.count == nil) != (other.count == nil) ||
+		(o.count != nil && *o.count != *other.count) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "count"),
+			Old:  helpers.Value(o.count),
+			New:  helpers.Value(other.count),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'reserved_resource' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ReservedResource) Changes(modified *ReservedResource) *ReservedResource {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ReservedResource)
+	if modified.resourceName != nil && (o.resourceName == nil || *o.resourceName != *modified.resourceName) {
+		result.resourceName = new(string)
+		*result.resourceName = *modified.resourceName
+	}
+	if modified.resourceType != nil && (o.resourceType == nil || *o.resourceType != *modified.resourceType) {
+		result.resourceType = new(string)
+		*result.resourceType = *modified.resourceType
+	}
+	if modified.byoc != nil && (o.byoc == nil || *o.byoc != *modified.byoc) {
+		result.byoc = new(bool)
+		*result.byoc = *modified.byoc
+	}
+	if modified.availabilityZoneType != nil && (o.availabilityZoneType == nil || *o.availabilityZoneType != *modified.availabilityZoneType) {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *modified.availabilityZoneType
+	}
+	if modified.count != nil && (o.count == nil || *o.count != *modified.count) {
+		result.count = new(int)
+		*result.count = *modified.count
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printReservedResource prints a value of the 'reserved_resource' type to the given printer.
+func printReservedResource(object *ReservedResource, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ReservedResource")
+	if object.resourceName != nil {
+		printer.PrintObjectField("ResourceName")
+		printer.PrintString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		printer.PrintObjectField("ResourceType")
+		printer.PrintString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		printer.PrintObjectField("BYOC")
+		printer.PrintBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		printer.PrintObjectField("AvailabilityZoneType")
+		printer.PrintString(*object.availabilityZoneType)
+	}
+	if object.count != nil {
+		printer.PrintObjectField("Count")
+		printer.PrintInt(*object.count)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ReservedResourceList is a list of values of the 'reserved_resource' type.
 type ReservedResourceList struct {
 	items []*ReservedResource
@@ -226,3 +487,105 @@ func (l *ReservedResourceList) Range(f func(index int, item *ReservedResource) b
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ReservedResourceList) Copy() *ReservedResourceList {
+	if l == nil {
+		return nil
+	}
+	result := new(ReservedResourceList)
+	if l.items != nil {
+		result.items = make([]*ReservedResource, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ReservedResourceList) Equal(other *ReservedResourceList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ReservedResourceList) Diff(other *ReservedResourceList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ReservedResourceList) diff(path string, other *ReservedResourceList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ReservedResourceList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printReservedResourceList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ReservedResourceList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printReservedResourceList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ReservedResourceList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printReservedResourceList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ReservedResourceList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printReservedResourceList(l, printer)
+	}
+}
+
+// printReservedResourceList prints a list of values of the 'reserved_resource' type to the given printer.
+func printReservedResourceList(list *ReservedResourceList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printReservedResource(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ResourceQuotaBuilder contains the data and logic needed to build 'resource_quota' objects.
 //
 //
@@ -34,6 +38,7 @@ type ResourceQuotaBuilder struct {
 	availabilityZoneType *string
 	allowed              *int
 	reserved             *int
+	extra                map[string]interface{}
 }
 
 // NewResourceQuota creates a new builder of 'resource_quota' objects.
@@ -41,6 +46,29 @@ func NewResourceQuota() *ResourceQuotaBuilder {
 	return new(ResourceQuotaBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'resource_quota' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ResourceQuota) Builder() *ResourceQuotaBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ResourceQuotaBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.organizationID = o.organizationID
+	b.sku = o.sku
+	b.resourceName = o.resourceName
+	b.resourceType = o.resourceType
+	b.byoc = o.byoc
+	b.availabilityZoneType = o.availabilityZoneType
+	b.allowed = o.allowed
+	b.reserved = o.reserved
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *ResourceQuotaBuilder) ID(value string) *ResourceQuotaBuilder {
 	b.id = &value
@@ -131,9 +159,17 @@ func (b *ResourceQuotaBuilder) Reserved(value int) *ResourceQuotaBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'resource_quota' type, and
+// that will be written as they are when the object is encoded.
+func (b *ResourceQuotaBuilder) Extra(value map[string]interface{}) *ResourceQuotaBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'resource_quota' object using the configuration stored in the builder.
 func (b *ResourceQuotaBuilder) Build() (object *ResourceQuota, err error) {
 	object = new(ResourceQuota)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
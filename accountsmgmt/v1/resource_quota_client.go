@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -81,6 +81,7 @@ type ResourceQuotaGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -95,6 +96,17 @@ func (r *ResourceQuotaGetRequest) Header(name string, value interface{}) *Resour
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ResourceQuotaGetRequest) Decoding(value helpers.DecodingOptions) *ResourceQuotaGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -128,14 +140,14 @@ func (r *ResourceQuotaGetRequest) SendContext(ctx context.Context) (result *Reso
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -189,29 +201,26 @@ func (r *ResourceQuotaGetResponse) GetBody() (value *ResourceQuota, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *ResourceQuotaGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(resourceQuotaData)
-	err = decoder.Decode(data)
+func (r *ResourceQuotaGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readResourceQuota(iterator)
+	return iterator.Error()
 }
 
 // ResourceQuotaUpdateRequest is the request for the 'update' method.
 type ResourceQuotaUpdateRequest struct {
-	transport http.RoundTripper
-	path      string
-	metric    string
-	query     url.Values
-	header    http.Header
-	body      *ResourceQuota
+	transport  http.RoundTripper
+	path       string
+	metric     string
+	query      url.Values
+	header     http.Header
+	body       *ResourceQuota
+	decoding   helpers.DecodingOptions
+	original   *ResourceQuota
+	mergePatch bool
 }
 
 // Parameter adds a query parameter.
@@ -226,11 +235,45 @@ func (r *ResourceQuotaUpdateRequest) Header(name string, value interface{}) *Res
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ResourceQuotaUpdateRequest) Decoding(value helpers.DecodingOptions) *ResourceQuotaUpdateRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 //
 func (r *ResourceQuotaUpdateRequest) Body(value *ResourceQuota) *ResourceQuotaUpdateRequest {
 	r.body = value
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// Changes sets the body of the request to the attributes of the modified object that have
+// values different to the values in the original object, as calculated by the Changes method
+// of the type.
+func (r *ResourceQuotaUpdateRequest) Changes(original, modified *ResourceQuota) *ResourceQuotaUpdateRequest {
+	r.body = original.Changes(modified)
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// MergePatch sets the body of the request to the JSON merge patch, as described in RFC 7396,
+// that transforms the original object into the modified one. The request will be sent with the
+// `application/merge-patch+json` content type. Attributes removed in the modified object will
+// be set to null in the patch.
+func (r *ResourceQuotaUpdateRequest) MergePatch(original, modified *ResourceQuota) *ResourceQuotaUpdateRequest {
+	r.body = modified
+	r.original = original
+	r.mergePatch = true
 	return r
 }
 
@@ -246,6 +289,9 @@ func (r *ResourceQuotaUpdateRequest) Send() (result *ResourceQuotaUpdateResponse
 func (r *ResourceQuotaUpdateRequest) SendContext(ctx context.Context) (result *ResourceQuotaUpdateResponse, err error) {
 	query := helpers.CopyQuery(r.query)
 	header := helpers.SetHeader(r.header, r.metric)
+	if r.mergePatch {
+		header.Set("Content-Type", helpers.MergePatchContentType)
+	}
 	buffer := new(bytes.Buffer)
 	err = r.marshal(buffer)
 	if err != nil {
@@ -273,14 +319,14 @@ func (r *ResourceQuotaUpdateRequest) SendContext(ctx context.Context) (result *R
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -291,13 +337,31 @@ func (r *ResourceQuotaUpdateRequest) SendContext(ctx context.Context) (result *R
 // 'update' method.
 func (r *ResourceQuotaUpdateRequest) marshal(writer io.Writer) error {
 	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	if r.mergePatch {
+		original := new(bytes.Buffer)
+		err = MarshalResourceQuota(r.original, original)
+		if err != nil {
+			return err
+		}
+		modified := new(bytes.Buffer)
+		err = MarshalResourceQuota(r.body, modified)
+		if err != nil {
+			return err
+		}
+		var patch []byte
+		patch, err = helpers.MergePatch(original.Bytes(), modified.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(patch)
+		return err
+	}
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeResourceQuota(r.body, stream)
+	return stream.Flush()
 }
 
 // ResourceQuotaUpdateResponse is the response for the 'update' method.
@@ -347,17 +411,30 @@ func (r *ResourceQuotaUpdateResponse) GetBody() (value *ResourceQuota, ok bool)
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'update' method.
-func (r *ResourceQuotaUpdateResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(resourceQuotaData)
-	err = decoder.Decode(data)
+func (r *ResourceQuotaUpdateResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readResourceQuota(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *ResourceQuotaClient) Metadata() *helpers.ResourceMetadata {
+	return resourceQuotaResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *ResourceQuotaClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
 }
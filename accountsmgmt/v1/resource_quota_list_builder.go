@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'resource_quota' objects.
 type ResourceQuotaListBuilder struct {
 	items []*ResourceQuotaBuilder
+	link  bool
+	href  *string
 }
 
 // NewResourceQuotaList creates a new builder of 'resource_quota' objects.
@@ -30,8 +32,25 @@ func NewResourceQuotaList() *ResourceQuotaListBuilder {
 	return new(ResourceQuotaListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ResourceQuotaList) Builder() *ResourceQuotaListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ResourceQuotaListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*ResourceQuotaBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ResourceQuotaListBuilder) Items(values ...*ResourceQuotaBuilder) *ResourceQuotaListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*ResourceQuotaBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *ResourceQuotaListBuilder) Build() (list *ResourceQuotaList, err error)
 		}
 	}
 	list = new(ResourceQuotaList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// resourceQuotaListData is type used internally to marshal and unmarshal lists of objects
-// of type 'resource_quota'.
-type resourceQuotaListData []*resourceQuotaData
-
 // UnmarshalResourceQuotaList reads a list of values of the 'resource_quota'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalResourceQuotaList(source interface{}) (list *ResourceQuotaList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalResourceQuotaList(source interface{}, options ...helpers.DecodingOptions) (list *ResourceQuotaList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data resourceQuotaListData
-	err = decoder.Decode(&data)
+	list = readResourceQuotaList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'resource_quota' value to a JSON document.
-func (l *ResourceQuotaList) wrap() (data resourceQuotaListData, err error) {
-	if l == nil {
+// MarshalResourceQuotaList writes a list of values of the 'resource_quota' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalResourceQuotaList(list *ResourceQuotaList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeResourceQuotaList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *ResourceQuotaList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeResourceQuotaListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalResourceQuotaList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *ResourceQuotaList) UnmarshalJSON(data []byte) error {
+	var list *ResourceQuotaList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalResourceQuotaList(data)
+	} else {
+		list, err = unmarshalResourceQuotaListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = ResourceQuotaList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ResourceQuotaList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ResourceQuotaList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalResourceQuotaListLink reads a list of values of the 'resource_quota' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalResourceQuotaListLink(source interface{}, options ...helpers.DecodingOptions) (list *ResourceQuotaList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(resourceQuotaListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readResourceQuotaListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'resource_quota' type.
-func (d resourceQuotaListData) unwrap() (list *ResourceQuotaList, err error) {
-	if d == nil {
+// writeResourceQuotaList writes a list of values of the 'resource_quota' type to the given
+// stream, as a JSON array.
+func writeResourceQuotaList(list *ResourceQuotaList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ResourceQuota, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeResourceQuota(item, stream)
 	}
-	list = new(ResourceQuotaList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// resourceQuotaListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'resource_quota'.
-type resourceQuotaListLinkData struct {
-	Kind  *string              "json:\"kind,omitempty\""
-	HREF  *string              "json:\"href,omitempty\""
-	Items []*resourceQuotaData "json:\"items,omitempty\""
+// readResourceQuotaList reads a list of values of the 'resource_quota' type from the given
+// iterator, as a JSON array.
+func readResourceQuotaList(iterator *helpers.Iterator) *ResourceQuotaList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ResourceQuotaList)
+	list.items = []*ResourceQuota{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readResourceQuota(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'resource_quota' value to a link.
-func (l *ResourceQuotaList) wrapLink() (data *resourceQuotaListLinkData, err error) {
-	if l == nil {
+// writeResourceQuotaListLink writes a list of values of the 'resource_quota' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeResourceQuotaListLink(list *ResourceQuotaList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*resourceQuotaData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(resourceQuotaListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = ResourceQuotaListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(ResourceQuotaListLinkKind)
 	} else {
-		*data.Kind = ResourceQuotaListKind
+		stream.WriteString(ResourceQuotaListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeResourceQuotaList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'resource_quota' type to a list.
-func (d *resourceQuotaListLinkData) unwrapLink() (list *ResourceQuotaList, err error) {
-	if d == nil {
-		return
+// readResourceQuotaListLink reads a list of values of the 'resource_quota' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readResourceQuotaListLink(iterator *helpers.Iterator) *ResourceQuotaList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*ResourceQuota, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(ResourceQuotaList)
+	list.items = []*ResourceQuota{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(ResourceQuotaList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ResourceQuotaListKind:
-			list.link = false
-		case ResourceQuotaListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(ResourceQuotaListKind, ResourceQuotaListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readResourceQuotaList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ResourceQuotaListKind,
-				ResourceQuotaListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,115 +20,211 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// resourceQuotaData is the data structure used internally to marshal and unmarshal
-// objects of type 'resource_quota'.
-type resourceQuotaData struct {
-	Kind                 *string "json:\"kind,omitempty\""
-	ID                   *string "json:\"id,omitempty\""
-	HREF                 *string "json:\"href,omitempty\""
-	OrganizationID       *string "json:\"organization_id,omitempty\""
-	SKU                  *string "json:\"sku,omitempty\""
-	ResourceName         *string "json:\"resource_name,omitempty\""
-	ResourceType         *string "json:\"resource_type,omitempty\""
-	BYOC                 *bool   "json:\"byoc,omitempty\""
-	AvailabilityZoneType *string "json:\"availability_zone_type,omitempty\""
-	Allowed              *int    "json:\"allowed,omitempty\""
-	Reserved             *int    "json:\"reserved,omitempty\""
-}
-
 // MarshalResourceQuota writes a value of the 'resource_quota' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalResourceQuota(object *ResourceQuota, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeResourceQuota(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'resource_quota'
-// value to a JSON document.
-func (o *ResourceQuota) wrap() (data *resourceQuotaData, err error) {
-	if o == nil {
+// writeResourceQuota writes a value of the 'resource_quota' type to the given stream.
+func writeResourceQuota(object *ResourceQuota, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(resourceQuotaData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = ResourceQuotaLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(ResourceQuotaLinkKind)
 	} else {
-		*data.Kind = ResourceQuotaKind
-	}
-	data.OrganizationID = o.organizationID
-	data.SKU = o.sku
-	data.ResourceName = o.resourceName
-	data.ResourceType = o.resourceType
-	data.BYOC = o.byoc
-	data.AvailabilityZoneType = o.availabilityZoneType
-	data.Allowed = o.allowed
-	data.Reserved = o.reserved
-	return
+		stream.WriteString(ResourceQuotaKind)
+	}
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.organizationID != nil {
+		stream.WriteObjectField("organization_id")
+		stream.WriteString(*object.organizationID)
+	}
+	if object.sku != nil {
+		stream.WriteObjectField("sku")
+		stream.WriteString(*object.sku)
+	}
+	if object.resourceName != nil {
+		stream.WriteObjectField("resource_name")
+		stream.WriteString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		stream.WriteObjectField("resource_type")
+		stream.WriteString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		stream.WriteObjectField("byoc")
+		stream.WriteBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		stream.WriteObjectField("availability_zone_type")
+		stream.WriteString(*object.availabilityZoneType)
+	}
+	if object.allowed != nil {
+		stream.WriteObjectField("allowed")
+		stream.WriteInt(*object.allowed)
+	}
+	if object.reserved != nil {
+		stream.WriteObjectField("reserved")
+		stream.WriteInt(*object.reserved)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalResourceQuota reads a value of the 'resource_quota' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalResourceQuota(source interface{}) (object *ResourceQuota, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalResourceQuota(source interface{}, options ...helpers.DecodingOptions) (object *ResourceQuota, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(resourceQuotaData)
-	err = decoder.Decode(data)
+	object = readResourceQuota(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ResourceQuota)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'resource_quota' type.
-func (d *resourceQuotaData) unwrap() (object *ResourceQuota, err error) {
-	if d == nil {
-		return
+// readResourceQuota reads a value of the 'resource_quota' type from the given iterator.
+func readResourceQuota(iterator *helpers.Iterator) *ResourceQuota {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ResourceQuota)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ResourceQuotaKind:
-			object.link = false
-		case ResourceQuotaLinkKind:
-			object.link = true
+	object := new(ResourceQuota)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(ResourceQuotaKind, ResourceQuotaLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "organization_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.organizationID = &value
+			}
+		case "sku":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.sku = &value
+			}
+		case "resource_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceName = &value
+			}
+		case "resource_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.resourceType = &value
+			}
+		case "byoc":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.byoc = &value
+			}
+		case "availability_zone_type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.availabilityZoneType = &value
+			}
+		case "allowed":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.allowed = &value
+			}
+		case "reserved":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.reserved = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ResourceQuotaKind,
-				ResourceQuotaLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.organizationID = d.OrganizationID
-	object.sku = d.SKU
-	object.resourceName = d.ResourceName
-	object.resourceType = d.ResourceType
-	object.byoc = d.BYOC
-	object.availabilityZoneType = d.AvailabilityZoneType
-	object.allowed = d.Allowed
-	object.reserved = d.Reserved
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalResourceQuota function.
+func (o *ResourceQuota) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeResourceQuota(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalResourceQuota function, using the
+// default decoding options.
+func (o *ResourceQuota) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalResourceQuota(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ResourceQuota{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ResourceQuota) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ResourceQuota) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ResourceQuotaServer represents the interface the manages the 'resource_quota' resource.
@@ -75,14 +77,12 @@ func (r *ResourceQuotaGetServerResponse) SetStatusCode(status int) *ResourceQuot
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *ResourceQuotaGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeResourceQuota(r.body, stream)
+	return stream.Flush()
 }
 
 // ResourceQuotaUpdateServerRequest is the request for the 'update' method.
@@ -117,18 +117,12 @@ func (r *ResourceQuotaUpdateServerRequest) GetBody() (value *ResourceQuota, ok b
 // unmarshal is the method used internally to unmarshal request to the
 // 'update' method.
 func (r *ResourceQuotaUpdateServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(resourceQuotaData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readResourceQuota(iterator)
+	return iterator.Error()
 }
 
 // ResourceQuotaUpdateServerResponse is the response for the 'update' method.
@@ -155,67 +149,106 @@ func (r *ResourceQuotaUpdateServerResponse) SetStatusCode(status int) *ResourceQ
 // marshall is the method used internally to marshal responses for the
 // 'update' method.
 func (r *ResourceQuotaUpdateServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeResourceQuota(r.body, stream)
+	return stream.Flush()
 }
 
-// ResourceQuotaServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// ResourceQuotaServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type ResourceQuotaServerAdapter struct {
 	server ResourceQuotaServer
 	router *mux.Router
+	prefix string
 }
 
+// NewResourceQuotaServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewResourceQuotaServerAdapter(server ResourceQuotaServer, router *mux.Router) *ResourceQuotaServerAdapter {
 	adapter := new(ResourceQuotaServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("PATCH").HandlerFunc(adapter.updateHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *ResourceQuotaServerAdapter) readResourceQuotaGetServerRequest(r *http.Request) (*ResourceQuotaGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *ResourceQuotaServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchResourceQuota(w, r, a.server, segments)
+}
+
+// dispatchResourceQuota navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchResourceQuota(w http.ResponseWriter, r *http.Request, server ResourceQuotaServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptResourceQuotaGetRequest(w, r, server)
+		case http.MethodPatch:
+			adaptResourceQuotaUpdateRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readResourceQuotaGetServerRequest(r *http.Request) (*ResourceQuotaGetServerRequest, error) {
 	result := new(ResourceQuotaGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *ResourceQuotaServerAdapter) writeResourceQuotaGetServerResponse(w http.ResponseWriter, r *ResourceQuotaGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeResourceQuotaGetServerResponse(w http.ResponseWriter, r *ResourceQuotaGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ResourceQuotaServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readResourceQuotaGetServerRequest(r)
+func adaptResourceQuotaGetRequest(w http.ResponseWriter, r *http.Request, server ResourceQuotaServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readResourceQuotaGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ResourceQuotaGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeResourceQuotaGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeResourceQuotaGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -225,9 +258,9 @@ func (a *ResourceQuotaServerAdapter) getHandler(w http.ResponseWriter, r *http.R
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *ResourceQuotaServerAdapter) readResourceQuotaUpdateServerRequest(r *http.Request) (*ResourceQuotaUpdateServerRequest, error) {
+func readResourceQuotaUpdateServerRequest(r *http.Request) (*ResourceQuotaUpdateServerRequest, error) {
 	result := new(ResourceQuotaUpdateServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -235,36 +268,42 @@ func (a *ResourceQuotaServerAdapter) readResourceQuotaUpdateServerRequest(r *htt
 	}
 	return result, nil
 }
-func (a *ResourceQuotaServerAdapter) writeResourceQuotaUpdateServerResponse(w http.ResponseWriter, r *ResourceQuotaUpdateServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeResourceQuotaUpdateServerResponse(w http.ResponseWriter, r *ResourceQuotaUpdateServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ResourceQuotaServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readResourceQuotaUpdateServerRequest(r)
+func adaptResourceQuotaUpdateRequest(w http.ResponseWriter, r *http.Request, server ResourceQuotaServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readResourceQuotaUpdateServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ResourceQuotaUpdateServerResponse)
-	err = a.server.Update(r.Context(), req, resp)
+	err = server.Update(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Update: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Update", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeResourceQuotaUpdateServerResponse(w, resp)
+	err = writeResourceQuotaUpdateServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ResourceQuotaKind is the name of the type used to represent objects
 // of type 'resource_quota'.
 const ResourceQuotaKind = "ResourceQuota"
@@ -46,6 +54,7 @@ type ResourceQuota struct {
 	availabilityZoneType *string
 	allowed              *int
 	reserved             *int
+	extra                map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -100,6 +109,27 @@ func (o *ResourceQuota) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete resource quota that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *ResourceQuota) Resolve(ctx context.Context, transport http.RoundTripper) (result *ResourceQuota, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to resource quota '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewResourceQuotaClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *ResourceQuota) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -114,6 +144,59 @@ func (o *ResourceQuota) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'resource_quota' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ResourceQuota) Metadata() *helpers.TypeMetadata {
+	return resourceQuotaMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ResourceQuota) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printResourceQuota(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ResourceQuota) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printResourceQuota(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ResourceQuota) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printResourceQuota(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ResourceQuota) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printResourceQuota(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'resource_quota' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ResourceQuota) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // OrganizationID returns the value of the 'organization_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -310,6 +393,339 @@ const ResourceQuotaListLinkKind = "ResourceQuotaListLink"
 // objects of type 'resource_quota'.
 const ResourceQuotaListNilKind = "ResourceQuotaListNil"
 
+// Copy returns a deep copy of the 'resource_quota' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ResourceQuota) Copy() *ResourceQuota {
+	if o == nil {
+		return nil
+	}
+	result := new(ResourceQuota)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.organizationID != nil {
+		result.organizationID = new(string)
+		*result.organizationID = *o.organizationID
+	}
+	if o.sku != nil {
+		result.sku = new(string)
+		*result.sku = *o.sku
+	}
+	if o.resourceName != nil {
+		result.resourceName = new(string)
+		*result.resourceName = *o.resourceName
+	}
+	if o.resourceType != nil {
+		result.resourceType = new(string)
+		*result.resourceType = *o.resourceType
+	}
+	if o.byoc != nil {
+		result.byoc = new(bool)
+		*result.byoc = *o.byoc
+	}
+	if o.availabilityZoneType != nil {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *o.availabilityZoneType
+	}
+	if o.allowed != nil {
+		result.allowed = new(int)
+		*result.allowed = *o.allowed
+	}
+	if o.reserved != nil {
+		result.reserved = new(int)
+		*result.reserved = *o.reserved
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'resource_quota' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ResourceQuota) Equal(other *ResourceQuota) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		return false
+	}
+	if (o.sku == nil) != (other.sku == nil) ||
+		(o.sku != nil && *o.sku != *other.sku) {
+		return false
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		return false
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		return false
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		return false
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		return false
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		return false
+	}
+	if (o.reserved == nil) != (other.reserved == nil) ||
+		(o.reserved != nil && *o.reserved != *other.reserved) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'resource_quota' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ResourceQuota) Diff(other *ResourceQuota) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'resource_quota' object and the given one, using the given path as prefix.
+func (o *ResourceQuota) diff(path string, other *ResourceQuota, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "organization_id"),
+			Old:  helpers.Value(o.organizationID),
+			New:  helpers.Value(other.organizationID),
+		})
+	}
+	if (o.sku == nil) != (other.sku == nil) ||
+		(o.sku != nil && *o.sku != *other.sku) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "sku"),
+			Old:  helpers.Value(o.sku),
+			New:  helpers.Value(other.sku),
+		})
+	}
+	if (o.resourceName == nil) != (other.resourceName == nil) ||
+		(o.resourceName != nil && *o.resourceName != *other.resourceName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_name"),
+			Old:  helpers.Value(o.resourceName),
+			New:  helpers.Value(other.resourceName),
+		})
+	}
+	if (o.resourceType == nil) != (other.resourceType == nil) ||
+		(o.resourceType != nil && *o.resourceType != *other.resourceType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "resource_type"),
+			Old:  helpers.Value(o.resourceType),
+			New:  helpers.Value(other.resourceType),
+		})
+	}
+	if (o.byoc == nil) != (other.byoc == nil) ||
+		(o.byoc != nil && *o.byoc != *other.byoc) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "byoc"),
+			Old:  helpers.Value(o.byoc),
+			New:  helpers.Value(other.byoc),
+		})
+	}
+	if (o.availabilityZoneType == nil) != (other.availabilityZoneType == nil) ||
+		(o.availabilityZoneType != nil && *o.availabilityZoneType != *other.availabilityZoneType) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "availability_zone_type"),
+			Old:  helpers.Value(o.availabilityZoneType),
+			New:  helpers.Value(other.availabilityZoneType),
+		})
+	}
+	if (o.allowed == nil) != (other.allowed == nil) ||
+		(o.allowed != nil && *o.allowed != *other.allowed) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "allowed"),
+			Old:  helpers.Value(o.allowed),
+			New:  helpers.Value(other.allowed),
+		})
+	}
+	if (o.reserved == nil) != (other.reserved == nil) ||
+		(o.reserved != nil && *o.reserved != *other.reserved) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "reserved"),
+			Old:  helpers.Value(o.reserved),
+			New:  helpers.Value(other.reserved),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'resource_quota' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ResourceQuota) Changes(modified *ResourceQuota) *ResourceQuota {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ResourceQuota)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.organizationID != nil && (o.organizationID == nil || *o.organizationID != *modified.organizationID) {
+		result.organizationID = new(string)
+		*result.organizationID = *modified.organizationID
+	}
+	if modified.sku != nil && (o.sku == nil || *o.sku != *modified.sku) {
+		result.sku = new(string)
+		*result.sku = *modified.sku
+	}
+	if modified.resourceName != nil && (o.resourceName == nil || *o.resourceName != *modified.resourceName) {
+		result.resourceName = new(string)
+		*result.resourceName = *modified.resourceName
+	}
+	if modified.resourceType != nil && (o.resourceType == nil || *o.resourceType != *modified.resourceType) {
+		result.resourceType = new(string)
+		*result.resourceType = *modified.resourceType
+	}
+	if modified.byoc != nil && (o.byoc == nil || *o.byoc != *modified.byoc) {
+		result.byoc = new(bool)
+		*result.byoc = *modified.byoc
+	}
+	if modified.availabilityZoneType != nil && (o.availabilityZoneType == nil || *o.availabilityZoneType != *modified.availabilityZoneType) {
+		result.availabilityZoneType = new(string)
+		*result.availabilityZoneType = *modified.availabilityZoneType
+	}
+	if modified.allowed != nil && (o.allowed == nil || *o.allowed != *modified.allowed) {
+		result.allowed = new(int)
+		*result.allowed = *modified.allowed
+	}
+	if modified.reserved != nil && (o.reserved == nil || *o.reserved != *modified.reserved) {
+		result.reserved = new(int)
+		*result.reserved = *modified.reserved
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printResourceQuota prints a value of the 'resource_quota' type to the given printer.
+func printResourceQuota(object *ResourceQuota, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ResourceQuota")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.organizationID != nil {
+		printer.PrintObjectField("OrganizationID")
+		printer.PrintString(*object.organizationID)
+	}
+	if object.sku != nil {
+		printer.PrintObjectField("SKU")
+		printer.PrintString(*object.sku)
+	}
+	if object.resourceName != nil {
+		printer.PrintObjectField("ResourceName")
+		printer.PrintString(*object.resourceName)
+	}
+	if object.resourceType != nil {
+		printer.PrintObjectField("ResourceType")
+		printer.PrintString(*object.resourceType)
+	}
+	if object.byoc != nil {
+		printer.PrintObjectField("BYOC")
+		printer.PrintBool(*object.byoc)
+	}
+	if object.availabilityZoneType != nil {
+		printer.PrintObjectField("AvailabilityZoneType")
+		printer.PrintString(*object.availabilityZoneType)
+	}
+	if object.allowed != nil {
+		printer.PrintObjectField("Allowed")
+		printer.PrintInt(*object.allowed)
+	}
+	if object.reserved != nil {
+		printer.PrintObjectField("Reserved")
+		printer.PrintInt(*object.reserved)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ResourceQuotaList is a list of values of the 'resource_quota' type.
 type ResourceQuotaList struct {
 	href  *string
@@ -417,3 +833,146 @@ func (l *ResourceQuotaList) Range(f func(index int, item *ResourceQuota) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ResourceQuotaList) Copy() *ResourceQuotaList {
+	if l == nil {
+		return nil
+	}
+	result := new(ResourceQuotaList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*ResourceQuota, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ResourceQuotaList) Equal(other *ResourceQuotaList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ResourceQuotaList) Diff(other *ResourceQuotaList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ResourceQuotaList) diff(path string, other *ResourceQuotaList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ResourceQuotaList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printResourceQuotaListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ResourceQuotaList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printResourceQuotaListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ResourceQuotaList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printResourceQuotaListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ResourceQuotaList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printResourceQuotaListLink(l, printer)
+	}
+}
+
+// printResourceQuotaList prints a list of values of the 'resource_quota' type to the given printer.
+func printResourceQuotaList(list *ResourceQuotaList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printResourceQuota(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printResourceQuotaListLink prints a list of values of the 'resource_quota' type to the
+// given printer, including the link to the list.
+func printResourceQuotaListLink(list *ResourceQuotaList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ResourceQuotaList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printResourceQuotaList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Validate checks that the object can be used to create a resource quota. It returns nil if it
+// is valid, or a *helpers.ValidationError containing all the violations if it isn't.
+func (o *ResourceQuota) Validate() error {
+	validator := new(helpers.Validator)
+	if o == nil {
+		validator.Report("", "resource quota is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// ValidateUpdate checks that the object can be used to update a resource quota. Only the attributes that
+// are present are checked, as the rest of the attributes of the resource quota aren't changed.
+func (o *ResourceQuota) ValidateUpdate() error {
+	validator := &helpers.Validator{
+		Partial: true,
+	}
+	if o == nil {
+		validator.Report("", "resource quota is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes.
+func (o *ResourceQuota) validate(path string, validator *helpers.Validator) {
+	validator.Required(helpers.Path(path, "organization_id"), o.organizationID)
+	validator.Required(helpers.Path(path, "sku"), o.sku)
+	validator.Required(helpers.Path(path, "resource_name"), o.resourceName)
+	validator.Required(helpers.Path(path, "resource_type"), o.resourceType)
+	if o.allowed != nil && *o.allowed < 0 {
+		validator.Report(helpers.Path(path, "allowed"), "%d is negative", *o.allowed)
+	}
+	if o.reserved != nil && *o.reserved < 0 {
+		validator.Report(helpers.Path(path, "reserved"), "%d is negative", *o.reserved)
+	}
+	if o.allowed != nil && o.reserved != nil && *o.reserved > *o.allowed {
+		validator.Report(
+			helpers.Path(path, "reserved"),
+			"%d is greater than the value of 'allowed', which is %d",
+			*o.reserved, *o.allowed,
+		)
+	}
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RoleBindingBuilder contains the data and logic needed to build 'role_binding' objects.
 //
 //
@@ -31,6 +35,7 @@ type RoleBindingBuilder struct {
 	account      *AccountBuilder
 	organization *OrganizationBuilder
 	role         *RoleBuilder
+	extra        map[string]interface{}
 }
 
 // NewRoleBinding creates a new builder of 'role_binding' objects.
@@ -38,6 +43,34 @@ func NewRoleBinding() *RoleBindingBuilder {
 	return new(RoleBindingBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'role_binding' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *RoleBinding) Builder() *RoleBindingBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(RoleBindingBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.type_ = o.type_
+	if o.subscription != nil {
+		b.subscription = o.subscription.Builder()
+	}
+	if o.account != nil {
+		b.account = o.account.Builder()
+	}
+	if o.organization != nil {
+		b.organization = o.organization.Builder()
+	}
+	if o.role != nil {
+		b.role = o.role.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *RoleBindingBuilder) ID(value string) *RoleBindingBuilder {
 	b.id = &value
@@ -101,9 +134,17 @@ func (b *RoleBindingBuilder) Role(value *RoleBuilder) *RoleBindingBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'role_binding' type, and
+// that will be written as they are when the object is encoded.
+func (b *RoleBindingBuilder) Extra(value map[string]interface{}) *RoleBindingBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'role_binding' object using the configuration stored in the builder.
 func (b *RoleBindingBuilder) Build() (object *RoleBinding, err error) {
 	object = new(RoleBinding)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
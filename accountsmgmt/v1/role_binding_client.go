@@ -21,7 +21,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -79,6 +79,7 @@ type RoleBindingGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -93,6 +94,17 @@ func (r *RoleBindingGetRequest) Header(name string, value interface{}) *RoleBind
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RoleBindingGetRequest) Decoding(value helpers.DecodingOptions) *RoleBindingGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -126,14 +138,14 @@ func (r *RoleBindingGetRequest) SendContext(ctx context.Context) (result *RoleBi
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -187,19 +199,13 @@ func (r *RoleBindingGetResponse) GetBody() (value *RoleBinding, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *RoleBindingGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(roleBindingData)
-	err = decoder.Decode(data)
-	if err != nil {
-		return err
-	}
-	r.body, err = data.unwrap()
+func (r *RoleBindingGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	return err
+	r.body = readRoleBinding(iterator)
+	return iterator.Error()
 }
 
 // RoleBindingDeleteRequest is the request for the 'delete' method.
@@ -256,7 +262,7 @@ func (r *RoleBindingDeleteRequest) SendContext(ctx context.Context) (result *Rol
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
@@ -287,3 +293,22 @@ func (r *RoleBindingDeleteResponse) Header() http.Header {
 func (r *RoleBindingDeleteResponse) Error() *errors.Error {
 	return r.err
 }
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *RoleBindingClient) Metadata() *helpers.ResourceMetadata {
+	return roleBindingResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *RoleBindingClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
+}
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'role_binding' objects.
 type RoleBindingListBuilder struct {
 	items []*RoleBindingBuilder
+	link  bool
+	href  *string
 }
 
 // NewRoleBindingList creates a new builder of 'role_binding' objects.
@@ -30,8 +32,25 @@ func NewRoleBindingList() *RoleBindingListBuilder {
 	return new(RoleBindingListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *RoleBindingList) Builder() *RoleBindingListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(RoleBindingListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*RoleBindingBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *RoleBindingListBuilder) Items(values ...*RoleBindingBuilder) *RoleBindingListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*RoleBindingBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *RoleBindingListBuilder) Build() (list *RoleBindingList, err error) {
 		}
 	}
 	list = new(RoleBindingList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// roleBindingListData is type used internally to marshal and unmarshal lists of objects
-// of type 'role_binding'.
-type roleBindingListData []*roleBindingData
-
 // UnmarshalRoleBindingList reads a list of values of the 'role_binding'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalRoleBindingList(source interface{}) (list *RoleBindingList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalRoleBindingList(source interface{}, options ...helpers.DecodingOptions) (list *RoleBindingList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data roleBindingListData
-	err = decoder.Decode(&data)
+	list = readRoleBindingList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'role_binding' value to a JSON document.
-func (l *RoleBindingList) wrap() (data roleBindingListData, err error) {
-	if l == nil {
+// MarshalRoleBindingList writes a list of values of the 'role_binding' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalRoleBindingList(list *RoleBindingList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeRoleBindingList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *RoleBindingList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRoleBindingListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalRoleBindingList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *RoleBindingList) UnmarshalJSON(data []byte) error {
+	var list *RoleBindingList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalRoleBindingList(data)
+	} else {
+		list, err = unmarshalRoleBindingListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = RoleBindingList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *RoleBindingList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *RoleBindingList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalRoleBindingListLink reads a list of values of the 'role_binding' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalRoleBindingListLink(source interface{}, options ...helpers.DecodingOptions) (list *RoleBindingList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(roleBindingListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readRoleBindingListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'role_binding' type.
-func (d roleBindingListData) unwrap() (list *RoleBindingList, err error) {
-	if d == nil {
+// writeRoleBindingList writes a list of values of the 'role_binding' type to the given
+// stream, as a JSON array.
+func writeRoleBindingList(list *RoleBindingList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*RoleBinding, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeRoleBinding(item, stream)
 	}
-	list = new(RoleBindingList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// roleBindingListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'role_binding'.
-type roleBindingListLinkData struct {
-	Kind  *string            "json:\"kind,omitempty\""
-	HREF  *string            "json:\"href,omitempty\""
-	Items []*roleBindingData "json:\"items,omitempty\""
+// readRoleBindingList reads a list of values of the 'role_binding' type from the given
+// iterator, as a JSON array.
+func readRoleBindingList(iterator *helpers.Iterator) *RoleBindingList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(RoleBindingList)
+	list.items = []*RoleBinding{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readRoleBinding(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'role_binding' value to a link.
-func (l *RoleBindingList) wrapLink() (data *roleBindingListLinkData, err error) {
-	if l == nil {
+// writeRoleBindingListLink writes a list of values of the 'role_binding' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeRoleBindingListLink(list *RoleBindingList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*roleBindingData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(roleBindingListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = RoleBindingListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(RoleBindingListLinkKind)
 	} else {
-		*data.Kind = RoleBindingListKind
+		stream.WriteString(RoleBindingListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRoleBindingList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'role_binding' type to a list.
-func (d *roleBindingListLinkData) unwrapLink() (list *RoleBindingList, err error) {
-	if d == nil {
-		return
+// readRoleBindingListLink reads a list of values of the 'role_binding' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readRoleBindingListLink(iterator *helpers.Iterator) *RoleBindingList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*RoleBinding, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(RoleBindingList)
+	list.items = []*RoleBinding{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(RoleBindingList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RoleBindingListKind:
-			list.link = false
-		case RoleBindingListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(RoleBindingListKind, RoleBindingListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readRoleBindingList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RoleBindingListKind,
-				RoleBindingListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,130 +20,172 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// roleBindingData is the data structure used internally to marshal and unmarshal
-// objects of type 'role_binding'.
-type roleBindingData struct {
-	Kind         *string           "json:\"kind,omitempty\""
-	ID           *string           "json:\"id,omitempty\""
-	HREF         *string           "json:\"href,omitempty\""
-	Type         *string           "json:\"type,omitempty\""
-	Subscription *subscriptionData "json:\"subscription,omitempty\""
-	Account      *accountData      "json:\"account,omitempty\""
-	Organization *organizationData "json:\"organization,omitempty\""
-	Role         *roleData         "json:\"role,omitempty\""
-}
-
 // MarshalRoleBinding writes a value of the 'role_binding' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalRoleBinding(object *RoleBinding, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeRoleBinding(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'role_binding'
-// value to a JSON document.
-func (o *RoleBinding) wrap() (data *roleBindingData, err error) {
-	if o == nil {
+// writeRoleBinding writes a value of the 'role_binding' type to the given stream.
+func writeRoleBinding(object *RoleBinding, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(roleBindingData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = RoleBindingLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(RoleBindingLinkKind)
 	} else {
-		*data.Kind = RoleBindingKind
+		stream.WriteString(RoleBindingKind)
 	}
-	data.Type = o.type_
-	data.Subscription, err = o.subscription.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Account, err = o.account.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	data.Organization, err = o.organization.wrap()
-	if err != nil {
-		return
+	if object.type_ != nil {
+		stream.WriteObjectField("type")
+		stream.WriteString(*object.type_)
 	}
-	data.Role, err = o.role.wrap()
-	if err != nil {
-		return
+	if object.subscription != nil {
+		stream.WriteObjectField("subscription")
+		writeSubscription(object.subscription, stream)
 	}
-	return
+	if object.account != nil {
+		stream.WriteObjectField("account")
+		writeAccount(object.account, stream)
+	}
+	if object.organization != nil {
+		stream.WriteObjectField("organization")
+		writeOrganization(object.organization, stream)
+	}
+	if object.role != nil {
+		stream.WriteObjectField("role")
+		writeRole(object.role, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalRoleBinding reads a value of the 'role_binding' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalRoleBinding(source interface{}) (object *RoleBinding, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalRoleBinding(source interface{}, options ...helpers.DecodingOptions) (object *RoleBinding, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(roleBindingData)
-	err = decoder.Decode(data)
+	object = readRoleBinding(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(RoleBinding)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'role_binding' type.
-func (d *roleBindingData) unwrap() (object *RoleBinding, err error) {
-	if d == nil {
-		return
-	}
-	object = new(RoleBinding)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RoleBindingKind:
-			object.link = false
-		case RoleBindingLinkKind:
-			object.link = true
+// readRoleBinding reads a value of the 'role_binding' type from the given iterator.
+func readRoleBinding(iterator *helpers.Iterator) *RoleBinding {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(RoleBinding)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(RoleBindingKind, RoleBindingLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "type":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.type_ = &value
+			}
+		case "subscription":
+			object.subscription = readSubscription(iterator)
+		case "account":
+			object.account = readAccount(iterator)
+		case "organization":
+			object.organization = readOrganization(iterator)
+		case "role":
+			object.role = readRole(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RoleBindingKind,
-				RoleBindingLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.type_ = d.Type
-	object.subscription, err = d.Subscription.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalRoleBinding function.
+func (o *RoleBinding) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRoleBinding(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalRoleBinding function, using the
+// default decoding options.
+func (o *RoleBinding) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalRoleBinding(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.account, err = d.Account.unwrap()
-	if err != nil {
-		return
+	if object == nil {
+		*o = RoleBinding{}
+		return nil
 	}
-	object.organization, err = d.Organization.unwrap()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *RoleBinding) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.role, err = d.Role.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *RoleBinding) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
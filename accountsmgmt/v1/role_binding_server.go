@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RoleBindingServer represents the interface the manages the 'role_binding' resource.
@@ -75,14 +77,12 @@ func (r *RoleBindingGetServerResponse) SetStatusCode(status int) *RoleBindingGet
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *RoleBindingGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRoleBinding(r.body, stream)
+	return stream.Flush()
 }
 
 // RoleBindingDeleteServerRequest is the request for the 'delete' method.
@@ -103,57 +103,98 @@ func (r *RoleBindingDeleteServerResponse) SetStatusCode(status int) *RoleBinding
 	return r
 }
 
-// RoleBindingServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RoleBindingServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RoleBindingServerAdapter struct {
 	server RoleBindingServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRoleBindingServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRoleBindingServerAdapter(server RoleBindingServer, router *mux.Router) *RoleBindingServerAdapter {
 	adapter := new(RoleBindingServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RoleBindingServerAdapter) readRoleBindingGetServerRequest(r *http.Request) (*RoleBindingGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RoleBindingServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRoleBinding(w, r, a.server, segments)
+}
+
+// dispatchRoleBinding navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRoleBinding(w http.ResponseWriter, r *http.Request, server RoleBindingServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRoleBindingGetRequest(w, r, server)
+		case http.MethodDelete:
+			adaptRoleBindingDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readRoleBindingGetServerRequest(r *http.Request) (*RoleBindingGetServerRequest, error) {
 	result := new(RoleBindingGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *RoleBindingServerAdapter) writeRoleBindingGetServerResponse(w http.ResponseWriter, r *RoleBindingGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRoleBindingGetServerResponse(w http.ResponseWriter, r *RoleBindingGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RoleBindingServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleBindingGetServerRequest(r)
+func adaptRoleBindingGetRequest(w http.ResponseWriter, r *http.Request, server RoleBindingServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleBindingGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleBindingGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeRoleBindingGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeRoleBindingGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -163,38 +204,38 @@ func (a *RoleBindingServerAdapter) getHandler(w http.ResponseWriter, r *http.Req
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *RoleBindingServerAdapter) readRoleBindingDeleteServerRequest(r *http.Request) (*RoleBindingDeleteServerRequest, error) {
+func readRoleBindingDeleteServerRequest(r *http.Request) (*RoleBindingDeleteServerRequest, error) {
 	result := new(RoleBindingDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *RoleBindingServerAdapter) writeRoleBindingDeleteServerResponse(w http.ResponseWriter, r *RoleBindingDeleteServerResponse) error {
+func writeRoleBindingDeleteServerResponse(w http.ResponseWriter, r *RoleBindingDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *RoleBindingServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleBindingDeleteServerRequest(r)
+func adaptRoleBindingDeleteRequest(w http.ResponseWriter, r *http.Request, server RoleBindingServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleBindingDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleBindingDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeRoleBindingDeleteServerResponse(w, resp)
+	err = writeRoleBindingDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
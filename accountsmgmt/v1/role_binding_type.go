@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RoleBindingKind is the name of the type used to represent objects
 // of type 'role_binding'.
 const RoleBindingKind = "RoleBinding"
@@ -43,6 +51,7 @@ type RoleBinding struct {
 	account      *Account
 	organization *Organization
 	role         *Role
+	extra        map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -97,6 +106,27 @@ func (o *RoleBinding) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete role binding that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *RoleBinding) Resolve(ctx context.Context, transport http.RoundTripper) (result *RoleBinding, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to role binding '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewRoleBindingClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *RoleBinding) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -108,6 +138,59 @@ func (o *RoleBinding) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'role_binding' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *RoleBinding) Metadata() *helpers.TypeMetadata {
+	return roleBindingMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *RoleBinding) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRoleBinding(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *RoleBinding) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRoleBinding(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *RoleBinding) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRoleBinding(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *RoleBinding) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRoleBinding(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'role_binding' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *RoleBinding) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Type returns the value of the 'type' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -235,6 +318,219 @@ const RoleBindingListLinkKind = "RoleBindingListLink"
 // objects of type 'role_binding'.
 const RoleBindingListNilKind = "RoleBindingListNil"
 
+// Copy returns a deep copy of the 'role_binding' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *RoleBinding) Copy() *RoleBinding {
+	if o == nil {
+		return nil
+	}
+	result := new(RoleBinding)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.type_ != nil {
+		result.type_ = new(string)
+		*result.type_ = *o.type_
+	}
+	result.subscription = o.subscription.Copy()
+	result.account = o.account.Copy()
+	result.organization = o.organization.Copy()
+	result.role = o.role.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'role_binding' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *RoleBinding) Equal(other *RoleBinding) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		return false
+	}
+	if !o.subscription.Equal(other.subscription) {
+		return false
+	}
+	if !o.account.Equal(other.account) {
+		return false
+	}
+	if !o.organization.Equal(other.organization) {
+		return false
+	}
+	if !o.role.Equal(other.role) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'role_binding' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *RoleBinding) Diff(other *RoleBinding) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'role_binding' object and the given one, using the given path as prefix.
+func (o *RoleBinding) diff(path string, other *RoleBinding, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "type"),
+			Old:  helpers.Value(o.type_),
+			New:  helpers.Value(other.type_),
+		})
+	}
+	result = o.subscription.diff(helpers.Path(path, "subscription"), other.subscription, result)
+	result = o.account.diff(helpers.Path(path, "account"), other.account, result)
+	result = o.organization.diff(helpers.Path(path, "organization"), other.organization, result)
+	result = o.role.diff(helpers.Path(path, "role"), other.role, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'role_binding' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *RoleBinding) Changes(modified *RoleBinding) *RoleBinding {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(RoleBinding)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.type_ != nil && (o.type_ == nil || *o.type_ != *modified.type_) {
+		result.type_ = new(string)
+		*result.type_ = *modified.type_
+	}
+	if modified.subscription != nil && !o.subscription.Equal(modified.subscription) {
+		result.subscription = o.subscription.Changes(modified.subscription)
+	}
+	if modified.account != nil && !o.account.Equal(modified.account) {
+		result.account = o.account.Changes(modified.account)
+	}
+	if modified.organization != nil && !o.organization.Equal(modified.organization) {
+		result.organization = o.organization.Changes(modified.organization)
+	}
+	if modified.role != nil && !o.role.Equal(modified.role) {
+		result.role = o.role.Changes(modified.role)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printRoleBinding prints a value of the 'role_binding' type to the given printer.
+func printRoleBinding(object *RoleBinding, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.RoleBinding")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.type_ != nil {
+		printer.PrintObjectField("Type")
+		printer.PrintString(*object.type_)
+	}
+	if object.subscription != nil {
+		printer.PrintObjectField("Subscription")
+		printSubscription(object.subscription, printer)
+	}
+	if object.account != nil {
+		printer.PrintObjectField("Account")
+		printAccount(object.account, printer)
+	}
+	if object.organization != nil {
+		printer.PrintObjectField("Organization")
+		printOrganization(object.organization, printer)
+	}
+	if object.role != nil {
+		printer.PrintObjectField("Role")
+		printRole(object.role, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // RoleBindingList is a list of values of the 'role_binding' type.
 type RoleBindingList struct {
 	href  *string
@@ -342,3 +638,146 @@ func (l *RoleBindingList) Range(f func(index int, item *RoleBinding) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *RoleBindingList) Copy() *RoleBindingList {
+	if l == nil {
+		return nil
+	}
+	result := new(RoleBindingList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*RoleBinding, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *RoleBindingList) Equal(other *RoleBindingList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *RoleBindingList) Diff(other *RoleBindingList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *RoleBindingList) diff(path string, other *RoleBindingList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *RoleBindingList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printRoleBindingListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *RoleBindingList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printRoleBindingListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *RoleBindingList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printRoleBindingListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *RoleBindingList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printRoleBindingListLink(l, printer)
+	}
+}
+
+// printRoleBindingList prints a list of values of the 'role_binding' type to the given printer.
+func printRoleBindingList(list *RoleBindingList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printRoleBinding(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printRoleBindingListLink prints a list of values of the 'role_binding' type to the
+// given printer, including the link to the list.
+func printRoleBindingListLink(list *RoleBindingList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.RoleBindingList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printRoleBindingList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RoleBindingsServer represents the interface the manages the 'role_bindings' resource.
@@ -56,6 +58,7 @@ type RoleBindingsListServerRequest struct {
 	query url.Values
 	page  *int
 	size  *int
+	order []*helpers.OrderItem
 	total *int
 }
 
@@ -111,6 +114,58 @@ func (r *RoleBindingsListServerRequest) GetSize() (value int, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the role binding
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `type` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// type desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RoleBindingsListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the role binding
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `type` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// type desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *RoleBindingsListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -191,27 +246,29 @@ func (r *RoleBindingsListServerResponse) SetStatusCode(status int) *RoleBindings
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *RoleBindingsListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(roleBindingsListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// roleBindingsListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type roleBindingsListServerResponseData struct {
-	Page  *int                "json:\"page,omitempty\""
-	Size  *int                "json:\"size,omitempty\""
-	Total *int                "json:\"total,omitempty\""
-	Items roleBindingListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRoleBindingList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
 // RoleBindingsAddServerRequest is the request for the 'add' method.
@@ -246,18 +303,12 @@ func (r *RoleBindingsAddServerRequest) GetBody() (value *RoleBinding, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'add' method.
 func (r *RoleBindingsAddServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(roleBindingData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readRoleBinding(iterator)
+	return iterator.Error()
 }
 
 // RoleBindingsAddServerResponse is the response for the 'add' method.
@@ -284,75 +335,174 @@ func (r *RoleBindingsAddServerResponse) SetStatusCode(status int) *RoleBindingsA
 // marshall is the method used internally to marshal responses for the
 // 'add' method.
 func (r *RoleBindingsAddServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRoleBinding(r.body, stream)
+	return stream.Flush()
 }
 
-// RoleBindingsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RoleBindingsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RoleBindingsServerAdapter struct {
 	server RoleBindingsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRoleBindingsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRoleBindingsServerAdapter(server RoleBindingsServer, router *mux.Router) *RoleBindingsServerAdapter {
 	adapter := new(RoleBindingsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.roleBindingHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
-	adapter.router.Methods("POST").HandlerFunc(adapter.addHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RoleBindingsServerAdapter) roleBindingHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.RoleBinding(id)
-	targetAdapter := NewRoleBindingServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RoleBindingsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRoleBindings(w, r, a.server, segments)
+}
+
+// dispatchRoleBindings navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRoleBindings(w http.ResponseWriter, r *http.Request, server RoleBindingsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRoleBindingsListRequest(w, r, server)
+		case http.MethodPost:
+			adaptRoleBindingsAddRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.RoleBinding(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRoleBinding(w, r, target, segments[1:])
+	}
 }
-func (a *RoleBindingsServerAdapter) readRoleBindingsListServerRequest(r *http.Request) (*RoleBindingsListServerRequest, error) {
+
+// roleBindingsListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var roleBindingsListOrderAttributes = map[string]bool{
+	"account.ban_description":          true,
+	"account.banned":                   true,
+	"account.email":                    true,
+	"account.first_name":               true,
+	"account.href":                     true,
+	"account.id":                       true,
+	"account.last_name":                true,
+	"account.name":                     true,
+	"account.username":                 true,
+	"href":                             true,
+	"id":                               true,
+	"organization.href":                true,
+	"organization.id":                  true,
+	"organization.name":                true,
+	"role.href":                        true,
+	"role.id":                          true,
+	"role.name":                        true,
+	"subscription.cluster_id":          true,
+	"subscription.external_cluster_id": true,
+	"subscription.href":                true,
+	"subscription.id":                  true,
+	"subscription.last_telemetry_date": true,
+	"subscription.organization_id":     true,
+	"type":                             true,
+}
+
+func readRoleBindingsListServerRequest(r *http.Request) (*RoleBindingsListServerRequest, error) {
+	var err error
 	result := new(RoleBindingsListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", roleBindingsListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *RoleBindingsServerAdapter) writeRoleBindingsListServerResponse(w http.ResponseWriter, r *RoleBindingsListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRoleBindingsListServerResponse(w http.ResponseWriter, r *RoleBindingsListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RoleBindingsServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleBindingsListServerRequest(r)
+func adaptRoleBindingsListRequest(w http.ResponseWriter, r *http.Request, server RoleBindingsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleBindingsListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleBindingsListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRoleBindingsListServerResponse(w, resp)
+	err = writeRoleBindingsListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -362,9 +512,9 @@ func (a *RoleBindingsServerAdapter) listHandler(w http.ResponseWriter, r *http.R
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *RoleBindingsServerAdapter) readRoleBindingsAddServerRequest(r *http.Request) (*RoleBindingsAddServerRequest, error) {
+func readRoleBindingsAddServerRequest(r *http.Request) (*RoleBindingsAddServerRequest, error) {
 	result := new(RoleBindingsAddServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -372,36 +522,42 @@ func (a *RoleBindingsServerAdapter) readRoleBindingsAddServerRequest(r *http.Req
 	}
 	return result, nil
 }
-func (a *RoleBindingsServerAdapter) writeRoleBindingsAddServerResponse(w http.ResponseWriter, r *RoleBindingsAddServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRoleBindingsAddServerResponse(w http.ResponseWriter, r *RoleBindingsAddServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RoleBindingsServerAdapter) addHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleBindingsAddServerRequest(r)
+func adaptRoleBindingsAddRequest(w http.ResponseWriter, r *http.Request, server RoleBindingsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleBindingsAddServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleBindingsAddServerResponse)
-	err = a.server.Add(r.Context(), req, resp)
+	err = server.Add(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Add: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Add", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusCreated
 	}
-	err = a.writeRoleBindingsAddServerResponse(w, resp)
+	err = writeRoleBindingsAddServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
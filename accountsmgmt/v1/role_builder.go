@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // RoleBuilder contains the data and logic needed to build 'role' objects.
 //
 //
@@ -28,6 +32,7 @@ type RoleBuilder struct {
 	link        bool
 	name        *string
 	permissions []*PermissionBuilder
+	extra       map[string]interface{}
 }
 
 // NewRole creates a new builder of 'role' objects.
@@ -35,6 +40,28 @@ func NewRole() *RoleBuilder {
 	return new(RoleBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'role' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Role) Builder() *RoleBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(RoleBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	if o.permissions != nil {
+		b.permissions = make([]*PermissionBuilder, len(o.permissions.items))
+		for i, item := range o.permissions.items {
+			b.permissions[i] = item.Builder()
+		}
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *RoleBuilder) ID(value string) *RoleBuilder {
 	b.id = &value
@@ -72,9 +99,17 @@ func (b *RoleBuilder) Permissions(values ...*PermissionBuilder) *RoleBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'role' type, and
+// that will be written as they are when the object is encoded.
+func (b *RoleBuilder) Extra(value map[string]interface{}) *RoleBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'role' object using the configuration stored in the builder.
 func (b *RoleBuilder) Build() (object *Role, err error) {
 	object = new(Role)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
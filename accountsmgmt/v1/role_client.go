@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -92,6 +92,7 @@ type RoleGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -106,6 +107,17 @@ func (r *RoleGetRequest) Header(name string, value interface{}) *RoleGetRequest
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RoleGetRequest) Decoding(value helpers.DecodingOptions) *RoleGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -139,14 +151,14 @@ func (r *RoleGetRequest) SendContext(ctx context.Context) (result *RoleGetRespon
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -200,29 +212,26 @@ func (r *RoleGetResponse) GetBody() (value *Role, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *RoleGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(roleData)
-	err = decoder.Decode(data)
+func (r *RoleGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readRole(iterator)
+	return iterator.Error()
 }
 
 // RoleUpdateRequest is the request for the 'update' method.
 type RoleUpdateRequest struct {
-	transport http.RoundTripper
-	path      string
-	metric    string
-	query     url.Values
-	header    http.Header
-	body      *Role
+	transport  http.RoundTripper
+	path       string
+	metric     string
+	query      url.Values
+	header     http.Header
+	body       *Role
+	decoding   helpers.DecodingOptions
+	original   *Role
+	mergePatch bool
 }
 
 // Parameter adds a query parameter.
@@ -237,11 +246,45 @@ func (r *RoleUpdateRequest) Header(name string, value interface{}) *RoleUpdateRe
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *RoleUpdateRequest) Decoding(value helpers.DecodingOptions) *RoleUpdateRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 //
 func (r *RoleUpdateRequest) Body(value *Role) *RoleUpdateRequest {
 	r.body = value
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// Changes sets the body of the request to the attributes of the modified object that have
+// values different to the values in the original object, as calculated by the Changes method
+// of the type.
+func (r *RoleUpdateRequest) Changes(original, modified *Role) *RoleUpdateRequest {
+	r.body = original.Changes(modified)
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// MergePatch sets the body of the request to the JSON merge patch, as described in RFC 7396,
+// that transforms the original object into the modified one. The request will be sent with the
+// `application/merge-patch+json` content type. Attributes removed in the modified object will
+// be set to null in the patch.
+func (r *RoleUpdateRequest) MergePatch(original, modified *Role) *RoleUpdateRequest {
+	r.body = modified
+	r.original = original
+	r.mergePatch = true
 	return r
 }
 
@@ -257,6 +300,9 @@ func (r *RoleUpdateRequest) Send() (result *RoleUpdateResponse, err error) {
 func (r *RoleUpdateRequest) SendContext(ctx context.Context) (result *RoleUpdateResponse, err error) {
 	query := helpers.CopyQuery(r.query)
 	header := helpers.SetHeader(r.header, r.metric)
+	if r.mergePatch {
+		header.Set("Content-Type", helpers.MergePatchContentType)
+	}
 	buffer := new(bytes.Buffer)
 	err = r.marshal(buffer)
 	if err != nil {
@@ -284,14 +330,14 @@ func (r *RoleUpdateRequest) SendContext(ctx context.Context) (result *RoleUpdate
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -302,13 +348,31 @@ func (r *RoleUpdateRequest) SendContext(ctx context.Context) (result *RoleUpdate
 // 'update' method.
 func (r *RoleUpdateRequest) marshal(writer io.Writer) error {
 	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	if r.mergePatch {
+		original := new(bytes.Buffer)
+		err = MarshalRole(r.original, original)
+		if err != nil {
+			return err
+		}
+		modified := new(bytes.Buffer)
+		err = MarshalRole(r.body, modified)
+		if err != nil {
+			return err
+		}
+		var patch []byte
+		patch, err = helpers.MergePatch(original.Bytes(), modified.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(patch)
+		return err
+	}
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRole(r.body, stream)
+	return stream.Flush()
 }
 
 // RoleUpdateResponse is the response for the 'update' method.
@@ -358,19 +422,13 @@ func (r *RoleUpdateResponse) GetBody() (value *Role, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'update' method.
-func (r *RoleUpdateResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(roleData)
-	err = decoder.Decode(data)
+func (r *RoleUpdateResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readRole(iterator)
+	return iterator.Error()
 }
 
 // RoleDeleteRequest is the request for the 'delete' method.
@@ -427,7 +485,7 @@ func (r *RoleDeleteRequest) SendContext(ctx context.Context) (result *RoleDelete
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
@@ -458,3 +516,22 @@ func (r *RoleDeleteResponse) Header() http.Header {
 func (r *RoleDeleteResponse) Error() *errors.Error {
 	return r.err
 }
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *RoleClient) Metadata() *helpers.ResourceMetadata {
+	return roleResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *RoleClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
+}
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 // 'role' objects.
 type RoleListBuilder struct {
 	items []*RoleBuilder
+	link  bool
+	href  *string
 }
 
 // NewRoleList creates a new builder of 'role' objects.
@@ -30,8 +32,25 @@ func NewRoleList() *RoleListBuilder {
 	return new(RoleListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *RoleList) Builder() *RoleListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(RoleListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*RoleBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *RoleListBuilder) Items(values ...*RoleBuilder) *RoleListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*RoleBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *RoleListBuilder) Build() (list *RoleList, err error) {
 		}
 	}
 	list = new(RoleList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
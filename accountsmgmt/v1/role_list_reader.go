@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// roleListData is type used internally to marshal and unmarshal lists of objects
-// of type 'role'.
-type roleListData []*roleData
-
 // UnmarshalRoleList reads a list of values of the 'role'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalRoleList(source interface{}) (list *RoleList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalRoleList(source interface{}, options ...helpers.DecodingOptions) (list *RoleList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data roleListData
-	err = decoder.Decode(&data)
+	list = readRoleList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'role' value to a JSON document.
-func (l *RoleList) wrap() (data roleListData, err error) {
-	if l == nil {
+// MarshalRoleList writes a list of values of the 'role' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalRoleList(list *RoleList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeRoleList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *RoleList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRoleListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalRoleList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *RoleList) UnmarshalJSON(data []byte) error {
+	var list *RoleList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalRoleList(data)
+	} else {
+		list, err = unmarshalRoleListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = RoleList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *RoleList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *RoleList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalRoleListLink reads a list of values of the 'role' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalRoleListLink(source interface{}, options ...helpers.DecodingOptions) (list *RoleList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(roleListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readRoleListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'role' type.
-func (d roleListData) unwrap() (list *RoleList, err error) {
-	if d == nil {
+// writeRoleList writes a list of values of the 'role' type to the given
+// stream, as a JSON array.
+func writeRoleList(list *RoleList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Role, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeRole(item, stream)
 	}
-	list = new(RoleList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// roleListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'role'.
-type roleListLinkData struct {
-	Kind  *string     "json:\"kind,omitempty\""
-	HREF  *string     "json:\"href,omitempty\""
-	Items []*roleData "json:\"items,omitempty\""
+// readRoleList reads a list of values of the 'role' type from the given
+// iterator, as a JSON array.
+func readRoleList(iterator *helpers.Iterator) *RoleList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(RoleList)
+	list.items = []*Role{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readRole(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'role' value to a link.
-func (l *RoleList) wrapLink() (data *roleListLinkData, err error) {
-	if l == nil {
+// writeRoleListLink writes a list of values of the 'role' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeRoleListLink(list *RoleList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*roleData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(roleListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = RoleListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(RoleListLinkKind)
 	} else {
-		*data.Kind = RoleListKind
+		stream.WriteString(RoleListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeRoleList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'role' type to a list.
-func (d *roleListLinkData) unwrapLink() (list *RoleList, err error) {
-	if d == nil {
-		return
+// readRoleListLink reads a list of values of the 'role' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readRoleListLink(iterator *helpers.Iterator) *RoleList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Role, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(RoleList)
+	list.items = []*Role{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(RoleList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RoleListKind:
-			list.link = false
-		case RoleListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(RoleListKind, RoleListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readRoleList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RoleListKind,
-				RoleListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
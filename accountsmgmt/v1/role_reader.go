@@ -20,103 +20,154 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// roleData is the data structure used internally to marshal and unmarshal
-// objects of type 'role'.
-type roleData struct {
-	Kind        *string            "json:\"kind,omitempty\""
-	ID          *string            "json:\"id,omitempty\""
-	HREF        *string            "json:\"href,omitempty\""
-	Name        *string            "json:\"name,omitempty\""
-	Permissions permissionListData "json:\"permissions,omitempty\""
-}
-
 // MarshalRole writes a value of the 'role' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalRole(object *Role, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeRole(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'role'
-// value to a JSON document.
-func (o *Role) wrap() (data *roleData, err error) {
-	if o == nil {
+// writeRole writes a value of the 'role' type to the given stream.
+func writeRole(object *Role, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(roleData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = RoleLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(RoleLinkKind)
 	} else {
-		*data.Kind = RoleKind
+		stream.WriteString(RoleKind)
 	}
-	data.Name = o.name
-	data.Permissions, err = o.permissions.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.permissions != nil && len(object.permissions.items) > 0 {
+		stream.WriteObjectField("permissions")
+		writePermissionList(object.permissions, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalRole reads a value of the 'role' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalRole(source interface{}) (object *Role, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalRole(source interface{}, options ...helpers.DecodingOptions) (object *Role, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(roleData)
-	err = decoder.Decode(data)
+	object = readRole(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Role)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'role' type.
-func (d *roleData) unwrap() (object *Role, err error) {
-	if d == nil {
-		return
+// readRole reads a value of the 'role' type from the given iterator.
+func readRole(iterator *helpers.Iterator) *Role {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Role)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case RoleKind:
-			object.link = false
-		case RoleLinkKind:
-			object.link = true
+	object := new(Role)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(RoleKind, RoleLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "permissions":
+			object.permissions = readPermissionList(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				RoleKind,
-				RoleLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	object.permissions, err = d.Permissions.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalRole function.
+func (o *Role) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeRole(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalRole function, using the
+// default decoding options.
+func (o *Role) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalRole(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Role{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Role) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Role) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RoleServer represents the interface the manages the 'role' resource.
@@ -80,14 +82,12 @@ func (r *RoleGetServerResponse) SetStatusCode(status int) *RoleGetServerResponse
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *RoleGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRole(r.body, stream)
+	return stream.Flush()
 }
 
 // RoleUpdateServerRequest is the request for the 'update' method.
@@ -122,18 +122,12 @@ func (r *RoleUpdateServerRequest) GetBody() (value *Role, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'update' method.
 func (r *RoleUpdateServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(roleData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readRole(iterator)
+	return iterator.Error()
 }
 
 // RoleUpdateServerResponse is the response for the 'update' method.
@@ -160,14 +154,12 @@ func (r *RoleUpdateServerResponse) SetStatusCode(status int) *RoleUpdateServerRe
 // marshall is the method used internally to marshal responses for the
 // 'update' method.
 func (r *RoleUpdateServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeRole(r.body, stream)
+	return stream.Flush()
 }
 
 // RoleDeleteServerRequest is the request for the 'delete' method.
@@ -188,58 +180,100 @@ func (r *RoleDeleteServerResponse) SetStatusCode(status int) *RoleDeleteServerRe
 	return r
 }
 
-// RoleServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RoleServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RoleServerAdapter struct {
 	server RoleServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRoleServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRoleServerAdapter(server RoleServer, router *mux.Router) *RoleServerAdapter {
 	adapter := new(RoleServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("PATCH").HandlerFunc(adapter.updateHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RoleServerAdapter) readRoleGetServerRequest(r *http.Request) (*RoleGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RoleServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchRole(w, r, a.server, segments)
+}
+
+// dispatchRole navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRole(w http.ResponseWriter, r *http.Request, server RoleServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptRoleGetRequest(w, r, server)
+		case http.MethodPatch:
+			adaptRoleUpdateRequest(w, r, server)
+		case http.MethodDelete:
+			adaptRoleDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readRoleGetServerRequest(r *http.Request) (*RoleGetServerRequest, error) {
 	result := new(RoleGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *RoleServerAdapter) writeRoleGetServerResponse(w http.ResponseWriter, r *RoleGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRoleGetServerResponse(w http.ResponseWriter, r *RoleGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RoleServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleGetServerRequest(r)
+func adaptRoleGetRequest(w http.ResponseWriter, r *http.Request, server RoleServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRoleGetServerResponse(w, resp)
+	err = writeRoleGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -249,9 +283,9 @@ func (a *RoleServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *RoleServerAdapter) readRoleUpdateServerRequest(r *http.Request) (*RoleUpdateServerRequest, error) {
+func readRoleUpdateServerRequest(r *http.Request) (*RoleUpdateServerRequest, error) {
 	result := new(RoleUpdateServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -259,36 +293,42 @@ func (a *RoleServerAdapter) readRoleUpdateServerRequest(r *http.Request) (*RoleU
 	}
 	return result, nil
 }
-func (a *RoleServerAdapter) writeRoleUpdateServerResponse(w http.ResponseWriter, r *RoleUpdateServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeRoleUpdateServerResponse(w http.ResponseWriter, r *RoleUpdateServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *RoleServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleUpdateServerRequest(r)
+func adaptRoleUpdateRequest(w http.ResponseWriter, r *http.Request, server RoleServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleUpdateServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleUpdateServerResponse)
-	err = a.server.Update(r.Context(), req, resp)
+	err = server.Update(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Update: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Update", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeRoleUpdateServerResponse(w, resp)
+	err = writeRoleUpdateServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -298,38 +338,38 @@ func (a *RoleServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *RoleServerAdapter) readRoleDeleteServerRequest(r *http.Request) (*RoleDeleteServerRequest, error) {
+func readRoleDeleteServerRequest(r *http.Request) (*RoleDeleteServerRequest, error) {
 	result := new(RoleDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *RoleServerAdapter) writeRoleDeleteServerResponse(w http.ResponseWriter, r *RoleDeleteServerResponse) error {
+func writeRoleDeleteServerResponse(w http.ResponseWriter, r *RoleDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *RoleServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readRoleDeleteServerRequest(r)
+func adaptRoleDeleteRequest(w http.ResponseWriter, r *http.Request, server RoleServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readRoleDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(RoleDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeRoleDeleteServerResponse(w, resp)
+	err = writeRoleDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -23,6 +23,8 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RootServer represents the interface the manages the 'root' resource.
@@ -94,102 +96,133 @@ type RootServer interface {
 	Subscriptions() SubscriptionsServer
 }
 
-// RootServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RootServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RootServerAdapter struct {
 	server RootServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRootServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRootServerAdapter(server RootServer, router *mux.Router) *RootServerAdapter {
 	adapter := new(RootServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/accounts").HandlerFunc(adapter.accountsHandler)
-	adapter.router.PathPrefix("/current_account").HandlerFunc(adapter.currentAccountHandler)
-	adapter.router.PathPrefix("/organizations").HandlerFunc(adapter.organizationsHandler)
-	adapter.router.PathPrefix("/access_token").HandlerFunc(adapter.accessTokenHandler)
-	adapter.router.PathPrefix("/permissions").HandlerFunc(adapter.permissionsHandler)
-	adapter.router.PathPrefix("/registries").HandlerFunc(adapter.registriesHandler)
-	adapter.router.PathPrefix("/registry_credentials").HandlerFunc(adapter.registryCredentialsHandler)
-	adapter.router.PathPrefix("/cluster_authorizations").HandlerFunc(adapter.clusterAuthorizationsHandler)
-	adapter.router.PathPrefix("/cluster_registrations").HandlerFunc(adapter.clusterRegistrationsHandler)
-	adapter.router.PathPrefix("/roles").HandlerFunc(adapter.rolesHandler)
-	adapter.router.PathPrefix("/role_bindings").HandlerFunc(adapter.roleBindingsHandler)
-	adapter.router.PathPrefix("/subscriptions").HandlerFunc(adapter.subscriptionsHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RootServerAdapter) accountsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Accounts()
-	targetAdapter := NewAccountsServerAdapter(target, a.router.PathPrefix("/accounts").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) currentAccountHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.CurrentAccount()
-	targetAdapter := NewCurrentAccountServerAdapter(target, a.router.PathPrefix("/current_account").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) organizationsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Organizations()
-	targetAdapter := NewOrganizationsServerAdapter(target, a.router.PathPrefix("/organizations").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) accessTokenHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.AccessToken()
-	targetAdapter := NewAccessTokenServerAdapter(target, a.router.PathPrefix("/access_token").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) permissionsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Permissions()
-	targetAdapter := NewPermissionsServerAdapter(target, a.router.PathPrefix("/permissions").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) registriesHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Registries()
-	targetAdapter := NewRegistriesServerAdapter(target, a.router.PathPrefix("/registries").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) registryCredentialsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.RegistryCredentials()
-	targetAdapter := NewRegistryCredentialsServerAdapter(target, a.router.PathPrefix("/registry_credentials").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) clusterAuthorizationsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.ClusterAuthorizations()
-	targetAdapter := NewClusterAuthorizationsServerAdapter(target, a.router.PathPrefix("/cluster_authorizations").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) clusterRegistrationsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.ClusterRegistrations()
-	targetAdapter := NewClusterRegistrationsServerAdapter(target, a.router.PathPrefix("/cluster_registrations").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) rolesHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Roles()
-	targetAdapter := NewRolesServerAdapter(target, a.router.PathPrefix("/roles").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) roleBindingsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.RoleBindings()
-	targetAdapter := NewRoleBindingsServerAdapter(target, a.router.PathPrefix("/role_bindings").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) subscriptionsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Subscriptions()
-	targetAdapter := NewSubscriptionsServerAdapter(target, a.router.PathPrefix("/subscriptions").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RootServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	helpers.SetRoute(r, a.prefix, rootResource, segments)
+	dispatchRoot(w, r, a.server, segments)
+}
+
+// dispatchRoot navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRoot(w http.ResponseWriter, r *http.Request, server RootServer, segments []string) {
+	if len(segments) == 0 {
+		errors.SendNotFound(w, r)
+		return
+	}
+	switch segments[0] {
+	case "accounts":
+		target := server.Accounts()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchAccounts(w, r, target, segments[1:])
+	case "current_account":
+		target := server.CurrentAccount()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchCurrentAccount(w, r, target, segments[1:])
+	case "organizations":
+		target := server.Organizations()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchOrganizations(w, r, target, segments[1:])
+	case "access_token":
+		target := server.AccessToken()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchAccessToken(w, r, target, segments[1:])
+	case "permissions":
+		target := server.Permissions()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchPermissions(w, r, target, segments[1:])
+	case "registries":
+		target := server.Registries()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRegistries(w, r, target, segments[1:])
+	case "registry_credentials":
+		target := server.RegistryCredentials()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRegistryCredentials(w, r, target, segments[1:])
+	case "cluster_authorizations":
+		target := server.ClusterAuthorizations()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchClusterAuthorizations(w, r, target, segments[1:])
+	case "cluster_registrations":
+		target := server.ClusterRegistrations()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchClusterRegistrations(w, r, target, segments[1:])
+	case "roles":
+		target := server.Roles()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRoles(w, r, target, segments[1:])
+	case "role_bindings":
+		target := server.RoleBindings()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchRoleBindings(w, r, target, segments[1:])
+	case "subscriptions":
+		target := server.Subscriptions()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchSubscriptions(w, r, target, segments[1:])
+	default:
+		errors.SendNotFound(w, r)
+	}
 }
 func (a *RootServerAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	a.router.ServeHTTP(w, r)
@@ -21,6 +21,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // SubscriptionBuilder contains the data and logic needed to build 'subscription' objects.
@@ -37,6 +39,7 @@ type SubscriptionBuilder struct {
 	organizationID     *string
 	lastTelemetryDate  *time.Time
 	creator            *AccountBuilder
+	extra              map[string]interface{}
 }
 
 // NewSubscription creates a new builder of 'subscription' objects.
@@ -44,6 +47,34 @@ func NewSubscription() *SubscriptionBuilder {
 	return new(SubscriptionBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'subscription' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Subscription) Builder() *SubscriptionBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(SubscriptionBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	if o.plan != nil {
+		b.plan = o.plan.Builder()
+	}
+	if o.registryCredential != nil {
+		b.registryCredential = o.registryCredential.Builder()
+	}
+	b.clusterID = o.clusterID
+	b.externalClusterID = o.externalClusterID
+	b.organizationID = o.organizationID
+	b.lastTelemetryDate = o.lastTelemetryDate
+	if o.creator != nil {
+		b.creator = o.creator.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *SubscriptionBuilder) ID(value string) *SubscriptionBuilder {
 	b.id = &value
@@ -125,9 +156,17 @@ func (b *SubscriptionBuilder) Creator(value *AccountBuilder) *SubscriptionBuilde
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'subscription' type, and
+// that will be written as they are when the object is encoded.
+func (b *SubscriptionBuilder) Extra(value map[string]interface{}) *SubscriptionBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'subscription' object using the configuration stored in the builder.
 func (b *SubscriptionBuilder) Build() (object *Subscription, err error) {
 	object = new(Subscription)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
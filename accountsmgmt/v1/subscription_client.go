@@ -21,7 +21,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -79,6 +79,7 @@ type SubscriptionGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -93,6 +94,17 @@ func (r *SubscriptionGetRequest) Header(name string, value interface{}) *Subscri
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *SubscriptionGetRequest) Decoding(value helpers.DecodingOptions) *SubscriptionGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -126,14 +138,14 @@ func (r *SubscriptionGetRequest) SendContext(ctx context.Context) (result *Subsc
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -187,19 +199,13 @@ func (r *SubscriptionGetResponse) GetBody() (value *Subscription, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *SubscriptionGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(subscriptionData)
-	err = decoder.Decode(data)
-	if err != nil {
-		return err
-	}
-	r.body, err = data.unwrap()
+func (r *SubscriptionGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	return err
+	r.body = readSubscription(iterator)
+	return iterator.Error()
 }
 
 // SubscriptionDeleteRequest is the request for the 'delete' method.
@@ -256,7 +262,7 @@ func (r *SubscriptionDeleteRequest) SendContext(ctx context.Context) (result *Su
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
@@ -287,3 +293,22 @@ func (r *SubscriptionDeleteResponse) Header() http.Header {
 func (r *SubscriptionDeleteResponse) Error() *errors.Error {
 	return r.err
 }
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *SubscriptionClient) Metadata() *helpers.ResourceMetadata {
+	return subscriptionResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *SubscriptionClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+	return
+}
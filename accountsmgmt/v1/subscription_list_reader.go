@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// subscriptionListData is type used internally to marshal and unmarshal lists of objects
-// of type 'subscription'.
-type subscriptionListData []*subscriptionData
-
 // UnmarshalSubscriptionList reads a list of values of the 'subscription'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalSubscriptionList(source interface{}) (list *SubscriptionList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalSubscriptionList(source interface{}, options ...helpers.DecodingOptions) (list *SubscriptionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data subscriptionListData
-	err = decoder.Decode(&data)
+	list = readSubscriptionList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'subscription' value to a JSON document.
-func (l *SubscriptionList) wrap() (data subscriptionListData, err error) {
-	if l == nil {
+// MarshalSubscriptionList writes a list of values of the 'subscription' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalSubscriptionList(list *SubscriptionList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeSubscriptionList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *SubscriptionList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSubscriptionListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalSubscriptionList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *SubscriptionList) UnmarshalJSON(data []byte) error {
+	var list *SubscriptionList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalSubscriptionList(data)
+	} else {
+		list, err = unmarshalSubscriptionListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = SubscriptionList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *SubscriptionList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *SubscriptionList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalSubscriptionListLink reads a list of values of the 'subscription' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalSubscriptionListLink(source interface{}, options ...helpers.DecodingOptions) (list *SubscriptionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(subscriptionListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readSubscriptionListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'subscription' type.
-func (d subscriptionListData) unwrap() (list *SubscriptionList, err error) {
-	if d == nil {
+// writeSubscriptionList writes a list of values of the 'subscription' type to the given
+// stream, as a JSON array.
+func writeSubscriptionList(list *SubscriptionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Subscription, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeSubscription(item, stream)
 	}
-	list = new(SubscriptionList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// subscriptionListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'subscription'.
-type subscriptionListLinkData struct {
-	Kind  *string             "json:\"kind,omitempty\""
-	HREF  *string             "json:\"href,omitempty\""
-	Items []*subscriptionData "json:\"items,omitempty\""
+// readSubscriptionList reads a list of values of the 'subscription' type from the given
+// iterator, as a JSON array.
+func readSubscriptionList(iterator *helpers.Iterator) *SubscriptionList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(SubscriptionList)
+	list.items = []*Subscription{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readSubscription(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'subscription' value to a link.
-func (l *SubscriptionList) wrapLink() (data *subscriptionListLinkData, err error) {
-	if l == nil {
+// writeSubscriptionListLink writes a list of values of the 'subscription' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeSubscriptionListLink(list *SubscriptionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*subscriptionData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(subscriptionListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = SubscriptionListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(SubscriptionListLinkKind)
 	} else {
-		*data.Kind = SubscriptionListKind
+		stream.WriteString(SubscriptionListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeSubscriptionList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'subscription' type to a list.
-func (d *subscriptionListLinkData) unwrapLink() (list *SubscriptionList, err error) {
-	if d == nil {
-		return
+// readSubscriptionListLink reads a list of values of the 'subscription' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readSubscriptionListLink(iterator *helpers.Iterator) *SubscriptionList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Subscription, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(SubscriptionList)
+	list.items = []*Subscription{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(SubscriptionList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case SubscriptionListKind:
-			list.link = false
-		case SubscriptionListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(SubscriptionListKind, SubscriptionListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readSubscriptionList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				SubscriptionListKind,
-				SubscriptionListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
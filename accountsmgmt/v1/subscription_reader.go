@@ -20,131 +20,193 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
-	"fmt"
-	time "time"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// subscriptionData is the data structure used internally to marshal and unmarshal
-// objects of type 'subscription'.
-type subscriptionData struct {
-	Kind               *string                 "json:\"kind,omitempty\""
-	ID                 *string                 "json:\"id,omitempty\""
-	HREF               *string                 "json:\"href,omitempty\""
-	Plan               *planData               "json:\"plan,omitempty\""
-	RegistryCredential *registryCredentialData "json:\"registry_credential,omitempty\""
-	ClusterID          *string                 "json:\"cluster_id,omitempty\""
-	ExternalClusterID  *string                 "json:\"external_cluster_id,omitempty\""
-	OrganizationID     *string                 "json:\"organization_id,omitempty\""
-	LastTelemetryDate  *time.Time              "json:\"last_telemetry_date,omitempty\""
-	Creator            *accountData            "json:\"creator,omitempty\""
-}
-
 // MarshalSubscription writes a value of the 'subscription' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalSubscription(object *Subscription, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeSubscription(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'subscription'
-// value to a JSON document.
-func (o *Subscription) wrap() (data *subscriptionData, err error) {
-	if o == nil {
+// writeSubscription writes a value of the 'subscription' type to the given stream.
+func writeSubscription(object *Subscription, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(subscriptionData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = SubscriptionLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(SubscriptionLinkKind)
 	} else {
-		*data.Kind = SubscriptionKind
+		stream.WriteString(SubscriptionKind)
 	}
-	data.Plan, err = o.plan.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.RegistryCredential, err = o.registryCredential.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	data.ClusterID = o.clusterID
-	data.ExternalClusterID = o.externalClusterID
-	data.OrganizationID = o.organizationID
-	data.LastTelemetryDate = o.lastTelemetryDate
-	data.Creator, err = o.creator.wrap()
-	if err != nil {
-		return
+	if object.plan != nil {
+		stream.WriteObjectField("plan")
+		writePlan(object.plan, stream)
 	}
-	return
+	if object.registryCredential != nil {
+		stream.WriteObjectField("registry_credential")
+		writeRegistryCredential(object.registryCredential, stream)
+	}
+	if object.clusterID != nil {
+		stream.WriteObjectField("cluster_id")
+		stream.WriteString(*object.clusterID)
+	}
+	if object.externalClusterID != nil {
+		stream.WriteObjectField("external_cluster_id")
+		stream.WriteString(*object.externalClusterID)
+	}
+	if object.organizationID != nil {
+		stream.WriteObjectField("organization_id")
+		stream.WriteString(*object.organizationID)
+	}
+	if object.lastTelemetryDate != nil {
+		stream.WriteObjectField("last_telemetry_date")
+		stream.WriteTime(*object.lastTelemetryDate)
+	}
+	if object.creator != nil {
+		stream.WriteObjectField("creator")
+		writeAccount(object.creator, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalSubscription reads a value of the 'subscription' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalSubscription(source interface{}) (object *Subscription, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalSubscription(source interface{}, options ...helpers.DecodingOptions) (object *Subscription, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(subscriptionData)
-	err = decoder.Decode(data)
+	object = readSubscription(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Subscription)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'subscription' type.
-func (d *subscriptionData) unwrap() (object *Subscription, err error) {
-	if d == nil {
-		return
+// readSubscription reads a value of the 'subscription' type from the given iterator.
+func readSubscription(iterator *helpers.Iterator) *Subscription {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Subscription)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case SubscriptionKind:
-			object.link = false
-		case SubscriptionLinkKind:
-			object.link = true
+	object := new(Subscription)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(SubscriptionKind, SubscriptionLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "plan":
+			object.plan = readPlan(iterator)
+		case "registry_credential":
+			object.registryCredential = readRegistryCredential(iterator)
+		case "cluster_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clusterID = &value
+			}
+		case "external_cluster_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.externalClusterID = &value
+			}
+		case "organization_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.organizationID = &value
+			}
+		case "last_telemetry_date":
+			if !iterator.ReadNull() {
+				value := iterator.ReadTime()
+				object.lastTelemetryDate = &value
+			}
+		case "creator":
+			object.creator = readAccount(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				SubscriptionKind,
-				SubscriptionLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.plan, err = d.Plan.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalSubscription function.
+func (o *Subscription) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSubscription(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalSubscription function, using the
+// default decoding options.
+func (o *Subscription) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalSubscription(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.registryCredential, err = d.RegistryCredential.unwrap()
+	if object == nil {
+		*o = Subscription{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Subscription) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.clusterID = d.ClusterID
-	object.externalClusterID = d.ExternalClusterID
-	object.organizationID = d.OrganizationID
-	object.lastTelemetryDate = d.LastTelemetryDate
-	object.creator, err = d.Creator.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Subscription) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // SubscriptionServer represents the interface the manages the 'subscription' resource.
@@ -75,14 +77,12 @@ func (r *SubscriptionGetServerResponse) SetStatusCode(status int) *SubscriptionG
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *SubscriptionGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeSubscription(r.body, stream)
+	return stream.Flush()
 }
 
 // SubscriptionDeleteServerRequest is the request for the 'delete' method.
@@ -103,57 +103,98 @@ func (r *SubscriptionDeleteServerResponse) SetStatusCode(status int) *Subscripti
 	return r
 }
 
-// SubscriptionServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// SubscriptionServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type SubscriptionServerAdapter struct {
 	server SubscriptionServer
 	router *mux.Router
+	prefix string
 }
 
+// NewSubscriptionServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewSubscriptionServerAdapter(server SubscriptionServer, router *mux.Router) *SubscriptionServerAdapter {
 	adapter := new(SubscriptionServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *SubscriptionServerAdapter) readSubscriptionGetServerRequest(r *http.Request) (*SubscriptionGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *SubscriptionServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchSubscription(w, r, a.server, segments)
+}
+
+// dispatchSubscription navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchSubscription(w http.ResponseWriter, r *http.Request, server SubscriptionServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptSubscriptionGetRequest(w, r, server)
+		case http.MethodDelete:
+			adaptSubscriptionDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readSubscriptionGetServerRequest(r *http.Request) (*SubscriptionGetServerRequest, error) {
 	result := new(SubscriptionGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *SubscriptionServerAdapter) writeSubscriptionGetServerResponse(w http.ResponseWriter, r *SubscriptionGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeSubscriptionGetServerResponse(w http.ResponseWriter, r *SubscriptionGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *SubscriptionServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readSubscriptionGetServerRequest(r)
+func adaptSubscriptionGetRequest(w http.ResponseWriter, r *http.Request, server SubscriptionServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readSubscriptionGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(SubscriptionGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeSubscriptionGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeSubscriptionGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -163,38 +204,38 @@ func (a *SubscriptionServerAdapter) getHandler(w http.ResponseWriter, r *http.Re
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *SubscriptionServerAdapter) readSubscriptionDeleteServerRequest(r *http.Request) (*SubscriptionDeleteServerRequest, error) {
+func readSubscriptionDeleteServerRequest(r *http.Request) (*SubscriptionDeleteServerRequest, error) {
 	result := new(SubscriptionDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *SubscriptionServerAdapter) writeSubscriptionDeleteServerResponse(w http.ResponseWriter, r *SubscriptionDeleteServerResponse) error {
+func writeSubscriptionDeleteServerResponse(w http.ResponseWriter, r *SubscriptionDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *SubscriptionServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readSubscriptionDeleteServerRequest(r)
+func adaptSubscriptionDeleteRequest(w http.ResponseWriter, r *http.Request, server SubscriptionServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readSubscriptionDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(SubscriptionDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeSubscriptionDeleteServerResponse(w, resp)
+	err = writeSubscriptionDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -20,7 +20,12 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // SubscriptionKind is the name of the type used to represent objects
@@ -49,6 +54,7 @@ type Subscription struct {
 	organizationID     *string
 	lastTelemetryDate  *time.Time
 	creator            *Account
+	extra              map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -103,6 +109,27 @@ func (o *Subscription) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete subscription that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Subscription) Resolve(ctx context.Context, transport http.RoundTripper) (result *Subscription, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to subscription '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewSubscriptionClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Subscription) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -116,6 +143,59 @@ func (o *Subscription) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'subscription' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Subscription) Metadata() *helpers.TypeMetadata {
+	return subscriptionMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Subscription) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSubscription(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Subscription) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSubscription(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Subscription) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSubscription(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Subscription) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSubscription(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'subscription' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Subscription) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Plan returns the value of the 'plan' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -289,6 +369,279 @@ const SubscriptionListLinkKind = "SubscriptionListLink"
 // objects of type 'subscription'.
 const SubscriptionListNilKind = "SubscriptionListNil"
 
+// Copy returns a deep copy of the 'subscription' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Subscription) Copy() *Subscription {
+	if o == nil {
+		return nil
+	}
+	result := new(Subscription)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	result.plan = o.plan.Copy()
+	result.registryCredential = o.registryCredential.Copy()
+	if o.clusterID != nil {
+		result.clusterID = new(string)
+		*result.clusterID = *o.clusterID
+	}
+	if o.externalClusterID != nil {
+		result.externalClusterID = new(string)
+		*result.externalClusterID = *o.externalClusterID
+	}
+	if o.organizationID != nil {
+		result.organizationID = new(string)
+		*result.organizationID = *o.organizationID
+	}
+	if o.lastTelemetryDate != nil {
+		result.lastTelemetryDate = new(time.Time)
+		*result.lastTelemetryDate = *o.lastTelemetryDate
+	}
+	result.creator = o.creator.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'subscription' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Subscription) Equal(other *Subscription) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if !o.plan.Equal(other.plan) {
+		return false
+	}
+	if !o.registryCredential.Equal(other.registryCredential) {
+		return false
+	}
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		return false
+	}
+	if (o.externalClusterID == nil) != (other.externalClusterID == nil) ||
+		(o.externalClusterID != nil && *o.externalClusterID != *other.externalClusterID) {
+		return false
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		return false
+	}
+	if (o.lastTelemetryDate == nil) != (other.lastTelemetryDate == nil) ||
+		(o.lastTelemetryDate != nil && !o.lastTelemetryDate.Equal(*other.lastTelemetryDate)) {
+		return false
+	}
+	if !o.creator.Equal(other.creator) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'subscription' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Subscription) Diff(other *Subscription) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'subscription' object and the given one, using the given path as prefix.
+func (o *Subscription) diff(path string, other *Subscription, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	result = o.plan.diff(helpers.Path(path, "plan"), other.plan, result)
+	result = o.registryCredential.diff(helpers.Path(path, "registry_credential"), other.registryCredential, result)
+	if (o.clusterID == nil) != (other.clusterID == nil) ||
+		(o.clusterID != nil && *o.clusterID != *other.clusterID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "cluster_id"),
+			Old:  helpers.Value(o.clusterID),
+			New:  helpers.Value(other.clusterID),
+		})
+	}
+	if (o.externalClusterID == nil) != (other.externalClusterID == nil) ||
+		(o.externalClusterID != nil && *o.externalClusterID != *other.externalClusterID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "external_cluster_id"),
+			Old:  helpers.Value(o.externalClusterID),
+			New:  helpers.Value(other.externalClusterID),
+		})
+	}
+	if (o.organizationID == nil) != (other.organizationID == nil) ||
+		(o.organizationID != nil && *o.organizationID != *other.organizationID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "organization_id"),
+			Old:  helpers.Value(o.organizationID),
+			New:  helpers.Value(other.organizationID),
+		})
+	}
+	if (o.lastTelemetryDate == nil) != (other.lastTelemetryDate == nil) ||
+		(o.lastTelemetryDate != nil && !o.lastTelemetryDate.Equal(*other.lastTelemetryDate)) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "last_telemetry_date"),
+			Old:  helpers.Value(o.lastTelemetryDate),
+			New:  helpers.Value(other.lastTelemetryDate),
+		})
+	}
+	result = o.creator.diff(helpers.Path(path, "creator"), other.creator, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'subscription' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Subscription) Changes(modified *Subscription) *Subscription {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Subscription)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.plan != nil && !o.plan.Equal(modified.plan) {
+		result.plan = o.plan.Changes(modified.plan)
+	}
+	if modified.registryCredential != nil && !o.registryCredential.Equal(modified.registryCredential) {
+		result.registryCredential = o.registryCredential.Changes(modified.registryCredential)
+	}
+	if modified.clusterID != nil && (o.clusterID == nil || *o.clusterID != *modified.clusterID) {
+		result.clusterID = new(string)
+		*result.clusterID = *modified.clusterID
+	}
+	if modified.externalClusterID != nil && (o.externalClusterID == nil || *o.externalClusterID != *modified.externalClusterID) {
+		result.externalClusterID = new(string)
+		*result.externalClusterID = *modified.externalClusterID
+	}
+	if modified.organizationID != nil && (o.organizationID == nil || *o.organizationID != *modified.organizationID) {
+		result.organizationID = new(string)
+		*result.organizationID = *modified.organizationID
+	}
+	if modified.lastTelemetryDate != nil && (o.lastTelemetryDate == nil || !o.lastTelemetryDate.Equal(*modified.lastTelemetryDate)) {
+		result.lastTelemetryDate = new(time.Time)
+		*result.lastTelemetryDate = *modified.lastTelemetryDate
+	}
+	if modified.creator != nil && !o.creator.Equal(modified.creator) {
+		result.creator = o.creator.Changes(modified.creator)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printSubscription prints a value of the 'subscription' type to the given printer.
+func printSubscription(object *Subscription, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Subscription")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.plan != nil {
+		printer.PrintObjectField("Plan")
+		printPlan(object.plan, printer)
+	}
+	if object.registryCredential != nil {
+		printer.PrintObjectField("RegistryCredential")
+		printRegistryCredential(object.registryCredential, printer)
+	}
+	if object.clusterID != nil {
+		printer.PrintObjectField("ClusterID")
+		printer.PrintString(*object.clusterID)
+	}
+	if object.externalClusterID != nil {
+		printer.PrintObjectField("ExternalClusterID")
+		printer.PrintString(*object.externalClusterID)
+	}
+	if object.organizationID != nil {
+		printer.PrintObjectField("OrganizationID")
+		printer.PrintString(*object.organizationID)
+	}
+	if object.lastTelemetryDate != nil {
+		printer.PrintObjectField("LastTelemetryDate")
+		printer.PrintTime(*object.lastTelemetryDate)
+	}
+	if object.creator != nil {
+		printer.PrintObjectField("Creator")
+		printAccount(object.creator, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // SubscriptionList is a list of values of the 'subscription' type.
 type SubscriptionList struct {
 	href  *string
@@ -396,3 +749,146 @@ func (l *SubscriptionList) Range(f func(index int, item *Subscription) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *SubscriptionList) Copy() *SubscriptionList {
+	if l == nil {
+		return nil
+	}
+	result := new(SubscriptionList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Subscription, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *SubscriptionList) Equal(other *SubscriptionList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *SubscriptionList) Diff(other *SubscriptionList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *SubscriptionList) diff(path string, other *SubscriptionList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *SubscriptionList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSubscriptionListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *SubscriptionList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSubscriptionListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *SubscriptionList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSubscriptionListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *SubscriptionList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSubscriptionListLink(l, printer)
+	}
+}
+
+// printSubscriptionList prints a list of values of the 'subscription' type to the given printer.
+func printSubscriptionList(list *SubscriptionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printSubscription(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printSubscriptionListLink prints a list of values of the 'subscription' type to the
+// given printer, including the link to the list.
+func printSubscriptionListLink(list *SubscriptionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.SubscriptionList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printSubscriptionList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
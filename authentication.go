@@ -0,0 +1,539 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the middleware that authenticates and authorizes the
+// requests received by the server.
+
+package sdk
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+)
+
+// Authorizer is the type of the functions that decide if a request is allowed. The context will
+// contain the authenticated token, which can be obtained using the TokenFromContext function. The
+// function should return nil if the request is allowed. If it returns an *errors.Error it will be
+// sent to the client, so it can be used to send 401 or 403 errors with specific details. Any other
+// error will be sent to the client as a 403 error.
+type Authorizer func(ctx context.Context, method, path string) error
+
+// AuthenticationBuilder contains the configuration and logic needed to create the middleware that
+// authenticates requests using JSON web tokens sent in the `Authorization` header. Don't create
+// instances of this type directly, use the NewAuthenticationBuilder function instead.
+type AuthenticationBuilder struct {
+	logger     Logger
+	keys       []interface{}
+	keysURL    string
+	keysClient *http.Client
+	public     []string
+	authorizer Authorizer
+}
+
+// authenticationHandler is the HTTP handler that implements the authentication middleware.
+type authenticationHandler struct {
+	logger     Logger
+	next       http.Handler
+	keys       []interface{}
+	keysURL    string
+	keysClient *http.Client
+	public     []*regexp.Regexp
+	authorizer Authorizer
+	parser     *jwt.Parser
+
+	// The following fields contain the keys loaded from the JSON web key set URL and the
+	// state of the loading process. They are protected by the mutex.
+	keysMutex    *sync.Mutex
+	keysByID     map[string]interface{}
+	keysLoaded   time.Time
+	keysFailures int
+	keysLoading  chan struct{}
+}
+
+// Minimum time between two consecutive attempts to load the keys from the JSON web key set URL.
+// After failed attempts the time is doubled for each consecutive failure, up to the maximum.
+const (
+	authenticationKeysRefreshInterval  = time.Minute
+	authenticationKeysMaxRetryInterval = 16 * time.Minute
+)
+
+// Maximum time to wait for the JSON web key set URL to respond:
+const authenticationKeysTimeout = 30 * time.Second
+
+// authenticationMethods contains the signing methods that are accepted. Tokens that declare any
+// other method, in particular `none` or the symmetric HMAC methods, are rejected without trying to
+// verify them.
+var authenticationMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+}
+
+// authenticationContextKey is the type of the key used to store the token in the request context.
+type authenticationContextKey int
+
+// authenticationTokenKey is the key used to store the token in the request context.
+const authenticationTokenKey authenticationContextKey = 0
+
+// NewAuthenticationBuilder creates a builder that knows how to create authentication middleware.
+func NewAuthenticationBuilder() *AuthenticationBuilder {
+	return new(AuthenticationBuilder)
+}
+
+// Logger sets the logger that will be used by the middleware. By default it uses the Go `log`
+// package, with the debug level disabled and the rest enabled.
+func (b *AuthenticationBuilder) Logger(logger Logger) *AuthenticationBuilder {
+	b.logger = logger
+	return b
+}
+
+// Keys adds keys that will be used to verify the signatures of the tokens. The values can be
+// *rsa.PublicKey or *ecdsa.PublicKey objects.
+func (b *AuthenticationBuilder) Keys(values ...interface{}) *AuthenticationBuilder {
+	b.keys = append(b.keys, values...)
+	return b
+}
+
+// KeysURL sets the URL of a JSON web key set that contains the keys that will be used to verify the
+// signatures of the tokens, for example the `certs` endpoint of an OpenID server. The keys will be
+// loaded when the first request is received, and reloaded when a token signed with an unknown key
+// identifier is received.
+func (b *AuthenticationBuilder) KeysURL(value string) *AuthenticationBuilder {
+	b.keysURL = value
+	return b
+}
+
+// KeysClient sets the HTTP client that will be used to load the JSON web key set. The default is
+// to use the default HTTP client of the `net/http` package.
+func (b *AuthenticationBuilder) KeysClient(value *http.Client) *AuthenticationBuilder {
+	b.keysClient = value
+	return b
+}
+
+// Public adds regular expressions for paths that don't require authentication. For example, to
+// allow unauthenticated access to the metadata of the services:
+//
+//	builder.Public(`^/api/[^/]+(/v1)?$`)
+func (b *AuthenticationBuilder) Public(values ...string) *AuthenticationBuilder {
+	b.public = append(b.public, values...)
+	return b
+}
+
+// Authorizer sets the function that will be called to check if authenticated requests are allowed.
+// By default all authenticated requests are allowed.
+func (b *AuthenticationBuilder) Authorizer(value Authorizer) *AuthenticationBuilder {
+	b.authorizer = value
+	return b
+}
+
+// Build uses the configuration stored in the builder to create the authentication middleware.
+func (b *AuthenticationBuilder) Build() (result Middleware, err error) {
+	// Check that we have some source of keys:
+	if len(b.keys) == 0 && b.keysURL == "" {
+		err = fmt.Errorf("either keys or a keys URL are necessary, but none has been provided")
+		return
+	}
+	for i, key := range b.keys {
+		switch key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+		default:
+			err = fmt.Errorf("key %d is of unsupported type '%T'", i, key)
+			return
+		}
+	}
+
+	// Compile the regular expressions for the public paths:
+	public := make([]*regexp.Regexp, len(b.public))
+	for i, expr := range b.public {
+		public[i], err = regexp.Compile(expr)
+		if err != nil {
+			err = fmt.Errorf("can't compile public path regular expression '%s': %v", expr, err)
+			return
+		}
+	}
+
+	// Create the default logger, if needed:
+	logger := b.logger
+	if logger == nil {
+		logger, err = NewGoLoggerBuilder().
+			Debug(false).
+			Info(true).
+			Warn(true).
+			Error(true).
+			Build()
+		if err != nil {
+			err = fmt.Errorf("can't create default logger: %v", err)
+			return
+		}
+	}
+
+	// Set the default keys client, if needed:
+	keysClient := b.keysClient
+	if keysClient == nil {
+		keysClient = http.DefaultClient
+	}
+
+	// Copy the keys, so that the builder can be reused:
+	keys := make([]interface{}, len(b.keys))
+	copy(keys, b.keys)
+
+	keysURL := b.keysURL
+	authorizer := b.authorizer
+	result = func(next http.Handler) http.Handler {
+		return &authenticationHandler{
+			logger:     logger,
+			next:       next,
+			keys:       keys,
+			keysURL:    keysURL,
+			keysClient: keysClient,
+			keysMutex:  &sync.Mutex{},
+			public:     public,
+			authorizer: authorizer,
+			parser: &jwt.Parser{
+				ValidMethods: authenticationMethods,
+			},
+		}
+	}
+	return
+}
+
+// TokenFromContext returns the token that was used to authenticate the request, or nil if the
+// request wasn't authenticated.
+func TokenFromContext(ctx context.Context) *jwt.Token {
+	token, _ := ctx.Value(authenticationTokenKey).(*jwt.Token)
+	return token
+}
+
+// ClaimsFromContext returns the claims of the token that was used to authenticate the request, or
+// nil if the request wasn't authenticated.
+func ClaimsFromContext(ctx context.Context) jwt.MapClaims {
+	token := TokenFromContext(ctx)
+	if token == nil {
+		return nil
+	}
+	claims, _ := token.Claims.(jwt.MapClaims)
+	return claims
+}
+
+// ServeHTTP is the implementation of the http.Handler interface.
+func (h *authenticationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Skip authentication for public paths:
+	for _, public := range h.public {
+		if public.MatchString(r.URL.Path) {
+			h.next.ServeHTTP(w, r)
+			return
+		}
+	}
+
+	// Extract the bearer token from the authorization header:
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		h.sendError(w, r, http.StatusUnauthorized, "Request doesn't contain the 'Authorization' header")
+		return
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "Bearer") {
+		h.sendError(w, r, http.StatusUnauthorized, "Authorization header doesn't contain a bearer token")
+		return
+	}
+
+	// Parse and verify the token:
+	token, err := h.parseToken(ctx, fields[1])
+	if err != nil {
+		h.logger.Debug(ctx, "Bearer token for path '%s' isn't valid: %v", r.URL.Path, err)
+		h.sendError(w, r, http.StatusUnauthorized, "Bearer token isn't valid")
+		return
+	}
+	ctx = context.WithValue(ctx, authenticationTokenKey, token)
+	r = r.WithContext(ctx)
+
+	// Check the authorization:
+	if h.authorizer != nil {
+		err = h.authorizer(ctx, r.Method, r.URL.Path)
+		if err != nil {
+			body, ok := err.(*errors.Error)
+			if !ok || body == nil {
+				body, _ = errors.NewError().
+					ID("403").
+					Status(http.StatusForbidden).
+					Reason(err.Error()).
+					Build()
+			}
+			errors.SendError(w, r, body)
+			return
+		}
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// parseToken parses and verifies the given token text, and checks that it contains the expiration
+// claim.
+func (h *authenticationHandler) parseToken(ctx context.Context, text string) (token *jwt.Token,
+	err error) {
+	// Find the key identifier, if any:
+	unverified, _, err := h.parser.ParseUnverified(text, jwt.MapClaims{})
+	if err != nil {
+		return
+	}
+	kid, _ := unverified.Header["kid"].(string)
+
+	// Try the candidate keys:
+	candidates, err := h.candidateKeys(ctx, kid)
+	if err != nil {
+		return
+	}
+	if len(candidates) == 0 {
+		err = fmt.Errorf("there is no key to verify the token")
+		return
+	}
+	for _, candidate := range candidates {
+		key := candidate
+		token, err = h.parser.ParseWithClaims(text, jwt.MapClaims{}, func(*jwt.Token) (interface{}, error) {
+			return key, nil
+		})
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	// Tokens that don't expire aren't accepted:
+	claims := token.Claims.(jwt.MapClaims)
+	if _, ok := claims["exp"]; !ok {
+		token = nil
+		err = fmt.Errorf("token doesn't contain the 'exp' claim")
+		return
+	}
+	return
+}
+
+// candidateKeys returns the keys that could have been used to sign a token with the given key
+// identifier. If the key identifier isn't known, or if the keys haven't been loaded yet, it loads
+// them from the JSON web key set URL, unless the last attempt was too recent. Only one load is
+// performed at a time: concurrent requests wait for it to finish instead of starting their own.
+func (h *authenticationHandler) candidateKeys(ctx context.Context, kid string) (keys []interface{},
+	err error) {
+	keys = append(keys, h.keys...)
+	if h.keysURL == "" {
+		return
+	}
+	h.keysMutex.Lock()
+	_, known := h.keysByID[kid]
+	if h.keysByID == nil || (kid != "" && !known) {
+		loading := h.keysLoading
+		if loading == nil && time.Since(h.keysLoaded) >= h.keysRetryInterval() {
+			loading = make(chan struct{})
+			h.keysLoading = loading
+			h.keysLoaded = time.Now()
+			go h.loadKeys(ctx, loading)
+		}
+		if loading != nil {
+			h.keysMutex.Unlock()
+			select {
+			case <-loading:
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+			h.keysMutex.Lock()
+		}
+	}
+	if kid != "" {
+		key, ok := h.keysByID[kid]
+		if ok {
+			keys = append(keys, key)
+		}
+	} else {
+		for _, key := range h.keysByID {
+			keys = append(keys, key)
+		}
+	}
+	h.keysMutex.Unlock()
+	return
+}
+
+// keysRetryInterval returns the minimum time that should pass since the last attempt to load the
+// keys before trying again. The mutex must be held when calling this method.
+func (h *authenticationHandler) keysRetryInterval() time.Duration {
+	interval := authenticationKeysRefreshInterval
+	for i := 1; i < h.keysFailures && interval < authenticationKeysMaxRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > authenticationKeysMaxRetryInterval {
+		interval = authenticationKeysMaxRetryInterval
+	}
+	return interval
+}
+
+// loadKeys loads the keys from the JSON web key set URL, updates the state of the handler, and then
+// closes the given channel to notify the requests that are waiting. It runs in its own goroutine
+// and doesn't hold the mutex while the keys are being loaded. The context is only used for logging,
+// so that the load isn't cancelled when the request that started it is cancelled.
+func (h *authenticationHandler) loadKeys(ctx context.Context, done chan struct{}) {
+	keys, err := h.fetchKeys(ctx)
+	h.keysMutex.Lock()
+	if err != nil {
+		h.keysFailures++
+		h.logger.Error(
+			ctx,
+			"Can't load keys from '%s', will retry in %s: %v",
+			h.keysURL, h.keysRetryInterval(), err,
+		)
+	} else {
+		h.keysByID = keys
+		h.keysFailures = 0
+		h.logger.Debug(ctx, "Loaded %d keys from '%s'", len(keys), h.keysURL)
+	}
+	h.keysLoading = nil
+	h.keysMutex.Unlock()
+	close(done)
+}
+
+// fetchKeys sends the request to the JSON web key set URL and parses the keys contained in the
+// response.
+func (h *authenticationHandler) fetchKeys(ctx context.Context) (keys map[string]interface{},
+	err error) {
+	timeout, cancel := context.WithTimeout(context.Background(), authenticationKeysTimeout)
+	defer cancel()
+	request, err := http.NewRequest(http.MethodGet, h.keysURL, nil)
+	if err != nil {
+		return
+	}
+	request = request.WithContext(timeout)
+	response, err := h.keysClient.Do(request)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		err = fmt.Errorf("response status code is %d", response.StatusCode)
+		return
+	}
+	var set struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	err = json.NewDecoder(response.Body).Decode(&set)
+	if err != nil {
+		err = fmt.Errorf("can't parse response: %v", err)
+		return
+	}
+	keys = make(map[string]interface{}, len(set.Keys))
+	for _, data := range set.Keys {
+		kid, _ := data["kid"].(string)
+		key, err := parseJSONWebKey(data)
+		if err != nil {
+			h.logger.Warn(ctx, "Ignoring key '%s' loaded from '%s': %v", kid, h.keysURL, err)
+			continue
+		}
+		keys[kid] = key
+	}
+	return
+}
+
+// parseJSONWebKey converts the given JSON web key into an RSA or ECDSA public key.
+func parseJSONWebKey(data map[string]interface{}) (key interface{}, err error) {
+	text := func(name string) string {
+		value, _ := data[name].(string)
+		return value
+	}
+	decode := func(name string) (*big.Int, error) {
+		bytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(text(name), "="))
+		if err != nil {
+			return nil, fmt.Errorf("can't decode parameter '%s': %v", name, err)
+		}
+		return new(big.Int).SetBytes(bytes), nil
+	}
+	switch text("kty") {
+	case "RSA":
+		var n, e *big.Int
+		n, err = decode("n")
+		if err != nil {
+			return
+		}
+		e, err = decode("e")
+		if err != nil {
+			return
+		}
+		key = &rsa.PublicKey{
+			N: n,
+			E: int(e.Int64()),
+		}
+	case "EC":
+		var curve elliptic.Curve
+		switch text("crv") {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			err = fmt.Errorf("curve '%s' isn't supported", text("crv"))
+			return
+		}
+		var x, y *big.Int
+		x, err = decode("x")
+		if err != nil {
+			return
+		}
+		y, err = decode("y")
+		if err != nil {
+			return
+		}
+		key = &ecdsa.PublicKey{
+			Curve: curve,
+			X:     x,
+			Y:     y,
+		}
+	default:
+		err = fmt.Errorf("key type '%s' isn't supported", text("kty"))
+	}
+	return
+}
+
+// sendError sends an authentication error with the given status and reason.
+func (h *authenticationHandler) sendError(w http.ResponseWriter, r *http.Request, status int,
+	reason string) {
+	body, _ := errors.NewError().
+		ID(fmt.Sprintf("%d", status)).
+		Status(status).
+		Reason(reason).
+		Build()
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	errors.SendError(w, r, body)
+}
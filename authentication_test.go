@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the authentication middleware.
+
+package sdk
+
+import (
+	"context"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+)
+
+var _ = Describe("Authentication", func() {
+	var logger Logger
+	var claims map[string]interface{}
+	var next http.Handler
+
+	BeforeEach(func() {
+		var err error
+		logger, err = NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Debug(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		claims = nil
+		next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims = ClaimsFromContext(r.Context())
+			w.WriteHeader(http.StatusOK)
+		})
+	})
+
+	// send sends a request with the given token to the given middleware and returns the
+	// recorded response.
+	send := func(handler http.Handler, method, path, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	Describe("With keys", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			middleware, err := NewAuthenticationBuilder().
+				Logger(logger).
+				Keys(jwtPublicKey).
+				Public(`^/api/[^/]+$`).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			handler = middleware(next)
+		})
+
+		It("Can't be created without keys", func() {
+			_, err := NewAuthenticationBuilder().Build()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Accepts valid token and puts claims in the context", func() {
+			token := DefaultToken("Bearer", 5*time.Minute)
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(claims).To(HaveKeyWithValue("typ", "Bearer"))
+		})
+
+		It("Rejects request without token", func() {
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", "")
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Header().Get("WWW-Authenticate")).To(Equal("Bearer"))
+			body, err := errors.UnmarshalError(recorder.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.ID()).To(Equal("401"))
+		})
+
+		It("Rejects expired token", func() {
+			token := DefaultToken("Bearer", -5*time.Minute)
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Rejects token without expiration", func() {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"typ": "Bearer",
+			}).SignedString(jwtPrivateKey)
+			Expect(err).ToNot(HaveOccurred())
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Rejects token signed with symmetric algorithm", func() {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"typ": "Bearer",
+				"exp": time.Now().Add(5 * time.Minute).Unix(),
+			}).SignedString([]byte("mysecret"))
+			Expect(err).ToNot(HaveOccurred())
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Rejects token without signature", func() {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+				"typ": "Bearer",
+				"exp": time.Now().Add(5 * time.Minute).Unix(),
+			}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			Expect(err).ToNot(HaveOccurred())
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Rejects malformed token", func() {
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", "junk")
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Doesn't require token for public paths", func() {
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt", "")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(claims).To(BeNil())
+		})
+	})
+
+	Describe("With authorizer", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			middleware, err := NewAuthenticationBuilder().
+				Logger(logger).
+				Keys(jwtPublicKey).
+				Authorizer(func(ctx context.Context, method, path string) error {
+					Expect(TokenFromContext(ctx)).ToNot(BeNil())
+					if method == http.MethodDelete {
+						body, err := errors.NewError().
+							ID("CLUSTERS-MGMT-403").
+							Status(http.StatusForbidden).
+							Reason("Deleting isn't allowed").
+							Build()
+						Expect(err).ToNot(HaveOccurred())
+						return body
+					}
+					return nil
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			handler = middleware(next)
+		})
+
+		It("Allows request accepted by the authorizer", func() {
+			token := DefaultToken("Bearer", 5*time.Minute)
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", token)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("Sends error returned by the authorizer", func() {
+			token := DefaultToken("Bearer", 5*time.Minute)
+			recorder := send(handler, http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123", token)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			body, err := errors.UnmarshalError(recorder.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body.ID()).To(Equal("CLUSTERS-MGMT-403"))
+			Expect(body.Reason()).To(Equal("Deleting isn't allowed"))
+		})
+	})
+
+	Describe("With keys URL", func() {
+		var keysServer *ghttp.Server
+
+		BeforeEach(func() {
+			encode := func(value *big.Int) string {
+				return base64.RawURLEncoding.EncodeToString(value.Bytes())
+			}
+			keysServer = ghttp.NewServer()
+			keysServer.AppendHandlers(
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"keys": []interface{}{
+						map[string]interface{}{
+							"kid": "123",
+							"kty": "RSA",
+							"alg": "RS256",
+							"n":   encode(jwtPublicKey.N),
+							"e":   encode(big.NewInt(int64(jwtPublicKey.E))),
+						},
+					},
+				}),
+			)
+		})
+
+		AfterEach(func() {
+			keysServer.Close()
+		})
+
+		It("Accepts token signed with loaded key", func() {
+			middleware, err := NewAuthenticationBuilder().
+				Logger(logger).
+				KeysURL(keysServer.URL()).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			handler := middleware(next)
+			token := DefaultToken("Bearer", 5*time.Minute)
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			recorder = send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(keysServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Doesn't retry immediately after failing to load keys", func() {
+			keysServer.SetHandler(0, ghttp.RespondWith(http.StatusInternalServerError, nil))
+			middleware, err := NewAuthenticationBuilder().
+				Logger(logger).
+				KeysURL(keysServer.URL()).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			handler := middleware(next)
+			token := DefaultToken("Bearer", 5*time.Minute)
+			recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			recorder = send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters", token)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(keysServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Loads keys only once for concurrent requests", func() {
+			respond := keysServer.GetHandler(0)
+			keysServer.SetHandler(0, func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(100 * time.Millisecond)
+				respond(w, r)
+			})
+			middleware, err := NewAuthenticationBuilder().
+				Logger(logger).
+				KeysURL(keysServer.URL()).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			token := DefaultToken("Bearer", 5*time.Minute)
+			codes := make([]int, 10)
+			var group sync.WaitGroup
+			for i := range codes {
+				group.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer group.Done()
+					path := "/api/clusters_mgmt/v1/clusters"
+					codes[i] = send(handler, http.MethodGet, path, token).Code
+				}(i)
+			}
+			group.Wait()
+			for _, code := range codes {
+				Expect(code).To(Equal(http.StatusOK))
+			}
+			Expect(keysServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
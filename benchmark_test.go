@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains benchmarks for the JSON encoding and decoding of the model types. Run them
+// with a command like this:
+//
+//	go test -run NONE -bench . -benchmem
+
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+// benchmarkClusterJSON is the template used to generate the clusters used by the benchmarks. It
+// is similar to what the server returns.
+const benchmarkClusterJSON = `{
+	"kind": "Cluster",
+	"id": "%[1]d",
+	"href": "/api/clusters_mgmt/v1/clusters/%[1]d",
+	"name": "mycluster-%[1]d",
+	"flavour": {
+		"kind": "FlavourLink",
+		"id": "osd-4",
+		"href": "/api/clusters_mgmt/v1/flavours/osd-4"
+	},
+	"console": {
+		"url": "https://console-openshift-console.apps.mycluster-%[1]d.example.com"
+	},
+	"multi_az": true,
+	"nodes": {
+		"master": 3,
+		"infra": 3,
+		"compute": 9
+	},
+	"api": {
+		"url": "https://api.mycluster-%[1]d.example.com:6443"
+	},
+	"region": {
+		"kind": "CloudRegionLink",
+		"id": "us-east-1",
+		"href": "/api/clusters_mgmt/v1/cloud_providers/aws/regions/us-east-1"
+	},
+	"display_name": "My cluster %[1]d",
+	"dns": {
+		"base_domain": "example.com"
+	},
+	"properties": {
+		"owner": "me",
+		"purpose": "benchmark"
+	},
+	"state": "ready",
+	"managed": true,
+	"external_id": "c6c2c3a0-7f3e-4a0b-9a6e-%012[1]d",
+	"network": {
+		"machine_cidr": "10.0.0.0/16",
+		"service_cidr": "172.30.0.0/16",
+		"pod_cidr": "10.128.0.0/14"
+	},
+	"creation_timestamp": "2019-07-01T10:20:30.123456Z",
+	"expiration_timestamp": "2019-08-01T10:20:30Z",
+	"cloud_provider": {
+		"kind": "CloudProviderLink",
+		"id": "aws",
+		"href": "/api/clusters_mgmt/v1/cloud_providers/aws"
+	},
+	"openshift_version": "4.1.4",
+	"subscription": {
+		"kind": "SubscriptionLink",
+		"id": "%[1]d",
+		"href": "/api/accounts_mgmt/v1/subscriptions/%[1]d"
+	},
+	"groups": {
+		"kind": "GroupListLink",
+		"href": "/api/clusters_mgmt/v1/clusters/%[1]d/groups"
+	},
+	"creator": "me@example.com",
+	"version": {
+		"kind": "VersionLink",
+		"id": "openshift-v4.1.4",
+		"href": "/api/clusters_mgmt/v1/versions/openshift-v4.1.4"
+	},
+	"identity_providers": {
+		"kind": "IdentityProviderListLink",
+		"href": "/api/clusters_mgmt/v1/clusters/%[1]d/identity_providers"
+	},
+	"metrics": {
+		"memory": {
+			"updated_timestamp": "2019-07-01T10:20:30Z",
+			"used": {
+				"value": 1.5e+10,
+				"unit": "B"
+			},
+			"total": {
+				"value": 6.4e+10,
+				"unit": "B"
+			}
+		},
+		"cpu": {
+			"updated_timestamp": "2019-07-01T10:20:30Z",
+			"used": {
+				"value": 2.25,
+				"unit": "cores"
+			},
+			"total": {
+				"value": 16,
+				"unit": "cores"
+			}
+		}
+	}
+}`
+
+// benchmarkClusterList generates a JSON array containing the given number of clusters.
+func benchmarkClusterList(size int) []byte {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString("[")
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		fmt.Fprintf(buffer, benchmarkClusterJSON, i)
+	}
+	buffer.WriteString("]")
+	return buffer.Bytes()
+}
+
+func BenchmarkUnmarshalCluster(b *testing.B) {
+	data := []byte(fmt.Sprintf(benchmarkClusterJSON, 0))
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := cmv1.UnmarshalCluster(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalClusterList(b *testing.B) {
+	data := benchmarkClusterList(100)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := cmv1.UnmarshalClusterList(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalCluster(b *testing.B) {
+	cluster, err := cmv1.UnmarshalCluster(fmt.Sprintf(benchmarkClusterJSON, 0))
+	if err != nil {
+		b.Fatal(err)
+	}
+	buffer := new(bytes.Buffer)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buffer.Reset()
+		err = cmv1.MarshalCluster(cluster, buffer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalClusterList(b *testing.B) {
+	list, err := cmv1.UnmarshalClusterList(benchmarkClusterList(100))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err = list.MarshalJSON()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the builders populated from existing objects.
+
+package sdk
+
+import (
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Builder", func() {
+	var cluster *cmv1.Cluster
+
+	BeforeEach(func() {
+		var err error
+		cluster, err = cmv1.UnmarshalCluster(`{
+			"kind": "Cluster",
+			"id": "123",
+			"href": "/api/clusters_mgmt/v1/clusters/123",
+			"name": "mycluster",
+			"flavour": {
+				"kind": "FlavourLink",
+				"id": "4",
+				"href": "/api/clusters_mgmt/v1/flavours/4"
+			},
+			"region": {
+				"kind": "CloudRegionLink",
+				"id": "us-east-1"
+			},
+			"nodes": {
+				"compute": 3,
+				"master": 3
+			},
+			"aws": {
+				"access_key_id": "myid"
+			},
+			"properties": {
+				"owner": "me"
+			},
+			"groups": {
+				"kind": "GroupListLink",
+				"href": "/api/clusters_mgmt/v1/clusters/123/groups",
+				"items": [
+					{
+						"kind": "Group",
+						"id": "456"
+					}
+				]
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Builds an equal object", func() {
+		copy, err := cluster.Builder().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(copy).ToNot(BeIdenticalTo(cluster))
+		Expect(copy.Diff(cluster)).To(BeEmpty())
+	})
+
+	It("Builds a modified copy", func() {
+		builder := cluster.Builder()
+		builder.Nodes(cluster.Nodes().Builder().Compute(10))
+		builder.Properties(map[string]string{
+			"owner": "you",
+		})
+		modified, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modified.Diff(cluster)).To(ConsistOf(
+			helpers.Difference{
+				Path: "nodes.compute",
+				Old:  10,
+				New:  3,
+			},
+			helpers.Difference{
+				Path: "properties[owner]",
+				Old:  "you",
+				New:  "me",
+			},
+		))
+		Expect(cluster.Nodes().Compute()).To(Equal(3))
+		Expect(cluster.Properties()).To(HaveKeyWithValue("owner", "me"))
+	})
+
+	It("Returns nil for nil object", func() {
+		var nilCluster *cmv1.Cluster
+		Expect(nilCluster.Builder()).To(BeNil())
+	})
+
+	It("Builds an equal list", func() {
+		list, err := cmv1.UnmarshalClusterList(`[
+			{
+				"kind": "Cluster",
+				"id": "123"
+			},
+			{
+				"kind": "Cluster",
+				"id": "456"
+			}
+		]`)
+		Expect(err).ToNot(HaveOccurred())
+		copy, err := list.Builder().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(copy.Equal(list)).To(BeTrue())
+	})
+})
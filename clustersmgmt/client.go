@@ -20,6 +20,7 @@ limitations under the License.
 package clustersmgmt // github.com/openshift-online/uhc-sdk-go/clustersmgmt
 
 import (
+	"fmt"
 	"net/http"
 	"path"
 
@@ -51,3 +52,20 @@ func (c *Client) V1() *v1.RootClient {
 		path.Join(c.metric, "v1"),
 	)
 }
+
+// Route returns the client that corresponds to the given path segments,
+// relative to the root of the service, together with the identifiers extracted
+// from the path. The first segment is the version of the service.
+func (c *Client) Route(segments []string) (client interface{}, ids []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		return
+	}
+	switch segments[0] {
+	case "v1":
+		return c.V1().Route(segments[1:])
+	default:
+		err = fmt.Errorf("can't find version '%s' of service 'clusters_mgmt'", segments[0])
+		return
+	}
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AdminCredentialsBuilder contains the data and logic needed to build 'admin_credentials' objects.
 //
 // Temporary administrator credentials generated during the installation of the
@@ -26,6 +30,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 type AdminCredentialsBuilder struct {
 	user     *string
 	password *string
+	extra    map[string]interface{}
 }
 
 // NewAdminCredentials creates a new builder of 'admin_credentials' objects.
@@ -33,6 +38,20 @@ func NewAdminCredentials() *AdminCredentialsBuilder {
 	return new(AdminCredentialsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'admin_credentials' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *AdminCredentials) Builder() *AdminCredentialsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(AdminCredentialsBuilder)
+	b.user = o.user
+	b.password = o.password
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // User sets the value of the 'user' attribute
 // to the given value.
 //
@@ -51,9 +70,17 @@ func (b *AdminCredentialsBuilder) Password(value string) *AdminCredentialsBuilde
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'admin_credentials' type, and
+// that will be written as they are when the object is encoded.
+func (b *AdminCredentialsBuilder) Extra(value map[string]interface{}) *AdminCredentialsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'admin_credentials' object using the configuration stored in the builder.
 func (b *AdminCredentialsBuilder) Build() (object *AdminCredentials, err error) {
 	object = new(AdminCredentials)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.user != nil {
 		object.user = b.user
 	}
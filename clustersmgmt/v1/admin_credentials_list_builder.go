@@ -30,6 +30,19 @@ func NewAdminCredentialsList() *AdminCredentialsListBuilder {
 	return new(AdminCredentialsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *AdminCredentialsList) Builder() *AdminCredentialsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(AdminCredentialsListBuilder)
+	b.items = make([]*AdminCredentialsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *AdminCredentialsListBuilder) Items(values ...*AdminCredentialsBuilder) *AdminCredentialsListBuilder {
 	b.items = make([]*AdminCredentialsBuilder, len(values))
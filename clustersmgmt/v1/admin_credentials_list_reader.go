@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// adminCredentialsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'admin_credentials'.
-type adminCredentialsListData []*adminCredentialsData
-
 // UnmarshalAdminCredentialsList reads a list of values of the 'admin_credentials'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalAdminCredentialsList(source interface{}) (list *AdminCredentialsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalAdminCredentialsList(source interface{}, options ...helpers.DecodingOptions) (list *AdminCredentialsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data adminCredentialsListData
-	err = decoder.Decode(&data)
+	list = readAdminCredentialsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'admin_credentials' value to a JSON document.
-func (l *AdminCredentialsList) wrap() (data adminCredentialsListData, err error) {
-	if l == nil {
-		return
+// MarshalAdminCredentialsList writes a list of values of the 'admin_credentials' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalAdminCredentialsList(list *AdminCredentialsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(adminCredentialsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeAdminCredentialsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *AdminCredentialsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAdminCredentialsList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAdminCredentialsList function,
+// using the default decoding options.
+func (l *AdminCredentialsList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalAdminCredentialsList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = AdminCredentialsList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'admin_credentials' type.
-func (d adminCredentialsListData) unwrap() (list *AdminCredentialsList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *AdminCredentialsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *AdminCredentialsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeAdminCredentialsList writes a list of values of the 'admin_credentials' type to the given
+// stream, as a JSON array.
+func writeAdminCredentialsList(list *AdminCredentialsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*AdminCredentials, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeAdminCredentials(item, stream)
 	}
-	list = new(AdminCredentialsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readAdminCredentialsList reads a list of values of the 'admin_credentials' type from the given
+// iterator, as a JSON array.
+func readAdminCredentialsList(iterator *helpers.Iterator) *AdminCredentialsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(AdminCredentialsList)
+	list.items = []*AdminCredentials{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readAdminCredentials(iterator))
+	}
+	return list
 }
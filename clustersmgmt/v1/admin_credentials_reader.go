@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// adminCredentialsData is the data structure used internally to marshal and unmarshal
-// objects of type 'admin_credentials'.
-type adminCredentialsData struct {
-	User     *string "json:\"user,omitempty\""
-	Password *string "json:\"password,omitempty\""
-}
-
 // MarshalAdminCredentials writes a value of the 'admin_credentials' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalAdminCredentials(object *AdminCredentials, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeAdminCredentials(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'admin_credentials'
-// value to a JSON document.
-func (o *AdminCredentials) wrap() (data *adminCredentialsData, err error) {
-	if o == nil {
+// writeAdminCredentials writes a value of the 'admin_credentials' type to the given stream.
+func writeAdminCredentials(object *AdminCredentials, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(adminCredentialsData)
-	data.User = o.user
-	data.Password = o.password
-	return
+	stream.WriteObjectStart()
+	if object.user != nil {
+		stream.WriteObjectField("user")
+		stream.WriteString(*object.user)
+	}
+	if object.password != nil {
+		stream.WriteObjectField("password")
+		stream.WriteString(*object.password)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalAdminCredentials reads a value of the 'admin_credentials' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalAdminCredentials(source interface{}) (object *AdminCredentials, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalAdminCredentials(source interface{}, options ...helpers.DecodingOptions) (object *AdminCredentials, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(adminCredentialsData)
-	err = decoder.Decode(data)
+	object = readAdminCredentials(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(AdminCredentials)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'admin_credentials' type.
-func (d *adminCredentialsData) unwrap() (object *AdminCredentials, err error) {
-	if d == nil {
-		return
+// readAdminCredentials reads a value of the 'admin_credentials' type from the given iterator.
+func readAdminCredentials(iterator *helpers.Iterator) *AdminCredentials {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(AdminCredentials)
-	object.user = d.User
-	object.password = d.Password
-	return
+	object := new(AdminCredentials)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "user":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.user = &value
+			}
+		case "password":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.password = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalAdminCredentials function.
+func (o *AdminCredentials) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAdminCredentials(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAdminCredentials function, using the
+// default decoding options.
+func (o *AdminCredentials) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalAdminCredentials(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = AdminCredentials{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *AdminCredentials) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *AdminCredentials) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
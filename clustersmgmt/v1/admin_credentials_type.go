@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AdminCredentials represents the values of the 'admin_credentials' type.
 //
 // Temporary administrator credentials generated during the installation of the
@@ -26,6 +32,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 type AdminCredentials struct {
 	user     *string
 	password *string
+	extra    map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -35,6 +42,59 @@ func (o *AdminCredentials) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'admin_credentials' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *AdminCredentials) Metadata() *helpers.TypeMetadata {
+	return adminCredentialsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *AdminCredentials) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAdminCredentials(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *AdminCredentials) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAdminCredentials(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *AdminCredentials) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAdminCredentials(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *AdminCredentials) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAdminCredentials(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'admin_credentials' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *AdminCredentials) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // User returns the value of the 'user' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -81,6 +141,135 @@ func (o *AdminCredentials) GetPassword() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'admin_credentials' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *AdminCredentials) Copy() *AdminCredentials {
+	if o == nil {
+		return nil
+	}
+	result := new(AdminCredentials)
+	if o.user != nil {
+		result.user = new(string)
+		*result.user = *o.user
+	}
+	if o.password != nil {
+		result.password = new(string)
+		*result.password = *o.password
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'admin_credentials' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *AdminCredentials) Equal(other *AdminCredentials) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.user == nil) != (other.user == nil) ||
+		(o.user != nil && *o.user != *other.user) {
+		return false
+	}
+	if (o.password == nil) != (other.password == nil) ||
+		(o.password != nil && *o.password != *other.password) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'admin_credentials' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *AdminCredentials) Diff(other *AdminCredentials) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'admin_credentials' object and the given one, using the given path as prefix.
+func (o *AdminCredentials) diff(path string, other *AdminCredentials, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.user == nil) != (other.user == nil) ||
+		(o.user != nil && *o.user != *other.user) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "user"),
+			Old:  helpers.Value(o.user),
+			New:  helpers.Value(other.user),
+		})
+	}
+	if (o.password == nil) != (other.password == nil) ||
+		(o.password != nil && *o.password != *other.password) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "password"),
+			Old:  helpers.Value(o.password),
+			New:  helpers.Value(other.password),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'admin_credentials' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *AdminCredentials) Changes(modified *AdminCredentials) *AdminCredentials {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(AdminCredentials)
+	if modified.user != nil && (o.user == nil || *o.user != *modified.user) {
+		result.user = new(string)
+		*result.user = *modified.user
+	}
+	if modified.password != nil && (o.password == nil || *o.password != *modified.password) {
+		result.password = new(string)
+		*result.password = *modified.password
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printAdminCredentials prints a value of the 'admin_credentials' type to the given printer.
+func printAdminCredentials(object *AdminCredentials, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.AdminCredentials")
+	if object.user != nil {
+		printer.PrintObjectField("User")
+		printer.PrintString(*object.user)
+	}
+	if object.password != nil {
+		printer.PrintObjectField("Password")
+		printer.PrintSensitive(*object.password)
+	}
+	printer.PrintObjectEnd()
+}
+
 // AdminCredentialsList is a list of values of the 'admin_credentials' type.
 type AdminCredentialsList struct {
 	items []*AdminCredentials
@@ -152,3 +341,105 @@ func (l *AdminCredentialsList) Range(f func(index int, item *AdminCredentials) b
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *AdminCredentialsList) Copy() *AdminCredentialsList {
+	if l == nil {
+		return nil
+	}
+	result := new(AdminCredentialsList)
+	if l.items != nil {
+		result.items = make([]*AdminCredentials, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *AdminCredentialsList) Equal(other *AdminCredentialsList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *AdminCredentialsList) Diff(other *AdminCredentialsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *AdminCredentialsList) diff(path string, other *AdminCredentialsList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *AdminCredentialsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAdminCredentialsList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *AdminCredentialsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAdminCredentialsList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *AdminCredentialsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAdminCredentialsList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *AdminCredentialsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAdminCredentialsList(l, printer)
+	}
+}
+
+// printAdminCredentialsList prints a list of values of the 'admin_credentials' type to the given printer.
+func printAdminCredentialsList(list *AdminCredentialsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printAdminCredentials(item, printer)
+	}
+	printer.PrintListEnd()
+}
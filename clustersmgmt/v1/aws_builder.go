@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AWSBuilder contains the data and logic needed to build 'AWS' objects.
 //
 // _Amazon Web Services_ specific settings of a cluster.
 type AWSBuilder struct {
 	accessKeyID     *string
 	secretAccessKey *string
+	extra           map[string]interface{}
 }
 
 // NewAWS creates a new builder of 'AWS' objects.
@@ -32,6 +37,20 @@ func NewAWS() *AWSBuilder {
 	return new(AWSBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'AWS' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *AWS) Builder() *AWSBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(AWSBuilder)
+	b.accessKeyID = o.accessKeyID
+	b.secretAccessKey = o.secretAccessKey
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // AccessKeyID sets the value of the 'access_key_ID' attribute
 // to the given value.
 //
@@ -50,9 +69,17 @@ func (b *AWSBuilder) SecretAccessKey(value string) *AWSBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'AWS' type, and
+// that will be written as they are when the object is encoded.
+func (b *AWSBuilder) Extra(value map[string]interface{}) *AWSBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'AWS' object using the configuration stored in the builder.
 func (b *AWSBuilder) Build() (object *AWS, err error) {
 	object = new(AWS)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.accessKeyID != nil {
 		object.accessKeyID = b.accessKeyID
 	}
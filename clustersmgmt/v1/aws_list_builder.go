@@ -30,6 +30,19 @@ func NewAWSList() *AWSListBuilder {
 	return new(AWSListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *AWSList) Builder() *AWSListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(AWSListBuilder)
+	b.items = make([]*AWSBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *AWSListBuilder) Items(values ...*AWSBuilder) *AWSListBuilder {
 	b.items = make([]*AWSBuilder, len(values))
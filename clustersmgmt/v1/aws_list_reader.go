@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// awsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'AWS'.
-type awsListData []*awsData
-
 // UnmarshalAWSList reads a list of values of the 'AWS'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalAWSList(source interface{}) (list *AWSList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalAWSList(source interface{}, options ...helpers.DecodingOptions) (list *AWSList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data awsListData
-	err = decoder.Decode(&data)
+	list = readAWSList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'AWS' value to a JSON document.
-func (l *AWSList) wrap() (data awsListData, err error) {
-	if l == nil {
-		return
+// MarshalAWSList writes a list of values of the 'AWS' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalAWSList(list *AWSList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(awsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeAWSList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *AWSList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAWSList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAWSList function,
+// using the default decoding options.
+func (l *AWSList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalAWSList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = AWSList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'AWS' type.
-func (d awsListData) unwrap() (list *AWSList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *AWSList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *AWSList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeAWSList writes a list of values of the 'AWS' type to the given
+// stream, as a JSON array.
+func writeAWSList(list *AWSList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*AWS, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeAWS(item, stream)
 	}
-	list = new(AWSList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readAWSList reads a list of values of the 'AWS' type from the given
+// iterator, as a JSON array.
+func readAWSList(iterator *helpers.Iterator) *AWSList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(AWSList)
+	list.items = []*AWS{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readAWS(iterator))
+	}
+	return list
 }
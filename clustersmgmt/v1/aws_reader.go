@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// awsData is the data structure used internally to marshal and unmarshal
-// objects of type 'AWS'.
-type awsData struct {
-	AccessKeyID     *string "json:\"access_key_id,omitempty\""
-	SecretAccessKey *string "json:\"secret_access_key,omitempty\""
-}
-
 // MarshalAWS writes a value of the 'AWS' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalAWS(object *AWS, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeAWS(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'AWS'
-// value to a JSON document.
-func (o *AWS) wrap() (data *awsData, err error) {
-	if o == nil {
+// writeAWS writes a value of the 'AWS' type to the given stream.
+func writeAWS(object *AWS, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(awsData)
-	data.AccessKeyID = o.accessKeyID
-	data.SecretAccessKey = o.secretAccessKey
-	return
+	stream.WriteObjectStart()
+	if object.accessKeyID != nil {
+		stream.WriteObjectField("access_key_id")
+		stream.WriteString(*object.accessKeyID)
+	}
+	if object.secretAccessKey != nil {
+		stream.WriteObjectField("secret_access_key")
+		stream.WriteString(*object.secretAccessKey)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalAWS reads a value of the 'AWS' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalAWS(source interface{}) (object *AWS, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalAWS(source interface{}, options ...helpers.DecodingOptions) (object *AWS, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(awsData)
-	err = decoder.Decode(data)
+	object = readAWS(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(AWS)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'AWS' type.
-func (d *awsData) unwrap() (object *AWS, err error) {
-	if d == nil {
-		return
+// readAWS reads a value of the 'AWS' type from the given iterator.
+func readAWS(iterator *helpers.Iterator) *AWS {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(AWS)
-	object.accessKeyID = d.AccessKeyID
-	object.secretAccessKey = d.SecretAccessKey
-	return
+	object := new(AWS)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "access_key_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.accessKeyID = &value
+			}
+		case "secret_access_key":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.secretAccessKey = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalAWS function.
+func (o *AWS) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeAWS(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalAWS function, using the
+// default decoding options.
+func (o *AWS) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalAWS(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = AWS{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *AWS) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *AWS) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,12 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // AWS represents the values of the 'AWS' type.
 //
 // _Amazon Web Services_ specific settings of a cluster.
 type AWS struct {
 	accessKeyID     *string
 	secretAccessKey *string
+	extra           map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -34,6 +41,59 @@ func (o *AWS) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'AWS' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *AWS) Metadata() *helpers.TypeMetadata {
+	return awsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *AWS) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAWS(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *AWS) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAWS(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *AWS) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAWS(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *AWS) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAWS(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'AWS' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *AWS) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // AccessKeyID returns the value of the 'access_key_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -80,6 +140,135 @@ func (o *AWS) GetSecretAccessKey() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'AWS' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *AWS) Copy() *AWS {
+	if o == nil {
+		return nil
+	}
+	result := new(AWS)
+	if o.accessKeyID != nil {
+		result.accessKeyID = new(string)
+		*result.accessKeyID = *o.accessKeyID
+	}
+	if o.secretAccessKey != nil {
+		result.secretAccessKey = new(string)
+		*result.secretAccessKey = *o.secretAccessKey
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'AWS' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *AWS) Equal(other *AWS) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.accessKeyID == nil) != (other.accessKeyID == nil) ||
+		(o.accessKeyID != nil && *o.accessKeyID != *other.accessKeyID) {
+		return false
+	}
+	if (o.secretAccessKey == nil) != (other.secretAccessKey == nil) ||
+		(o.secretAccessKey != nil && *o.secretAccessKey != *other.secretAccessKey) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'AWS' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *AWS) Diff(other *AWS) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'AWS' object and the given one, using the given path as prefix.
+func (o *AWS) diff(path string, other *AWS, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.accessKeyID == nil) != (other.accessKeyID == nil) ||
+		(o.accessKeyID != nil && *o.accessKeyID != *other.accessKeyID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "access_key_id"),
+			Old:  helpers.Value(o.accessKeyID),
+			New:  helpers.Value(other.accessKeyID),
+		})
+	}
+	if (o.secretAccessKey == nil) != (other.secretAccessKey == nil) ||
+		(o.secretAccessKey != nil && *o.secretAccessKey != *other.secretAccessKey) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "secret_access_key"),
+			Old:  helpers.Value(o.secretAccessKey),
+			New:  helpers.Value(other.secretAccessKey),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'AWS' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *AWS) Changes(modified *AWS) *AWS {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(AWS)
+	if modified.accessKeyID != nil && (o.accessKeyID == nil || *o.accessKeyID != *modified.accessKeyID) {
+		result.accessKeyID = new(string)
+		*result.accessKeyID = *modified.accessKeyID
+	}
+	if modified.secretAccessKey != nil && (o.secretAccessKey == nil || *o.secretAccessKey != *modified.secretAccessKey) {
+		result.secretAccessKey = new(string)
+		*result.secretAccessKey = *modified.secretAccessKey
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printAWS prints a value of the 'AWS' type to the given printer.
+func printAWS(object *AWS, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.AWS")
+	if object.accessKeyID != nil {
+		printer.PrintObjectField("AccessKeyID")
+		printer.PrintString(*object.accessKeyID)
+	}
+	if object.secretAccessKey != nil {
+		printer.PrintObjectField("SecretAccessKey")
+		printer.PrintSensitive(*object.secretAccessKey)
+	}
+	printer.PrintObjectEnd()
+}
+
 // AWSList is a list of values of the 'AWS' type.
 type AWSList struct {
 	items []*AWS
@@ -151,3 +340,105 @@ func (l *AWSList) Range(f func(index int, item *AWS) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *AWSList) Copy() *AWSList {
+	if l == nil {
+		return nil
+	}
+	result := new(AWSList)
+	if l.items != nil {
+		result.items = make([]*AWS, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *AWSList) Equal(other *AWSList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *AWSList) Diff(other *AWSList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *AWSList) diff(path string, other *AWSList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *AWSList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printAWSList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *AWSList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printAWSList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *AWSList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printAWSList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *AWSList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printAWSList(l, printer)
+	}
+}
+
+// printAWSList prints a list of values of the 'AWS' type to the given printer.
+func printAWSList(list *AWSList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printAWS(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // CloudProviderBuilder contains the data and logic needed to build 'cloud_provider' objects.
 //
 // Cloud provider.
 type CloudProviderBuilder struct {
 	name        *string
 	displayName *string
+	extra       map[string]interface{}
 }
 
 // NewCloudProvider creates a new builder of 'cloud_provider' objects.
@@ -32,6 +37,20 @@ func NewCloudProvider() *CloudProviderBuilder {
 	return new(CloudProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cloud_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *CloudProvider) Builder() *CloudProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(CloudProviderBuilder)
+	b.name = o.name
+	b.displayName = o.displayName
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Name sets the value of the 'name' attribute
 // to the given value.
 //
@@ -50,9 +69,17 @@ func (b *CloudProviderBuilder) DisplayName(value string) *CloudProviderBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cloud_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *CloudProviderBuilder) Extra(value map[string]interface{}) *CloudProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cloud_provider' object using the configuration stored in the builder.
 func (b *CloudProviderBuilder) Build() (object *CloudProvider, err error) {
 	object = new(CloudProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.name != nil {
 		object.name = b.name
 	}
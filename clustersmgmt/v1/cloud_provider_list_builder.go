@@ -30,6 +30,19 @@ func NewCloudProviderList() *CloudProviderListBuilder {
 	return new(CloudProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *CloudProviderList) Builder() *CloudProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(CloudProviderListBuilder)
+	b.items = make([]*CloudProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *CloudProviderListBuilder) Items(values ...*CloudProviderBuilder) *CloudProviderListBuilder {
 	b.items = make([]*CloudProviderBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// cloudProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cloud_provider'.
-type cloudProviderListData []*cloudProviderData
-
 // UnmarshalCloudProviderList reads a list of values of the 'cloud_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalCloudProviderList(source interface{}) (list *CloudProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalCloudProviderList(source interface{}, options ...helpers.DecodingOptions) (list *CloudProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data cloudProviderListData
-	err = decoder.Decode(&data)
+	list = readCloudProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cloud_provider' value to a JSON document.
-func (l *CloudProviderList) wrap() (data cloudProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalCloudProviderList writes a list of values of the 'cloud_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalCloudProviderList(list *CloudProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(cloudProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeCloudProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *CloudProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeCloudProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalCloudProviderList function,
+// using the default decoding options.
+func (l *CloudProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalCloudProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = CloudProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cloud_provider' type.
-func (d cloudProviderListData) unwrap() (list *CloudProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *CloudProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *CloudProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeCloudProviderList writes a list of values of the 'cloud_provider' type to the given
+// stream, as a JSON array.
+func writeCloudProviderList(list *CloudProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*CloudProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeCloudProvider(item, stream)
 	}
-	list = new(CloudProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readCloudProviderList reads a list of values of the 'cloud_provider' type from the given
+// iterator, as a JSON array.
+func readCloudProviderList(iterator *helpers.Iterator) *CloudProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(CloudProviderList)
+	list.items = []*CloudProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readCloudProvider(iterator))
+	}
+	return list
 }
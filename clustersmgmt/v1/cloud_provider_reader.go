@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// cloudProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'cloud_provider'.
-type cloudProviderData struct {
-	Name        *string "json:\"name,omitempty\""
-	DisplayName *string "json:\"display_name,omitempty\""
-}
-
 // MarshalCloudProvider writes a value of the 'cloud_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalCloudProvider(object *CloudProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeCloudProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cloud_provider'
-// value to a JSON document.
-func (o *CloudProvider) wrap() (data *cloudProviderData, err error) {
-	if o == nil {
+// writeCloudProvider writes a value of the 'cloud_provider' type to the given stream.
+func writeCloudProvider(object *CloudProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(cloudProviderData)
-	data.Name = o.name
-	data.DisplayName = o.displayName
-	return
+	stream.WriteObjectStart()
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.displayName != nil {
+		stream.WriteObjectField("display_name")
+		stream.WriteString(*object.displayName)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalCloudProvider reads a value of the 'cloud_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalCloudProvider(source interface{}) (object *CloudProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalCloudProvider(source interface{}, options ...helpers.DecodingOptions) (object *CloudProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(cloudProviderData)
-	err = decoder.Decode(data)
+	object = readCloudProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(CloudProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cloud_provider' type.
-func (d *cloudProviderData) unwrap() (object *CloudProvider, err error) {
-	if d == nil {
-		return
+// readCloudProvider reads a value of the 'cloud_provider' type from the given iterator.
+func readCloudProvider(iterator *helpers.Iterator) *CloudProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(CloudProvider)
-	object.name = d.Name
-	object.displayName = d.DisplayName
-	return
+	object := new(CloudProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "display_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.displayName = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalCloudProvider function.
+func (o *CloudProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeCloudProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalCloudProvider function, using the
+// default decoding options.
+func (o *CloudProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalCloudProvider(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = CloudProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *CloudProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *CloudProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,12 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // CloudProvider represents the values of the 'cloud_provider' type.
 //
 // Cloud provider.
 type CloudProvider struct {
 	name        *string
 	displayName *string
+	extra       map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -34,6 +41,59 @@ func (o *CloudProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cloud_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *CloudProvider) Metadata() *helpers.TypeMetadata {
+	return cloudProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *CloudProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printCloudProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *CloudProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printCloudProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *CloudProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printCloudProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *CloudProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printCloudProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cloud_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *CloudProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -82,6 +142,135 @@ func (o *CloudProvider) GetDisplayName() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cloud_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *CloudProvider) Copy() *CloudProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(CloudProvider)
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	if o.displayName != nil {
+		result.displayName = new(string)
+		*result.displayName = *o.displayName
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cloud_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *CloudProvider) Equal(other *CloudProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cloud_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *CloudProvider) Diff(other *CloudProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cloud_provider' object and the given one, using the given path as prefix.
+func (o *CloudProvider) diff(path string, other *CloudProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "display_name"),
+			Old:  helpers.Value(o.displayName),
+			New:  helpers.Value(other.displayName),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cloud_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *CloudProvider) Changes(modified *CloudProvider) *CloudProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(CloudProvider)
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.displayName != nil && (o.displayName == nil || *o.displayName != *modified.displayName) {
+		result.displayName = new(string)
+		*result.displayName = *modified.displayName
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printCloudProvider prints a value of the 'cloud_provider' type to the given printer.
+func printCloudProvider(object *CloudProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.CloudProvider")
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.displayName != nil {
+		printer.PrintObjectField("DisplayName")
+		printer.PrintString(*object.displayName)
+	}
+	printer.PrintObjectEnd()
+}
+
 // CloudProviderList is a list of values of the 'cloud_provider' type.
 type CloudProviderList struct {
 	items []*CloudProvider
@@ -153,3 +342,105 @@ func (l *CloudProviderList) Range(f func(index int, item *CloudProvider) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *CloudProviderList) Copy() *CloudProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(CloudProviderList)
+	if l.items != nil {
+		result.items = make([]*CloudProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *CloudProviderList) Equal(other *CloudProviderList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *CloudProviderList) Diff(other *CloudProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *CloudProviderList) diff(path string, other *CloudProviderList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *CloudProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printCloudProviderList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *CloudProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printCloudProviderList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *CloudProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printCloudProviderList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *CloudProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printCloudProviderList(l, printer)
+	}
+}
+
+// printCloudProviderList prints a list of values of the 'cloud_provider' type to the given printer.
+func printCloudProviderList(list *CloudProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printCloudProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
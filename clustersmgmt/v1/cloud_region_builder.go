@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // CloudRegionBuilder contains the data and logic needed to build 'cloud_region' objects.
 //
 // Description of a region of a cloud provider.
@@ -29,6 +33,7 @@ type CloudRegionBuilder struct {
 	name          *string
 	displayName   *string
 	cloudProvider *CloudProviderBuilder
+	extra         map[string]interface{}
 }
 
 // NewCloudRegion creates a new builder of 'cloud_region' objects.
@@ -36,6 +41,26 @@ func NewCloudRegion() *CloudRegionBuilder {
 	return new(CloudRegionBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cloud_region' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *CloudRegion) Builder() *CloudRegionBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(CloudRegionBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	b.displayName = o.displayName
+	if o.cloudProvider != nil {
+		b.cloudProvider = o.cloudProvider.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *CloudRegionBuilder) ID(value string) *CloudRegionBuilder {
 	b.id = &value
@@ -81,9 +106,17 @@ func (b *CloudRegionBuilder) CloudProvider(value *CloudProviderBuilder) *CloudRe
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cloud_region' type, and
+// that will be written as they are when the object is encoded.
+func (b *CloudRegionBuilder) Extra(value map[string]interface{}) *CloudRegionBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cloud_region' object using the configuration stored in the builder.
 func (b *CloudRegionBuilder) Build() (object *CloudRegion, err error) {
 	object = new(CloudRegion)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'cloud_region' objects.
 type CloudRegionListBuilder struct {
 	items []*CloudRegionBuilder
+	link  bool
+	href  *string
 }
 
 // NewCloudRegionList creates a new builder of 'cloud_region' objects.
@@ -30,8 +32,25 @@ func NewCloudRegionList() *CloudRegionListBuilder {
 	return new(CloudRegionListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *CloudRegionList) Builder() *CloudRegionListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(CloudRegionListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*CloudRegionBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *CloudRegionListBuilder) Items(values ...*CloudRegionBuilder) *CloudRegionListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*CloudRegionBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *CloudRegionListBuilder) Build() (list *CloudRegionList, err error) {
 		}
 	}
 	list = new(CloudRegionList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
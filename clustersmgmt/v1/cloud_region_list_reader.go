@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// cloudRegionListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cloud_region'.
-type cloudRegionListData []*cloudRegionData
-
 // UnmarshalCloudRegionList reads a list of values of the 'cloud_region'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalCloudRegionList(source interface{}) (list *CloudRegionList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalCloudRegionList(source interface{}, options ...helpers.DecodingOptions) (list *CloudRegionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data cloudRegionListData
-	err = decoder.Decode(&data)
+	list = readCloudRegionList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cloud_region' value to a JSON document.
-func (l *CloudRegionList) wrap() (data cloudRegionListData, err error) {
-	if l == nil {
+// MarshalCloudRegionList writes a list of values of the 'cloud_region' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalCloudRegionList(list *CloudRegionList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeCloudRegionList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *CloudRegionList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeCloudRegionListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalCloudRegionList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *CloudRegionList) UnmarshalJSON(data []byte) error {
+	var list *CloudRegionList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalCloudRegionList(data)
+	} else {
+		list, err = unmarshalCloudRegionListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = CloudRegionList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *CloudRegionList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *CloudRegionList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalCloudRegionListLink reads a list of values of the 'cloud_region' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalCloudRegionListLink(source interface{}, options ...helpers.DecodingOptions) (list *CloudRegionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(cloudRegionListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readCloudRegionListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cloud_region' type.
-func (d cloudRegionListData) unwrap() (list *CloudRegionList, err error) {
-	if d == nil {
+// writeCloudRegionList writes a list of values of the 'cloud_region' type to the given
+// stream, as a JSON array.
+func writeCloudRegionList(list *CloudRegionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*CloudRegion, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeCloudRegion(item, stream)
 	}
-	list = new(CloudRegionList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// cloudRegionListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'cloud_region'.
-type cloudRegionListLinkData struct {
-	Kind  *string            "json:\"kind,omitempty\""
-	HREF  *string            "json:\"href,omitempty\""
-	Items []*cloudRegionData "json:\"items,omitempty\""
+// readCloudRegionList reads a list of values of the 'cloud_region' type from the given
+// iterator, as a JSON array.
+func readCloudRegionList(iterator *helpers.Iterator) *CloudRegionList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(CloudRegionList)
+	list.items = []*CloudRegion{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readCloudRegion(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'cloud_region' value to a link.
-func (l *CloudRegionList) wrapLink() (data *cloudRegionListLinkData, err error) {
-	if l == nil {
+// writeCloudRegionListLink writes a list of values of the 'cloud_region' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeCloudRegionListLink(list *CloudRegionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*cloudRegionData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(cloudRegionListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = CloudRegionListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(CloudRegionListLinkKind)
 	} else {
-		*data.Kind = CloudRegionListKind
+		stream.WriteString(CloudRegionListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeCloudRegionList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'cloud_region' type to a list.
-func (d *cloudRegionListLinkData) unwrapLink() (list *CloudRegionList, err error) {
-	if d == nil {
-		return
+// readCloudRegionListLink reads a list of values of the 'cloud_region' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readCloudRegionListLink(iterator *helpers.Iterator) *CloudRegionList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*CloudRegion, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(CloudRegionList)
+	list.items = []*CloudRegion{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(CloudRegionList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case CloudRegionListKind:
-			list.link = false
-		case CloudRegionListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(CloudRegionListKind, CloudRegionListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readCloudRegionList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				CloudRegionListKind,
-				CloudRegionListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,106 +20,163 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// cloudRegionData is the data structure used internally to marshal and unmarshal
-// objects of type 'cloud_region'.
-type cloudRegionData struct {
-	Kind          *string            "json:\"kind,omitempty\""
-	ID            *string            "json:\"id,omitempty\""
-	HREF          *string            "json:\"href,omitempty\""
-	Name          *string            "json:\"name,omitempty\""
-	DisplayName   *string            "json:\"display_name,omitempty\""
-	CloudProvider *cloudProviderData "json:\"cloud_provider,omitempty\""
-}
-
 // MarshalCloudRegion writes a value of the 'cloud_region' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalCloudRegion(object *CloudRegion, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeCloudRegion(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cloud_region'
-// value to a JSON document.
-func (o *CloudRegion) wrap() (data *cloudRegionData, err error) {
-	if o == nil {
+// writeCloudRegion writes a value of the 'cloud_region' type to the given stream.
+func writeCloudRegion(object *CloudRegion, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(cloudRegionData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = CloudRegionLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(CloudRegionLinkKind)
 	} else {
-		*data.Kind = CloudRegionKind
+		stream.WriteString(CloudRegionKind)
 	}
-	data.Name = o.name
-	data.DisplayName = o.displayName
-	data.CloudProvider, err = o.cloudProvider.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.displayName != nil {
+		stream.WriteObjectField("display_name")
+		stream.WriteString(*object.displayName)
+	}
+	if object.cloudProvider != nil {
+		stream.WriteObjectField("cloud_provider")
+		writeCloudProvider(object.cloudProvider, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalCloudRegion reads a value of the 'cloud_region' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalCloudRegion(source interface{}) (object *CloudRegion, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalCloudRegion(source interface{}, options ...helpers.DecodingOptions) (object *CloudRegion, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(cloudRegionData)
-	err = decoder.Decode(data)
+	object = readCloudRegion(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(CloudRegion)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cloud_region' type.
-func (d *cloudRegionData) unwrap() (object *CloudRegion, err error) {
-	if d == nil {
-		return
+// readCloudRegion reads a value of the 'cloud_region' type from the given iterator.
+func readCloudRegion(iterator *helpers.Iterator) *CloudRegion {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(CloudRegion)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case CloudRegionKind:
-			object.link = false
-		case CloudRegionLinkKind:
-			object.link = true
+	object := new(CloudRegion)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(CloudRegionKind, CloudRegionLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "display_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.displayName = &value
+			}
+		case "cloud_provider":
+			object.cloudProvider = readCloudProvider(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				CloudRegionKind,
-				CloudRegionLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	object.displayName = d.DisplayName
-	object.cloudProvider, err = d.CloudProvider.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalCloudRegion function.
+func (o *CloudRegion) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeCloudRegion(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalCloudRegion function, using the
+// default decoding options.
+func (o *CloudRegion) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalCloudRegion(data)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if object == nil {
+		*o = CloudRegion{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *CloudRegion) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *CloudRegion) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,16 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // CloudRegionKind is the name of the type used to represent objects
 // of type 'cloud_region'.
 const CloudRegionKind = "CloudRegion"
@@ -41,6 +51,7 @@ type CloudRegion struct {
 	name          *string
 	displayName   *string
 	cloudProvider *CloudProvider
+	extra         map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -95,6 +106,47 @@ func (o *CloudRegion) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete cloud region that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *CloudRegion) Resolve(ctx context.Context, transport http.RoundTripper) (result *CloudRegion, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to cloud region '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL: &url.URL{
+			Path: href,
+		},
+		Header: helpers.SetHeader(nil, helpers.MetricPath(href)),
+	}
+	if ctx != nil {
+		request = request.WithContext(ctx)
+	}
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 400 {
+		var failure *errors.Error
+		failure, err = errors.ReadError(response)
+		if err != nil {
+			return
+		}
+		err = failure
+		return
+	}
+	result, err = UnmarshalCloudRegion(response.Body)
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *CloudRegion) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -104,6 +156,59 @@ func (o *CloudRegion) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cloud_region' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *CloudRegion) Metadata() *helpers.TypeMetadata {
+	return cloudRegionMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *CloudRegion) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printCloudRegion(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *CloudRegion) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printCloudRegion(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *CloudRegion) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printCloudRegion(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *CloudRegion) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printCloudRegion(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cloud_region' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *CloudRegion) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -191,6 +296,207 @@ const CloudRegionListLinkKind = "CloudRegionListLink"
 // objects of type 'cloud_region'.
 const CloudRegionListNilKind = "CloudRegionListNil"
 
+// Copy returns a deep copy of the 'cloud_region' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *CloudRegion) Copy() *CloudRegion {
+	if o == nil {
+		return nil
+	}
+	result := new(CloudRegion)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	if o.displayName != nil {
+		result.displayName = new(string)
+		*result.displayName = *o.displayName
+	}
+	result.cloudProvider = o.cloudProvider.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cloud_region' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *CloudRegion) Equal(other *CloudRegion) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		return false
+	}
+	if !o.cloudProvider.Equal(other.cloudProvider) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cloud_region' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *CloudRegion) Diff(other *CloudRegion) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cloud_region' object and the given one, using the given path as prefix.
+func (o *CloudRegion) diff(path string, other *CloudRegion, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "display_name"),
+			Old:  helpers.Value(o.displayName),
+			New:  helpers.Value(other.displayName),
+		})
+	}
+	result = o.cloudProvider.diff(helpers.Path(path, "cloud_provider"), other.cloudProvider, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cloud_region' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *CloudRegion) Changes(modified *CloudRegion) *CloudRegion {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(CloudRegion)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.displayName != nil && (o.displayName == nil || *o.displayName != *modified.displayName) {
+		result.displayName = new(string)
+		*result.displayName = *modified.displayName
+	}
+	if modified.cloudProvider != nil && !o.cloudProvider.Equal(modified.cloudProvider) {
+		result.cloudProvider = o.cloudProvider.Changes(modified.cloudProvider)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printCloudRegion prints a value of the 'cloud_region' type to the given printer.
+func printCloudRegion(object *CloudRegion, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.CloudRegion")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.displayName != nil {
+		printer.PrintObjectField("DisplayName")
+		printer.PrintString(*object.displayName)
+	}
+	if object.cloudProvider != nil {
+		printer.PrintObjectField("CloudProvider")
+		printCloudProvider(object.cloudProvider, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // CloudRegionList is a list of values of the 'cloud_region' type.
 type CloudRegionList struct {
 	href  *string
@@ -298,3 +604,146 @@ func (l *CloudRegionList) Range(f func(index int, item *CloudRegion) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *CloudRegionList) Copy() *CloudRegionList {
+	if l == nil {
+		return nil
+	}
+	result := new(CloudRegionList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*CloudRegion, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *CloudRegionList) Equal(other *CloudRegionList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *CloudRegionList) Diff(other *CloudRegionList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *CloudRegionList) diff(path string, other *CloudRegionList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *CloudRegionList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printCloudRegionListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *CloudRegionList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printCloudRegionListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *CloudRegionList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printCloudRegionListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *CloudRegionList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printCloudRegionListLink(l, printer)
+	}
+}
+
+// printCloudRegionList prints a list of values of the 'cloud_region' type to the given printer.
+func printCloudRegionList(list *CloudRegionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printCloudRegion(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printCloudRegionListLink prints a list of values of the 'cloud_region' type to the
+// given printer, including the link to the list.
+func printCloudRegionListLink(list *CloudRegionList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.CloudRegionList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printCloudRegionList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
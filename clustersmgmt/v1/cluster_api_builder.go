@@ -19,11 +19,16 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAPIBuilder contains the data and logic needed to build 'cluster_API' objects.
 //
 // Information about the API of a cluster.
 type ClusterAPIBuilder struct {
-	url *string
+	url   *string
+	extra map[string]interface{}
 }
 
 // NewClusterAPI creates a new builder of 'cluster_API' objects.
@@ -31,6 +36,19 @@ func NewClusterAPI() *ClusterAPIBuilder {
 	return new(ClusterAPIBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_API' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterAPI) Builder() *ClusterAPIBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterAPIBuilder)
+	b.url = o.url
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // URL sets the value of the 'URL' attribute
 // to the given value.
 //
@@ -40,9 +58,17 @@ func (b *ClusterAPIBuilder) URL(value string) *ClusterAPIBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_API' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterAPIBuilder) Extra(value map[string]interface{}) *ClusterAPIBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_API' object using the configuration stored in the builder.
 func (b *ClusterAPIBuilder) Build() (object *ClusterAPI, err error) {
 	object = new(ClusterAPI)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.url != nil {
 		object.url = b.url
 	}
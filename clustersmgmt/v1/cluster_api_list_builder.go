@@ -30,6 +30,19 @@ func NewClusterAPIList() *ClusterAPIListBuilder {
 	return new(ClusterAPIListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterAPIList) Builder() *ClusterAPIListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterAPIListBuilder)
+	b.items = make([]*ClusterAPIBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterAPIListBuilder) Items(values ...*ClusterAPIBuilder) *ClusterAPIListBuilder {
 	b.items = make([]*ClusterAPIBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAPIListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_API'.
-type clusterAPIListData []*clusterAPIData
-
 // UnmarshalClusterAPIList reads a list of values of the 'cluster_API'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterAPIList(source interface{}) (list *ClusterAPIList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterAPIList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterAPIList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterAPIListData
-	err = decoder.Decode(&data)
+	list = readClusterAPIList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_API' value to a JSON document.
-func (l *ClusterAPIList) wrap() (data clusterAPIListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterAPIList writes a list of values of the 'cluster_API' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterAPIList(list *ClusterAPIList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterAPIListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterAPIList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterAPIList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAPIList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAPIList function,
+// using the default decoding options.
+func (l *ClusterAPIList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterAPIList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterAPIList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_API' type.
-func (d clusterAPIListData) unwrap() (list *ClusterAPIList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterAPIList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterAPIList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterAPIList writes a list of values of the 'cluster_API' type to the given
+// stream, as a JSON array.
+func writeClusterAPIList(list *ClusterAPIList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterAPI, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterAPI(item, stream)
 	}
-	list = new(ClusterAPIList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterAPIList reads a list of values of the 'cluster_API' type from the given
+// iterator, as a JSON array.
+func readClusterAPIList(iterator *helpers.Iterator) *ClusterAPIList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterAPIList)
+	list.items = []*ClusterAPI{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterAPI(iterator))
+	}
+	return list
 }
@@ -23,60 +23,119 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterAPIData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_API'.
-type clusterAPIData struct {
-	URL *string "json:\"url,omitempty\""
-}
-
 // MarshalClusterAPI writes a value of the 'cluster_API' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterAPI(object *ClusterAPI, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeClusterAPI(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_API'
-// value to a JSON document.
-func (o *ClusterAPI) wrap() (data *clusterAPIData, err error) {
-	if o == nil {
+// writeClusterAPI writes a value of the 'cluster_API' type to the given stream.
+func writeClusterAPI(object *ClusterAPI, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterAPIData)
-	data.URL = o.url
-	return
+	stream.WriteObjectStart()
+	if object.url != nil {
+		stream.WriteObjectField("url")
+		stream.WriteString(*object.url)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterAPI reads a value of the 'cluster_API' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterAPI(source interface{}) (object *ClusterAPI, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterAPI(source interface{}, options ...helpers.DecodingOptions) (object *ClusterAPI, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterAPIData)
-	err = decoder.Decode(data)
+	object = readClusterAPI(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterAPI)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_API' type.
-func (d *clusterAPIData) unwrap() (object *ClusterAPI, err error) {
-	if d == nil {
-		return
+// readClusterAPI reads a value of the 'cluster_API' type from the given iterator.
+func readClusterAPI(iterator *helpers.Iterator) *ClusterAPI {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterAPI)
-	object.url = d.URL
-	return
+	object := new(ClusterAPI)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "url":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.url = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterAPI function.
+func (o *ClusterAPI) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterAPI(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterAPI function, using the
+// default decoding options.
+func (o *ClusterAPI) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterAPI(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterAPI{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterAPI) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterAPI) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
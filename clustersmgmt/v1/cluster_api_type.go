@@ -19,11 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterAPI represents the values of the 'cluster_API' type.
 //
 // Information about the API of a cluster.
 type ClusterAPI struct {
-	url *string
+	url   *string
+	extra map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -32,6 +39,59 @@ func (o *ClusterAPI) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_API' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterAPI) Metadata() *helpers.TypeMetadata {
+	return clusterAPIMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterAPI) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAPI(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterAPI) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAPI(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterAPI) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAPI(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterAPI) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAPI(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_API' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterAPI) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // URL returns the value of the 'URL' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -55,6 +115,111 @@ func (o *ClusterAPI) GetURL() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_API' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterAPI) Copy() *ClusterAPI {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterAPI)
+	if o.url != nil {
+		result.url = new(string)
+		*result.url = *o.url
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_API' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterAPI) Equal(other *ClusterAPI) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_API' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterAPI) Diff(other *ClusterAPI) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_API' object and the given one, using the given path as prefix.
+func (o *ClusterAPI) diff(path string, other *ClusterAPI, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "url"),
+			Old:  helpers.Value(o.url),
+			New:  helpers.Value(other.url),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_API' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterAPI) Changes(modified *ClusterAPI) *ClusterAPI {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterAPI)
+	if modified.url != nil && (o.url == nil || *o.url != *modified.url) {
+		result.url = new(string)
+		*result.url = *modified.url
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterAPI prints a value of the 'cluster_API' type to the given printer.
+func printClusterAPI(object *ClusterAPI, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterAPI")
+	if object.url != nil {
+		printer.PrintObjectField("URL")
+		printer.PrintString(*object.url)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterAPIList is a list of values of the 'cluster_API' type.
 type ClusterAPIList struct {
 	items []*ClusterAPI
@@ -126,3 +291,105 @@ func (l *ClusterAPIList) Range(f func(index int, item *ClusterAPI) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterAPIList) Copy() *ClusterAPIList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterAPIList)
+	if l.items != nil {
+		result.items = make([]*ClusterAPI, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterAPIList) Equal(other *ClusterAPIList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterAPIList) Diff(other *ClusterAPIList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterAPIList) diff(path string, other *ClusterAPIList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterAPIList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterAPIList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterAPIList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterAPIList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterAPIList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterAPIList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterAPIList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterAPIList(l, printer)
+	}
+}
+
+// printClusterAPIList prints a list of values of the 'cluster_API' type to the given printer.
+func printClusterAPIList(list *ClusterAPIList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterAPI(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -21,6 +21,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterBuilder contains the data and logic needed to build 'cluster' objects.
@@ -63,34 +65,39 @@ import (
 // attributes are mandatory when creation a cluster with your own Amazon Web
 // Services account.
 type ClusterBuilder struct {
-	id                  *string
-	href                *string
-	link                bool
-	name                *string
-	flavour             *FlavourBuilder
-	console             *ClusterConsoleBuilder
-	multiAZ             *bool
-	nodes               *ClusterNodesBuilder
-	api                 *ClusterAPIBuilder
-	region              *CloudRegionBuilder
-	displayName         *string
-	dns                 *DNSBuilder
-	properties          map[string]string
-	state               *ClusterState
-	managed             *bool
-	externalID          *string
-	aws                 *AWSBuilder
-	network             *NetworkBuilder
-	creationTimestamp   *time.Time
-	expirationTimestamp *time.Time
-	cloudProvider       *CloudProviderBuilder
-	openshiftVersion    *string
-	subscription        *SubscriptionBuilder
-	groups              []*GroupBuilder
-	creator             *string
-	version             *VersionBuilder
-	identityProviders   []*IdentityProviderBuilder
-	metrics             *ClusterMetricsBuilder
+	id                    *string
+	href                  *string
+	link                  bool
+	name                  *string
+	flavour               *FlavourBuilder
+	console               *ClusterConsoleBuilder
+	multiAZ               *bool
+	nodes                 *ClusterNodesBuilder
+	api                   *ClusterAPIBuilder
+	region                *CloudRegionBuilder
+	displayName           *string
+	dns                   *DNSBuilder
+	properties            map[string]string
+	state                 *ClusterState
+	managed               *bool
+	externalID            *string
+	aws                   *AWSBuilder
+	network               *NetworkBuilder
+	creationTimestamp     *time.Time
+	expirationTimestamp   *time.Time
+	cloudProvider         *CloudProviderBuilder
+	openshiftVersion      *string
+	subscription          *SubscriptionBuilder
+	groups                []*GroupBuilder
+	groupsLink            bool
+	groupsHREF            *string
+	creator               *string
+	version               *VersionBuilder
+	identityProviders     []*IdentityProviderBuilder
+	identityProvidersLink bool
+	identityProvidersHREF *string
+	metrics               *ClusterMetricsBuilder
+	extra                 map[string]interface{}
 }
 
 // NewCluster creates a new builder of 'cluster' objects.
@@ -98,6 +105,89 @@ func NewCluster() *ClusterBuilder {
 	return new(ClusterBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Cluster) Builder() *ClusterBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	if o.flavour != nil {
+		b.flavour = o.flavour.Builder()
+	}
+	if o.console != nil {
+		b.console = o.console.Builder()
+	}
+	b.multiAZ = o.multiAZ
+	if o.nodes != nil {
+		b.nodes = o.nodes.Builder()
+	}
+	if o.api != nil {
+		b.api = o.api.Builder()
+	}
+	if o.region != nil {
+		b.region = o.region.Builder()
+	}
+	b.displayName = o.displayName
+	if o.dns != nil {
+		b.dns = o.dns.Builder()
+	}
+	if o.properties != nil {
+		b.properties = make(map[string]string, len(o.properties))
+		for key, value := range o.properties {
+			b.properties[key] = value
+		}
+	}
+	b.state = o.state
+	b.managed = o.managed
+	b.externalID = o.externalID
+	if o.aws != nil {
+		b.aws = o.aws.Builder()
+	}
+	if o.network != nil {
+		b.network = o.network.Builder()
+	}
+	b.creationTimestamp = o.creationTimestamp
+	b.expirationTimestamp = o.expirationTimestamp
+	if o.cloudProvider != nil {
+		b.cloudProvider = o.cloudProvider.Builder()
+	}
+	b.openshiftVersion = o.openshiftVersion
+	if o.subscription != nil {
+		b.subscription = o.subscription.Builder()
+	}
+	if o.groups != nil {
+		b.groups = make([]*GroupBuilder, len(o.groups.items))
+		for i, item := range o.groups.items {
+			b.groups[i] = item.Builder()
+		}
+		b.groupsLink = o.groups.link
+		b.groupsHREF = o.groups.href
+	}
+	b.creator = o.creator
+	if o.version != nil {
+		b.version = o.version.Builder()
+	}
+	if o.identityProviders != nil {
+		b.identityProviders = make([]*IdentityProviderBuilder, len(o.identityProviders.items))
+		for i, item := range o.identityProviders.items {
+			b.identityProviders[i] = item.Builder()
+		}
+		b.identityProvidersLink = o.identityProviders.link
+		b.identityProvidersHREF = o.identityProviders.href
+	}
+	if o.metrics != nil {
+		b.metrics = o.metrics.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *ClusterBuilder) ID(value string) *ClusterBuilder {
 	b.id = &value
@@ -302,6 +392,8 @@ func (b *ClusterBuilder) Subscription(value *SubscriptionBuilder) *ClusterBuilde
 //
 //
 func (b *ClusterBuilder) Groups(values ...*GroupBuilder) *ClusterBuilder {
+	b.groupsLink = false
+	b.groupsHREF = nil
 	b.groups = make([]*GroupBuilder, len(values))
 	copy(b.groups, values)
 	return b
@@ -330,6 +422,8 @@ func (b *ClusterBuilder) Version(value *VersionBuilder) *ClusterBuilder {
 //
 //
 func (b *ClusterBuilder) IdentityProviders(values ...*IdentityProviderBuilder) *ClusterBuilder {
+	b.identityProvidersLink = false
+	b.identityProvidersHREF = nil
 	b.identityProviders = make([]*IdentityProviderBuilder, len(values))
 	copy(b.identityProviders, values)
 	return b
@@ -344,9 +438,17 @@ func (b *ClusterBuilder) Metrics(value *ClusterMetricsBuilder) *ClusterBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterBuilder) Extra(value map[string]interface{}) *ClusterBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster' object using the configuration stored in the builder.
 func (b *ClusterBuilder) Build() (object *Cluster, err error) {
 	object = new(Cluster)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -442,6 +544,8 @@ func (b *ClusterBuilder) Build() (object *Cluster, err error) {
 	}
 	if b.groups != nil {
 		object.groups = new(GroupList)
+		object.groups.link = b.groupsLink
+		object.groups.href = b.groupsHREF
 		object.groups.items = make([]*Group, len(b.groups))
 		for i, item := range b.groups {
 			object.groups.items[i], err = item.Build()
@@ -461,6 +565,8 @@ func (b *ClusterBuilder) Build() (object *Cluster, err error) {
 	}
 	if b.identityProviders != nil {
 		object.identityProviders = new(IdentityProviderList)
+		object.identityProviders.link = b.identityProvidersLink
+		object.identityProviders.href = b.identityProvidersHREF
 		object.identityProviders.items = make([]*IdentityProvider, len(b.identityProviders))
 		for i, item := range b.identityProviders {
 			object.identityProviders.items[i], err = item.Build()
@@ -22,7 +22,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -148,6 +148,7 @@ type ClusterGetRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -162,6 +163,17 @@ func (r *ClusterGetRequest) Header(name string, value interface{}) *ClusterGetRe
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ClusterGetRequest) Decoding(value helpers.DecodingOptions) *ClusterGetRequest {
+	r.decoding = value
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -195,14 +207,14 @@ func (r *ClusterGetRequest) SendContext(ctx context.Context) (result *ClusterGet
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -256,29 +268,26 @@ func (r *ClusterGetResponse) GetBody() (value *Cluster, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'get' method.
-func (r *ClusterGetResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterData)
-	err = decoder.Decode(data)
+func (r *ClusterGetResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readCluster(iterator)
+	return iterator.Error()
 }
 
 // ClusterUpdateRequest is the request for the 'update' method.
 type ClusterUpdateRequest struct {
-	transport http.RoundTripper
-	path      string
-	metric    string
-	query     url.Values
-	header    http.Header
-	body      *Cluster
+	transport  http.RoundTripper
+	path       string
+	metric     string
+	query      url.Values
+	header     http.Header
+	body       *Cluster
+	decoding   helpers.DecodingOptions
+	original   *Cluster
+	mergePatch bool
 }
 
 // Parameter adds a query parameter.
@@ -293,11 +302,45 @@ func (r *ClusterUpdateRequest) Header(name string, value interface{}) *ClusterUp
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *ClusterUpdateRequest) Decoding(value helpers.DecodingOptions) *ClusterUpdateRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 //
 func (r *ClusterUpdateRequest) Body(value *Cluster) *ClusterUpdateRequest {
 	r.body = value
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// Changes sets the body of the request to the attributes of the modified object that have
+// values different to the values in the original object, as calculated by the Changes method
+// of the type.
+func (r *ClusterUpdateRequest) Changes(original, modified *Cluster) *ClusterUpdateRequest {
+	r.body = original.Changes(modified)
+	r.original = nil
+	r.mergePatch = false
+	return r
+}
+
+// MergePatch sets the body of the request to the JSON merge patch, as described in RFC 7396,
+// that transforms the original object into the modified one. The request will be sent with the
+// `application/merge-patch+json` content type. Attributes removed in the modified object will
+// be set to null in the patch.
+func (r *ClusterUpdateRequest) MergePatch(original, modified *Cluster) *ClusterUpdateRequest {
+	r.body = modified
+	r.original = original
+	r.mergePatch = true
 	return r
 }
 
@@ -313,6 +356,9 @@ func (r *ClusterUpdateRequest) Send() (result *ClusterUpdateResponse, err error)
 func (r *ClusterUpdateRequest) SendContext(ctx context.Context) (result *ClusterUpdateResponse, err error) {
 	query := helpers.CopyQuery(r.query)
 	header := helpers.SetHeader(r.header, r.metric)
+	if r.mergePatch {
+		header.Set("Content-Type", helpers.MergePatchContentType)
+	}
 	buffer := new(bytes.Buffer)
 	err = r.marshal(buffer)
 	if err != nil {
@@ -340,14 +386,14 @@ func (r *ClusterUpdateRequest) SendContext(ctx context.Context) (result *Cluster
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -358,13 +404,31 @@ func (r *ClusterUpdateRequest) SendContext(ctx context.Context) (result *Cluster
 // 'update' method.
 func (r *ClusterUpdateRequest) marshal(writer io.Writer) error {
 	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	if r.mergePatch {
+		original := new(bytes.Buffer)
+		err = MarshalCluster(r.original, original)
+		if err != nil {
+			return err
+		}
+		modified := new(bytes.Buffer)
+		err = MarshalCluster(r.body, modified)
+		if err != nil {
+			return err
+		}
+		var patch []byte
+		patch, err = helpers.MergePatch(original.Bytes(), modified.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(patch)
+		return err
+	}
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeCluster(r.body, stream)
+	return stream.Flush()
 }
 
 // ClusterUpdateResponse is the response for the 'update' method.
@@ -414,19 +478,13 @@ func (r *ClusterUpdateResponse) GetBody() (value *Cluster, ok bool) {
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'update' method.
-func (r *ClusterUpdateResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterData)
-	err = decoder.Decode(data)
+func (r *ClusterUpdateResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readCluster(iterator)
+	return iterator.Error()
 }
 
 // ClusterDeleteRequest is the request for the 'delete' method.
@@ -483,7 +541,7 @@ func (r *ClusterDeleteRequest) SendContext(ctx context.Context) (result *Cluster
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
@@ -514,3 +572,35 @@ func (r *ClusterDeleteResponse) Header() http.Header {
 func (r *ClusterDeleteResponse) Error() *errors.Error {
 	return r.err
 }
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *ClusterClient) Metadata() *helpers.ResourceMetadata {
+	return clusterResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *ClusterClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	switch segments[0] {
+	case "status":
+		return c.Status().route(segments[1:], ids)
+	case "credentials":
+		return c.Credentials().route(segments[1:], ids)
+	case "logs":
+		return c.Logs().route(segments[1:], ids)
+	case "groups":
+		return c.Groups().route(segments[1:], ids)
+	case "identity_providers":
+		return c.IdentityProviders().route(segments[1:], ids)
+	default:
+		err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+		return
+	}
+}
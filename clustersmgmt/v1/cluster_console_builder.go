@@ -19,11 +19,16 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterConsoleBuilder contains the data and logic needed to build 'cluster_console' objects.
 //
 // Information about the console of a cluster.
 type ClusterConsoleBuilder struct {
-	url *string
+	url   *string
+	extra map[string]interface{}
 }
 
 // NewClusterConsole creates a new builder of 'cluster_console' objects.
@@ -31,6 +36,19 @@ func NewClusterConsole() *ClusterConsoleBuilder {
 	return new(ClusterConsoleBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_console' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterConsole) Builder() *ClusterConsoleBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterConsoleBuilder)
+	b.url = o.url
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // URL sets the value of the 'URL' attribute
 // to the given value.
 //
@@ -40,9 +58,17 @@ func (b *ClusterConsoleBuilder) URL(value string) *ClusterConsoleBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_console' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterConsoleBuilder) Extra(value map[string]interface{}) *ClusterConsoleBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_console' object using the configuration stored in the builder.
 func (b *ClusterConsoleBuilder) Build() (object *ClusterConsole, err error) {
 	object = new(ClusterConsole)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.url != nil {
 		object.url = b.url
 	}
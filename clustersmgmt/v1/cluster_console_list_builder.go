@@ -30,6 +30,19 @@ func NewClusterConsoleList() *ClusterConsoleListBuilder {
 	return new(ClusterConsoleListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterConsoleList) Builder() *ClusterConsoleListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterConsoleListBuilder)
+	b.items = make([]*ClusterConsoleBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterConsoleListBuilder) Items(values ...*ClusterConsoleBuilder) *ClusterConsoleListBuilder {
 	b.items = make([]*ClusterConsoleBuilder, len(values))
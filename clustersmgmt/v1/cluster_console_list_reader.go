@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterConsoleListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_console'.
-type clusterConsoleListData []*clusterConsoleData
-
 // UnmarshalClusterConsoleList reads a list of values of the 'cluster_console'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterConsoleList(source interface{}) (list *ClusterConsoleList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterConsoleList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterConsoleList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterConsoleListData
-	err = decoder.Decode(&data)
+	list = readClusterConsoleList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_console' value to a JSON document.
-func (l *ClusterConsoleList) wrap() (data clusterConsoleListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterConsoleList writes a list of values of the 'cluster_console' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterConsoleList(list *ClusterConsoleList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterConsoleListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterConsoleList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterConsoleList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterConsoleList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterConsoleList function,
+// using the default decoding options.
+func (l *ClusterConsoleList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterConsoleList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterConsoleList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_console' type.
-func (d clusterConsoleListData) unwrap() (list *ClusterConsoleList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterConsoleList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterConsoleList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterConsoleList writes a list of values of the 'cluster_console' type to the given
+// stream, as a JSON array.
+func writeClusterConsoleList(list *ClusterConsoleList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterConsole, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterConsole(item, stream)
 	}
-	list = new(ClusterConsoleList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterConsoleList reads a list of values of the 'cluster_console' type from the given
+// iterator, as a JSON array.
+func readClusterConsoleList(iterator *helpers.Iterator) *ClusterConsoleList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterConsoleList)
+	list.items = []*ClusterConsole{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterConsole(iterator))
+	}
+	return list
 }
@@ -23,60 +23,119 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterConsoleData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_console'.
-type clusterConsoleData struct {
-	URL *string "json:\"url,omitempty\""
-}
-
 // MarshalClusterConsole writes a value of the 'cluster_console' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterConsole(object *ClusterConsole, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeClusterConsole(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_console'
-// value to a JSON document.
-func (o *ClusterConsole) wrap() (data *clusterConsoleData, err error) {
-	if o == nil {
+// writeClusterConsole writes a value of the 'cluster_console' type to the given stream.
+func writeClusterConsole(object *ClusterConsole, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterConsoleData)
-	data.URL = o.url
-	return
+	stream.WriteObjectStart()
+	if object.url != nil {
+		stream.WriteObjectField("url")
+		stream.WriteString(*object.url)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterConsole reads a value of the 'cluster_console' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterConsole(source interface{}) (object *ClusterConsole, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterConsole(source interface{}, options ...helpers.DecodingOptions) (object *ClusterConsole, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterConsoleData)
-	err = decoder.Decode(data)
+	object = readClusterConsole(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterConsole)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_console' type.
-func (d *clusterConsoleData) unwrap() (object *ClusterConsole, err error) {
-	if d == nil {
-		return
+// readClusterConsole reads a value of the 'cluster_console' type from the given iterator.
+func readClusterConsole(iterator *helpers.Iterator) *ClusterConsole {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterConsole)
-	object.url = d.URL
-	return
+	object := new(ClusterConsole)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "url":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.url = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterConsole function.
+func (o *ClusterConsole) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterConsole(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterConsole function, using the
+// default decoding options.
+func (o *ClusterConsole) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterConsole(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterConsole{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterConsole) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterConsole) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,11 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterConsole represents the values of the 'cluster_console' type.
 //
 // Information about the console of a cluster.
 type ClusterConsole struct {
-	url *string
+	url   *string
+	extra map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -32,6 +39,59 @@ func (o *ClusterConsole) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_console' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterConsole) Metadata() *helpers.TypeMetadata {
+	return clusterConsoleMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterConsole) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterConsole(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterConsole) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterConsole(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterConsole) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterConsole(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterConsole) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterConsole(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_console' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterConsole) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // URL returns the value of the 'URL' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -55,6 +115,111 @@ func (o *ClusterConsole) GetURL() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_console' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterConsole) Copy() *ClusterConsole {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterConsole)
+	if o.url != nil {
+		result.url = new(string)
+		*result.url = *o.url
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_console' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterConsole) Equal(other *ClusterConsole) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_console' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterConsole) Diff(other *ClusterConsole) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_console' object and the given one, using the given path as prefix.
+func (o *ClusterConsole) diff(path string, other *ClusterConsole, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "url"),
+			Old:  helpers.Value(o.url),
+			New:  helpers.Value(other.url),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_console' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterConsole) Changes(modified *ClusterConsole) *ClusterConsole {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterConsole)
+	if modified.url != nil && (o.url == nil || *o.url != *modified.url) {
+		result.url = new(string)
+		*result.url = *modified.url
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterConsole prints a value of the 'cluster_console' type to the given printer.
+func printClusterConsole(object *ClusterConsole, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterConsole")
+	if object.url != nil {
+		printer.PrintObjectField("URL")
+		printer.PrintString(*object.url)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterConsoleList is a list of values of the 'cluster_console' type.
 type ClusterConsoleList struct {
 	items []*ClusterConsole
@@ -126,3 +291,105 @@ func (l *ClusterConsoleList) Range(f func(index int, item *ClusterConsole) bool)
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterConsoleList) Copy() *ClusterConsoleList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterConsoleList)
+	if l.items != nil {
+		result.items = make([]*ClusterConsole, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterConsoleList) Equal(other *ClusterConsoleList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterConsoleList) Diff(other *ClusterConsoleList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterConsoleList) diff(path string, other *ClusterConsoleList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterConsoleList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterConsoleList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterConsoleList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterConsoleList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterConsoleList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterConsoleList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterConsoleList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterConsoleList(l, printer)
+	}
+}
+
+// printClusterConsoleList prints a list of values of the 'cluster_console' type to the given printer.
+func printClusterConsoleList(list *ClusterConsoleList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterConsole(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterCredentialsBuilder contains the data and logic needed to build 'cluster_credentials' objects.
 //
 // Credentials of the a cluster.
@@ -29,6 +33,7 @@ type ClusterCredentialsBuilder struct {
 	kubeconfig *string
 	ssh        *SshcredentialsBuilder
 	admin      *AdminCredentialsBuilder
+	extra      map[string]interface{}
 }
 
 // NewClusterCredentials creates a new builder of 'cluster_credentials' objects.
@@ -36,6 +41,28 @@ func NewClusterCredentials() *ClusterCredentialsBuilder {
 	return new(ClusterCredentialsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_credentials' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterCredentials) Builder() *ClusterCredentialsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterCredentialsBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.kubeconfig = o.kubeconfig
+	if o.ssh != nil {
+		b.ssh = o.ssh.Builder()
+	}
+	if o.admin != nil {
+		b.admin = o.admin.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *ClusterCredentialsBuilder) ID(value string) *ClusterCredentialsBuilder {
 	b.id = &value
@@ -82,9 +109,17 @@ func (b *ClusterCredentialsBuilder) Admin(value *AdminCredentialsBuilder) *Clust
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_credentials' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterCredentialsBuilder) Extra(value map[string]interface{}) *ClusterCredentialsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_credentials' object using the configuration stored in the builder.
 func (b *ClusterCredentialsBuilder) Build() (object *ClusterCredentials, err error) {
 	object = new(ClusterCredentials)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'cluster_credentials' objects.
 type ClusterCredentialsListBuilder struct {
 	items []*ClusterCredentialsBuilder
+	link  bool
+	href  *string
 }
 
 // NewClusterCredentialsList creates a new builder of 'cluster_credentials' objects.
@@ -30,8 +32,25 @@ func NewClusterCredentialsList() *ClusterCredentialsListBuilder {
 	return new(ClusterCredentialsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterCredentialsList) Builder() *ClusterCredentialsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterCredentialsListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*ClusterCredentialsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterCredentialsListBuilder) Items(values ...*ClusterCredentialsBuilder) *ClusterCredentialsListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*ClusterCredentialsBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *ClusterCredentialsListBuilder) Build() (list *ClusterCredentialsList, e
 		}
 	}
 	list = new(ClusterCredentialsList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterCredentialsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_credentials'.
-type clusterCredentialsListData []*clusterCredentialsData
-
 // UnmarshalClusterCredentialsList reads a list of values of the 'cluster_credentials'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterCredentialsList(source interface{}) (list *ClusterCredentialsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterCredentialsList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterCredentialsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterCredentialsListData
-	err = decoder.Decode(&data)
+	list = readClusterCredentialsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_credentials' value to a JSON document.
-func (l *ClusterCredentialsList) wrap() (data clusterCredentialsListData, err error) {
-	if l == nil {
+// MarshalClusterCredentialsList writes a list of values of the 'cluster_credentials' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterCredentialsList(list *ClusterCredentialsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeClusterCredentialsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *ClusterCredentialsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterCredentialsListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalClusterCredentialsList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *ClusterCredentialsList) UnmarshalJSON(data []byte) error {
+	var list *ClusterCredentialsList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalClusterCredentialsList(data)
+	} else {
+		list, err = unmarshalClusterCredentialsListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = ClusterCredentialsList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterCredentialsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterCredentialsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalClusterCredentialsListLink reads a list of values of the 'cluster_credentials' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalClusterCredentialsListLink(source interface{}, options ...helpers.DecodingOptions) (list *ClusterCredentialsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(clusterCredentialsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readClusterCredentialsListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_credentials' type.
-func (d clusterCredentialsListData) unwrap() (list *ClusterCredentialsList, err error) {
-	if d == nil {
+// writeClusterCredentialsList writes a list of values of the 'cluster_credentials' type to the given
+// stream, as a JSON array.
+func writeClusterCredentialsList(list *ClusterCredentialsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterCredentials, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterCredentials(item, stream)
 	}
-	list = new(ClusterCredentialsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// clusterCredentialsListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'cluster_credentials'.
-type clusterCredentialsListLinkData struct {
-	Kind  *string                   "json:\"kind,omitempty\""
-	HREF  *string                   "json:\"href,omitempty\""
-	Items []*clusterCredentialsData "json:\"items,omitempty\""
+// readClusterCredentialsList reads a list of values of the 'cluster_credentials' type from the given
+// iterator, as a JSON array.
+func readClusterCredentialsList(iterator *helpers.Iterator) *ClusterCredentialsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterCredentialsList)
+	list.items = []*ClusterCredentials{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterCredentials(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'cluster_credentials' value to a link.
-func (l *ClusterCredentialsList) wrapLink() (data *clusterCredentialsListLinkData, err error) {
-	if l == nil {
+// writeClusterCredentialsListLink writes a list of values of the 'cluster_credentials' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeClusterCredentialsListLink(list *ClusterCredentialsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*clusterCredentialsData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(clusterCredentialsListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = ClusterCredentialsListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(ClusterCredentialsListLinkKind)
 	} else {
-		*data.Kind = ClusterCredentialsListKind
+		stream.WriteString(ClusterCredentialsListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeClusterCredentialsList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'cluster_credentials' type to a list.
-func (d *clusterCredentialsListLinkData) unwrapLink() (list *ClusterCredentialsList, err error) {
-	if d == nil {
-		return
+// readClusterCredentialsListLink reads a list of values of the 'cluster_credentials' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readClusterCredentialsListLink(iterator *helpers.Iterator) *ClusterCredentialsList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*ClusterCredentials, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(ClusterCredentialsList)
+	list.items = []*ClusterCredentials{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(ClusterCredentialsList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterCredentialsListKind:
-			list.link = false
-		case ClusterCredentialsListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(ClusterCredentialsListKind, ClusterCredentialsListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readClusterCredentialsList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterCredentialsListKind,
-				ClusterCredentialsListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
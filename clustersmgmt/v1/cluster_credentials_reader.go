@@ -20,112 +20,160 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterCredentialsData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_credentials'.
-type clusterCredentialsData struct {
-	Kind       *string               "json:\"kind,omitempty\""
-	ID         *string               "json:\"id,omitempty\""
-	HREF       *string               "json:\"href,omitempty\""
-	Kubeconfig *string               "json:\"kubeconfig,omitempty\""
-	SSH        *sshcredentialsData   "json:\"ssh,omitempty\""
-	Admin      *adminCredentialsData "json:\"admin,omitempty\""
-}
-
 // MarshalClusterCredentials writes a value of the 'cluster_credentials' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterCredentials(object *ClusterCredentials, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeClusterCredentials(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_credentials'
-// value to a JSON document.
-func (o *ClusterCredentials) wrap() (data *clusterCredentialsData, err error) {
-	if o == nil {
+// writeClusterCredentials writes a value of the 'cluster_credentials' type to the given stream.
+func writeClusterCredentials(object *ClusterCredentials, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterCredentialsData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = ClusterCredentialsLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(ClusterCredentialsLinkKind)
 	} else {
-		*data.Kind = ClusterCredentialsKind
+		stream.WriteString(ClusterCredentialsKind)
 	}
-	data.Kubeconfig = o.kubeconfig
-	data.SSH, err = o.ssh.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Admin, err = o.admin.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	return
+	if object.kubeconfig != nil {
+		stream.WriteObjectField("kubeconfig")
+		stream.WriteString(*object.kubeconfig)
+	}
+	if object.ssh != nil {
+		stream.WriteObjectField("ssh")
+		writeSshcredentials(object.ssh, stream)
+	}
+	if object.admin != nil {
+		stream.WriteObjectField("admin")
+		writeAdminCredentials(object.admin, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterCredentials reads a value of the 'cluster_credentials' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterCredentials(source interface{}) (object *ClusterCredentials, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterCredentials(source interface{}, options ...helpers.DecodingOptions) (object *ClusterCredentials, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterCredentialsData)
-	err = decoder.Decode(data)
+	object = readClusterCredentials(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterCredentials)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_credentials' type.
-func (d *clusterCredentialsData) unwrap() (object *ClusterCredentials, err error) {
-	if d == nil {
-		return
+// readClusterCredentials reads a value of the 'cluster_credentials' type from the given iterator.
+func readClusterCredentials(iterator *helpers.Iterator) *ClusterCredentials {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterCredentials)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterCredentialsKind:
-			object.link = false
-		case ClusterCredentialsLinkKind:
-			object.link = true
+	object := new(ClusterCredentials)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(ClusterCredentialsKind, ClusterCredentialsLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "kubeconfig":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.kubeconfig = &value
+			}
+		case "ssh":
+			object.ssh = readSshcredentials(iterator)
+		case "admin":
+			object.admin = readAdminCredentials(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterCredentialsKind,
-				ClusterCredentialsLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.kubeconfig = d.Kubeconfig
-	object.ssh, err = d.SSH.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterCredentials function.
+func (o *ClusterCredentials) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterCredentials(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterCredentials function, using the
+// default decoding options.
+func (o *ClusterCredentials) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterCredentials(data)
 	if err != nil {
-		return
+		return err
+	}
+	if object == nil {
+		*o = ClusterCredentials{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterCredentials) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
 	}
-	object.admin, err = d.Admin.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterCredentials) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
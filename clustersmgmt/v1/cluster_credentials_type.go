@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterCredentialsKind is the name of the type used to represent objects
 // of type 'cluster_credentials'.
 const ClusterCredentialsKind = "ClusterCredentials"
@@ -41,6 +49,7 @@ type ClusterCredentials struct {
 	kubeconfig *string
 	ssh        *Sshcredentials
 	admin      *AdminCredentials
+	extra      map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -95,6 +104,27 @@ func (o *ClusterCredentials) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete cluster credentials that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *ClusterCredentials) Resolve(ctx context.Context, transport http.RoundTripper) (result *ClusterCredentials, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to cluster credentials '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewCredentialsClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *ClusterCredentials) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -104,6 +134,59 @@ func (o *ClusterCredentials) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_credentials' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterCredentials) Metadata() *helpers.TypeMetadata {
+	return clusterCredentialsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterCredentials) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterCredentials(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterCredentials) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterCredentials(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterCredentials) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterCredentials(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterCredentials) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterCredentials(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_credentials' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterCredentials) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Kubeconfig returns the value of the 'kubeconfig' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -187,6 +270,195 @@ const ClusterCredentialsListLinkKind = "ClusterCredentialsListLink"
 // objects of type 'cluster_credentials'.
 const ClusterCredentialsListNilKind = "ClusterCredentialsListNil"
 
+// Copy returns a deep copy of the 'cluster_credentials' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterCredentials) Copy() *ClusterCredentials {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterCredentials)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.kubeconfig != nil {
+		result.kubeconfig = new(string)
+		*result.kubeconfig = *o.kubeconfig
+	}
+	result.ssh = o.ssh.Copy()
+	result.admin = o.admin.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_credentials' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterCredentials) Equal(other *ClusterCredentials) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.kubeconfig == nil) != (other.kubeconfig == nil) ||
+		(o.kubeconfig != nil && *o.kubeconfig != *other.kubeconfig) {
+		return false
+	}
+	if !o.ssh.Equal(other.ssh) {
+		return false
+	}
+	if !o.admin.Equal(other.admin) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_credentials' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterCredentials) Diff(other *ClusterCredentials) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_credentials' object and the given one, using the given path as prefix.
+func (o *ClusterCredentials) diff(path string, other *ClusterCredentials, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.kubeconfig == nil) != (other.kubeconfig == nil) ||
+		(o.kubeconfig != nil && *o.kubeconfig != *other.kubeconfig) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kubeconfig"),
+			Old:  helpers.Value(o.kubeconfig),
+			New:  helpers.Value(other.kubeconfig),
+		})
+	}
+	result = o.ssh.diff(helpers.Path(path, "ssh"), other.ssh, result)
+	result = o.admin.diff(helpers.Path(path, "admin"), other.admin, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_credentials' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterCredentials) Changes(modified *ClusterCredentials) *ClusterCredentials {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterCredentials)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.kubeconfig != nil && (o.kubeconfig == nil || *o.kubeconfig != *modified.kubeconfig) {
+		result.kubeconfig = new(string)
+		*result.kubeconfig = *modified.kubeconfig
+	}
+	if modified.ssh != nil && !o.ssh.Equal(modified.ssh) {
+		result.ssh = o.ssh.Changes(modified.ssh)
+	}
+	if modified.admin != nil && !o.admin.Equal(modified.admin) {
+		result.admin = o.admin.Changes(modified.admin)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterCredentials prints a value of the 'cluster_credentials' type to the given printer.
+func printClusterCredentials(object *ClusterCredentials, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterCredentials")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.kubeconfig != nil {
+		printer.PrintObjectField("Kubeconfig")
+		printer.PrintSensitive(*object.kubeconfig)
+	}
+	if object.ssh != nil {
+		printer.PrintObjectField("SSH")
+		printSshcredentials(object.ssh, printer)
+	}
+	if object.admin != nil {
+		printer.PrintObjectField("Admin")
+		printAdminCredentials(object.admin, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterCredentialsList is a list of values of the 'cluster_credentials' type.
 type ClusterCredentialsList struct {
 	href  *string
@@ -294,3 +566,146 @@ func (l *ClusterCredentialsList) Range(f func(index int, item *ClusterCredential
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterCredentialsList) Copy() *ClusterCredentialsList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterCredentialsList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*ClusterCredentials, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterCredentialsList) Equal(other *ClusterCredentialsList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterCredentialsList) Diff(other *ClusterCredentialsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterCredentialsList) diff(path string, other *ClusterCredentialsList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterCredentialsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterCredentialsListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterCredentialsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterCredentialsListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterCredentialsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterCredentialsListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterCredentialsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterCredentialsListLink(l, printer)
+	}
+}
+
+// printClusterCredentialsList prints a list of values of the 'cluster_credentials' type to the given printer.
+func printClusterCredentialsList(list *ClusterCredentialsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterCredentials(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printClusterCredentialsListLink prints a list of values of the 'cluster_credentials' type to the
+// given printer, including the link to the list.
+func printClusterCredentialsListLink(list *ClusterCredentialsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterCredentialsList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printClusterCredentialsList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
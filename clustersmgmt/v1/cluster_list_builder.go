@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'cluster' objects.
 type ClusterListBuilder struct {
 	items []*ClusterBuilder
+	link  bool
+	href  *string
 }
 
 // NewClusterList creates a new builder of 'cluster' objects.
@@ -30,8 +32,25 @@ func NewClusterList() *ClusterListBuilder {
 	return new(ClusterListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterList) Builder() *ClusterListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*ClusterBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterListBuilder) Items(values ...*ClusterBuilder) *ClusterListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*ClusterBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *ClusterListBuilder) Build() (list *ClusterList, err error) {
 		}
 	}
 	list = new(ClusterList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster'.
-type clusterListData []*clusterData
-
 // UnmarshalClusterList reads a list of values of the 'cluster'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterList(source interface{}) (list *ClusterList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterListData
-	err = decoder.Decode(&data)
+	list = readClusterList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster' value to a JSON document.
-func (l *ClusterList) wrap() (data clusterListData, err error) {
-	if l == nil {
+// MarshalClusterList writes a list of values of the 'cluster' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterList(list *ClusterList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeClusterList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *ClusterList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalClusterList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *ClusterList) UnmarshalJSON(data []byte) error {
+	var list *ClusterList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalClusterList(data)
+	} else {
+		list, err = unmarshalClusterListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = ClusterList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalClusterListLink reads a list of values of the 'cluster' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalClusterListLink(source interface{}, options ...helpers.DecodingOptions) (list *ClusterList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(clusterListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readClusterListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster' type.
-func (d clusterListData) unwrap() (list *ClusterList, err error) {
-	if d == nil {
+// writeClusterList writes a list of values of the 'cluster' type to the given
+// stream, as a JSON array.
+func writeClusterList(list *ClusterList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Cluster, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeCluster(item, stream)
 	}
-	list = new(ClusterList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// clusterListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'cluster'.
-type clusterListLinkData struct {
-	Kind  *string        "json:\"kind,omitempty\""
-	HREF  *string        "json:\"href,omitempty\""
-	Items []*clusterData "json:\"items,omitempty\""
+// readClusterList reads a list of values of the 'cluster' type from the given
+// iterator, as a JSON array.
+func readClusterList(iterator *helpers.Iterator) *ClusterList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterList)
+	list.items = []*Cluster{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readCluster(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'cluster' value to a link.
-func (l *ClusterList) wrapLink() (data *clusterListLinkData, err error) {
-	if l == nil {
+// writeClusterListLink writes a list of values of the 'cluster' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeClusterListLink(list *ClusterList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*clusterData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(clusterListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = ClusterListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(ClusterListLinkKind)
 	} else {
-		*data.Kind = ClusterListKind
+		stream.WriteString(ClusterListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeClusterList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'cluster' type to a list.
-func (d *clusterListLinkData) unwrapLink() (list *ClusterList, err error) {
-	if d == nil {
-		return
+// readClusterListLink reads a list of values of the 'cluster' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readClusterListLink(iterator *helpers.Iterator) *ClusterList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Cluster, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(ClusterList)
+	list.items = []*Cluster{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(ClusterList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterListKind:
-			list.link = false
-		case ClusterListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(ClusterListKind, ClusterListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readClusterList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterListKind,
-				ClusterListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
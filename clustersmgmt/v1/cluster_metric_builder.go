@@ -21,6 +21,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterMetricBuilder contains the data and logic needed to build 'cluster_metric' objects.
@@ -31,6 +33,7 @@ type ClusterMetricBuilder struct {
 	updatedTimestamp *time.Time
 	total            *ValueBuilder
 	used             *ValueBuilder
+	extra            map[string]interface{}
 }
 
 // NewClusterMetric creates a new builder of 'cluster_metric' objects.
@@ -38,6 +41,25 @@ func NewClusterMetric() *ClusterMetricBuilder {
 	return new(ClusterMetricBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_metric' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterMetric) Builder() *ClusterMetricBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterMetricBuilder)
+	b.updatedTimestamp = o.updatedTimestamp
+	if o.total != nil {
+		b.total = o.total.Builder()
+	}
+	if o.used != nil {
+		b.used = o.used.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // UpdatedTimestamp sets the value of the 'updated_timestamp' attribute
 // to the given value.
 //
@@ -99,9 +121,17 @@ func (b *ClusterMetricBuilder) Used(value *ValueBuilder) *ClusterMetricBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_metric' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterMetricBuilder) Extra(value map[string]interface{}) *ClusterMetricBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_metric' object using the configuration stored in the builder.
 func (b *ClusterMetricBuilder) Build() (object *ClusterMetric, err error) {
 	object = new(ClusterMetric)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.updatedTimestamp != nil {
 		object.updatedTimestamp = b.updatedTimestamp
 	}
@@ -30,6 +30,19 @@ func NewClusterMetricList() *ClusterMetricListBuilder {
 	return new(ClusterMetricListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterMetricList) Builder() *ClusterMetricListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterMetricListBuilder)
+	b.items = make([]*ClusterMetricBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterMetricListBuilder) Items(values ...*ClusterMetricBuilder) *ClusterMetricListBuilder {
 	b.items = make([]*ClusterMetricBuilder, len(values))
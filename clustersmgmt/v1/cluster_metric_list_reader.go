@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterMetricListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_metric'.
-type clusterMetricListData []*clusterMetricData
-
 // UnmarshalClusterMetricList reads a list of values of the 'cluster_metric'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterMetricList(source interface{}) (list *ClusterMetricList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterMetricList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterMetricList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterMetricListData
-	err = decoder.Decode(&data)
+	list = readClusterMetricList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_metric' value to a JSON document.
-func (l *ClusterMetricList) wrap() (data clusterMetricListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterMetricList writes a list of values of the 'cluster_metric' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterMetricList(list *ClusterMetricList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterMetricListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterMetricList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterMetricList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterMetricList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterMetricList function,
+// using the default decoding options.
+func (l *ClusterMetricList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterMetricList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterMetricList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_metric' type.
-func (d clusterMetricListData) unwrap() (list *ClusterMetricList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterMetricList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterMetricList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterMetricList writes a list of values of the 'cluster_metric' type to the given
+// stream, as a JSON array.
+func writeClusterMetricList(list *ClusterMetricList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterMetric, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterMetric(item, stream)
 	}
-	list = new(ClusterMetricList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterMetricList reads a list of values of the 'cluster_metric' type from the given
+// iterator, as a JSON array.
+func readClusterMetricList(iterator *helpers.Iterator) *ClusterMetricList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterMetricList)
+	list.items = []*ClusterMetric{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterMetric(iterator))
+	}
+	return list
 }
@@ -20,83 +20,134 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	time "time"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterMetricData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_metric'.
-type clusterMetricData struct {
-	UpdatedTimestamp *time.Time "json:\"updated_timestamp,omitempty\""
-	Total            *valueData "json:\"total,omitempty\""
-	Used             *valueData "json:\"used,omitempty\""
-}
-
 // MarshalClusterMetric writes a value of the 'cluster_metric' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterMetric(object *ClusterMetric, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterMetric(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_metric'
-// value to a JSON document.
-func (o *ClusterMetric) wrap() (data *clusterMetricData, err error) {
-	if o == nil {
+// writeClusterMetric writes a value of the 'cluster_metric' type to the given stream.
+func writeClusterMetric(object *ClusterMetric, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterMetricData)
-	data.UpdatedTimestamp = o.updatedTimestamp
-	data.Total, err = o.total.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.updatedTimestamp != nil {
+		stream.WriteObjectField("updated_timestamp")
+		stream.WriteTime(*object.updatedTimestamp)
 	}
-	data.Used, err = o.used.wrap()
-	if err != nil {
-		return
+	if object.total != nil {
+		stream.WriteObjectField("total")
+		writeValue(object.total, stream)
 	}
-	return
+	if object.used != nil {
+		stream.WriteObjectField("used")
+		writeValue(object.used, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterMetric reads a value of the 'cluster_metric' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterMetric(source interface{}) (object *ClusterMetric, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterMetric(source interface{}, options ...helpers.DecodingOptions) (object *ClusterMetric, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterMetricData)
-	err = decoder.Decode(data)
+	object = readClusterMetric(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterMetric)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_metric' type.
-func (d *clusterMetricData) unwrap() (object *ClusterMetric, err error) {
-	if d == nil {
-		return
+// readClusterMetric reads a value of the 'cluster_metric' type from the given iterator.
+func readClusterMetric(iterator *helpers.Iterator) *ClusterMetric {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterMetric)
-	object.updatedTimestamp = d.UpdatedTimestamp
-	object.total, err = d.Total.unwrap()
+	object := new(ClusterMetric)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "updated_timestamp":
+			if !iterator.ReadNull() {
+				value := iterator.ReadTime()
+				object.updatedTimestamp = &value
+			}
+		case "total":
+			object.total = readValue(iterator)
+		case "used":
+			object.used = readValue(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterMetric function.
+func (o *ClusterMetric) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterMetric(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterMetric function, using the
+// default decoding options.
+func (o *ClusterMetric) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterMetric(data)
 	if err != nil {
-		return
+		return err
+	}
+	if object == nil {
+		*o = ClusterMetric{}
+		return nil
 	}
-	object.used, err = d.Used.unwrap()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterMetric) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	return
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterMetric) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
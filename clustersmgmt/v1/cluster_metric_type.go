@@ -20,7 +20,10 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"fmt"
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterMetric represents the values of the 'cluster_metric' type.
@@ -31,6 +34,7 @@ type ClusterMetric struct {
 	updatedTimestamp *time.Time
 	total            *Value
 	used             *Value
+	extra            map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -41,6 +45,59 @@ func (o *ClusterMetric) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_metric' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterMetric) Metadata() *helpers.TypeMetadata {
+	return clusterMetricMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterMetric) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterMetric(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterMetric) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterMetric(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterMetric) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterMetric(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterMetric) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterMetric(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_metric' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterMetric) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // UpdatedTimestamp returns the value of the 'updated_timestamp' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -114,6 +171,135 @@ func (o *ClusterMetric) GetUsed() (value *Value, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_metric' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterMetric) Copy() *ClusterMetric {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterMetric)
+	if o.updatedTimestamp != nil {
+		result.updatedTimestamp = new(time.Time)
+		*result.updatedTimestamp = *o.updatedTimestamp
+	}
+	result.total = o.total.Copy()
+	result.used = o.used.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_metric' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterMetric) Equal(other *ClusterMetric) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.updatedTimestamp == nil) != (other.updatedTimestamp == nil) ||
+		(o.updatedTimestamp != nil && !o.updatedTimestamp.Equal(*other.updatedTimestamp)) {
+		return false
+	}
+	if !o.total.Equal(other.total) {
+		return false
+	}
+	if !o.used.Equal(other.used) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_metric' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterMetric) Diff(other *ClusterMetric) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_metric' object and the given one, using the given path as prefix.
+func (o *ClusterMetric) diff(path string, other *ClusterMetric, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.updatedTimestamp == nil) != (other.updatedTimestamp == nil) ||
+		(o.updatedTimestamp != nil && !o.updatedTimestamp.Equal(*other.updatedTimestamp)) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "updated_timestamp"),
+			Old:  helpers.Value(o.updatedTimestamp),
+			New:  helpers.Value(other.updatedTimestamp),
+		})
+	}
+	result = o.total.diff(helpers.Path(path, "total"), other.total, result)
+	result = o.used.diff(helpers.Path(path, "used"), other.used, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_metric' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterMetric) Changes(modified *ClusterMetric) *ClusterMetric {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterMetric)
+	if modified.updatedTimestamp != nil && (o.updatedTimestamp == nil || !o.updatedTimestamp.Equal(*modified.updatedTimestamp)) {
+		result.updatedTimestamp = new(time.Time)
+		*result.updatedTimestamp = *modified.updatedTimestamp
+	}
+	if modified.total != nil && !o.total.Equal(modified.total) {
+		result.total = o.total.Changes(modified.total)
+	}
+	if modified.used != nil && !o.used.Equal(modified.used) {
+		result.used = o.used.Changes(modified.used)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterMetric prints a value of the 'cluster_metric' type to the given printer.
+func printClusterMetric(object *ClusterMetric, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterMetric")
+	if object.updatedTimestamp != nil {
+		printer.PrintObjectField("UpdatedTimestamp")
+		printer.PrintTime(*object.updatedTimestamp)
+	}
+	if object.total != nil {
+		printer.PrintObjectField("Total")
+		printValue(object.total, printer)
+	}
+	if object.used != nil {
+		printer.PrintObjectField("Used")
+		printValue(object.used, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterMetricList is a list of values of the 'cluster_metric' type.
 type ClusterMetricList struct {
 	items []*ClusterMetric
@@ -185,3 +371,105 @@ func (l *ClusterMetricList) Range(f func(index int, item *ClusterMetric) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterMetricList) Copy() *ClusterMetricList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterMetricList)
+	if l.items != nil {
+		result.items = make([]*ClusterMetric, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterMetricList) Equal(other *ClusterMetricList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterMetricList) Diff(other *ClusterMetricList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterMetricList) diff(path string, other *ClusterMetricList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterMetricList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterMetricList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterMetricList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterMetricList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterMetricList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterMetricList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterMetricList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterMetricList(l, printer)
+	}
+}
+
+// printClusterMetricList prints a list of values of the 'cluster_metric' type to the given printer.
+func printClusterMetricList(list *ClusterMetricList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterMetric(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterMetricsBuilder contains the data and logic needed to build 'cluster_metrics' objects.
 //
 // Cluster metrics received via telemetry.
@@ -29,6 +33,7 @@ type ClusterMetricsBuilder struct {
 	computeNodesCPU    *ClusterMetricBuilder
 	computeNodesMemory *ClusterMetricBuilder
 	nodes              *ClusterNodesBuilder
+	extra              map[string]interface{}
 }
 
 // NewClusterMetrics creates a new builder of 'cluster_metrics' objects.
@@ -36,6 +41,36 @@ func NewClusterMetrics() *ClusterMetricsBuilder {
 	return new(ClusterMetricsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_metrics' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterMetrics) Builder() *ClusterMetricsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterMetricsBuilder)
+	if o.cpu != nil {
+		b.cpu = o.cpu.Builder()
+	}
+	if o.memory != nil {
+		b.memory = o.memory.Builder()
+	}
+	if o.storage != nil {
+		b.storage = o.storage.Builder()
+	}
+	if o.computeNodesCPU != nil {
+		b.computeNodesCPU = o.computeNodesCPU.Builder()
+	}
+	if o.computeNodesMemory != nil {
+		b.computeNodesMemory = o.computeNodesMemory.Builder()
+	}
+	if o.nodes != nil {
+		b.nodes = o.nodes.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // CPU sets the value of the 'CPU' attribute
 // to the given value.
 //
@@ -95,9 +130,17 @@ func (b *ClusterMetricsBuilder) Nodes(value *ClusterNodesBuilder) *ClusterMetric
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_metrics' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterMetricsBuilder) Extra(value map[string]interface{}) *ClusterMetricsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_metrics' object using the configuration stored in the builder.
 func (b *ClusterMetricsBuilder) Build() (object *ClusterMetrics, err error) {
 	object = new(ClusterMetrics)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.cpu != nil {
 		object.cpu, err = b.cpu.Build()
 		if err != nil {
@@ -30,6 +30,19 @@ func NewClusterMetricsList() *ClusterMetricsListBuilder {
 	return new(ClusterMetricsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterMetricsList) Builder() *ClusterMetricsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterMetricsListBuilder)
+	b.items = make([]*ClusterMetricsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterMetricsListBuilder) Items(values ...*ClusterMetricsBuilder) *ClusterMetricsListBuilder {
 	b.items = make([]*ClusterMetricsBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterMetricsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_metrics'.
-type clusterMetricsListData []*clusterMetricsData
-
 // UnmarshalClusterMetricsList reads a list of values of the 'cluster_metrics'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterMetricsList(source interface{}) (list *ClusterMetricsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterMetricsList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterMetricsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterMetricsListData
-	err = decoder.Decode(&data)
+	list = readClusterMetricsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_metrics' value to a JSON document.
-func (l *ClusterMetricsList) wrap() (data clusterMetricsListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterMetricsList writes a list of values of the 'cluster_metrics' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterMetricsList(list *ClusterMetricsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterMetricsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterMetricsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterMetricsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterMetricsList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterMetricsList function,
+// using the default decoding options.
+func (l *ClusterMetricsList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterMetricsList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterMetricsList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_metrics' type.
-func (d clusterMetricsListData) unwrap() (list *ClusterMetricsList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterMetricsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterMetricsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterMetricsList writes a list of values of the 'cluster_metrics' type to the given
+// stream, as a JSON array.
+func writeClusterMetricsList(list *ClusterMetricsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterMetrics, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterMetrics(item, stream)
 	}
-	list = new(ClusterMetricsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterMetricsList reads a list of values of the 'cluster_metrics' type from the given
+// iterator, as a JSON array.
+func readClusterMetricsList(iterator *helpers.Iterator) *ClusterMetricsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterMetricsList)
+	list.items = []*ClusterMetrics{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterMetrics(iterator))
+	}
+	return list
 }
@@ -23,111 +23,146 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterMetricsData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_metrics'.
-type clusterMetricsData struct {
-	CPU                *clusterMetricData "json:\"cpu,omitempty\""
-	Memory             *clusterMetricData "json:\"memory,omitempty\""
-	Storage            *clusterMetricData "json:\"storage,omitempty\""
-	ComputeNodesCPU    *clusterMetricData "json:\"compute_nodes_cpu,omitempty\""
-	ComputeNodesMemory *clusterMetricData "json:\"compute_nodes_memory,omitempty\""
-	Nodes              *clusterNodesData  "json:\"nodes,omitempty\""
-}
-
 // MarshalClusterMetrics writes a value of the 'cluster_metrics' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterMetrics(object *ClusterMetrics, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterMetrics(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_metrics'
-// value to a JSON document.
-func (o *ClusterMetrics) wrap() (data *clusterMetricsData, err error) {
-	if o == nil {
+// writeClusterMetrics writes a value of the 'cluster_metrics' type to the given stream.
+func writeClusterMetrics(object *ClusterMetrics, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterMetricsData)
-	data.CPU, err = o.cpu.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.cpu != nil {
+		stream.WriteObjectField("cpu")
+		writeClusterMetric(object.cpu, stream)
 	}
-	data.Memory, err = o.memory.wrap()
-	if err != nil {
-		return
+	if object.memory != nil {
+		stream.WriteObjectField("memory")
+		writeClusterMetric(object.memory, stream)
 	}
-	data.Storage, err = o.storage.wrap()
-	if err != nil {
-		return
+	if object.storage != nil {
+		stream.WriteObjectField("storage")
+		writeClusterMetric(object.storage, stream)
 	}
-	data.ComputeNodesCPU, err = o.computeNodesCPU.wrap()
-	if err != nil {
-		return
+	if object.computeNodesCPU != nil {
+		stream.WriteObjectField("compute_nodes_cpu")
+		writeClusterMetric(object.computeNodesCPU, stream)
 	}
-	data.ComputeNodesMemory, err = o.computeNodesMemory.wrap()
-	if err != nil {
-		return
+	if object.computeNodesMemory != nil {
+		stream.WriteObjectField("compute_nodes_memory")
+		writeClusterMetric(object.computeNodesMemory, stream)
 	}
-	data.Nodes, err = o.nodes.wrap()
-	if err != nil {
-		return
+	if object.nodes != nil {
+		stream.WriteObjectField("nodes")
+		writeClusterNodes(object.nodes, stream)
 	}
-	return
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterMetrics reads a value of the 'cluster_metrics' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterMetrics(source interface{}) (object *ClusterMetrics, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterMetrics(source interface{}, options ...helpers.DecodingOptions) (object *ClusterMetrics, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterMetricsData)
-	err = decoder.Decode(data)
+	object = readClusterMetrics(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterMetrics)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_metrics' type.
-func (d *clusterMetricsData) unwrap() (object *ClusterMetrics, err error) {
-	if d == nil {
-		return
-	}
-	object = new(ClusterMetrics)
-	object.cpu, err = d.CPU.unwrap()
-	if err != nil {
-		return
-	}
-	object.memory, err = d.Memory.unwrap()
+// readClusterMetrics reads a value of the 'cluster_metrics' type from the given iterator.
+func readClusterMetrics(iterator *helpers.Iterator) *ClusterMetrics {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(ClusterMetrics)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "cpu":
+			object.cpu = readClusterMetric(iterator)
+		case "memory":
+			object.memory = readClusterMetric(iterator)
+		case "storage":
+			object.storage = readClusterMetric(iterator)
+		case "compute_nodes_cpu":
+			object.computeNodesCPU = readClusterMetric(iterator)
+		case "compute_nodes_memory":
+			object.computeNodesMemory = readClusterMetric(iterator)
+		case "nodes":
+			object.nodes = readClusterNodes(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterMetrics function.
+func (o *ClusterMetrics) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterMetrics(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterMetrics function, using the
+// default decoding options.
+func (o *ClusterMetrics) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterMetrics(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.storage, err = d.Storage.unwrap()
-	if err != nil {
-		return
+	if object == nil {
+		*o = ClusterMetrics{}
+		return nil
 	}
-	object.computeNodesCPU, err = d.ComputeNodesCPU.unwrap()
-	if err != nil {
-		return
-	}
-	object.computeNodesMemory, err = d.ComputeNodesMemory.unwrap()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterMetrics) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.nodes, err = d.Nodes.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterMetrics) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterMetrics represents the values of the 'cluster_metrics' type.
 //
 // Cluster metrics received via telemetry.
@@ -29,6 +35,7 @@ type ClusterMetrics struct {
 	computeNodesCPU    *ClusterMetric
 	computeNodesMemory *ClusterMetric
 	nodes              *ClusterNodes
+	extra              map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -42,6 +49,59 @@ func (o *ClusterMetrics) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_metrics' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterMetrics) Metadata() *helpers.TypeMetadata {
+	return clusterMetricsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterMetrics) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterMetrics(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterMetrics) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterMetrics(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterMetrics) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterMetrics(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterMetrics) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterMetrics(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_metrics' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterMetrics) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // CPU returns the value of the 'CPU' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -184,6 +244,159 @@ func (o *ClusterMetrics) GetNodes() (value *ClusterNodes, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_metrics' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterMetrics) Copy() *ClusterMetrics {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterMetrics)
+	result.cpu = o.cpu.Copy()
+	result.memory = o.memory.Copy()
+	result.storage = o.storage.Copy()
+	result.computeNodesCPU = o.computeNodesCPU.Copy()
+	result.computeNodesMemory = o.computeNodesMemory.Copy()
+	result.nodes = o.nodes.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_metrics' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterMetrics) Equal(other *ClusterMetrics) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if !o.cpu.Equal(other.cpu) {
+		return false
+	}
+	if !o.memory.Equal(other.memory) {
+		return false
+	}
+	if !o.storage.Equal(other.storage) {
+		return false
+	}
+	if !o.computeNodesCPU.Equal(other.computeNodesCPU) {
+		return false
+	}
+	if !o.computeNodesMemory.Equal(other.computeNodesMemory) {
+		return false
+	}
+	if !o.nodes.Equal(other.nodes) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_metrics' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterMetrics) Diff(other *ClusterMetrics) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_metrics' object and the given one, using the given path as prefix.
+func (o *ClusterMetrics) diff(path string, other *ClusterMetrics, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	result = o.cpu.diff(helpers.Path(path, "cpu"), other.cpu, result)
+	result = o.memory.diff(helpers.Path(path, "memory"), other.memory, result)
+	result = o.storage.diff(helpers.Path(path, "storage"), other.storage, result)
+	result = o.computeNodesCPU.diff(helpers.Path(path, "compute_nodes_cpu"), other.computeNodesCPU, result)
+	result = o.computeNodesMemory.diff(helpers.Path(path, "compute_nodes_memory"), other.computeNodesMemory, result)
+	result = o.nodes.diff(helpers.Path(path, "nodes"), other.nodes, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_metrics' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterMetrics) Changes(modified *ClusterMetrics) *ClusterMetrics {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterMetrics)
+	if modified.cpu != nil && !o.cpu.Equal(modified.cpu) {
+		result.cpu = o.cpu.Changes(modified.cpu)
+	}
+	if modified.memory != nil && !o.memory.Equal(modified.memory) {
+		result.memory = o.memory.Changes(modified.memory)
+	}
+	if modified.storage != nil && !o.storage.Equal(modified.storage) {
+		result.storage = o.storage.Changes(modified.storage)
+	}
+	if modified.computeNodesCPU != nil && !o.computeNodesCPU.Equal(modified.computeNodesCPU) {
+		result.computeNodesCPU = o.computeNodesCPU.Changes(modified.computeNodesCPU)
+	}
+	if modified.computeNodesMemory != nil && !o.computeNodesMemory.Equal(modified.computeNodesMemory) {
+		result.computeNodesMemory = o.computeNodesMemory.Changes(modified.computeNodesMemory)
+	}
+	if modified.nodes != nil && !o.nodes.Equal(modified.nodes) {
+		result.nodes = o.nodes.Changes(modified.nodes)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterMetrics prints a value of the 'cluster_metrics' type to the given printer.
+func printClusterMetrics(object *ClusterMetrics, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterMetrics")
+	if object.cpu != nil {
+		printer.PrintObjectField("CPU")
+		printClusterMetric(object.cpu, printer)
+	}
+	if object.memory != nil {
+		printer.PrintObjectField("Memory")
+		printClusterMetric(object.memory, printer)
+	}
+	if object.storage != nil {
+		printer.PrintObjectField("Storage")
+		printClusterMetric(object.storage, printer)
+	}
+	if object.computeNodesCPU != nil {
+		printer.PrintObjectField("ComputeNodesCPU")
+		printClusterMetric(object.computeNodesCPU, printer)
+	}
+	if object.computeNodesMemory != nil {
+		printer.PrintObjectField("ComputeNodesMemory")
+		printClusterMetric(object.computeNodesMemory, printer)
+	}
+	if object.nodes != nil {
+		printer.PrintObjectField("Nodes")
+		printClusterNodes(object.nodes, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterMetricsList is a list of values of the 'cluster_metrics' type.
 type ClusterMetricsList struct {
 	items []*ClusterMetrics
@@ -255,3 +468,105 @@ func (l *ClusterMetricsList) Range(f func(index int, item *ClusterMetrics) bool)
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterMetricsList) Copy() *ClusterMetricsList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterMetricsList)
+	if l.items != nil {
+		result.items = make([]*ClusterMetrics, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterMetricsList) Equal(other *ClusterMetricsList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterMetricsList) Diff(other *ClusterMetricsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterMetricsList) diff(path string, other *ClusterMetricsList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterMetricsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterMetricsList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterMetricsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterMetricsList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterMetricsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterMetricsList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterMetricsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterMetricsList(l, printer)
+	}
+}
+
+// printClusterMetricsList prints a list of values of the 'cluster_metrics' type to the given printer.
+func printClusterMetricsList(list *ClusterMetricsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterMetrics(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterNodesBuilder contains the data and logic needed to build 'cluster_nodes' objects.
 //
 // Counts of different classes of nodes inside a cluster.
@@ -27,6 +31,7 @@ type ClusterNodesBuilder struct {
 	master  *int
 	infra   *int
 	compute *int
+	extra   map[string]interface{}
 }
 
 // NewClusterNodes creates a new builder of 'cluster_nodes' objects.
@@ -34,6 +39,22 @@ func NewClusterNodes() *ClusterNodesBuilder {
 	return new(ClusterNodesBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_nodes' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterNodes) Builder() *ClusterNodesBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterNodesBuilder)
+	b.total = o.total
+	b.master = o.master
+	b.infra = o.infra
+	b.compute = o.compute
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Total sets the value of the 'total' attribute
 // to the given value.
 //
@@ -70,9 +91,17 @@ func (b *ClusterNodesBuilder) Compute(value int) *ClusterNodesBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_nodes' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterNodesBuilder) Extra(value map[string]interface{}) *ClusterNodesBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_nodes' object using the configuration stored in the builder.
 func (b *ClusterNodesBuilder) Build() (object *ClusterNodes, err error) {
 	object = new(ClusterNodes)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.total != nil {
 		object.total = b.total
 	}
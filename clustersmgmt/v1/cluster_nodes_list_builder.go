@@ -30,6 +30,19 @@ func NewClusterNodesList() *ClusterNodesListBuilder {
 	return new(ClusterNodesListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterNodesList) Builder() *ClusterNodesListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterNodesListBuilder)
+	b.items = make([]*ClusterNodesBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterNodesListBuilder) Items(values ...*ClusterNodesBuilder) *ClusterNodesListBuilder {
 	b.items = make([]*ClusterNodesBuilder, len(values))
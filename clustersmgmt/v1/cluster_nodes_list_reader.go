@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterNodesListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_nodes'.
-type clusterNodesListData []*clusterNodesData
-
 // UnmarshalClusterNodesList reads a list of values of the 'cluster_nodes'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterNodesList(source interface{}) (list *ClusterNodesList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterNodesList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterNodesList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterNodesListData
-	err = decoder.Decode(&data)
+	list = readClusterNodesList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_nodes' value to a JSON document.
-func (l *ClusterNodesList) wrap() (data clusterNodesListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterNodesList writes a list of values of the 'cluster_nodes' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterNodesList(list *ClusterNodesList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterNodesListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterNodesList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterNodesList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterNodesList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterNodesList function,
+// using the default decoding options.
+func (l *ClusterNodesList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterNodesList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterNodesList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_nodes' type.
-func (d clusterNodesListData) unwrap() (list *ClusterNodesList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterNodesList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterNodesList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterNodesList writes a list of values of the 'cluster_nodes' type to the given
+// stream, as a JSON array.
+func writeClusterNodesList(list *ClusterNodesList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterNodes, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterNodes(item, stream)
 	}
-	list = new(ClusterNodesList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterNodesList reads a list of values of the 'cluster_nodes' type from the given
+// iterator, as a JSON array.
+func readClusterNodesList(iterator *helpers.Iterator) *ClusterNodesList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterNodesList)
+	list.items = []*ClusterNodes{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterNodes(iterator))
+	}
+	return list
 }
@@ -23,69 +23,146 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterNodesData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_nodes'.
-type clusterNodesData struct {
-	Total   *int "json:\"total,omitempty\""
-	Master  *int "json:\"master,omitempty\""
-	Infra   *int "json:\"infra,omitempty\""
-	Compute *int "json:\"compute,omitempty\""
-}
-
 // MarshalClusterNodes writes a value of the 'cluster_nodes' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterNodes(object *ClusterNodes, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterNodes(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_nodes'
-// value to a JSON document.
-func (o *ClusterNodes) wrap() (data *clusterNodesData, err error) {
-	if o == nil {
+// writeClusterNodes writes a value of the 'cluster_nodes' type to the given stream.
+func writeClusterNodes(object *ClusterNodes, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterNodesData)
-	data.Total = o.total
-	data.Master = o.master
-	data.Infra = o.infra
-	data.Compute = o.compute
-	return
+	stream.WriteObjectStart()
+	if object.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*object.total)
+	}
+	if object.master != nil {
+		stream.WriteObjectField("master")
+		stream.WriteInt(*object.master)
+	}
+	if object.infra != nil {
+		stream.WriteObjectField("infra")
+		stream.WriteInt(*object.infra)
+	}
+	if object.compute != nil {
+		stream.WriteObjectField("compute")
+		stream.WriteInt(*object.compute)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterNodes reads a value of the 'cluster_nodes' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterNodes(source interface{}) (object *ClusterNodes, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterNodes(source interface{}, options ...helpers.DecodingOptions) (object *ClusterNodes, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterNodesData)
-	err = decoder.Decode(data)
+	object = readClusterNodes(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterNodes)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_nodes' type.
-func (d *clusterNodesData) unwrap() (object *ClusterNodes, err error) {
-	if d == nil {
-		return
+// readClusterNodes reads a value of the 'cluster_nodes' type from the given iterator.
+func readClusterNodes(iterator *helpers.Iterator) *ClusterNodes {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterNodes)
-	object.total = d.Total
-	object.master = d.Master
-	object.infra = d.Infra
-	object.compute = d.Compute
-	return
+	object := new(ClusterNodes)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "total":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.total = &value
+			}
+		case "master":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.master = &value
+			}
+		case "infra":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.infra = &value
+			}
+		case "compute":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				object.compute = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterNodes function.
+func (o *ClusterNodes) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterNodes(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterNodes function, using the
+// default decoding options.
+func (o *ClusterNodes) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterNodes(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterNodes{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterNodes) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterNodes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
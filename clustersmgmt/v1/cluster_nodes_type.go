@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterNodes represents the values of the 'cluster_nodes' type.
 //
 // Counts of different classes of nodes inside a cluster.
@@ -27,6 +33,7 @@ type ClusterNodes struct {
 	master  *int
 	infra   *int
 	compute *int
+	extra   map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +45,59 @@ func (o *ClusterNodes) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_nodes' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterNodes) Metadata() *helpers.TypeMetadata {
+	return clusterNodesMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterNodes) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterNodes(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterNodes) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterNodes(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterNodes) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterNodes(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterNodes) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterNodes(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_nodes' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterNodes) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Total returns the value of the 'total' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -130,6 +190,183 @@ func (o *ClusterNodes) GetCompute() (value int, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_nodes' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterNodes) Copy() *ClusterNodes {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterNodes)
+	if o.total != nil {
+		result.total = new(int)
+		*result.total = *o.total
+	}
+	if o.master != nil {
+		result.master = new(int)
+		*result.master = *o.master
+	}
+	if o.infra != nil {
+		result.infra = new(int)
+		*result.infra = *o.infra
+	}
+	if o.compute != nil {
+		result.compute = new(int)
+		*result.compute = *o.compute
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_nodes' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterNodes) Equal(other *ClusterNodes) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.total == nil) != (other.total == nil) ||
+		(o.total != nil && *o.total != *other.total) {
+		return false
+	}
+	if (o.master == nil) != (other.master == nil) ||
+		(o.master != nil && *o.master != *other.master) {
+		return false
+	}
+	if (o.infra == nil) != (other.infra == nil) ||
+		(o.infra != nil && *o.infra != *other.infra) {
+		return false
+	}
+	if (o.compute == nil) != (other.compute == nil) ||
+		(o.compute != nil && *o.compute != *other.compute) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_nodes' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterNodes) Diff(other *ClusterNodes) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_nodes' object and the given one, using the given path as prefix.
+func (o *ClusterNodes) diff(path string, other *ClusterNodes, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.total == nil) != (other.total == nil) ||
+		(o.total != nil && *o.total != *other.total) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "total"),
+			Old:  helpers.Value(o.total),
+			New:  helpers.Value(other.total),
+		})
+	}
+	if (o.master == nil) != (other.master == nil) ||
+		(o.master != nil && *o.master != *other.master) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "master"),
+			Old:  helpers.Value(o.master),
+			New:  helpers.Value(other.master),
+		})
+	}
+	if (o.infra == nil) != (other.infra == nil) ||
+		(o.infra != nil && *o.infra != *other.infra) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "infra"),
+			Old:  helpers.Value(o.infra),
+			New:  helpers.Value(other.infra),
+		})
+	}
+	if (o.compute == nil) != (other.compute == nil) ||
+		(o.compute != nil && *o.compute != *other.compute) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "compute"),
+			Old:  helpers.Value(o.compute),
+			New:  helpers.Value(other.compute),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_nodes' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterNodes) Changes(modified *ClusterNodes) *ClusterNodes {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterNodes)
+	if modified.total != nil && (o.total == nil || *o.total != *modified.total) {
+		result.total = new(int)
+		*result.total = *modified.total
+	}
+	if modified.master != nil && (o.master == nil || *o.master != *modified.master) {
+		result.master = new(int)
+		*result.master = *modified.master
+	}
+	if modified.infra != nil && (o.infra == nil || *o.infra != *modified.infra) {
+		result.infra = new(int)
+		*result.infra = *modified.infra
+	}
+	if modified.compute != nil && (o.compute == nil || *o.compute != *modified.compute) {
+		result.compute = new(int)
+		*result.compute = *modified.compute
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterNodes prints a value of the 'cluster_nodes' type to the given printer.
+func printClusterNodes(object *ClusterNodes, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterNodes")
+	if object.total != nil {
+		printer.PrintObjectField("Total")
+		printer.PrintInt(*object.total)
+	}
+	if object.master != nil {
+		printer.PrintObjectField("Master")
+		printer.PrintInt(*object.master)
+	}
+	if object.infra != nil {
+		printer.PrintObjectField("Infra")
+		printer.PrintInt(*object.infra)
+	}
+	if object.compute != nil {
+		printer.PrintObjectField("Compute")
+		printer.PrintInt(*object.compute)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterNodesList is a list of values of the 'cluster_nodes' type.
 type ClusterNodesList struct {
 	items []*ClusterNodes
@@ -201,3 +438,105 @@ func (l *ClusterNodesList) Range(f func(index int, item *ClusterNodes) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterNodesList) Copy() *ClusterNodesList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterNodesList)
+	if l.items != nil {
+		result.items = make([]*ClusterNodes, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterNodesList) Equal(other *ClusterNodesList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterNodesList) Diff(other *ClusterNodesList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterNodesList) diff(path string, other *ClusterNodesList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterNodesList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterNodesList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterNodesList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterNodesList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterNodesList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterNodesList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterNodesList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterNodesList(l, printer)
+	}
+}
+
+// printClusterNodesList prints a list of values of the 'cluster_nodes' type to the given printer.
+func printClusterNodesList(list *ClusterNodesList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterNodes(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes. Nil objects are valid.
+func (o *ClusterNodes) validate(path string, validator *helpers.Validator) {
+	if o == nil {
+		return
+	}
+	counts := []struct {
+		name  string
+		value *int
+	}{
+		{"total", o.total},
+		{"master", o.master},
+		{"infra", o.infra},
+		{"compute", o.compute},
+	}
+	for _, count := range counts {
+		if count.value != nil && *count.value < 0 {
+			validator.Report(helpers.Path(path, count.name), "%d is negative", *count.value)
+		}
+	}
+}
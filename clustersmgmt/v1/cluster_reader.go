@@ -20,251 +20,319 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-	time "time"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster'.
-type clusterData struct {
-	Kind                *string                       "json:\"kind,omitempty\""
-	ID                  *string                       "json:\"id,omitempty\""
-	HREF                *string                       "json:\"href,omitempty\""
-	Name                *string                       "json:\"name,omitempty\""
-	Flavour             *flavourData                  "json:\"flavour,omitempty\""
-	Console             *clusterConsoleData           "json:\"console,omitempty\""
-	MultiAZ             *bool                         "json:\"multi_az,omitempty\""
-	Nodes               *clusterNodesData             "json:\"nodes,omitempty\""
-	API                 *clusterAPIData               "json:\"api,omitempty\""
-	Region              *cloudRegionData              "json:\"region,omitempty\""
-	DisplayName         *string                       "json:\"display_name,omitempty\""
-	DNS                 *dnsData                      "json:\"dns,omitempty\""
-	Properties          map[string]string             "json:\"properties,omitempty\""
-	State               *ClusterState                 "json:\"state,omitempty\""
-	Managed             *bool                         "json:\"managed,omitempty\""
-	ExternalID          *string                       "json:\"external_id,omitempty\""
-	AWS                 *awsData                      "json:\"aws,omitempty\""
-	Network             *networkData                  "json:\"network,omitempty\""
-	CreationTimestamp   *time.Time                    "json:\"creation_timestamp,omitempty\""
-	ExpirationTimestamp *time.Time                    "json:\"expiration_timestamp,omitempty\""
-	CloudProvider       *cloudProviderData            "json:\"cloud_provider,omitempty\""
-	OpenshiftVersion    *string                       "json:\"openshift_version,omitempty\""
-	Subscription        *subscriptionData             "json:\"subscription,omitempty\""
-	Groups              *groupListLinkData            "json:\"groups,omitempty\""
-	Creator             *string                       "json:\"creator,omitempty\""
-	Version             *versionData                  "json:\"version,omitempty\""
-	IdentityProviders   *identityProviderListLinkData "json:\"identity_providers,omitempty\""
-	Metrics             *clusterMetricsData           "json:\"metrics,omitempty\""
-}
-
 // MarshalCluster writes a value of the 'cluster' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalCluster(object *Cluster, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeCluster(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster'
-// value to a JSON document.
-func (o *Cluster) wrap() (data *clusterData, err error) {
-	if o == nil {
+// writeCluster writes a value of the 'cluster' type to the given stream.
+func writeCluster(object *Cluster, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = ClusterLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(ClusterLinkKind)
 	} else {
-		*data.Kind = ClusterKind
+		stream.WriteString(ClusterKind)
 	}
-	data.Name = o.name
-	data.Flavour, err = o.flavour.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Console, err = o.console.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	data.MultiAZ = o.multiAZ
-	data.Nodes, err = o.nodes.wrap()
-	if err != nil {
-		return
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
 	}
-	data.API, err = o.api.wrap()
-	if err != nil {
-		return
+	if object.flavour != nil {
+		stream.WriteObjectField("flavour")
+		writeFlavour(object.flavour, stream)
 	}
-	data.Region, err = o.region.wrap()
-	if err != nil {
-		return
+	if object.console != nil {
+		stream.WriteObjectField("console")
+		writeClusterConsole(object.console, stream)
 	}
-	data.DisplayName = o.displayName
-	data.DNS, err = o.dns.wrap()
-	if err != nil {
-		return
+	if object.multiAZ != nil {
+		stream.WriteObjectField("multi_az")
+		stream.WriteBool(*object.multiAZ)
 	}
-	data.Properties = o.properties
-	data.State = o.state
-	data.Managed = o.managed
-	data.ExternalID = o.externalID
-	data.AWS, err = o.aws.wrap()
-	if err != nil {
-		return
+	if object.nodes != nil {
+		stream.WriteObjectField("nodes")
+		writeClusterNodes(object.nodes, stream)
 	}
-	data.Network, err = o.network.wrap()
-	if err != nil {
-		return
+	if object.api != nil {
+		stream.WriteObjectField("api")
+		writeClusterAPI(object.api, stream)
 	}
-	data.CreationTimestamp = o.creationTimestamp
-	data.ExpirationTimestamp = o.expirationTimestamp
-	data.CloudProvider, err = o.cloudProvider.wrap()
-	if err != nil {
-		return
+	if object.region != nil {
+		stream.WriteObjectField("region")
+		writeCloudRegion(object.region, stream)
 	}
-	data.OpenshiftVersion = o.openshiftVersion
-	data.Subscription, err = o.subscription.wrap()
-	if err != nil {
-		return
+	if object.displayName != nil {
+		stream.WriteObjectField("display_name")
+		stream.WriteString(*object.displayName)
 	}
-	data.Groups, err = o.groups.wrapLink()
-	if err != nil {
-		return
+	if object.dns != nil {
+		stream.WriteObjectField("dns")
+		writeDNS(object.dns, stream)
 	}
-	data.Creator = o.creator
-	data.Version, err = o.version.wrap()
-	if err != nil {
-		return
+	if len(object.properties) > 0 {
+		stream.WriteObjectField("properties")
+		stream.WriteStringMap(object.properties)
 	}
-	data.IdentityProviders, err = o.identityProviders.wrapLink()
-	if err != nil {
-		return
+	if object.state != nil {
+		stream.WriteObjectField("state")
+		stream.WriteString(string(*object.state))
 	}
-	data.Metrics, err = o.metrics.wrap()
-	if err != nil {
-		return
+	if object.managed != nil {
+		stream.WriteObjectField("managed")
+		stream.WriteBool(*object.managed)
 	}
-	return
-}
-
-// UnmarshalCluster reads a value of the 'cluster' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalCluster(source interface{}) (object *Cluster, err error) {
-	decoder, err := helpers.NewDecoder(source)
-	if err != nil {
-		return
+	if object.externalID != nil {
+		stream.WriteObjectField("external_id")
+		stream.WriteString(*object.externalID)
 	}
-	data := new(clusterData)
-	err = decoder.Decode(data)
-	if err != nil {
-		return
+	if object.aws != nil {
+		stream.WriteObjectField("aws")
+		writeAWS(object.aws, stream)
 	}
-	object, err = data.unwrap()
-	return
-}
-
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster' type.
-func (d *clusterData) unwrap() (object *Cluster, err error) {
-	if d == nil {
-		return
+	if object.network != nil {
+		stream.WriteObjectField("network")
+		writeNetwork(object.network, stream)
 	}
-	object = new(Cluster)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterKind:
-			object.link = false
-		case ClusterLinkKind:
-			object.link = true
-		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterKind,
-				ClusterLinkKind,
-				*d.Kind,
-			)
-			return
-		}
+	if object.creationTimestamp != nil {
+		stream.WriteObjectField("creation_timestamp")
+		stream.WriteTime(*object.creationTimestamp)
 	}
-	object.name = d.Name
-	object.flavour, err = d.Flavour.unwrap()
-	if err != nil {
-		return
+	if object.expirationTimestamp != nil {
+		stream.WriteObjectField("expiration_timestamp")
+		stream.WriteTime(*object.expirationTimestamp)
 	}
-	object.console, err = d.Console.unwrap()
-	if err != nil {
-		return
+	if object.cloudProvider != nil {
+		stream.WriteObjectField("cloud_provider")
+		writeCloudProvider(object.cloudProvider, stream)
 	}
-	object.multiAZ = d.MultiAZ
-	object.nodes, err = d.Nodes.unwrap()
-	if err != nil {
-		return
+	if object.openshiftVersion != nil {
+		stream.WriteObjectField("openshift_version")
+		stream.WriteString(*object.openshiftVersion)
 	}
-	object.api, err = d.API.unwrap()
-	if err != nil {
-		return
+	if object.subscription != nil {
+		stream.WriteObjectField("subscription")
+		writeSubscription(object.subscription, stream)
 	}
-	object.region, err = d.Region.unwrap()
-	if err != nil {
-		return
+	if object.groups != nil {
+		stream.WriteObjectField("groups")
+		writeGroupListLink(object.groups, stream)
 	}
-	object.displayName = d.DisplayName
-	object.dns, err = d.DNS.unwrap()
-	if err != nil {
-		return
+	if object.creator != nil {
+		stream.WriteObjectField("creator")
+		stream.WriteString(*object.creator)
 	}
-	object.properties = d.Properties
-	object.state = d.State
-	object.managed = d.Managed
-	object.externalID = d.ExternalID
-	object.aws, err = d.AWS.unwrap()
-	if err != nil {
-		return
+	if object.version != nil {
+		stream.WriteObjectField("version")
+		writeVersion(object.version, stream)
 	}
-	object.network, err = d.Network.unwrap()
-	if err != nil {
-		return
+	if object.identityProviders != nil {
+		stream.WriteObjectField("identity_providers")
+		writeIdentityProviderListLink(object.identityProviders, stream)
 	}
-	object.creationTimestamp = d.CreationTimestamp
-	object.expirationTimestamp = d.ExpirationTimestamp
-	object.cloudProvider, err = d.CloudProvider.unwrap()
-	if err != nil {
-		return
+	if object.metrics != nil {
+		stream.WriteObjectField("metrics")
+		writeClusterMetrics(object.metrics, stream)
 	}
-	object.openshiftVersion = d.OpenshiftVersion
-	object.subscription, err = d.Subscription.unwrap()
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
+}
+
+// UnmarshalCluster reads a value of the 'cluster' type from the given
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalCluster(source interface{}, options ...helpers.DecodingOptions) (object *Cluster, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	object.groups, err = d.Groups.unwrapLink()
+	object = readCluster(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object.creator = d.Creator
-	object.version, err = d.Version.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Cluster)
+	}
+	return
+}
+
+// readCluster reads a value of the 'cluster' type from the given iterator.
+func readCluster(iterator *helpers.Iterator) *Cluster {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(Cluster)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(ClusterKind, ClusterLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "flavour":
+			object.flavour = readFlavour(iterator)
+		case "console":
+			object.console = readClusterConsole(iterator)
+		case "multi_az":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.multiAZ = &value
+			}
+		case "nodes":
+			object.nodes = readClusterNodes(iterator)
+		case "api":
+			object.api = readClusterAPI(iterator)
+		case "region":
+			object.region = readCloudRegion(iterator)
+		case "display_name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.displayName = &value
+			}
+		case "dns":
+			object.dns = readDNS(iterator)
+		case "properties":
+			object.properties = iterator.ReadStringMap()
+		case "state":
+			if !iterator.ReadNull() {
+				value := ClusterState(iterator.ReadString())
+				object.state = &value
+			}
+		case "managed":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.managed = &value
+			}
+		case "external_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.externalID = &value
+			}
+		case "aws":
+			object.aws = readAWS(iterator)
+		case "network":
+			object.network = readNetwork(iterator)
+		case "creation_timestamp":
+			if !iterator.ReadNull() {
+				value := iterator.ReadTime()
+				object.creationTimestamp = &value
+			}
+		case "expiration_timestamp":
+			if !iterator.ReadNull() {
+				value := iterator.ReadTime()
+				object.expirationTimestamp = &value
+			}
+		case "cloud_provider":
+			object.cloudProvider = readCloudProvider(iterator)
+		case "openshift_version":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.openshiftVersion = &value
+			}
+		case "subscription":
+			object.subscription = readSubscription(iterator)
+		case "groups":
+			object.groups = readGroupListLink(iterator)
+		case "creator":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.creator = &value
+			}
+		case "version":
+			object.version = readVersion(iterator)
+		case "identity_providers":
+			object.identityProviders = readIdentityProviderListLink(iterator)
+		case "metrics":
+			object.metrics = readClusterMetrics(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalCluster function.
+func (o *Cluster) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeCluster(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalCluster function, using the
+// default decoding options.
+func (o *Cluster) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalCluster(data)
 	if err != nil {
-		return
+		return err
+	}
+	if object == nil {
+		*o = Cluster{}
+		return nil
 	}
-	object.identityProviders, err = d.IdentityProviders.unwrapLink()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Cluster) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.metrics, err = d.Metrics.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Cluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
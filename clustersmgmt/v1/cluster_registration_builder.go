@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistrationBuilder contains the data and logic needed to build 'cluster_registration' objects.
 //
 // Registration of a new cluster to the service.
 type ClusterRegistrationBuilder struct {
 	subscriptionID *string
 	externalID     *string
+	extra          map[string]interface{}
 }
 
 // NewClusterRegistration creates a new builder of 'cluster_registration' objects.
@@ -32,6 +37,20 @@ func NewClusterRegistration() *ClusterRegistrationBuilder {
 	return new(ClusterRegistrationBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_registration' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterRegistration) Builder() *ClusterRegistrationBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationBuilder)
+	b.subscriptionID = o.subscriptionID
+	b.externalID = o.externalID
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // SubscriptionID sets the value of the 'subscription_ID' attribute
 // to the given value.
 //
@@ -50,9 +69,17 @@ func (b *ClusterRegistrationBuilder) ExternalID(value string) *ClusterRegistrati
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_registration' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterRegistrationBuilder) Extra(value map[string]interface{}) *ClusterRegistrationBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_registration' object using the configuration stored in the builder.
 func (b *ClusterRegistrationBuilder) Build() (object *ClusterRegistration, err error) {
 	object = new(ClusterRegistration)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.subscriptionID != nil {
 		object.subscriptionID = b.subscriptionID
 	}
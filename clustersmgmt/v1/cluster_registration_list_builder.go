@@ -30,6 +30,19 @@ func NewClusterRegistrationList() *ClusterRegistrationListBuilder {
 	return new(ClusterRegistrationListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterRegistrationList) Builder() *ClusterRegistrationListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterRegistrationListBuilder)
+	b.items = make([]*ClusterRegistrationBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterRegistrationListBuilder) Items(values ...*ClusterRegistrationBuilder) *ClusterRegistrationListBuilder {
 	b.items = make([]*ClusterRegistrationBuilder, len(values))
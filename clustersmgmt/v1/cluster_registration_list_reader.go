@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_registration'.
-type clusterRegistrationListData []*clusterRegistrationData
-
 // UnmarshalClusterRegistrationList reads a list of values of the 'cluster_registration'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterRegistrationList(source interface{}) (list *ClusterRegistrationList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterRegistrationList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterRegistrationList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterRegistrationListData
-	err = decoder.Decode(&data)
+	list = readClusterRegistrationList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_registration' value to a JSON document.
-func (l *ClusterRegistrationList) wrap() (data clusterRegistrationListData, err error) {
-	if l == nil {
-		return
+// MarshalClusterRegistrationList writes a list of values of the 'cluster_registration' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterRegistrationList(list *ClusterRegistrationList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(clusterRegistrationListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeClusterRegistrationList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ClusterRegistrationList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistrationList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistrationList function,
+// using the default decoding options.
+func (l *ClusterRegistrationList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalClusterRegistrationList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ClusterRegistrationList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_registration' type.
-func (d clusterRegistrationListData) unwrap() (list *ClusterRegistrationList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterRegistrationList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterRegistrationList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeClusterRegistrationList writes a list of values of the 'cluster_registration' type to the given
+// stream, as a JSON array.
+func writeClusterRegistrationList(list *ClusterRegistrationList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterRegistration, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterRegistration(item, stream)
 	}
-	list = new(ClusterRegistrationList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readClusterRegistrationList reads a list of values of the 'cluster_registration' type from the given
+// iterator, as a JSON array.
+func readClusterRegistrationList(iterator *helpers.Iterator) *ClusterRegistrationList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterRegistrationList)
+	list.items = []*ClusterRegistration{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterRegistration(iterator))
+	}
+	return list
 }
@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterRegistrationData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_registration'.
-type clusterRegistrationData struct {
-	SubscriptionID *string "json:\"subscription_id,omitempty\""
-	ExternalID     *string "json:\"external_id,omitempty\""
-}
-
 // MarshalClusterRegistration writes a value of the 'cluster_registration' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterRegistration(object *ClusterRegistration, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterRegistration(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_registration'
-// value to a JSON document.
-func (o *ClusterRegistration) wrap() (data *clusterRegistrationData, err error) {
-	if o == nil {
+// writeClusterRegistration writes a value of the 'cluster_registration' type to the given stream.
+func writeClusterRegistration(object *ClusterRegistration, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterRegistrationData)
-	data.SubscriptionID = o.subscriptionID
-	data.ExternalID = o.externalID
-	return
+	stream.WriteObjectStart()
+	if object.subscriptionID != nil {
+		stream.WriteObjectField("subscription_id")
+		stream.WriteString(*object.subscriptionID)
+	}
+	if object.externalID != nil {
+		stream.WriteObjectField("external_id")
+		stream.WriteString(*object.externalID)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterRegistration reads a value of the 'cluster_registration' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterRegistration(source interface{}) (object *ClusterRegistration, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterRegistration(source interface{}, options ...helpers.DecodingOptions) (object *ClusterRegistration, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterRegistrationData)
-	err = decoder.Decode(data)
+	object = readClusterRegistration(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterRegistration)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_registration' type.
-func (d *clusterRegistrationData) unwrap() (object *ClusterRegistration, err error) {
-	if d == nil {
-		return
+// readClusterRegistration reads a value of the 'cluster_registration' type from the given iterator.
+func readClusterRegistration(iterator *helpers.Iterator) *ClusterRegistration {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterRegistration)
-	object.subscriptionID = d.SubscriptionID
-	object.externalID = d.ExternalID
-	return
+	object := new(ClusterRegistration)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "subscription_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.subscriptionID = &value
+			}
+		case "external_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.externalID = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterRegistration function.
+func (o *ClusterRegistration) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterRegistration(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterRegistration function, using the
+// default decoding options.
+func (o *ClusterRegistration) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterRegistration(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterRegistration{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterRegistration) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterRegistration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
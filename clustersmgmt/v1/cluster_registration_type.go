@@ -19,12 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterRegistration represents the values of the 'cluster_registration' type.
 //
 // Registration of a new cluster to the service.
 type ClusterRegistration struct {
 	subscriptionID *string
 	externalID     *string
+	extra          map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -34,6 +41,59 @@ func (o *ClusterRegistration) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_registration' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterRegistration) Metadata() *helpers.TypeMetadata {
+	return clusterRegistrationMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterRegistration) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistration(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterRegistration) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistration(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterRegistration) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistration(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterRegistration) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistration(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_registration' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterRegistration) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // SubscriptionID returns the value of the 'subscription_ID' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -116,6 +176,135 @@ func (o *ClusterRegistration) GetExternalID() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'cluster_registration' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterRegistration) Copy() *ClusterRegistration {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterRegistration)
+	if o.subscriptionID != nil {
+		result.subscriptionID = new(string)
+		*result.subscriptionID = *o.subscriptionID
+	}
+	if o.externalID != nil {
+		result.externalID = new(string)
+		*result.externalID = *o.externalID
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_registration' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterRegistration) Equal(other *ClusterRegistration) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.subscriptionID == nil) != (other.subscriptionID == nil) ||
+		(o.subscriptionID != nil && *o.subscriptionID != *other.subscriptionID) {
+		return false
+	}
+	if (o.externalID == nil) != (other.externalID == nil) ||
+		(o.externalID != nil && *o.externalID != *other.externalID) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_registration' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterRegistration) Diff(other *ClusterRegistration) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_registration' object and the given one, using the given path as prefix.
+func (o *ClusterRegistration) diff(path string, other *ClusterRegistration, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.subscriptionID == nil) != (other.subscriptionID == nil) ||
+		(o.subscriptionID != nil && *o.subscriptionID != *other.subscriptionID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "subscription_id"),
+			Old:  helpers.Value(o.subscriptionID),
+			New:  helpers.Value(other.subscriptionID),
+		})
+	}
+	if (o.externalID == nil) != (other.externalID == nil) ||
+		(o.externalID != nil && *o.externalID != *other.externalID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "external_id"),
+			Old:  helpers.Value(o.externalID),
+			New:  helpers.Value(other.externalID),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_registration' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterRegistration) Changes(modified *ClusterRegistration) *ClusterRegistration {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterRegistration)
+	if modified.subscriptionID != nil && (o.subscriptionID == nil || *o.subscriptionID != *modified.subscriptionID) {
+		result.subscriptionID = new(string)
+		*result.subscriptionID = *modified.subscriptionID
+	}
+	if modified.externalID != nil && (o.externalID == nil || *o.externalID != *modified.externalID) {
+		result.externalID = new(string)
+		*result.externalID = *modified.externalID
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterRegistration prints a value of the 'cluster_registration' type to the given printer.
+func printClusterRegistration(object *ClusterRegistration, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterRegistration")
+	if object.subscriptionID != nil {
+		printer.PrintObjectField("SubscriptionID")
+		printer.PrintString(*object.subscriptionID)
+	}
+	if object.externalID != nil {
+		printer.PrintObjectField("ExternalID")
+		printer.PrintString(*object.externalID)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterRegistrationList is a list of values of the 'cluster_registration' type.
 type ClusterRegistrationList struct {
 	items []*ClusterRegistration
@@ -187,3 +376,105 @@ func (l *ClusterRegistrationList) Range(f func(index int, item *ClusterRegistrat
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterRegistrationList) Copy() *ClusterRegistrationList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterRegistrationList)
+	if l.items != nil {
+		result.items = make([]*ClusterRegistration, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterRegistrationList) Equal(other *ClusterRegistrationList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterRegistrationList) Diff(other *ClusterRegistrationList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterRegistrationList) diff(path string, other *ClusterRegistrationList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterRegistrationList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterRegistrationList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterRegistrationList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterRegistrationList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterRegistrationList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterRegistrationList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterRegistrationList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterRegistrationList(l, printer)
+	}
+}
+
+// printClusterRegistrationList prints a list of values of the 'cluster_registration' type to the given printer.
+func printClusterRegistrationList(list *ClusterRegistrationList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterRegistration(item, printer)
+	}
+	printer.PrintListEnd()
+}
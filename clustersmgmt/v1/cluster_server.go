@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterServer represents the interface the manages the 'cluster' resource.
@@ -105,14 +107,12 @@ func (r *ClusterGetServerResponse) SetStatusCode(status int) *ClusterGetServerRe
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *ClusterGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeCluster(r.body, stream)
+	return stream.Flush()
 }
 
 // ClusterUpdateServerRequest is the request for the 'update' method.
@@ -147,18 +147,12 @@ func (r *ClusterUpdateServerRequest) GetBody() (value *Cluster, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'update' method.
 func (r *ClusterUpdateServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterData)
-	err = decoder.Decode(data)
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
-	}
-	return err
+	r.body = readCluster(iterator)
+	return iterator.Error()
 }
 
 // ClusterUpdateServerResponse is the response for the 'update' method.
@@ -185,14 +179,12 @@ func (r *ClusterUpdateServerResponse) SetStatusCode(status int) *ClusterUpdateSe
 // marshall is the method used internally to marshal responses for the
 // 'update' method.
 func (r *ClusterUpdateServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeCluster(r.body, stream)
+	return stream.Flush()
 }
 
 // ClusterDeleteServerRequest is the request for the 'delete' method.
@@ -213,93 +205,135 @@ func (r *ClusterDeleteServerResponse) SetStatusCode(status int) *ClusterDeleteSe
 	return r
 }
 
-// ClusterServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// ClusterServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type ClusterServerAdapter struct {
 	server ClusterServer
 	router *mux.Router
+	prefix string
 }
 
+// NewClusterServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewClusterServerAdapter(server ClusterServer, router *mux.Router) *ClusterServerAdapter {
 	adapter := new(ClusterServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/status").HandlerFunc(adapter.statusHandler)
-	adapter.router.PathPrefix("/credentials").HandlerFunc(adapter.credentialsHandler)
-	adapter.router.PathPrefix("/logs").HandlerFunc(adapter.logsHandler)
-	adapter.router.PathPrefix("/groups").HandlerFunc(adapter.groupsHandler)
-	adapter.router.PathPrefix("/identity_providers").HandlerFunc(adapter.identityProvidersHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("PATCH").HandlerFunc(adapter.updateHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *ClusterServerAdapter) statusHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Status()
-	targetAdapter := NewClusterStatusServerAdapter(target, a.router.PathPrefix("/status").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *ClusterServerAdapter) credentialsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Credentials()
-	targetAdapter := NewCredentialsServerAdapter(target, a.router.PathPrefix("/credentials").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *ClusterServerAdapter) logsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Logs()
-	targetAdapter := NewLogsServerAdapter(target, a.router.PathPrefix("/logs").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *ClusterServerAdapter) groupsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Groups()
-	targetAdapter := NewGroupsServerAdapter(target, a.router.PathPrefix("/groups").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *ClusterServerAdapter) identityProvidersHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.IdentityProviders()
-	targetAdapter := NewIdentityProvidersServerAdapter(target, a.router.PathPrefix("/identity_providers").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *ClusterServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchCluster(w, r, a.server, segments)
+}
+
+// dispatchCluster navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchCluster(w http.ResponseWriter, r *http.Request, server ClusterServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptClusterGetRequest(w, r, server)
+		case http.MethodPatch:
+			adaptClusterUpdateRequest(w, r, server)
+		case http.MethodDelete:
+			adaptClusterDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	case "status":
+		target := server.Status()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchClusterStatus(w, r, target, segments[1:])
+	case "credentials":
+		target := server.Credentials()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchCredentials(w, r, target, segments[1:])
+	case "logs":
+		target := server.Logs()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchLogs(w, r, target, segments[1:])
+	case "groups":
+		target := server.Groups()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchGroups(w, r, target, segments[1:])
+	case "identity_providers":
+		target := server.IdentityProviders()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchIdentityProviders(w, r, target, segments[1:])
+	default:
+		errors.SendNotFound(w, r)
+	}
 }
-func (a *ClusterServerAdapter) readClusterGetServerRequest(r *http.Request) (*ClusterGetServerRequest, error) {
+func readClusterGetServerRequest(r *http.Request) (*ClusterGetServerRequest, error) {
 	result := new(ClusterGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *ClusterServerAdapter) writeClusterGetServerResponse(w http.ResponseWriter, r *ClusterGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClusterGetServerResponse(w http.ResponseWriter, r *ClusterGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClusterServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClusterGetServerRequest(r)
+func adaptClusterGetRequest(w http.ResponseWriter, r *http.Request, server ClusterServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClusterGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClusterGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeClusterGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeClusterGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -309,9 +343,9 @@ func (a *ClusterServerAdapter) getHandler(w http.ResponseWriter, r *http.Request
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *ClusterServerAdapter) readClusterUpdateServerRequest(r *http.Request) (*ClusterUpdateServerRequest, error) {
+func readClusterUpdateServerRequest(r *http.Request) (*ClusterUpdateServerRequest, error) {
 	result := new(ClusterUpdateServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -319,36 +353,42 @@ func (a *ClusterServerAdapter) readClusterUpdateServerRequest(r *http.Request) (
 	}
 	return result, nil
 }
-func (a *ClusterServerAdapter) writeClusterUpdateServerResponse(w http.ResponseWriter, r *ClusterUpdateServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClusterUpdateServerResponse(w http.ResponseWriter, r *ClusterUpdateServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClusterServerAdapter) updateHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClusterUpdateServerRequest(r)
+func adaptClusterUpdateRequest(w http.ResponseWriter, r *http.Request, server ClusterServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClusterUpdateServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClusterUpdateServerResponse)
-	err = a.server.Update(r.Context(), req, resp)
+	err = server.Update(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Update: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Update", err)
+		return
 	}
-	err = a.writeClusterUpdateServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeClusterUpdateServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -358,38 +398,38 @@ func (a *ClusterServerAdapter) updateHandler(w http.ResponseWriter, r *http.Requ
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *ClusterServerAdapter) readClusterDeleteServerRequest(r *http.Request) (*ClusterDeleteServerRequest, error) {
+func readClusterDeleteServerRequest(r *http.Request) (*ClusterDeleteServerRequest, error) {
 	result := new(ClusterDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *ClusterServerAdapter) writeClusterDeleteServerResponse(w http.ResponseWriter, r *ClusterDeleteServerResponse) error {
+func writeClusterDeleteServerResponse(w http.ResponseWriter, r *ClusterDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *ClusterServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClusterDeleteServerRequest(r)
+func adaptClusterDeleteRequest(w http.ResponseWriter, r *http.Request, server ClusterServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClusterDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClusterDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeClusterDeleteServerResponse(w, resp)
+	err = writeClusterDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
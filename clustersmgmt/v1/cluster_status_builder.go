@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterStatusBuilder contains the data and logic needed to build 'cluster_status' objects.
 //
 // Detailed status of a cluster.
@@ -28,6 +32,7 @@ type ClusterStatusBuilder struct {
 	link        bool
 	state       *ClusterState
 	description *string
+	extra       map[string]interface{}
 }
 
 // NewClusterStatus creates a new builder of 'cluster_status' objects.
@@ -35,6 +40,23 @@ func NewClusterStatus() *ClusterStatusBuilder {
 	return new(ClusterStatusBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'cluster_status' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *ClusterStatus) Builder() *ClusterStatusBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ClusterStatusBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.state = o.state
+	b.description = o.description
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *ClusterStatusBuilder) ID(value string) *ClusterStatusBuilder {
 	b.id = &value
@@ -71,9 +93,17 @@ func (b *ClusterStatusBuilder) Description(value string) *ClusterStatusBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'cluster_status' type, and
+// that will be written as they are when the object is encoded.
+func (b *ClusterStatusBuilder) Extra(value map[string]interface{}) *ClusterStatusBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'cluster_status' object using the configuration stored in the builder.
 func (b *ClusterStatusBuilder) Build() (object *ClusterStatus, err error) {
 	object = new(ClusterStatus)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
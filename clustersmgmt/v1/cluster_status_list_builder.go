@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'cluster_status' objects.
 type ClusterStatusListBuilder struct {
 	items []*ClusterStatusBuilder
+	link  bool
+	href  *string
 }
 
 // NewClusterStatusList creates a new builder of 'cluster_status' objects.
@@ -30,8 +32,25 @@ func NewClusterStatusList() *ClusterStatusListBuilder {
 	return new(ClusterStatusListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ClusterStatusList) Builder() *ClusterStatusListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ClusterStatusListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*ClusterStatusBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ClusterStatusListBuilder) Items(values ...*ClusterStatusBuilder) *ClusterStatusListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*ClusterStatusBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *ClusterStatusListBuilder) Build() (list *ClusterStatusList, err error)
 		}
 	}
 	list = new(ClusterStatusList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterStatusListData is type used internally to marshal and unmarshal lists of objects
-// of type 'cluster_status'.
-type clusterStatusListData []*clusterStatusData
-
 // UnmarshalClusterStatusList reads a list of values of the 'cluster_status'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalClusterStatusList(source interface{}) (list *ClusterStatusList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalClusterStatusList(source interface{}, options ...helpers.DecodingOptions) (list *ClusterStatusList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data clusterStatusListData
-	err = decoder.Decode(&data)
+	list = readClusterStatusList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'cluster_status' value to a JSON document.
-func (l *ClusterStatusList) wrap() (data clusterStatusListData, err error) {
-	if l == nil {
+// MarshalClusterStatusList writes a list of values of the 'cluster_status' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalClusterStatusList(list *ClusterStatusList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeClusterStatusList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *ClusterStatusList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterStatusListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalClusterStatusList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *ClusterStatusList) UnmarshalJSON(data []byte) error {
+	var list *ClusterStatusList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalClusterStatusList(data)
+	} else {
+		list, err = unmarshalClusterStatusListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = ClusterStatusList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ClusterStatusList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ClusterStatusList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalClusterStatusListLink reads a list of values of the 'cluster_status' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalClusterStatusListLink(source interface{}, options ...helpers.DecodingOptions) (list *ClusterStatusList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(clusterStatusListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readClusterStatusListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'cluster_status' type.
-func (d clusterStatusListData) unwrap() (list *ClusterStatusList, err error) {
-	if d == nil {
+// writeClusterStatusList writes a list of values of the 'cluster_status' type to the given
+// stream, as a JSON array.
+func writeClusterStatusList(list *ClusterStatusList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*ClusterStatus, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeClusterStatus(item, stream)
 	}
-	list = new(ClusterStatusList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// clusterStatusListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'cluster_status'.
-type clusterStatusListLinkData struct {
-	Kind  *string              "json:\"kind,omitempty\""
-	HREF  *string              "json:\"href,omitempty\""
-	Items []*clusterStatusData "json:\"items,omitempty\""
+// readClusterStatusList reads a list of values of the 'cluster_status' type from the given
+// iterator, as a JSON array.
+func readClusterStatusList(iterator *helpers.Iterator) *ClusterStatusList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ClusterStatusList)
+	list.items = []*ClusterStatus{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readClusterStatus(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'cluster_status' value to a link.
-func (l *ClusterStatusList) wrapLink() (data *clusterStatusListLinkData, err error) {
-	if l == nil {
+// writeClusterStatusListLink writes a list of values of the 'cluster_status' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeClusterStatusListLink(list *ClusterStatusList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*clusterStatusData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(clusterStatusListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = ClusterStatusListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(ClusterStatusListLinkKind)
 	} else {
-		*data.Kind = ClusterStatusListKind
+		stream.WriteString(ClusterStatusListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeClusterStatusList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'cluster_status' type to a list.
-func (d *clusterStatusListLinkData) unwrapLink() (list *ClusterStatusList, err error) {
-	if d == nil {
-		return
+// readClusterStatusListLink reads a list of values of the 'cluster_status' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readClusterStatusListLink(iterator *helpers.Iterator) *ClusterStatusList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*ClusterStatus, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(ClusterStatusList)
+	list.items = []*ClusterStatus{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(ClusterStatusList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterStatusListKind:
-			list.link = false
-		case ClusterStatusListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(ClusterStatusListKind, ClusterStatusListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readClusterStatusList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterStatusListKind,
-				ClusterStatusListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,97 +20,157 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// clusterStatusData is the data structure used internally to marshal and unmarshal
-// objects of type 'cluster_status'.
-type clusterStatusData struct {
-	Kind        *string       "json:\"kind,omitempty\""
-	ID          *string       "json:\"id,omitempty\""
-	HREF        *string       "json:\"href,omitempty\""
-	State       *ClusterState "json:\"state,omitempty\""
-	Description *string       "json:\"description,omitempty\""
-}
-
 // MarshalClusterStatus writes a value of the 'cluster_status' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalClusterStatus(object *ClusterStatus, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeClusterStatus(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'cluster_status'
-// value to a JSON document.
-func (o *ClusterStatus) wrap() (data *clusterStatusData, err error) {
-	if o == nil {
+// writeClusterStatus writes a value of the 'cluster_status' type to the given stream.
+func writeClusterStatus(object *ClusterStatus, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(clusterStatusData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = ClusterStatusLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(ClusterStatusLinkKind)
 	} else {
-		*data.Kind = ClusterStatusKind
+		stream.WriteString(ClusterStatusKind)
 	}
-	data.State = o.state
-	data.Description = o.description
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.state != nil {
+		stream.WriteObjectField("state")
+		stream.WriteString(string(*object.state))
+	}
+	if object.description != nil {
+		stream.WriteObjectField("description")
+		stream.WriteString(*object.description)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalClusterStatus reads a value of the 'cluster_status' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalClusterStatus(source interface{}) (object *ClusterStatus, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalClusterStatus(source interface{}, options ...helpers.DecodingOptions) (object *ClusterStatus, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(clusterStatusData)
-	err = decoder.Decode(data)
+	object = readClusterStatus(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(ClusterStatus)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'cluster_status' type.
-func (d *clusterStatusData) unwrap() (object *ClusterStatus, err error) {
-	if d == nil {
-		return
+// readClusterStatus reads a value of the 'cluster_status' type from the given iterator.
+func readClusterStatus(iterator *helpers.Iterator) *ClusterStatus {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(ClusterStatus)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case ClusterStatusKind:
-			object.link = false
-		case ClusterStatusLinkKind:
-			object.link = true
+	object := new(ClusterStatus)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(ClusterStatusKind, ClusterStatusLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "state":
+			if !iterator.ReadNull() {
+				value := ClusterState(iterator.ReadString())
+				object.state = &value
+			}
+		case "description":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.description = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				ClusterStatusKind,
-				ClusterStatusLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.state = d.State
-	object.description = d.Description
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalClusterStatus function.
+func (o *ClusterStatus) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeClusterStatus(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalClusterStatus function, using the
+// default decoding options.
+func (o *ClusterStatus) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalClusterStatus(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = ClusterStatus{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *ClusterStatus) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *ClusterStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ClusterStatusKind is the name of the type used to represent objects
 // of type 'cluster_status'.
 const ClusterStatusKind = "ClusterStatus"
@@ -40,6 +48,7 @@ type ClusterStatus struct {
 	link        bool
 	state       *ClusterState
 	description *string
+	extra       map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -94,6 +103,27 @@ func (o *ClusterStatus) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete cluster status that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *ClusterStatus) Resolve(ctx context.Context, transport http.RoundTripper) (result *ClusterStatus, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to cluster status '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewClusterStatusClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Status_()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *ClusterStatus) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -102,6 +132,59 @@ func (o *ClusterStatus) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster_status' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *ClusterStatus) Metadata() *helpers.TypeMetadata {
+	return clusterStatusMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *ClusterStatus) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterStatus(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *ClusterStatus) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterStatus(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *ClusterStatus) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterStatus(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *ClusterStatus) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterStatus(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster_status' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *ClusterStatus) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // State returns the value of the 'state' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -160,6 +243,195 @@ const ClusterStatusListLinkKind = "ClusterStatusListLink"
 // objects of type 'cluster_status'.
 const ClusterStatusListNilKind = "ClusterStatusListNil"
 
+// Copy returns a deep copy of the 'cluster_status' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *ClusterStatus) Copy() *ClusterStatus {
+	if o == nil {
+		return nil
+	}
+	result := new(ClusterStatus)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.state != nil {
+		result.state = new(ClusterState)
+		*result.state = *o.state
+	}
+	if o.description != nil {
+		result.description = new(string)
+		*result.description = *o.description
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster_status' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *ClusterStatus) Equal(other *ClusterStatus) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.state == nil) != (other.state == nil) ||
+		(o.state != nil && *o.state != *other.state) {
+		return false
+	}
+	if (o.description == nil) != (other.description == nil) ||
+		(o.description != nil && *o.description != *other.description) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster_status' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *ClusterStatus) Diff(other *ClusterStatus) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster_status' object and the given one, using the given path as prefix.
+func (o *ClusterStatus) diff(path string, other *ClusterStatus, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.state == nil) != (other.state == nil) ||
+		(o.state != nil && *o.state != *other.state) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "state"),
+			Old:  helpers.Value(o.state),
+			New:  helpers.Value(other.state),
+		})
+	}
+	if (o.description == nil) != (other.description == nil) ||
+		(o.description != nil && *o.description != *other.description) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "description"),
+			Old:  helpers.Value(o.description),
+			New:  helpers.Value(other.description),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster_status' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *ClusterStatus) Changes(modified *ClusterStatus) *ClusterStatus {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(ClusterStatus)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.state != nil && (o.state == nil || *o.state != *modified.state) {
+		result.state = new(ClusterState)
+		*result.state = *modified.state
+	}
+	if modified.description != nil && (o.description == nil || *o.description != *modified.description) {
+		result.description = new(string)
+		*result.description = *modified.description
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printClusterStatus prints a value of the 'cluster_status' type to the given printer.
+func printClusterStatus(object *ClusterStatus, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterStatus")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.state != nil {
+		printer.PrintObjectField("State")
+		printer.PrintSymbol(string(*object.state))
+	}
+	if object.description != nil {
+		printer.PrintObjectField("Description")
+		printer.PrintString(*object.description)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterStatusList is a list of values of the 'cluster_status' type.
 type ClusterStatusList struct {
 	href  *string
@@ -267,3 +539,146 @@ func (l *ClusterStatusList) Range(f func(index int, item *ClusterStatus) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterStatusList) Copy() *ClusterStatusList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterStatusList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*ClusterStatus, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterStatusList) Equal(other *ClusterStatusList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterStatusList) Diff(other *ClusterStatusList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterStatusList) diff(path string, other *ClusterStatusList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterStatusList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterStatusListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterStatusList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterStatusListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterStatusList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterStatusListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterStatusList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterStatusListLink(l, printer)
+	}
+}
+
+// printClusterStatusList prints a list of values of the 'cluster_status' type to the given printer.
+func printClusterStatusList(list *ClusterStatusList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printClusterStatus(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printClusterStatusListLink prints a list of values of the 'cluster_status' type to the
+// given printer, including the link to the list.
+func printClusterStatusListLink(list *ClusterStatusList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterStatusList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printClusterStatusList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
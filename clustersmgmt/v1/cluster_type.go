@@ -20,7 +20,12 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClusterKind is the name of the type used to represent objects
@@ -103,6 +108,7 @@ type Cluster struct {
 	version             *Version
 	identityProviders   *IdentityProviderList
 	metrics             *ClusterMetrics
+	extra               map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -157,6 +163,27 @@ func (o *Cluster) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete cluster that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Cluster) Resolve(ctx context.Context, transport http.RoundTripper) (result *Cluster, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to cluster '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewClusterClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Cluster) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -188,6 +215,59 @@ func (o *Cluster) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'cluster' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Cluster) Metadata() *helpers.TypeMetadata {
+	return clusterMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Cluster) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printCluster(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Cluster) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printCluster(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Cluster) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printCluster(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Cluster) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printCluster(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'cluster' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Cluster) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Name returns the value of the 'name' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -807,6 +887,590 @@ const ClusterListLinkKind = "ClusterListLink"
 // objects of type 'cluster'.
 const ClusterListNilKind = "ClusterListNil"
 
+// Copy returns a deep copy of the 'cluster' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Cluster) Copy() *Cluster {
+	if o == nil {
+		return nil
+	}
+	result := new(Cluster)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	result.flavour = o.flavour.Copy()
+	result.console = o.console.Copy()
+	if o.multiAZ != nil {
+		result.multiAZ = new(bool)
+		*result.multiAZ = *o.multiAZ
+	}
+	result.nodes = o.nodes.Copy()
+	result.api = o.api.Copy()
+	result.region = o.region.Copy()
+	if o.displayName != nil {
+		result.displayName = new(string)
+		*result.displayName = *o.displayName
+	}
+	result.dns = o.dns.Copy()
+	if o.properties != nil {
+		result.properties = make(map[string]string, len(o.properties))
+		for key, value := range o.properties {
+			result.properties[key] = value
+		}
+	}
+	if o.state != nil {
+		result.state = new(ClusterState)
+		*result.state = *o.state
+	}
+	if o.managed != nil {
+		result.managed = new(bool)
+		*result.managed = *o.managed
+	}
+	if o.externalID != nil {
+		result.externalID = new(string)
+		*result.externalID = *o.externalID
+	}
+	result.aws = o.aws.Copy()
+	result.network = o.network.Copy()
+	if o.creationTimestamp != nil {
+		result.creationTimestamp = new(time.Time)
+		*result.creationTimestamp = *o.creationTimestamp
+	}
+	if o.expirationTimestamp != nil {
+		result.expirationTimestamp = new(time.Time)
+		*result.expirationTimestamp = *o.expirationTimestamp
+	}
+	result.cloudProvider = o.cloudProvider.Copy()
+	if o.openshiftVersion != nil {
+		result.openshiftVersion = new(string)
+		*result.openshiftVersion = *o.openshiftVersion
+	}
+	result.subscription = o.subscription.Copy()
+	result.groups = o.groups.Copy()
+	if o.creator != nil {
+		result.creator = new(string)
+		*result.creator = *o.creator
+	}
+	result.version = o.version.Copy()
+	result.identityProviders = o.identityProviders.Copy()
+	result.metrics = o.metrics.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'cluster' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Cluster) Equal(other *Cluster) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if !o.flavour.Equal(other.flavour) {
+		return false
+	}
+	if !o.console.Equal(other.console) {
+		return false
+	}
+	if (o.multiAZ == nil) != (other.multiAZ == nil) ||
+		(o.multiAZ != nil && *o.multiAZ != *other.multiAZ) {
+		return false
+	}
+	if !o.nodes.Equal(other.nodes) {
+		return false
+	}
+	if !o.api.Equal(other.api) {
+		return false
+	}
+	if !o.region.Equal(other.region) {
+		return false
+	}
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		return false
+	}
+	if !o.dns.Equal(other.dns) {
+		return false
+	}
+	if !helpers.EqualStrings(o.properties, other.properties) {
+		return false
+	}
+	if (o.state == nil) != (other.state == nil) ||
+		(o.state != nil && *o.state != *other.state) {
+		return false
+	}
+	if (o.managed == nil) != (other.managed == nil) ||
+		(o.managed != nil && *o.managed != *other.managed) {
+		return false
+	}
+	if (o.externalID == nil) != (other.externalID == nil) ||
+		(o.externalID != nil && *o.externalID != *other.externalID) {
+		return false
+	}
+	if !o.aws.Equal(other.aws) {
+		return false
+	}
+	if !o.network.Equal(other.network) {
+		return false
+	}
+	if (o.creationTimestamp == nil) != (other.creationTimestamp == nil) ||
+		(o.creationTimestamp != nil && !o.creationTimestamp.Equal(*other.creationTimestamp)) {
+		return false
+	}
+	if (o.expirationTimestamp == nil) != (other.expirationTimestamp == nil) ||
+		(o.expirationTimestamp != nil && !o.expirationTimestamp.Equal(*other.expirationTimestamp)) {
+		return false
+	}
+	if !o.cloudProvider.Equal(other.cloudProvider) {
+		return false
+	}
+	if (o.openshiftVersion == nil) != (other.openshiftVersion == nil) ||
+		(o.openshiftVersion != nil && *o.openshiftVersion != *other.openshiftVersion) {
+		return false
+	}
+	if !o.subscription.Equal(other.subscription) {
+		return false
+	}
+	if !o.groups.Equal(other.groups) {
+		return false
+	}
+	if (o.creator == nil) != (other.creator == nil) ||
+		(o.creator != nil && *o.creator != *other.creator) {
+		return false
+	}
+	if !o.version.Equal(other.version) {
+		return false
+	}
+	if !o.identityProviders.Equal(other.identityProviders) {
+		return false
+	}
+	if !o.metrics.Equal(other.metrics) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'cluster' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Cluster) Diff(other *Cluster) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'cluster' object and the given one, using the given path as prefix.
+func (o *Cluster) diff(path string, other *Cluster, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	result = o.flavour.diff(helpers.Path(path, "flavour"), other.flavour, result)
+	result = o.console.diff(helpers.Path(path, "console"), other.console, result)
+	if (o.multiAZ == nil) != (other.multiAZ == nil) ||
+		(o.multiAZ != nil && *o.multiAZ != *other.multiAZ) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "multi_az"),
+			Old:  helpers.Value(o.multiAZ),
+			New:  helpers.Value(other.multiAZ),
+		})
+	}
+	result = o.nodes.diff(helpers.Path(path, "nodes"), other.nodes, result)
+	result = o.api.diff(helpers.Path(path, "api"), other.api, result)
+	result = o.region.diff(helpers.Path(path, "region"), other.region, result)
+	if (o.displayName == nil) != (other.displayName == nil) ||
+		(o.displayName != nil && *o.displayName != *other.displayName) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "display_name"),
+			Old:  helpers.Value(o.displayName),
+			New:  helpers.Value(other.displayName),
+		})
+	}
+	result = o.dns.diff(helpers.Path(path, "dns"), other.dns, result)
+	for _, key := range mergeKeys(o.properties, other.properties) {
+		value, ok := o.properties[key]
+		otherValue, otherOk := other.properties[key]
+		if ok != otherOk || value != otherValue {
+			difference := helpers.Difference{
+				Path: helpers.Key(helpers.Path(path, "properties"), key),
+			}
+			if ok {
+				difference.Old = value
+			}
+			if otherOk {
+				difference.New = otherValue
+			}
+			result = append(result, difference)
+		}
+	}
+	if (o.state == nil) != (other.state == nil) ||
+		(o.state != nil && *o.state != *other.state) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "state"),
+			Old:  helpers.Value(o.state),
+			New:  helpers.Value(other.state),
+		})
+	}
+	if (o.managed == nil) != (other.managed == nil) ||
+		(o.managed != nil && *o.managed != *other.managed) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "managed"),
+			Old:  helpers.Value(o.managed),
+			New:  helpers.Value(other.managed),
+		})
+	}
+	if (o.externalID == nil) != (other.externalID == nil) ||
+		(o.externalID != nil && *o.externalID != *other.externalID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "external_id"),
+			Old:  helpers.Value(o.externalID),
+			New:  helpers.Value(other.externalID),
+		})
+	}
+	result = o.aws.diff(helpers.Path(path, "aws"), other.aws, result)
+	result = o.network.diff(helpers.Path(path, "network"), other.network, result)
+	if (o.creationTimestamp == nil) != (other.creationTimestamp == nil) ||
+		(o.creationTimestamp != nil && !o.creationTimestamp.Equal(*other.creationTimestamp)) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "creation_timestamp"),
+			Old:  helpers.Value(o.creationTimestamp),
+			New:  helpers.Value(other.creationTimestamp),
+		})
+	}
+	if (o.expirationTimestamp == nil) != (other.expirationTimestamp == nil) ||
+		(o.expirationTimestamp != nil && !o.expirationTimestamp.Equal(*other.expirationTimestamp)) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "expiration_timestamp"),
+			Old:  helpers.Value(o.expirationTimestamp),
+			New:  helpers.Value(other.expirationTimestamp),
+		})
+	}
+	result = o.cloudProvider.diff(helpers.Path(path, "cloud_provider"), other.cloudProvider, result)
+	if (o.openshiftVersion == nil) != (other.openshiftVersion == nil) ||
+		(o.openshiftVersion != nil && *o.openshiftVersion != *other.openshiftVersion) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "openshift_version"),
+			Old:  helpers.Value(o.openshiftVersion),
+			New:  helpers.Value(other.openshiftVersion),
+		})
+	}
+	result = o.subscription.diff(helpers.Path(path, "subscription"), other.subscription, result)
+	result = o.groups.diff(helpers.Path(path, "groups"), other.groups, result)
+	if (o.creator == nil) != (other.creator == nil) ||
+		(o.creator != nil && *o.creator != *other.creator) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "creator"),
+			Old:  helpers.Value(o.creator),
+			New:  helpers.Value(other.creator),
+		})
+	}
+	result = o.version.diff(helpers.Path(path, "version"), other.version, result)
+	result = o.identityProviders.diff(helpers.Path(path, "identity_providers"), other.identityProviders, result)
+	result = o.metrics.diff(helpers.Path(path, "metrics"), other.metrics, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'cluster' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Cluster) Changes(modified *Cluster) *Cluster {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Cluster)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.flavour != nil && !o.flavour.Equal(modified.flavour) {
+		result.flavour = o.flavour.Changes(modified.flavour)
+	}
+	if modified.console != nil && !o.console.Equal(modified.console) {
+		result.console = o.console.Changes(modified.console)
+	}
+	if modified.multiAZ != nil && (o.multiAZ == nil || *o.multiAZ != *modified.multiAZ) {
+		result.multiAZ = new(bool)
+		*result.multiAZ = *modified.multiAZ
+	}
+	if modified.nodes != nil && !o.nodes.Equal(modified.nodes) {
+		result.nodes = o.nodes.Changes(modified.nodes)
+	}
+	if modified.api != nil && !o.api.Equal(modified.api) {
+		result.api = o.api.Changes(modified.api)
+	}
+	if modified.region != nil && !o.region.Equal(modified.region) {
+		result.region = o.region.Changes(modified.region)
+	}
+	if modified.displayName != nil && (o.displayName == nil || *o.displayName != *modified.displayName) {
+		result.displayName = new(string)
+		*result.displayName = *modified.displayName
+	}
+	if modified.dns != nil && !o.dns.Equal(modified.dns) {
+		result.dns = o.dns.Changes(modified.dns)
+	}
+	if modified.properties != nil && !helpers.EqualStrings(o.properties, modified.properties) {
+		result.properties = make(map[string]string, len(modified.properties))
+		for key, value := range modified.properties {
+			result.properties[key] = value
+		}
+	}
+	if modified.state != nil && (o.state == nil || *o.state != *modified.state) {
+		result.state = new(ClusterState)
+		*result.state = *modified.state
+	}
+	if modified.managed != nil && (o.managed == nil || *o.managed != *modified.managed) {
+		result.managed = new(bool)
+		*result.managed = *modified.managed
+	}
+	if modified.externalID != nil && (o.externalID == nil || *o.externalID != *modified.externalID) {
+		result.externalID = new(string)
+		*result.externalID = *modified.externalID
+	}
+	if modified.aws != nil && !o.aws.Equal(modified.aws) {
+		result.aws = o.aws.Changes(modified.aws)
+	}
+	if modified.network != nil && !o.network.Equal(modified.network) {
+		result.network = o.network.Changes(modified.network)
+	}
+	if modified.creationTimestamp != nil && (o.creationTimestamp == nil || !o.creationTimestamp.Equal(*modified.creationTimestamp)) {
+		result.creationTimestamp = new(time.Time)
+		*result.creationTimestamp = *modified.creationTimestamp
+	}
+	if modified.expirationTimestamp != nil && (o.expirationTimestamp == nil || !o.expirationTimestamp.Equal(*modified.expirationTimestamp)) {
+		result.expirationTimestamp = new(time.Time)
+		*result.expirationTimestamp = *modified.expirationTimestamp
+	}
+	if modified.cloudProvider != nil && !o.cloudProvider.Equal(modified.cloudProvider) {
+		result.cloudProvider = o.cloudProvider.Changes(modified.cloudProvider)
+	}
+	if modified.openshiftVersion != nil && (o.openshiftVersion == nil || *o.openshiftVersion != *modified.openshiftVersion) {
+		result.openshiftVersion = new(string)
+		*result.openshiftVersion = *modified.openshiftVersion
+	}
+	if modified.subscription != nil && !o.subscription.Equal(modified.subscription) {
+		result.subscription = o.subscription.Changes(modified.subscription)
+	}
+	if modified.groups != nil && !o.groups.Equal(modified.groups) {
+		result.groups = modified.groups.Copy()
+	}
+	if modified.creator != nil && (o.creator == nil || *o.creator != *modified.creator) {
+		result.creator = new(string)
+		*result.creator = *modified.creator
+	}
+	if modified.version != nil && !o.version.Equal(modified.version) {
+		result.version = o.version.Changes(modified.version)
+	}
+	if modified.identityProviders != nil && !o.identityProviders.Equal(modified.identityProviders) {
+		result.identityProviders = modified.identityProviders.Copy()
+	}
+	if modified.metrics != nil && !o.metrics.Equal(modified.metrics) {
+		result.metrics = o.metrics.Changes(modified.metrics)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printCluster prints a value of the 'cluster' type to the given printer.
+func printCluster(object *Cluster, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Cluster")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.flavour != nil {
+		printer.PrintObjectField("Flavour")
+		printFlavour(object.flavour, printer)
+	}
+	if object.console != nil {
+		printer.PrintObjectField("Console")
+		printClusterConsole(object.console, printer)
+	}
+	if object.multiAZ != nil {
+		printer.PrintObjectField("MultiAZ")
+		printer.PrintBool(*object.multiAZ)
+	}
+	if object.nodes != nil {
+		printer.PrintObjectField("Nodes")
+		printClusterNodes(object.nodes, printer)
+	}
+	if object.api != nil {
+		printer.PrintObjectField("API")
+		printClusterAPI(object.api, printer)
+	}
+	if object.region != nil {
+		printer.PrintObjectField("Region")
+		printCloudRegion(object.region, printer)
+	}
+	if object.displayName != nil {
+		printer.PrintObjectField("DisplayName")
+		printer.PrintString(*object.displayName)
+	}
+	if object.dns != nil {
+		printer.PrintObjectField("DNS")
+		printDNS(object.dns, printer)
+	}
+	if object.properties != nil {
+		printer.PrintObjectField("Properties")
+		printer.PrintStringMap(object.properties)
+	}
+	if object.state != nil {
+		printer.PrintObjectField("State")
+		printer.PrintSymbol(string(*object.state))
+	}
+	if object.managed != nil {
+		printer.PrintObjectField("Managed")
+		printer.PrintBool(*object.managed)
+	}
+	if object.externalID != nil {
+		printer.PrintObjectField("ExternalID")
+		printer.PrintString(*object.externalID)
+	}
+	if object.aws != nil {
+		printer.PrintObjectField("AWS")
+		printAWS(object.aws, printer)
+	}
+	if object.network != nil {
+		printer.PrintObjectField("Network")
+		printNetwork(object.network, printer)
+	}
+	if object.creationTimestamp != nil {
+		printer.PrintObjectField("CreationTimestamp")
+		printer.PrintTime(*object.creationTimestamp)
+	}
+	if object.expirationTimestamp != nil {
+		printer.PrintObjectField("ExpirationTimestamp")
+		printer.PrintTime(*object.expirationTimestamp)
+	}
+	if object.cloudProvider != nil {
+		printer.PrintObjectField("CloudProvider")
+		printCloudProvider(object.cloudProvider, printer)
+	}
+	if object.openshiftVersion != nil {
+		printer.PrintObjectField("OpenshiftVersion")
+		printer.PrintString(*object.openshiftVersion)
+	}
+	if object.subscription != nil {
+		printer.PrintObjectField("Subscription")
+		printSubscription(object.subscription, printer)
+	}
+	if object.groups != nil {
+		printer.PrintObjectField("Groups")
+		printGroupListLink(object.groups, printer)
+	}
+	if object.creator != nil {
+		printer.PrintObjectField("Creator")
+		printer.PrintString(*object.creator)
+	}
+	if object.version != nil {
+		printer.PrintObjectField("Version")
+		printVersion(object.version, printer)
+	}
+	if object.identityProviders != nil {
+		printer.PrintObjectField("IdentityProviders")
+		printIdentityProviderListLink(object.identityProviders, printer)
+	}
+	if object.metrics != nil {
+		printer.PrintObjectField("Metrics")
+		printClusterMetrics(object.metrics, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ClusterList is a list of values of the 'cluster' type.
 type ClusterList struct {
 	href  *string
@@ -914,3 +1578,146 @@ func (l *ClusterList) Range(f func(index int, item *Cluster) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ClusterList) Copy() *ClusterList {
+	if l == nil {
+		return nil
+	}
+	result := new(ClusterList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Cluster, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ClusterList) Equal(other *ClusterList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ClusterList) Diff(other *ClusterList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ClusterList) diff(path string, other *ClusterList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ClusterList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printClusterListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ClusterList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printClusterListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ClusterList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printClusterListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ClusterList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printClusterListLink(l, printer)
+	}
+}
+
+// printClusterList prints a list of values of the 'cluster' type to the given printer.
+func printClusterList(list *ClusterList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printCluster(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printClusterListLink prints a list of values of the 'cluster' type to the
+// given printer, including the link to the list.
+func printClusterListLink(list *ClusterList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.ClusterList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printClusterList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Validate checks that the object can be used to create a cluster. It returns nil if it is
+// valid, or a *helpers.ValidationError containing all the violations if it isn't.
+//
+// Note that this only checks the rules that can be checked without contacting the server, so a
+// request containing a valid object can still be rejected.
+func (o *Cluster) Validate() error {
+	validator := new(helpers.Validator)
+	if o == nil {
+		validator.Report("", "cluster is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// ValidateUpdate checks that the object can be used to update a cluster. Only the attributes that
+// are present are checked, as the rest of the attributes of the cluster aren't changed.
+func (o *Cluster) ValidateUpdate() error {
+	validator := &helpers.Validator{
+		Partial: true,
+	}
+	if o == nil {
+		validator.Report("", "cluster is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes.
+func (o *Cluster) validate(path string, validator *helpers.Validator) {
+	// The name is used as part of the DNS names of the cluster:
+	name := helpers.Path(path, "name")
+	validator.Required(name, o.name)
+	if o.name != nil && *o.name != "" && !helpers.IsDNSLabel(*o.name) {
+		validator.Report(
+			name,
+			"'%s' isn't a valid DNS label, it should contain at most 63 lower case "+
+				"letters, digits or dashes, and start and end with a letter or digit",
+			*o.name,
+		)
+	}
+
+	// The region is usually a reference, so only the identifier is required:
+	if o.region == nil {
+		validator.Missing(helpers.Path(path, "region"))
+	} else {
+		validator.Required(helpers.Path(path, "region.id"), o.region.id)
+	}
+
+	// Nested objects:
+	o.aws.validate(helpers.Path(path, "aws"), validator)
+	o.network.validate(helpers.Path(path, "network"), validator)
+	o.nodes.validate(helpers.Path(path, "nodes"), validator)
+
+	// Compute nodes are distributed evenly across three availability zones:
+	if o.multiAZ != nil && *o.multiAZ && o.nodes != nil && o.nodes.compute != nil {
+		if *o.nodes.compute%3 != 0 {
+			validator.Report(
+				helpers.Path(path, "nodes.compute"),
+				"%d isn't a multiple of 3, as required when 'multi_az' is true",
+				*o.nodes.compute,
+			)
+		}
+	}
+
+	// Identity providers that are links are managed by their own resource:
+	if o.identityProviders != nil {
+		providers := helpers.Path(path, "identity_providers")
+		for i, provider := range o.identityProviders.items {
+			if provider != nil && !provider.link {
+				provider.validate(helpers.Index(providers, i), validator)
+			}
+		}
+	}
+}
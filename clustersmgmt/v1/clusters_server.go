@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // ClustersServer represents the interface the manages the 'clusters' resource.
@@ -59,6 +61,7 @@ type ClustersListServerRequest struct {
 	page   *int
 	size   *int
 	search *string
+	order  []*helpers.OrderItem
 	total  *int
 }
 
@@ -164,6 +167,58 @@ func (r *ClustersListServerRequest) GetSearch() (value string, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the cluster
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `creation_timestamp` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// creation_timestamp desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *ClustersListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the cluster
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `creation_timestamp` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// creation_timestamp desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *ClustersListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -244,27 +299,29 @@ func (r *ClustersListServerResponse) SetStatusCode(status int) *ClustersListServ
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *ClustersListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(clustersListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// clustersListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type clustersListServerResponseData struct {
-	Page  *int            "json:\"page,omitempty\""
-	Size  *int            "json:\"size,omitempty\""
-	Total *int            "json:\"total,omitempty\""
-	Items clusterListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeClusterList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
 // ClustersAddServerRequest is the request for the 'add' method.
@@ -299,18 +356,12 @@ func (r *ClustersAddServerRequest) GetBody() (value *Cluster, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'add' method.
 func (r *ClustersAddServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(clusterData)
-	err = decoder.Decode(data)
-	if err != nil {
-		return err
-	}
-	r.body, err = data.unwrap()
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	return err
+	r.body = readCluster(iterator)
+	return iterator.Error()
 }
 
 // ClustersAddServerResponse is the response for the 'add' method.
@@ -337,75 +388,192 @@ func (r *ClustersAddServerResponse) SetStatusCode(status int) *ClustersAddServer
 // marshall is the method used internally to marshal responses for the
 // 'add' method.
 func (r *ClustersAddServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeCluster(r.body, stream)
+	return stream.Flush()
 }
 
-// ClustersServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// ClustersServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type ClustersServerAdapter struct {
 	server ClustersServer
 	router *mux.Router
+	prefix string
 }
 
+// NewClustersServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewClustersServerAdapter(server ClustersServer, router *mux.Router) *ClustersServerAdapter {
 	adapter := new(ClustersServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.clusterHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
-	adapter.router.Methods("POST").HandlerFunc(adapter.addHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *ClustersServerAdapter) clusterHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.Cluster(id)
-	targetAdapter := NewClusterServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *ClustersServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchClusters(w, r, a.server, segments)
+}
+
+// dispatchClusters navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchClusters(w http.ResponseWriter, r *http.Request, server ClustersServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptClustersListRequest(w, r, server)
+		case http.MethodPost:
+			adaptClustersAddRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.Cluster(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchCluster(w, r, target, segments[1:])
+	}
 }
-func (a *ClustersServerAdapter) readClustersListServerRequest(r *http.Request) (*ClustersListServerRequest, error) {
+
+// clustersListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var clustersListOrderAttributes = map[string]bool{
+	"api.url":                     true,
+	"cloud_provider.display_name": true,
+	"cloud_provider.name":         true,
+	"console.url":                 true,
+	"creation_timestamp":          true,
+	"creator":                     true,
+	"display_name":                true,
+	"dns.base_domain":             true,
+	"expiration_timestamp":        true,
+	"external_id":                 true,
+	"flavour.href":                true,
+	"flavour.id":                  true,
+	"flavour.name":                true,
+	"flavour.version":             true,
+	"href":                        true,
+	"id":                          true,
+	"managed":                     true,
+	"multi_az":                    true,
+	"name":                        true,
+	"network.machine_cidr":        true,
+	"network.pod_cidr":            true,
+	"network.service_cidr":        true,
+	"nodes.compute":               true,
+	"nodes.infra":                 true,
+	"nodes.master":                true,
+	"nodes.total":                 true,
+	"openshift_version":           true,
+	"region.display_name":         true,
+	"region.href":                 true,
+	"region.id":                   true,
+	"region.name":                 true,
+	"state":                       true,
+	"subscription.href":           true,
+	"subscription.id":             true,
+	"version.default":             true,
+	"version.enabled":             true,
+	"version.href":                true,
+	"version.id":                  true,
+}
+
+func readClustersListServerRequest(r *http.Request) (*ClustersListServerRequest, error) {
+	var err error
 	result := new(ClustersListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.search, err = helpers.ParseString(result.query, "search")
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", clustersListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *ClustersServerAdapter) writeClustersListServerResponse(w http.ResponseWriter, r *ClustersListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClustersListServerResponse(w http.ResponseWriter, r *ClustersListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClustersServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClustersListServerRequest(r)
+func adaptClustersListRequest(w http.ResponseWriter, r *http.Request, server ClustersServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClustersListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClustersListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeClustersListServerResponse(w, resp)
+	err = writeClustersListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -415,9 +583,9 @@ func (a *ClustersServerAdapter) listHandler(w http.ResponseWriter, r *http.Reque
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *ClustersServerAdapter) readClustersAddServerRequest(r *http.Request) (*ClustersAddServerRequest, error) {
+func readClustersAddServerRequest(r *http.Request) (*ClustersAddServerRequest, error) {
 	result := new(ClustersAddServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -425,36 +593,42 @@ func (a *ClustersServerAdapter) readClustersAddServerRequest(r *http.Request) (*
 	}
 	return result, nil
 }
-func (a *ClustersServerAdapter) writeClustersAddServerResponse(w http.ResponseWriter, r *ClustersAddServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeClustersAddServerResponse(w http.ResponseWriter, r *ClustersAddServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *ClustersServerAdapter) addHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readClustersAddServerRequest(r)
+func adaptClustersAddRequest(w http.ResponseWriter, r *http.Request, server ClustersServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readClustersAddServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(ClustersAddServerResponse)
-	err = a.server.Add(r.Context(), req, resp)
+	err = server.Add(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Add: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Add", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusCreated
 	}
-	err = a.writeClustersAddServerResponse(w, resp)
+	err = writeClustersAddServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // DashboardBuilder contains the data and logic needed to build 'dashboard' objects.
 //
 // Collection of metrics intended to render a graphical dashboard.
@@ -28,6 +32,7 @@ type DashboardBuilder struct {
 	link    bool
 	name    *string
 	metrics []*MetricBuilder
+	extra   map[string]interface{}
 }
 
 // NewDashboard creates a new builder of 'dashboard' objects.
@@ -35,6 +40,28 @@ func NewDashboard() *DashboardBuilder {
 	return new(DashboardBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'dashboard' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Dashboard) Builder() *DashboardBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(DashboardBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.name = o.name
+	if o.metrics != nil {
+		b.metrics = make([]*MetricBuilder, len(o.metrics.items))
+		for i, item := range o.metrics.items {
+			b.metrics[i] = item.Builder()
+		}
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *DashboardBuilder) ID(value string) *DashboardBuilder {
 	b.id = &value
@@ -72,9 +99,17 @@ func (b *DashboardBuilder) Metrics(values ...*MetricBuilder) *DashboardBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'dashboard' type, and
+// that will be written as they are when the object is encoded.
+func (b *DashboardBuilder) Extra(value map[string]interface{}) *DashboardBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'dashboard' object using the configuration stored in the builder.
 func (b *DashboardBuilder) Build() (object *Dashboard, err error) {
 	object = new(Dashboard)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'dashboard' objects.
 type DashboardListBuilder struct {
 	items []*DashboardBuilder
+	link  bool
+	href  *string
 }
 
 // NewDashboardList creates a new builder of 'dashboard' objects.
@@ -30,8 +32,25 @@ func NewDashboardList() *DashboardListBuilder {
 	return new(DashboardListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *DashboardList) Builder() *DashboardListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(DashboardListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*DashboardBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *DashboardListBuilder) Items(values ...*DashboardBuilder) *DashboardListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*DashboardBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *DashboardListBuilder) Build() (list *DashboardList, err error) {
 		}
 	}
 	list = new(DashboardList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// dashboardListData is type used internally to marshal and unmarshal lists of objects
-// of type 'dashboard'.
-type dashboardListData []*dashboardData
-
 // UnmarshalDashboardList reads a list of values of the 'dashboard'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalDashboardList(source interface{}) (list *DashboardList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalDashboardList(source interface{}, options ...helpers.DecodingOptions) (list *DashboardList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data dashboardListData
-	err = decoder.Decode(&data)
+	list = readDashboardList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'dashboard' value to a JSON document.
-func (l *DashboardList) wrap() (data dashboardListData, err error) {
-	if l == nil {
+// MarshalDashboardList writes a list of values of the 'dashboard' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalDashboardList(list *DashboardList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeDashboardList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *DashboardList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeDashboardListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalDashboardList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *DashboardList) UnmarshalJSON(data []byte) error {
+	var list *DashboardList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalDashboardList(data)
+	} else {
+		list, err = unmarshalDashboardListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = DashboardList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *DashboardList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *DashboardList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalDashboardListLink reads a list of values of the 'dashboard' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalDashboardListLink(source interface{}, options ...helpers.DecodingOptions) (list *DashboardList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(dashboardListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readDashboardListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'dashboard' type.
-func (d dashboardListData) unwrap() (list *DashboardList, err error) {
-	if d == nil {
+// writeDashboardList writes a list of values of the 'dashboard' type to the given
+// stream, as a JSON array.
+func writeDashboardList(list *DashboardList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Dashboard, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeDashboard(item, stream)
 	}
-	list = new(DashboardList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// dashboardListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'dashboard'.
-type dashboardListLinkData struct {
-	Kind  *string          "json:\"kind,omitempty\""
-	HREF  *string          "json:\"href,omitempty\""
-	Items []*dashboardData "json:\"items,omitempty\""
+// readDashboardList reads a list of values of the 'dashboard' type from the given
+// iterator, as a JSON array.
+func readDashboardList(iterator *helpers.Iterator) *DashboardList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(DashboardList)
+	list.items = []*Dashboard{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readDashboard(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'dashboard' value to a link.
-func (l *DashboardList) wrapLink() (data *dashboardListLinkData, err error) {
-	if l == nil {
+// writeDashboardListLink writes a list of values of the 'dashboard' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeDashboardListLink(list *DashboardList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*dashboardData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(dashboardListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = DashboardListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(DashboardListLinkKind)
 	} else {
-		*data.Kind = DashboardListKind
+		stream.WriteString(DashboardListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeDashboardList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'dashboard' type to a list.
-func (d *dashboardListLinkData) unwrapLink() (list *DashboardList, err error) {
-	if d == nil {
-		return
+// readDashboardListLink reads a list of values of the 'dashboard' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readDashboardListLink(iterator *helpers.Iterator) *DashboardList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Dashboard, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(DashboardList)
+	list.items = []*Dashboard{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(DashboardList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case DashboardListKind:
-			list.link = false
-		case DashboardListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(DashboardListKind, DashboardListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readDashboardList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				DashboardListKind,
-				DashboardListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,103 +20,154 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// dashboardData is the data structure used internally to marshal and unmarshal
-// objects of type 'dashboard'.
-type dashboardData struct {
-	Kind    *string        "json:\"kind,omitempty\""
-	ID      *string        "json:\"id,omitempty\""
-	HREF    *string        "json:\"href,omitempty\""
-	Name    *string        "json:\"name,omitempty\""
-	Metrics metricListData "json:\"metrics,omitempty\""
-}
-
 // MarshalDashboard writes a value of the 'dashboard' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalDashboard(object *Dashboard, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeDashboard(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'dashboard'
-// value to a JSON document.
-func (o *Dashboard) wrap() (data *dashboardData, err error) {
-	if o == nil {
+// writeDashboard writes a value of the 'dashboard' type to the given stream.
+func writeDashboard(object *Dashboard, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(dashboardData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = DashboardLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(DashboardLinkKind)
 	} else {
-		*data.Kind = DashboardKind
+		stream.WriteString(DashboardKind)
 	}
-	data.Name = o.name
-	data.Metrics, err = o.metrics.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.metrics != nil && len(object.metrics.items) > 0 {
+		stream.WriteObjectField("metrics")
+		writeMetricList(object.metrics, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalDashboard reads a value of the 'dashboard' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalDashboard(source interface{}) (object *Dashboard, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalDashboard(source interface{}, options ...helpers.DecodingOptions) (object *Dashboard, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(dashboardData)
-	err = decoder.Decode(data)
+	object = readDashboard(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Dashboard)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'dashboard' type.
-func (d *dashboardData) unwrap() (object *Dashboard, err error) {
-	if d == nil {
-		return
+// readDashboard reads a value of the 'dashboard' type from the given iterator.
+func readDashboard(iterator *helpers.Iterator) *Dashboard {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Dashboard)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case DashboardKind:
-			object.link = false
-		case DashboardLinkKind:
-			object.link = true
+	object := new(Dashboard)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(DashboardKind, DashboardLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "metrics":
+			object.metrics = readMetricList(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				DashboardKind,
-				DashboardLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.name = d.Name
-	object.metrics, err = d.Metrics.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalDashboard function.
+func (o *Dashboard) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeDashboard(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalDashboard function, using the
+// default decoding options.
+func (o *Dashboard) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalDashboard(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Dashboard{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Dashboard) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Dashboard) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -19,11 +19,16 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // DNSBuilder contains the data and logic needed to build 'DNS' objects.
 //
 // DNS settings of the cluster.
 type DNSBuilder struct {
 	baseDomain *string
+	extra      map[string]interface{}
 }
 
 // NewDNS creates a new builder of 'DNS' objects.
@@ -31,6 +36,19 @@ func NewDNS() *DNSBuilder {
 	return new(DNSBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'DNS' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *DNS) Builder() *DNSBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(DNSBuilder)
+	b.baseDomain = o.baseDomain
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // BaseDomain sets the value of the 'base_domain' attribute
 // to the given value.
 //
@@ -40,9 +58,17 @@ func (b *DNSBuilder) BaseDomain(value string) *DNSBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'DNS' type, and
+// that will be written as they are when the object is encoded.
+func (b *DNSBuilder) Extra(value map[string]interface{}) *DNSBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'DNS' object using the configuration stored in the builder.
 func (b *DNSBuilder) Build() (object *DNS, err error) {
 	object = new(DNS)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.baseDomain != nil {
 		object.baseDomain = b.baseDomain
 	}
@@ -30,6 +30,19 @@ func NewDNSList() *DNSListBuilder {
 	return new(DNSListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *DNSList) Builder() *DNSListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(DNSListBuilder)
+	b.items = make([]*DNSBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *DNSListBuilder) Items(values ...*DNSBuilder) *DNSListBuilder {
 	b.items = make([]*DNSBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// dnsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'DNS'.
-type dnsListData []*dnsData
-
 // UnmarshalDNSList reads a list of values of the 'DNS'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalDNSList(source interface{}) (list *DNSList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalDNSList(source interface{}, options ...helpers.DecodingOptions) (list *DNSList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data dnsListData
-	err = decoder.Decode(&data)
+	list = readDNSList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'DNS' value to a JSON document.
-func (l *DNSList) wrap() (data dnsListData, err error) {
-	if l == nil {
-		return
+// MarshalDNSList writes a list of values of the 'DNS' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalDNSList(list *DNSList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(dnsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeDNSList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *DNSList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeDNSList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalDNSList function,
+// using the default decoding options.
+func (l *DNSList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalDNSList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = DNSList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'DNS' type.
-func (d dnsListData) unwrap() (list *DNSList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *DNSList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *DNSList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeDNSList writes a list of values of the 'DNS' type to the given
+// stream, as a JSON array.
+func writeDNSList(list *DNSList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*DNS, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeDNS(item, stream)
 	}
-	list = new(DNSList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readDNSList reads a list of values of the 'DNS' type from the given
+// iterator, as a JSON array.
+func readDNSList(iterator *helpers.Iterator) *DNSList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(DNSList)
+	list.items = []*DNS{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readDNS(iterator))
+	}
+	return list
 }
@@ -23,60 +23,119 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// dnsData is the data structure used internally to marshal and unmarshal
-// objects of type 'DNS'.
-type dnsData struct {
-	BaseDomain *string "json:\"base_domain,omitempty\""
-}
-
 // MarshalDNS writes a value of the 'DNS' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalDNS(object *DNS, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeDNS(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'DNS'
-// value to a JSON document.
-func (o *DNS) wrap() (data *dnsData, err error) {
-	if o == nil {
+// writeDNS writes a value of the 'DNS' type to the given stream.
+func writeDNS(object *DNS, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(dnsData)
-	data.BaseDomain = o.baseDomain
-	return
+	stream.WriteObjectStart()
+	if object.baseDomain != nil {
+		stream.WriteObjectField("base_domain")
+		stream.WriteString(*object.baseDomain)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalDNS reads a value of the 'DNS' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalDNS(source interface{}) (object *DNS, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalDNS(source interface{}, options ...helpers.DecodingOptions) (object *DNS, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(dnsData)
-	err = decoder.Decode(data)
+	object = readDNS(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(DNS)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'DNS' type.
-func (d *dnsData) unwrap() (object *DNS, err error) {
-	if d == nil {
-		return
+// readDNS reads a value of the 'DNS' type from the given iterator.
+func readDNS(iterator *helpers.Iterator) *DNS {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(DNS)
-	object.baseDomain = d.BaseDomain
-	return
+	object := new(DNS)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "base_domain":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.baseDomain = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalDNS function.
+func (o *DNS) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeDNS(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalDNS function, using the
+// default decoding options.
+func (o *DNS) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalDNS(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = DNS{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *DNS) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *DNS) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
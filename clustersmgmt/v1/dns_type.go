@@ -19,11 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // DNS represents the values of the 'DNS' type.
 //
 // DNS settings of the cluster.
 type DNS struct {
 	baseDomain *string
+	extra      map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -32,6 +39,59 @@ func (o *DNS) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'DNS' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *DNS) Metadata() *helpers.TypeMetadata {
+	return dnsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *DNS) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printDNS(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *DNS) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printDNS(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *DNS) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printDNS(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *DNS) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printDNS(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'DNS' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *DNS) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // BaseDomain returns the value of the 'base_domain' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -141,6 +201,111 @@ func (o *DNS) GetBaseDomain() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'DNS' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *DNS) Copy() *DNS {
+	if o == nil {
+		return nil
+	}
+	result := new(DNS)
+	if o.baseDomain != nil {
+		result.baseDomain = new(string)
+		*result.baseDomain = *o.baseDomain
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'DNS' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *DNS) Equal(other *DNS) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.baseDomain == nil) != (other.baseDomain == nil) ||
+		(o.baseDomain != nil && *o.baseDomain != *other.baseDomain) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'DNS' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *DNS) Diff(other *DNS) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'DNS' object and the given one, using the given path as prefix.
+func (o *DNS) diff(path string, other *DNS, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.baseDomain == nil) != (other.baseDomain == nil) ||
+		(o.baseDomain != nil && *o.baseDomain != *other.baseDomain) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "base_domain"),
+			Old:  helpers.Value(o.baseDomain),
+			New:  helpers.Value(other.baseDomain),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'DNS' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *DNS) Changes(modified *DNS) *DNS {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(DNS)
+	if modified.baseDomain != nil && (o.baseDomain == nil || *o.baseDomain != *modified.baseDomain) {
+		result.baseDomain = new(string)
+		*result.baseDomain = *modified.baseDomain
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printDNS prints a value of the 'DNS' type to the given printer.
+func printDNS(object *DNS, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.DNS")
+	if object.baseDomain != nil {
+		printer.PrintObjectField("BaseDomain")
+		printer.PrintString(*object.baseDomain)
+	}
+	printer.PrintObjectEnd()
+}
+
 // DNSList is a list of values of the 'DNS' type.
 type DNSList struct {
 	items []*DNS
@@ -212,3 +377,105 @@ func (l *DNSList) Range(f func(index int, item *DNS) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *DNSList) Copy() *DNSList {
+	if l == nil {
+		return nil
+	}
+	result := new(DNSList)
+	if l.items != nil {
+		result.items = make([]*DNS, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *DNSList) Equal(other *DNSList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *DNSList) Diff(other *DNSList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *DNSList) diff(path string, other *DNSList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *DNSList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printDNSList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *DNSList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printDNSList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *DNSList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printDNSList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *DNSList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printDNSList(l, printer)
+	}
+}
+
+// printDNSList prints a list of values of the 'DNS' type to the given printer.
+func printDNSList(list *DNSList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printDNS(item, printer)
+	}
+	printer.PrintListEnd()
+}
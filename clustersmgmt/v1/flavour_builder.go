@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // FlavourBuilder contains the data and logic needed to build 'flavour' objects.
 //
 // Set of predefined properties of a cluster. For example, a _huge_ flavour can be a cluster
@@ -32,6 +36,7 @@ type FlavourBuilder struct {
 	nodes   *ClusterNodesBuilder
 	name    *string
 	network *NetworkBuilder
+	extra   map[string]interface{}
 }
 
 // NewFlavour creates a new builder of 'flavour' objects.
@@ -39,6 +44,32 @@ func NewFlavour() *FlavourBuilder {
 	return new(FlavourBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'flavour' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Flavour) Builder() *FlavourBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(FlavourBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	if o.aws != nil {
+		b.aws = o.aws.Builder()
+	}
+	b.version = o.version
+	if o.nodes != nil {
+		b.nodes = o.nodes.Builder()
+	}
+	b.name = o.name
+	if o.network != nil {
+		b.network = o.network.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *FlavourBuilder) ID(value string) *FlavourBuilder {
 	b.id = &value
@@ -102,9 +133,17 @@ func (b *FlavourBuilder) Network(value *NetworkBuilder) *FlavourBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'flavour' type, and
+// that will be written as they are when the object is encoded.
+func (b *FlavourBuilder) Extra(value map[string]interface{}) *FlavourBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'flavour' object using the configuration stored in the builder.
 func (b *FlavourBuilder) Build() (object *Flavour, err error) {
 	object = new(Flavour)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
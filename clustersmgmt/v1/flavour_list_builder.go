@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'flavour' objects.
 type FlavourListBuilder struct {
 	items []*FlavourBuilder
+	link  bool
+	href  *string
 }
 
 // NewFlavourList creates a new builder of 'flavour' objects.
@@ -30,8 +32,25 @@ func NewFlavourList() *FlavourListBuilder {
 	return new(FlavourListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *FlavourList) Builder() *FlavourListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(FlavourListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*FlavourBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *FlavourListBuilder) Items(values ...*FlavourBuilder) *FlavourListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*FlavourBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *FlavourListBuilder) Build() (list *FlavourList, err error) {
 		}
 	}
 	list = new(FlavourList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// flavourListData is type used internally to marshal and unmarshal lists of objects
-// of type 'flavour'.
-type flavourListData []*flavourData
-
 // UnmarshalFlavourList reads a list of values of the 'flavour'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalFlavourList(source interface{}) (list *FlavourList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalFlavourList(source interface{}, options ...helpers.DecodingOptions) (list *FlavourList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data flavourListData
-	err = decoder.Decode(&data)
+	list = readFlavourList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'flavour' value to a JSON document.
-func (l *FlavourList) wrap() (data flavourListData, err error) {
-	if l == nil {
+// MarshalFlavourList writes a list of values of the 'flavour' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalFlavourList(list *FlavourList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeFlavourList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *FlavourList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeFlavourListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalFlavourList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *FlavourList) UnmarshalJSON(data []byte) error {
+	var list *FlavourList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalFlavourList(data)
+	} else {
+		list, err = unmarshalFlavourListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = FlavourList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *FlavourList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *FlavourList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalFlavourListLink reads a list of values of the 'flavour' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalFlavourListLink(source interface{}, options ...helpers.DecodingOptions) (list *FlavourList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(flavourListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readFlavourListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'flavour' type.
-func (d flavourListData) unwrap() (list *FlavourList, err error) {
-	if d == nil {
+// writeFlavourList writes a list of values of the 'flavour' type to the given
+// stream, as a JSON array.
+func writeFlavourList(list *FlavourList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Flavour, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeFlavour(item, stream)
 	}
-	list = new(FlavourList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// flavourListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'flavour'.
-type flavourListLinkData struct {
-	Kind  *string        "json:\"kind,omitempty\""
-	HREF  *string        "json:\"href,omitempty\""
-	Items []*flavourData "json:\"items,omitempty\""
+// readFlavourList reads a list of values of the 'flavour' type from the given
+// iterator, as a JSON array.
+func readFlavourList(iterator *helpers.Iterator) *FlavourList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(FlavourList)
+	list.items = []*Flavour{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readFlavour(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'flavour' value to a link.
-func (l *FlavourList) wrapLink() (data *flavourListLinkData, err error) {
-	if l == nil {
+// writeFlavourListLink writes a list of values of the 'flavour' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeFlavourListLink(list *FlavourList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*flavourData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(flavourListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = FlavourListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(FlavourListLinkKind)
 	} else {
-		*data.Kind = FlavourListKind
+		stream.WriteString(FlavourListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeFlavourList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'flavour' type to a list.
-func (d *flavourListLinkData) unwrapLink() (list *FlavourList, err error) {
-	if d == nil {
-		return
+// readFlavourListLink reads a list of values of the 'flavour' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readFlavourListLink(iterator *helpers.Iterator) *FlavourList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Flavour, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(FlavourList)
+	list.items = []*Flavour{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(FlavourList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case FlavourListKind:
-			list.link = false
-		case FlavourListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(FlavourListKind, FlavourListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readFlavourList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				FlavourListKind,
-				FlavourListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
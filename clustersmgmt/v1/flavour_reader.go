@@ -20,124 +20,175 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// flavourData is the data structure used internally to marshal and unmarshal
-// objects of type 'flavour'.
-type flavourData struct {
-	Kind    *string           "json:\"kind,omitempty\""
-	ID      *string           "json:\"id,omitempty\""
-	HREF    *string           "json:\"href,omitempty\""
-	AWS     *awsData          "json:\"aws,omitempty\""
-	Version *string           "json:\"version,omitempty\""
-	Nodes   *clusterNodesData "json:\"nodes,omitempty\""
-	Name    *string           "json:\"name,omitempty\""
-	Network *networkData      "json:\"network,omitempty\""
-}
-
 // MarshalFlavour writes a value of the 'flavour' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalFlavour(object *Flavour, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	data, err := object.wrap()
-	if err != nil {
-		return err
-	}
-	return encoder.Encode(data)
+	writeFlavour(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'flavour'
-// value to a JSON document.
-func (o *Flavour) wrap() (data *flavourData, err error) {
-	if o == nil {
+// writeFlavour writes a value of the 'flavour' type to the given stream.
+func writeFlavour(object *Flavour, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(flavourData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = FlavourLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(FlavourLinkKind)
 	} else {
-		*data.Kind = FlavourKind
+		stream.WriteString(FlavourKind)
 	}
-	data.AWS, err = o.aws.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Version = o.version
-	data.Nodes, err = o.nodes.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	data.Name = o.name
-	data.Network, err = o.network.wrap()
-	if err != nil {
-		return
+	if object.aws != nil {
+		stream.WriteObjectField("aws")
+		writeAWS(object.aws, stream)
 	}
-	return
+	if object.version != nil {
+		stream.WriteObjectField("version")
+		stream.WriteString(*object.version)
+	}
+	if object.nodes != nil {
+		stream.WriteObjectField("nodes")
+		writeClusterNodes(object.nodes, stream)
+	}
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
+	}
+	if object.network != nil {
+		stream.WriteObjectField("network")
+		writeNetwork(object.network, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalFlavour reads a value of the 'flavour' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalFlavour(source interface{}) (object *Flavour, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalFlavour(source interface{}, options ...helpers.DecodingOptions) (object *Flavour, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(flavourData)
-	err = decoder.Decode(data)
+	object = readFlavour(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Flavour)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'flavour' type.
-func (d *flavourData) unwrap() (object *Flavour, err error) {
-	if d == nil {
-		return
+// readFlavour reads a value of the 'flavour' type from the given iterator.
+func readFlavour(iterator *helpers.Iterator) *Flavour {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Flavour)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case FlavourKind:
-			object.link = false
-		case FlavourLinkKind:
-			object.link = true
+	object := new(Flavour)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(FlavourKind, FlavourLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "aws":
+			object.aws = readAWS(iterator)
+		case "version":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.version = &value
+			}
+		case "nodes":
+			object.nodes = readClusterNodes(iterator)
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "network":
+			object.network = readNetwork(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				FlavourKind,
-				FlavourLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.aws, err = d.AWS.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalFlavour function.
+func (o *Flavour) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeFlavour(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalFlavour function, using the
+// default decoding options.
+func (o *Flavour) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalFlavour(data)
 	if err != nil {
-		return
+		return err
+	}
+	if object == nil {
+		*o = Flavour{}
+		return nil
 	}
-	object.version = d.Version
-	object.nodes, err = d.Nodes.unwrap()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Flavour) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.name = d.Name
-	object.network, err = d.Network.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Flavour) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // FlavourKind is the name of the type used to represent objects
 // of type 'flavour'.
 const FlavourKind = "Flavour"
@@ -44,6 +52,7 @@ type Flavour struct {
 	nodes   *ClusterNodes
 	name    *string
 	network *Network
+	extra   map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -98,6 +107,27 @@ func (o *Flavour) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete flavour that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *Flavour) Resolve(ctx context.Context, transport http.RoundTripper) (result *Flavour, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to flavour '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewFlavourClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *Flavour) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -109,6 +139,59 @@ func (o *Flavour) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'flavour' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Flavour) Metadata() *helpers.TypeMetadata {
+	return flavourMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Flavour) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printFlavour(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Flavour) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printFlavour(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Flavour) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printFlavour(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Flavour) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printFlavour(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'flavour' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Flavour) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // AWS returns the value of the 'AWS' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -256,6 +339,231 @@ const FlavourListLinkKind = "FlavourListLink"
 // objects of type 'flavour'.
 const FlavourListNilKind = "FlavourListNil"
 
+// Copy returns a deep copy of the 'flavour' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Flavour) Copy() *Flavour {
+	if o == nil {
+		return nil
+	}
+	result := new(Flavour)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	result.aws = o.aws.Copy()
+	if o.version != nil {
+		result.version = new(string)
+		*result.version = *o.version
+	}
+	result.nodes = o.nodes.Copy()
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	result.network = o.network.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'flavour' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Flavour) Equal(other *Flavour) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if !o.aws.Equal(other.aws) {
+		return false
+	}
+	if (o.version == nil) != (other.version == nil) ||
+		(o.version != nil && *o.version != *other.version) {
+		return false
+	}
+	if !o.nodes.Equal(other.nodes) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if !o.network.Equal(other.network) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'flavour' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Flavour) Diff(other *Flavour) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'flavour' object and the given one, using the given path as prefix.
+func (o *Flavour) diff(path string, other *Flavour, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	result = o.aws.diff(helpers.Path(path, "aws"), other.aws, result)
+	if (o.version == nil) != (other.version == nil) ||
+		(o.version != nil && *o.version != *other.version) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "version"),
+			Old:  helpers.Value(o.version),
+			New:  helpers.Value(other.version),
+		})
+	}
+	result = o.nodes.diff(helpers.Path(path, "nodes"), other.nodes, result)
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	result = o.network.diff(helpers.Path(path, "network"), other.network, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'flavour' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Flavour) Changes(modified *Flavour) *Flavour {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Flavour)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.aws != nil && !o.aws.Equal(modified.aws) {
+		result.aws = o.aws.Changes(modified.aws)
+	}
+	if modified.version != nil && (o.version == nil || *o.version != *modified.version) {
+		result.version = new(string)
+		*result.version = *modified.version
+	}
+	if modified.nodes != nil && !o.nodes.Equal(modified.nodes) {
+		result.nodes = o.nodes.Changes(modified.nodes)
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.network != nil && !o.network.Equal(modified.network) {
+		result.network = o.network.Changes(modified.network)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printFlavour prints a value of the 'flavour' type to the given printer.
+func printFlavour(object *Flavour, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Flavour")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.aws != nil {
+		printer.PrintObjectField("AWS")
+		printAWS(object.aws, printer)
+	}
+	if object.version != nil {
+		printer.PrintObjectField("Version")
+		printer.PrintString(*object.version)
+	}
+	if object.nodes != nil {
+		printer.PrintObjectField("Nodes")
+		printClusterNodes(object.nodes, printer)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.network != nil {
+		printer.PrintObjectField("Network")
+		printNetwork(object.network, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // FlavourList is a list of values of the 'flavour' type.
 type FlavourList struct {
 	href  *string
@@ -363,3 +671,146 @@ func (l *FlavourList) Range(f func(index int, item *Flavour) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *FlavourList) Copy() *FlavourList {
+	if l == nil {
+		return nil
+	}
+	result := new(FlavourList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*Flavour, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *FlavourList) Equal(other *FlavourList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *FlavourList) Diff(other *FlavourList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *FlavourList) diff(path string, other *FlavourList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *FlavourList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printFlavourListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *FlavourList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printFlavourListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *FlavourList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printFlavourListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *FlavourList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printFlavourListLink(l, printer)
+	}
+}
+
+// printFlavourList prints a list of values of the 'flavour' type to the given printer.
+func printFlavourList(list *FlavourList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printFlavour(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printFlavourListLink prints a list of values of the 'flavour' type to the
+// given printer, including the link to the list.
+func printFlavourListLink(list *FlavourList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.FlavourList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printFlavourList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
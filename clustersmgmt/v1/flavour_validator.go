@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Validate checks that the object can be used to create a flavour. It returns nil if it is
+// valid, or a *helpers.ValidationError containing all the violations if it isn't.
+func (o *Flavour) Validate() error {
+	validator := new(helpers.Validator)
+	if o == nil {
+		validator.Report("", "flavour is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes.
+func (o *Flavour) validate(path string, validator *helpers.Validator) {
+	validator.Required(helpers.Path(path, "name"), o.name)
+	o.network.validate(helpers.Path(path, "network"), validator)
+	o.nodes.validate(helpers.Path(path, "nodes"), validator)
+}
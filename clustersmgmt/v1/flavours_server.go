@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // FlavoursServer represents the interface the manages the 'flavours' resource.
@@ -57,6 +59,7 @@ type FlavoursListServerRequest struct {
 	page   *int
 	size   *int
 	search *string
+	order  []*helpers.OrderItem
 	total  *int
 }
 
@@ -160,6 +163,58 @@ func (r *FlavoursListServerRequest) GetSearch() (value string, ok bool) {
 	return
 }
 
+// Order returns the value of the 'order' parameter.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the flavour
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *FlavoursListServerRequest) Order() []*helpers.OrderItem {
+	if r != nil {
+		return r.order
+	}
+	return nil
+}
+
+// GetOrder returns the value of the 'order' parameter and
+// a flag indicating if the parameter has a value.
+//
+// Order criteria.
+//
+// The syntax of this parameter is similar to the syntax of the _order by_ clause
+// of an SQL statement, but using the names of the attributes of the flavour
+// instead of the names of the columns of a table. Each item contains the name
+// of an attribute, optionally followed by `asc` or `desc`. For example, in order
+// to sort descending by `name` and then ascending by `id` the value
+// should be:
+//
+// [source,sql]
+// ----
+// name desc, id asc
+// ----
+//
+// If the parameter isn't provided, or if the value is empty, then the order of
+// the results is undefined.
+func (r *FlavoursListServerRequest) GetOrder() (value []*helpers.OrderItem, ok bool) {
+	ok = r != nil && r.order != nil
+	if ok {
+		value = r.order
+	}
+	return
+}
+
 // Total returns the value of the 'total' parameter.
 //
 // Total number of items of the collection that match the search criteria,
@@ -240,27 +295,29 @@ func (r *FlavoursListServerResponse) SetStatusCode(status int) *FlavoursListServ
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *FlavoursListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(flavoursListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// flavoursListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type flavoursListServerResponseData struct {
-	Page  *int            "json:\"page,omitempty\""
-	Size  *int            "json:\"size,omitempty\""
-	Total *int            "json:\"total,omitempty\""
-	Items flavourListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeFlavourList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
 // FlavoursAddServerRequest is the request for the 'add' method.
@@ -295,18 +352,12 @@ func (r *FlavoursAddServerRequest) GetBody() (value *Flavour, ok bool) {
 // unmarshal is the method used internally to unmarshal request to the
 // 'add' method.
 func (r *FlavoursAddServerRequest) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(flavourData)
-	err = decoder.Decode(data)
-	if err != nil {
-		return err
-	}
-	r.body, err = data.unwrap()
+	iterator, err := helpers.NewIterator(reader)
 	if err != nil {
 		return err
 	}
-	return err
+	r.body = readFlavour(iterator)
+	return iterator.Error()
 }
 
 // FlavoursAddServerResponse is the response for the 'add' method.
@@ -333,75 +384,165 @@ func (r *FlavoursAddServerResponse) SetStatusCode(status int) *FlavoursAddServer
 // marshall is the method used internally to marshal responses for the
 // 'add' method.
 func (r *FlavoursAddServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeFlavour(r.body, stream)
+	return stream.Flush()
 }
 
-// FlavoursServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// FlavoursServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type FlavoursServerAdapter struct {
 	server FlavoursServer
 	router *mux.Router
+	prefix string
 }
 
+// NewFlavoursServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewFlavoursServerAdapter(server FlavoursServer, router *mux.Router) *FlavoursServerAdapter {
 	adapter := new(FlavoursServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.flavourHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
-	adapter.router.Methods("POST").HandlerFunc(adapter.addHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *FlavoursServerAdapter) flavourHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.Flavour(id)
-	targetAdapter := NewFlavourServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *FlavoursServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchFlavours(w, r, a.server, segments)
+}
+
+// dispatchFlavours navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchFlavours(w http.ResponseWriter, r *http.Request, server FlavoursServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptFlavoursListRequest(w, r, server)
+		case http.MethodPost:
+			adaptFlavoursAddRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.Flavour(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchFlavour(w, r, target, segments[1:])
+	}
+}
+
+// flavoursListOrderAttributes contains the names of the attributes that can be used in the
+// 'order' parameter of the 'list' method.
+var flavoursListOrderAttributes = map[string]bool{
+	"href":                 true,
+	"id":                   true,
+	"name":                 true,
+	"network.machine_cidr": true,
+	"network.pod_cidr":     true,
+	"network.service_cidr": true,
+	"nodes.compute":        true,
+	"nodes.infra":          true,
+	"nodes.master":         true,
+	"nodes.total":          true,
+	"version":              true,
 }
-func (a *FlavoursServerAdapter) readFlavoursListServerRequest(r *http.Request) (*FlavoursListServerRequest, error) {
+
+func readFlavoursListServerRequest(r *http.Request) (*FlavoursListServerRequest, error) {
+	var err error
 	result := new(FlavoursListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
+	result.page, err = helpers.ParseInteger(result.query, "page")
+	if err != nil {
+		return nil, err
+	}
+	if result.page == nil {
+		result.page = new(int)
+		*result.page = 1
+	}
+	err = helpers.CheckMinimum("page", *result.page, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.size, err = helpers.ParseInteger(result.query, "size")
+	if err != nil {
+		return nil, err
+	}
+	if result.size == nil {
+		result.size = new(int)
+		*result.size = 100
+	}
+	err = helpers.CheckMinimum("size", *result.size, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.search, err = helpers.ParseString(result.query, "search")
+	if err != nil {
+		return nil, err
+	}
+	result.order, err = helpers.ParseOrder(result.query, "order", flavoursListOrderAttributes)
+	if err != nil {
+		return nil, err
+	}
+	result.total, err = helpers.ParseInteger(result.query, "total")
+	if err != nil {
+		return nil, err
+	}
 	return result, nil
 }
-func (a *FlavoursServerAdapter) writeFlavoursListServerResponse(w http.ResponseWriter, r *FlavoursListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeFlavoursListServerResponse(w http.ResponseWriter, r *FlavoursListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *FlavoursServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readFlavoursListServerRequest(r)
+func adaptFlavoursListRequest(w http.ResponseWriter, r *http.Request, server FlavoursServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readFlavoursListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(FlavoursListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeFlavoursListServerResponse(w, resp)
+	err = writeFlavoursListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -411,9 +552,9 @@ func (a *FlavoursServerAdapter) listHandler(w http.ResponseWriter, r *http.Reque
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *FlavoursServerAdapter) readFlavoursAddServerRequest(r *http.Request) (*FlavoursAddServerRequest, error) {
+func readFlavoursAddServerRequest(r *http.Request) (*FlavoursAddServerRequest, error) {
 	result := new(FlavoursAddServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	err := result.unmarshal(r.Body)
 	if err != nil {
@@ -421,36 +562,42 @@ func (a *FlavoursServerAdapter) readFlavoursAddServerRequest(r *http.Request) (*
 	}
 	return result, nil
 }
-func (a *FlavoursServerAdapter) writeFlavoursAddServerResponse(w http.ResponseWriter, r *FlavoursAddServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeFlavoursAddServerResponse(w http.ResponseWriter, r *FlavoursAddServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *FlavoursServerAdapter) addHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readFlavoursAddServerRequest(r)
+func adaptFlavoursAddRequest(w http.ResponseWriter, r *http.Request, server FlavoursServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readFlavoursAddServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(FlavoursAddServerResponse)
-	err = a.server.Add(r.Context(), req, resp)
+	err = server.Add(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Add: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Add", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusCreated
 	}
-	err = a.writeFlavoursAddServerResponse(w, resp)
+	err = writeFlavoursAddServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GithubIdentityProviderBuilder contains the data and logic needed to build 'github_identity_provider' objects.
 //
 // Details for `github` identity providers.
@@ -27,6 +31,7 @@ type GithubIdentityProviderBuilder struct {
 	clientID *string
 	hostname *string
 	teams    []string
+	extra    map[string]interface{}
 }
 
 // NewGithubIdentityProvider creates a new builder of 'github_identity_provider' objects.
@@ -34,6 +39,25 @@ func NewGithubIdentityProvider() *GithubIdentityProviderBuilder {
 	return new(GithubIdentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'github_identity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *GithubIdentityProvider) Builder() *GithubIdentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(GithubIdentityProviderBuilder)
+	b.ca = o.ca
+	b.clientID = o.clientID
+	b.hostname = o.hostname
+	if o.teams != nil {
+		b.teams = make([]string, len(o.teams))
+		copy(b.teams, o.teams)
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // CA sets the value of the 'CA' attribute
 // to the given value.
 //
@@ -71,9 +95,17 @@ func (b *GithubIdentityProviderBuilder) Teams(values ...string) *GithubIdentityP
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'github_identity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *GithubIdentityProviderBuilder) Extra(value map[string]interface{}) *GithubIdentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'github_identity_provider' object using the configuration stored in the builder.
 func (b *GithubIdentityProviderBuilder) Build() (object *GithubIdentityProvider, err error) {
 	object = new(GithubIdentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.ca != nil {
 		object.ca = b.ca
 	}
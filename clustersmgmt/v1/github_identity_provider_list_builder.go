@@ -30,6 +30,19 @@ func NewGithubIdentityProviderList() *GithubIdentityProviderListBuilder {
 	return new(GithubIdentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *GithubIdentityProviderList) Builder() *GithubIdentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(GithubIdentityProviderListBuilder)
+	b.items = make([]*GithubIdentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *GithubIdentityProviderListBuilder) Items(values ...*GithubIdentityProviderBuilder) *GithubIdentityProviderListBuilder {
 	b.items = make([]*GithubIdentityProviderBuilder, len(values))
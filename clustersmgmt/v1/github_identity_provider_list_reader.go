@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// githubIdentityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'github_identity_provider'.
-type githubIdentityProviderListData []*githubIdentityProviderData
-
 // UnmarshalGithubIdentityProviderList reads a list of values of the 'github_identity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalGithubIdentityProviderList(source interface{}) (list *GithubIdentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalGithubIdentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *GithubIdentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data githubIdentityProviderListData
-	err = decoder.Decode(&data)
+	list = readGithubIdentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'github_identity_provider' value to a JSON document.
-func (l *GithubIdentityProviderList) wrap() (data githubIdentityProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalGithubIdentityProviderList writes a list of values of the 'github_identity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalGithubIdentityProviderList(list *GithubIdentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(githubIdentityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeGithubIdentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *GithubIdentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGithubIdentityProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGithubIdentityProviderList function,
+// using the default decoding options.
+func (l *GithubIdentityProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalGithubIdentityProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = GithubIdentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'github_identity_provider' type.
-func (d githubIdentityProviderListData) unwrap() (list *GithubIdentityProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *GithubIdentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *GithubIdentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeGithubIdentityProviderList writes a list of values of the 'github_identity_provider' type to the given
+// stream, as a JSON array.
+func writeGithubIdentityProviderList(list *GithubIdentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*GithubIdentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeGithubIdentityProvider(item, stream)
 	}
-	list = new(GithubIdentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readGithubIdentityProviderList reads a list of values of the 'github_identity_provider' type from the given
+// iterator, as a JSON array.
+func readGithubIdentityProviderList(iterator *helpers.Iterator) *GithubIdentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(GithubIdentityProviderList)
+	list.items = []*GithubIdentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readGithubIdentityProvider(iterator))
+	}
+	return list
 }
@@ -23,69 +23,143 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// githubIdentityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'github_identity_provider'.
-type githubIdentityProviderData struct {
-	CA       *string  "json:\"ca,omitempty\""
-	ClientID *string  "json:\"client_id,omitempty\""
-	Hostname *string  "json:\"hostname,omitempty\""
-	Teams    []string "json:\"teams,omitempty\""
-}
-
 // MarshalGithubIdentityProvider writes a value of the 'github_identity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalGithubIdentityProvider(object *GithubIdentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeGithubIdentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'github_identity_provider'
-// value to a JSON document.
-func (o *GithubIdentityProvider) wrap() (data *githubIdentityProviderData, err error) {
-	if o == nil {
+// writeGithubIdentityProvider writes a value of the 'github_identity_provider' type to the given stream.
+func writeGithubIdentityProvider(object *GithubIdentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(githubIdentityProviderData)
-	data.CA = o.ca
-	data.ClientID = o.clientID
-	data.Hostname = o.hostname
-	data.Teams = o.teams
-	return
+	stream.WriteObjectStart()
+	if object.ca != nil {
+		stream.WriteObjectField("ca")
+		stream.WriteString(*object.ca)
+	}
+	if object.clientID != nil {
+		stream.WriteObjectField("client_id")
+		stream.WriteString(*object.clientID)
+	}
+	if object.hostname != nil {
+		stream.WriteObjectField("hostname")
+		stream.WriteString(*object.hostname)
+	}
+	if len(object.teams) > 0 {
+		stream.WriteObjectField("teams")
+		stream.WriteStringSlice(object.teams)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalGithubIdentityProvider reads a value of the 'github_identity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalGithubIdentityProvider(source interface{}) (object *GithubIdentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalGithubIdentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *GithubIdentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(githubIdentityProviderData)
-	err = decoder.Decode(data)
+	object = readGithubIdentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(GithubIdentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'github_identity_provider' type.
-func (d *githubIdentityProviderData) unwrap() (object *GithubIdentityProvider, err error) {
-	if d == nil {
-		return
+// readGithubIdentityProvider reads a value of the 'github_identity_provider' type from the given iterator.
+func readGithubIdentityProvider(iterator *helpers.Iterator) *GithubIdentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(GithubIdentityProvider)
-	object.ca = d.CA
-	object.clientID = d.ClientID
-	object.hostname = d.Hostname
-	object.teams = d.Teams
-	return
+	object := new(GithubIdentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "ca":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.ca = &value
+			}
+		case "client_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientID = &value
+			}
+		case "hostname":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.hostname = &value
+			}
+		case "teams":
+			object.teams = iterator.ReadStringSlice()
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalGithubIdentityProvider function.
+func (o *GithubIdentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGithubIdentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGithubIdentityProvider function, using the
+// default decoding options.
+func (o *GithubIdentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalGithubIdentityProvider(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = GithubIdentityProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *GithubIdentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *GithubIdentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
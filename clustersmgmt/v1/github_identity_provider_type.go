@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GithubIdentityProvider represents the values of the 'github_identity_provider' type.
 //
 // Details for `github` identity providers.
@@ -27,6 +33,7 @@ type GithubIdentityProvider struct {
 	clientID *string
 	hostname *string
 	teams    []string
+	extra    map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +45,59 @@ func (o *GithubIdentityProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'github_identity_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *GithubIdentityProvider) Metadata() *helpers.TypeMetadata {
+	return githubIdentityProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *GithubIdentityProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printGithubIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *GithubIdentityProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printGithubIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *GithubIdentityProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printGithubIdentityProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *GithubIdentityProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printGithubIdentityProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'github_identity_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *GithubIdentityProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // CA returns the value of the 'CA' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -138,6 +198,186 @@ func (o *GithubIdentityProvider) GetTeams() (value []string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'github_identity_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *GithubIdentityProvider) Copy() *GithubIdentityProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(GithubIdentityProvider)
+	if o.ca != nil {
+		result.ca = new(string)
+		*result.ca = *o.ca
+	}
+	if o.clientID != nil {
+		result.clientID = new(string)
+		*result.clientID = *o.clientID
+	}
+	if o.hostname != nil {
+		result.hostname = new(string)
+		*result.hostname = *o.hostname
+	}
+	if o.teams != nil {
+		result.teams = make([]string, len(o.teams))
+		copy(result.teams, o.teams)
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'github_identity_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *GithubIdentityProvider) Equal(other *GithubIdentityProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		return false
+	}
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		return false
+	}
+	if (o.hostname == nil) != (other.hostname == nil) ||
+		(o.hostname != nil && *o.hostname != *other.hostname) {
+		return false
+	}
+	if len(o.teams) != len(other.teams) {
+		return false
+	}
+	for i, value := range o.teams {
+		if value != other.teams[i] {
+			return false
+		}
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'github_identity_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *GithubIdentityProvider) Diff(other *GithubIdentityProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'github_identity_provider' object and the given one, using the given path as prefix.
+func (o *GithubIdentityProvider) diff(path string, other *GithubIdentityProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "ca"),
+			Old:  helpers.Value(o.ca),
+			New:  helpers.Value(other.ca),
+		})
+	}
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "client_id"),
+			Old:  helpers.Value(o.clientID),
+			New:  helpers.Value(other.clientID),
+		})
+	}
+	if (o.hostname == nil) != (other.hostname == nil) ||
+		(o.hostname != nil && *o.hostname != *other.hostname) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "hostname"),
+			Old:  helpers.Value(o.hostname),
+			New:  helpers.Value(other.hostname),
+		})
+	}
+	if !equalStrings(o.teams, other.teams) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "teams"),
+			Old:  o.teams,
+			New:  other.teams,
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'github_identity_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *GithubIdentityProvider) Changes(modified *GithubIdentityProvider) *GithubIdentityProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(GithubIdentityProvider)
+	if modified.ca != nil && (o.ca == nil || *o.ca != *modified.ca) {
+		result.ca = new(string)
+		*result.ca = *modified.ca
+	}
+	if modified.clientID != nil && (o.clientID == nil || *o.clientID != *modified.clientID) {
+		result.clientID = new(string)
+		*result.clientID = *modified.clientID
+	}
+	if modified.hostname != nil && (o.hostname == nil || *o.hostname != *modified.hostname) {
+		result.hostname = new(string)
+		*result.hostname = *modified.hostname
+	}
+	if modified.teams != nil && !equalStrings(o.teams, modified.teams) {
+		result.teams = make([]string, len(modified.teams))
+		copy(result.teams, modified.teams)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printGithubIdentityProvider prints a value of the 'github_identity_provider' type to the given printer.
+func printGithubIdentityProvider(object *GithubIdentityProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.GithubIdentityProvider")
+	if object.ca != nil {
+		printer.PrintObjectField("CA")
+		printer.PrintString(*object.ca)
+	}
+	if object.clientID != nil {
+		printer.PrintObjectField("ClientID")
+		printer.PrintString(*object.clientID)
+	}
+	if object.hostname != nil {
+		printer.PrintObjectField("Hostname")
+		printer.PrintString(*object.hostname)
+	}
+	if object.teams != nil {
+		printer.PrintObjectField("Teams")
+		printer.PrintStringSlice(object.teams)
+	}
+	printer.PrintObjectEnd()
+}
+
 // GithubIdentityProviderList is a list of values of the 'github_identity_provider' type.
 type GithubIdentityProviderList struct {
 	items []*GithubIdentityProvider
@@ -209,3 +449,105 @@ func (l *GithubIdentityProviderList) Range(f func(index int, item *GithubIdentit
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *GithubIdentityProviderList) Copy() *GithubIdentityProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(GithubIdentityProviderList)
+	if l.items != nil {
+		result.items = make([]*GithubIdentityProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *GithubIdentityProviderList) Equal(other *GithubIdentityProviderList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *GithubIdentityProviderList) Diff(other *GithubIdentityProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *GithubIdentityProviderList) diff(path string, other *GithubIdentityProviderList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *GithubIdentityProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printGithubIdentityProviderList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *GithubIdentityProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printGithubIdentityProviderList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *GithubIdentityProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printGithubIdentityProviderList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *GithubIdentityProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printGithubIdentityProviderList(l, printer)
+	}
+}
+
+// printGithubIdentityProviderList prints a list of values of the 'github_identity_provider' type to the given printer.
+func printGithubIdentityProviderList(list *GithubIdentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printGithubIdentityProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GitlabIdentityProviderBuilder contains the data and logic needed to build 'gitlab_identity_provider' objects.
 //
 // Details for `gitlab` identity providers.
@@ -27,6 +31,7 @@ type GitlabIdentityProviderBuilder struct {
 	clientID     *string
 	clientSecret *string
 	url          *string
+	extra        map[string]interface{}
 }
 
 // NewGitlabIdentityProvider creates a new builder of 'gitlab_identity_provider' objects.
@@ -34,6 +39,22 @@ func NewGitlabIdentityProvider() *GitlabIdentityProviderBuilder {
 	return new(GitlabIdentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'gitlab_identity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *GitlabIdentityProvider) Builder() *GitlabIdentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(GitlabIdentityProviderBuilder)
+	b.ca = o.ca
+	b.clientID = o.clientID
+	b.clientSecret = o.clientSecret
+	b.url = o.url
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // CA sets the value of the 'CA' attribute
 // to the given value.
 //
@@ -70,9 +91,17 @@ func (b *GitlabIdentityProviderBuilder) URL(value string) *GitlabIdentityProvide
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'gitlab_identity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *GitlabIdentityProviderBuilder) Extra(value map[string]interface{}) *GitlabIdentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'gitlab_identity_provider' object using the configuration stored in the builder.
 func (b *GitlabIdentityProviderBuilder) Build() (object *GitlabIdentityProvider, err error) {
 	object = new(GitlabIdentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.ca != nil {
 		object.ca = b.ca
 	}
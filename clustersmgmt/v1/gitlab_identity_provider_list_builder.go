@@ -30,6 +30,19 @@ func NewGitlabIdentityProviderList() *GitlabIdentityProviderListBuilder {
 	return new(GitlabIdentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *GitlabIdentityProviderList) Builder() *GitlabIdentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(GitlabIdentityProviderListBuilder)
+	b.items = make([]*GitlabIdentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *GitlabIdentityProviderListBuilder) Items(values ...*GitlabIdentityProviderBuilder) *GitlabIdentityProviderListBuilder {
 	b.items = make([]*GitlabIdentityProviderBuilder, len(values))
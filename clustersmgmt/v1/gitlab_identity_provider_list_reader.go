@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// gitlabIdentityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'gitlab_identity_provider'.
-type gitlabIdentityProviderListData []*gitlabIdentityProviderData
-
 // UnmarshalGitlabIdentityProviderList reads a list of values of the 'gitlab_identity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalGitlabIdentityProviderList(source interface{}) (list *GitlabIdentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalGitlabIdentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *GitlabIdentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data gitlabIdentityProviderListData
-	err = decoder.Decode(&data)
+	list = readGitlabIdentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'gitlab_identity_provider' value to a JSON document.
-func (l *GitlabIdentityProviderList) wrap() (data gitlabIdentityProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalGitlabIdentityProviderList writes a list of values of the 'gitlab_identity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalGitlabIdentityProviderList(list *GitlabIdentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(gitlabIdentityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeGitlabIdentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *GitlabIdentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGitlabIdentityProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGitlabIdentityProviderList function,
+// using the default decoding options.
+func (l *GitlabIdentityProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalGitlabIdentityProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = GitlabIdentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'gitlab_identity_provider' type.
-func (d gitlabIdentityProviderListData) unwrap() (list *GitlabIdentityProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *GitlabIdentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *GitlabIdentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeGitlabIdentityProviderList writes a list of values of the 'gitlab_identity_provider' type to the given
+// stream, as a JSON array.
+func writeGitlabIdentityProviderList(list *GitlabIdentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*GitlabIdentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeGitlabIdentityProvider(item, stream)
 	}
-	list = new(GitlabIdentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readGitlabIdentityProviderList reads a list of values of the 'gitlab_identity_provider' type from the given
+// iterator, as a JSON array.
+func readGitlabIdentityProviderList(iterator *helpers.Iterator) *GitlabIdentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(GitlabIdentityProviderList)
+	list.items = []*GitlabIdentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readGitlabIdentityProvider(iterator))
+	}
+	return list
 }
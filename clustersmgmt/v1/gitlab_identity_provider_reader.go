@@ -23,69 +23,146 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// gitlabIdentityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'gitlab_identity_provider'.
-type gitlabIdentityProviderData struct {
-	CA           *string "json:\"ca,omitempty\""
-	ClientID     *string "json:\"client_id,omitempty\""
-	ClientSecret *string "json:\"client_secret,omitempty\""
-	URL          *string "json:\"url,omitempty\""
-}
-
 // MarshalGitlabIdentityProvider writes a value of the 'gitlab_identity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalGitlabIdentityProvider(object *GitlabIdentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeGitlabIdentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'gitlab_identity_provider'
-// value to a JSON document.
-func (o *GitlabIdentityProvider) wrap() (data *gitlabIdentityProviderData, err error) {
-	if o == nil {
+// writeGitlabIdentityProvider writes a value of the 'gitlab_identity_provider' type to the given stream.
+func writeGitlabIdentityProvider(object *GitlabIdentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(gitlabIdentityProviderData)
-	data.CA = o.ca
-	data.ClientID = o.clientID
-	data.ClientSecret = o.clientSecret
-	data.URL = o.url
-	return
+	stream.WriteObjectStart()
+	if object.ca != nil {
+		stream.WriteObjectField("ca")
+		stream.WriteString(*object.ca)
+	}
+	if object.clientID != nil {
+		stream.WriteObjectField("client_id")
+		stream.WriteString(*object.clientID)
+	}
+	if object.clientSecret != nil {
+		stream.WriteObjectField("client_secret")
+		stream.WriteString(*object.clientSecret)
+	}
+	if object.url != nil {
+		stream.WriteObjectField("url")
+		stream.WriteString(*object.url)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalGitlabIdentityProvider reads a value of the 'gitlab_identity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalGitlabIdentityProvider(source interface{}) (object *GitlabIdentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalGitlabIdentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *GitlabIdentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(gitlabIdentityProviderData)
-	err = decoder.Decode(data)
+	object = readGitlabIdentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(GitlabIdentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'gitlab_identity_provider' type.
-func (d *gitlabIdentityProviderData) unwrap() (object *GitlabIdentityProvider, err error) {
-	if d == nil {
-		return
+// readGitlabIdentityProvider reads a value of the 'gitlab_identity_provider' type from the given iterator.
+func readGitlabIdentityProvider(iterator *helpers.Iterator) *GitlabIdentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(GitlabIdentityProvider)
-	object.ca = d.CA
-	object.clientID = d.ClientID
-	object.clientSecret = d.ClientSecret
-	object.url = d.URL
-	return
+	object := new(GitlabIdentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "ca":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.ca = &value
+			}
+		case "client_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientID = &value
+			}
+		case "client_secret":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientSecret = &value
+			}
+		case "url":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.url = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalGitlabIdentityProvider function.
+func (o *GitlabIdentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGitlabIdentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGitlabIdentityProvider function, using the
+// default decoding options.
+func (o *GitlabIdentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalGitlabIdentityProvider(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = GitlabIdentityProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *GitlabIdentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *GitlabIdentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
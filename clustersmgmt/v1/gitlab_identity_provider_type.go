@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GitlabIdentityProvider represents the values of the 'gitlab_identity_provider' type.
 //
 // Details for `gitlab` identity providers.
@@ -27,6 +33,7 @@ type GitlabIdentityProvider struct {
 	clientID     *string
 	clientSecret *string
 	url          *string
+	extra        map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +45,59 @@ func (o *GitlabIdentityProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'gitlab_identity_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *GitlabIdentityProvider) Metadata() *helpers.TypeMetadata {
+	return gitlabIdentityProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *GitlabIdentityProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printGitlabIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *GitlabIdentityProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printGitlabIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *GitlabIdentityProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printGitlabIdentityProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *GitlabIdentityProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printGitlabIdentityProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'gitlab_identity_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *GitlabIdentityProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // CA returns the value of the 'CA' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -130,6 +190,183 @@ func (o *GitlabIdentityProvider) GetURL() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'gitlab_identity_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *GitlabIdentityProvider) Copy() *GitlabIdentityProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(GitlabIdentityProvider)
+	if o.ca != nil {
+		result.ca = new(string)
+		*result.ca = *o.ca
+	}
+	if o.clientID != nil {
+		result.clientID = new(string)
+		*result.clientID = *o.clientID
+	}
+	if o.clientSecret != nil {
+		result.clientSecret = new(string)
+		*result.clientSecret = *o.clientSecret
+	}
+	if o.url != nil {
+		result.url = new(string)
+		*result.url = *o.url
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'gitlab_identity_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *GitlabIdentityProvider) Equal(other *GitlabIdentityProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		return false
+	}
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		return false
+	}
+	if (o.clientSecret == nil) != (other.clientSecret == nil) ||
+		(o.clientSecret != nil && *o.clientSecret != *other.clientSecret) {
+		return false
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'gitlab_identity_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *GitlabIdentityProvider) Diff(other *GitlabIdentityProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'gitlab_identity_provider' object and the given one, using the given path as prefix.
+func (o *GitlabIdentityProvider) diff(path string, other *GitlabIdentityProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "ca"),
+			Old:  helpers.Value(o.ca),
+			New:  helpers.Value(other.ca),
+		})
+	}
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "client_id"),
+			Old:  helpers.Value(o.clientID),
+			New:  helpers.Value(other.clientID),
+		})
+	}
+	if (o.clientSecret == nil) != (other.clientSecret == nil) ||
+		(o.clientSecret != nil && *o.clientSecret != *other.clientSecret) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "client_secret"),
+			Old:  helpers.Value(o.clientSecret),
+			New:  helpers.Value(other.clientSecret),
+		})
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "url"),
+			Old:  helpers.Value(o.url),
+			New:  helpers.Value(other.url),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'gitlab_identity_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *GitlabIdentityProvider) Changes(modified *GitlabIdentityProvider) *GitlabIdentityProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(GitlabIdentityProvider)
+	if modified.ca != nil && (o.ca == nil || *o.ca != *modified.ca) {
+		result.ca = new(string)
+		*result.ca = *modified.ca
+	}
+	if modified.clientID != nil && (o.clientID == nil || *o.clientID != *modified.clientID) {
+		result.clientID = new(string)
+		*result.clientID = *modified.clientID
+	}
+	if modified.clientSecret != nil && (o.clientSecret == nil || *o.clientSecret != *modified.clientSecret) {
+		result.clientSecret = new(string)
+		*result.clientSecret = *modified.clientSecret
+	}
+	if modified.url != nil && (o.url == nil || *o.url != *modified.url) {
+		result.url = new(string)
+		*result.url = *modified.url
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printGitlabIdentityProvider prints a value of the 'gitlab_identity_provider' type to the given printer.
+func printGitlabIdentityProvider(object *GitlabIdentityProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.GitlabIdentityProvider")
+	if object.ca != nil {
+		printer.PrintObjectField("CA")
+		printer.PrintString(*object.ca)
+	}
+	if object.clientID != nil {
+		printer.PrintObjectField("ClientID")
+		printer.PrintString(*object.clientID)
+	}
+	if object.clientSecret != nil {
+		printer.PrintObjectField("ClientSecret")
+		printer.PrintSensitive(*object.clientSecret)
+	}
+	if object.url != nil {
+		printer.PrintObjectField("URL")
+		printer.PrintString(*object.url)
+	}
+	printer.PrintObjectEnd()
+}
+
 // GitlabIdentityProviderList is a list of values of the 'gitlab_identity_provider' type.
 type GitlabIdentityProviderList struct {
 	items []*GitlabIdentityProvider
@@ -201,3 +438,105 @@ func (l *GitlabIdentityProviderList) Range(f func(index int, item *GitlabIdentit
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *GitlabIdentityProviderList) Copy() *GitlabIdentityProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(GitlabIdentityProviderList)
+	if l.items != nil {
+		result.items = make([]*GitlabIdentityProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *GitlabIdentityProviderList) Equal(other *GitlabIdentityProviderList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *GitlabIdentityProviderList) Diff(other *GitlabIdentityProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *GitlabIdentityProviderList) diff(path string, other *GitlabIdentityProviderList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *GitlabIdentityProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printGitlabIdentityProviderList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *GitlabIdentityProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printGitlabIdentityProviderList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *GitlabIdentityProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printGitlabIdentityProviderList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *GitlabIdentityProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printGitlabIdentityProviderList(l, printer)
+	}
+}
+
+// printGitlabIdentityProviderList prints a list of values of the 'gitlab_identity_provider' type to the given printer.
+func printGitlabIdentityProviderList(list *GitlabIdentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printGitlabIdentityProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
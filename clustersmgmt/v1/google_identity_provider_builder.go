@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GoogleIdentityProviderBuilder contains the data and logic needed to build 'google_identity_provider' objects.
 //
 // Details for `google` identity providers.
@@ -26,6 +30,7 @@ type GoogleIdentityProviderBuilder struct {
 	clientID     *string
 	clientSecret *string
 	hostedDomain *string
+	extra        map[string]interface{}
 }
 
 // NewGoogleIdentityProvider creates a new builder of 'google_identity_provider' objects.
@@ -33,6 +38,21 @@ func NewGoogleIdentityProvider() *GoogleIdentityProviderBuilder {
 	return new(GoogleIdentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'google_identity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *GoogleIdentityProvider) Builder() *GoogleIdentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(GoogleIdentityProviderBuilder)
+	b.clientID = o.clientID
+	b.clientSecret = o.clientSecret
+	b.hostedDomain = o.hostedDomain
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ClientID sets the value of the 'client_ID' attribute
 // to the given value.
 //
@@ -60,9 +80,17 @@ func (b *GoogleIdentityProviderBuilder) HostedDomain(value string) *GoogleIdenti
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'google_identity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *GoogleIdentityProviderBuilder) Extra(value map[string]interface{}) *GoogleIdentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'google_identity_provider' object using the configuration stored in the builder.
 func (b *GoogleIdentityProviderBuilder) Build() (object *GoogleIdentityProvider, err error) {
 	object = new(GoogleIdentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.clientID != nil {
 		object.clientID = b.clientID
 	}
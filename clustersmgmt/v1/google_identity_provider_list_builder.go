@@ -30,6 +30,19 @@ func NewGoogleIdentityProviderList() *GoogleIdentityProviderListBuilder {
 	return new(GoogleIdentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *GoogleIdentityProviderList) Builder() *GoogleIdentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(GoogleIdentityProviderListBuilder)
+	b.items = make([]*GoogleIdentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *GoogleIdentityProviderListBuilder) Items(values ...*GoogleIdentityProviderBuilder) *GoogleIdentityProviderListBuilder {
 	b.items = make([]*GoogleIdentityProviderBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// googleIdentityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'google_identity_provider'.
-type googleIdentityProviderListData []*googleIdentityProviderData
-
 // UnmarshalGoogleIdentityProviderList reads a list of values of the 'google_identity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalGoogleIdentityProviderList(source interface{}) (list *GoogleIdentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalGoogleIdentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *GoogleIdentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data googleIdentityProviderListData
-	err = decoder.Decode(&data)
+	list = readGoogleIdentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'google_identity_provider' value to a JSON document.
-func (l *GoogleIdentityProviderList) wrap() (data googleIdentityProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalGoogleIdentityProviderList writes a list of values of the 'google_identity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalGoogleIdentityProviderList(list *GoogleIdentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(googleIdentityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeGoogleIdentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *GoogleIdentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGoogleIdentityProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGoogleIdentityProviderList function,
+// using the default decoding options.
+func (l *GoogleIdentityProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalGoogleIdentityProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = GoogleIdentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'google_identity_provider' type.
-func (d googleIdentityProviderListData) unwrap() (list *GoogleIdentityProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *GoogleIdentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *GoogleIdentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeGoogleIdentityProviderList writes a list of values of the 'google_identity_provider' type to the given
+// stream, as a JSON array.
+func writeGoogleIdentityProviderList(list *GoogleIdentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*GoogleIdentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeGoogleIdentityProvider(item, stream)
 	}
-	list = new(GoogleIdentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readGoogleIdentityProviderList reads a list of values of the 'google_identity_provider' type from the given
+// iterator, as a JSON array.
+func readGoogleIdentityProviderList(iterator *helpers.Iterator) *GoogleIdentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(GoogleIdentityProviderList)
+	list.items = []*GoogleIdentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readGoogleIdentityProvider(iterator))
+	}
+	return list
 }
@@ -23,66 +23,137 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// googleIdentityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'google_identity_provider'.
-type googleIdentityProviderData struct {
-	ClientID     *string "json:\"client_id,omitempty\""
-	ClientSecret *string "json:\"client_secret,omitempty\""
-	HostedDomain *string "json:\"hosted_domain,omitempty\""
-}
-
 // MarshalGoogleIdentityProvider writes a value of the 'google_identity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalGoogleIdentityProvider(object *GoogleIdentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeGoogleIdentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'google_identity_provider'
-// value to a JSON document.
-func (o *GoogleIdentityProvider) wrap() (data *googleIdentityProviderData, err error) {
-	if o == nil {
+// writeGoogleIdentityProvider writes a value of the 'google_identity_provider' type to the given stream.
+func writeGoogleIdentityProvider(object *GoogleIdentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(googleIdentityProviderData)
-	data.ClientID = o.clientID
-	data.ClientSecret = o.clientSecret
-	data.HostedDomain = o.hostedDomain
-	return
+	stream.WriteObjectStart()
+	if object.clientID != nil {
+		stream.WriteObjectField("client_id")
+		stream.WriteString(*object.clientID)
+	}
+	if object.clientSecret != nil {
+		stream.WriteObjectField("client_secret")
+		stream.WriteString(*object.clientSecret)
+	}
+	if object.hostedDomain != nil {
+		stream.WriteObjectField("hosted_domain")
+		stream.WriteString(*object.hostedDomain)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalGoogleIdentityProvider reads a value of the 'google_identity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalGoogleIdentityProvider(source interface{}) (object *GoogleIdentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalGoogleIdentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *GoogleIdentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(googleIdentityProviderData)
-	err = decoder.Decode(data)
+	object = readGoogleIdentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(GoogleIdentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'google_identity_provider' type.
-func (d *googleIdentityProviderData) unwrap() (object *GoogleIdentityProvider, err error) {
-	if d == nil {
-		return
+// readGoogleIdentityProvider reads a value of the 'google_identity_provider' type from the given iterator.
+func readGoogleIdentityProvider(iterator *helpers.Iterator) *GoogleIdentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(GoogleIdentityProvider)
-	object.clientID = d.ClientID
-	object.clientSecret = d.ClientSecret
-	object.hostedDomain = d.HostedDomain
-	return
+	object := new(GoogleIdentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "client_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientID = &value
+			}
+		case "client_secret":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientSecret = &value
+			}
+		case "hosted_domain":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.hostedDomain = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalGoogleIdentityProvider function.
+func (o *GoogleIdentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGoogleIdentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGoogleIdentityProvider function, using the
+// default decoding options.
+func (o *GoogleIdentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalGoogleIdentityProvider(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = GoogleIdentityProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *GoogleIdentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *GoogleIdentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
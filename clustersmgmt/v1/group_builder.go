@@ -19,14 +19,21 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // GroupBuilder contains the data and logic needed to build 'group' objects.
 //
 // Representation of a group of users.
 type GroupBuilder struct {
-	id    *string
-	href  *string
-	link  bool
-	users []*UserBuilder
+	id        *string
+	href      *string
+	link      bool
+	users     []*UserBuilder
+	usersLink bool
+	usersHREF *string
+	extra     map[string]interface{}
 }
 
 // NewGroup creates a new builder of 'group' objects.
@@ -34,6 +41,29 @@ func NewGroup() *GroupBuilder {
 	return new(GroupBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'group' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Group) Builder() *GroupBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(GroupBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	if o.users != nil {
+		b.users = make([]*UserBuilder, len(o.users.items))
+		for i, item := range o.users.items {
+			b.users[i] = item.Builder()
+		}
+		b.usersLink = o.users.link
+		b.usersHREF = o.users.href
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *GroupBuilder) ID(value string) *GroupBuilder {
 	b.id = &value
@@ -57,19 +87,31 @@ func (b *GroupBuilder) Link(value bool) *GroupBuilder {
 //
 //
 func (b *GroupBuilder) Users(values ...*UserBuilder) *GroupBuilder {
+	b.usersLink = false
+	b.usersHREF = nil
 	b.users = make([]*UserBuilder, len(values))
 	copy(b.users, values)
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'group' type, and
+// that will be written as they are when the object is encoded.
+func (b *GroupBuilder) Extra(value map[string]interface{}) *GroupBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'group' object using the configuration stored in the builder.
 func (b *GroupBuilder) Build() (object *Group, err error) {
 	object = new(Group)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
 	if b.users != nil {
 		object.users = new(UserList)
+		object.users.link = b.usersLink
+		object.users.href = b.usersHREF
 		object.users.items = make([]*User, len(b.users))
 		for i, item := range b.users {
 			object.users.items[i], err = item.Build()
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'group' objects.
 type GroupListBuilder struct {
 	items []*GroupBuilder
+	link  bool
+	href  *string
 }
 
 // NewGroupList creates a new builder of 'group' objects.
@@ -30,8 +32,25 @@ func NewGroupList() *GroupListBuilder {
 	return new(GroupListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *GroupList) Builder() *GroupListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(GroupListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*GroupBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *GroupListBuilder) Items(values ...*GroupBuilder) *GroupListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*GroupBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *GroupListBuilder) Build() (list *GroupList, err error) {
 		}
 	}
 	list = new(GroupList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
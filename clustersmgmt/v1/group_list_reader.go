@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// groupListData is type used internally to marshal and unmarshal lists of objects
-// of type 'group'.
-type groupListData []*groupData
-
 // UnmarshalGroupList reads a list of values of the 'group'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalGroupList(source interface{}) (list *GroupList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalGroupList(source interface{}, options ...helpers.DecodingOptions) (list *GroupList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data groupListData
-	err = decoder.Decode(&data)
+	list = readGroupList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'group' value to a JSON document.
-func (l *GroupList) wrap() (data groupListData, err error) {
-	if l == nil {
+// MarshalGroupList writes a list of values of the 'group' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalGroupList(list *GroupList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeGroupList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *GroupList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGroupListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalGroupList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *GroupList) UnmarshalJSON(data []byte) error {
+	var list *GroupList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalGroupList(data)
+	} else {
+		list, err = unmarshalGroupListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = GroupList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *GroupList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *GroupList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalGroupListLink reads a list of values of the 'group' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalGroupListLink(source interface{}, options ...helpers.DecodingOptions) (list *GroupList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(groupListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readGroupListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'group' type.
-func (d groupListData) unwrap() (list *GroupList, err error) {
-	if d == nil {
+// writeGroupList writes a list of values of the 'group' type to the given
+// stream, as a JSON array.
+func writeGroupList(list *GroupList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Group, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeGroup(item, stream)
 	}
-	list = new(GroupList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// groupListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'group'.
-type groupListLinkData struct {
-	Kind  *string      "json:\"kind,omitempty\""
-	HREF  *string      "json:\"href,omitempty\""
-	Items []*groupData "json:\"items,omitempty\""
+// readGroupList reads a list of values of the 'group' type from the given
+// iterator, as a JSON array.
+func readGroupList(iterator *helpers.Iterator) *GroupList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(GroupList)
+	list.items = []*Group{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readGroup(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'group' value to a link.
-func (l *GroupList) wrapLink() (data *groupListLinkData, err error) {
-	if l == nil {
+// writeGroupListLink writes a list of values of the 'group' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeGroupListLink(list *GroupList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*groupData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(groupListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = GroupListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(GroupListLinkKind)
 	} else {
-		*data.Kind = GroupListKind
+		stream.WriteString(GroupListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeGroupList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'group' type to a list.
-func (d *groupListLinkData) unwrapLink() (list *GroupList, err error) {
-	if d == nil {
-		return
+// readGroupListLink reads a list of values of the 'group' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readGroupListLink(iterator *helpers.Iterator) *GroupList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Group, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(GroupList)
+	list.items = []*Group{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(GroupList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case GroupListKind:
-			list.link = false
-		case GroupListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(GroupListKind, GroupListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readGroupList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				GroupListKind,
-				GroupListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
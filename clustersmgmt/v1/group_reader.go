@@ -20,100 +20,145 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// groupData is the data structure used internally to marshal and unmarshal
-// objects of type 'group'.
-type groupData struct {
-	Kind  *string           "json:\"kind,omitempty\""
-	ID    *string           "json:\"id,omitempty\""
-	HREF  *string           "json:\"href,omitempty\""
-	Users *userListLinkData "json:\"users,omitempty\""
-}
-
 // MarshalGroup writes a value of the 'group' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalGroup(object *Group, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeGroup(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'group'
-// value to a JSON document.
-func (o *Group) wrap() (data *groupData, err error) {
-	if o == nil {
+// writeGroup writes a value of the 'group' type to the given stream.
+func writeGroup(object *Group, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(groupData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = GroupLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(GroupLinkKind)
 	} else {
-		*data.Kind = GroupKind
+		stream.WriteString(GroupKind)
 	}
-	data.Users, err = o.users.wrapLink()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.users != nil {
+		stream.WriteObjectField("users")
+		writeUserListLink(object.users, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalGroup reads a value of the 'group' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalGroup(source interface{}) (object *Group, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalGroup(source interface{}, options ...helpers.DecodingOptions) (object *Group, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(groupData)
-	err = decoder.Decode(data)
+	object = readGroup(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Group)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'group' type.
-func (d *groupData) unwrap() (object *Group, err error) {
-	if d == nil {
-		return
+// readGroup reads a value of the 'group' type from the given iterator.
+func readGroup(iterator *helpers.Iterator) *Group {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Group)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case GroupKind:
-			object.link = false
-		case GroupLinkKind:
-			object.link = true
+	object := new(Group)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(GroupKind, GroupLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "users":
+			object.users = readUserListLink(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				GroupKind,
-				GroupLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.users, err = d.Users.unwrapLink()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalGroup function.
+func (o *Group) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeGroup(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalGroup function, using the
+// default decoding options.
+func (o *Group) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalGroup(data)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if object == nil {
+		*o = Group{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Group) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Group) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // GroupServer represents the interface the manages the 'group' resource.
@@ -75,73 +77,111 @@ func (r *GroupGetServerResponse) SetStatusCode(status int) *GroupGetServerRespon
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *GroupGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeGroup(r.body, stream)
+	return stream.Flush()
 }
 
-// GroupServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// GroupServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type GroupServerAdapter struct {
 	server GroupServer
 	router *mux.Router
+	prefix string
 }
 
+// NewGroupServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewGroupServerAdapter(server GroupServer, router *mux.Router) *GroupServerAdapter {
 	adapter := new(GroupServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/users").HandlerFunc(adapter.usersHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *GroupServerAdapter) usersHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Users()
-	targetAdapter := NewUsersServerAdapter(target, a.router.PathPrefix("/users").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *GroupServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchGroup(w, r, a.server, segments)
+}
+
+// dispatchGroup navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchGroup(w http.ResponseWriter, r *http.Request, server GroupServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptGroupGetRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	case "users":
+		target := server.Users()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchUsers(w, r, target, segments[1:])
+	default:
+		errors.SendNotFound(w, r)
+	}
 }
-func (a *GroupServerAdapter) readGroupGetServerRequest(r *http.Request) (*GroupGetServerRequest, error) {
+func readGroupGetServerRequest(r *http.Request) (*GroupGetServerRequest, error) {
 	result := new(GroupGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *GroupServerAdapter) writeGroupGetServerResponse(w http.ResponseWriter, r *GroupGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeGroupGetServerResponse(w http.ResponseWriter, r *GroupGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *GroupServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readGroupGetServerRequest(r)
+func adaptGroupGetRequest(w http.ResponseWriter, r *http.Request, server GroupServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readGroupGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(GroupGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeGroupGetServerResponse(w, resp)
+	err = writeGroupGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
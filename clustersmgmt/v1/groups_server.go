@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // GroupsServer represents the interface the manages the 'groups' resource.
@@ -102,87 +104,126 @@ func (r *GroupsListServerResponse) SetStatusCode(status int) *GroupsListServerRe
 // marshall is the method used internally to marshal responses for the
 // 'list' method.
 func (r *GroupsListServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data := new(groupsListServerResponseData)
-	data.Page = r.page
-	data.Size = r.size
-	data.Total = r.total
-	data.Items, err = r.items.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
-}
-
-// groupsListServerResponseData is the structure used internally to write the request of the
-// 'list' method.
-type groupsListServerResponseData struct {
-	Page  *int          "json:\"page,omitempty\""
-	Size  *int          "json:\"size,omitempty\""
-	Total *int          "json:\"total,omitempty\""
-	Items groupListData "json:\"items,omitempty\""
+	stream.WriteObjectStart()
+	if r.page != nil {
+		stream.WriteObjectField("page")
+		stream.WriteInt(*r.page)
+	}
+	if r.size != nil {
+		stream.WriteObjectField("size")
+		stream.WriteInt(*r.size)
+	}
+	if r.total != nil {
+		stream.WriteObjectField("total")
+		stream.WriteInt(*r.total)
+	}
+	if r.items != nil && len(r.items.items) > 0 {
+		stream.WriteObjectField("items")
+		writeGroupList(r.items, stream)
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
 }
 
-// GroupsServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// GroupsServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type GroupsServerAdapter struct {
 	server GroupsServer
 	router *mux.Router
+	prefix string
 }
 
+// NewGroupsServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewGroupsServerAdapter(server GroupsServer, router *mux.Router) *GroupsServerAdapter {
 	adapter := new(GroupsServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/{id}").HandlerFunc(adapter.groupHandler)
-	adapter.router.Methods("GET").HandlerFunc(adapter.listHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *GroupsServerAdapter) groupHandler(w http.ResponseWriter, r *http.Request) {
-	id := mux.Vars(r)["id"]
-	target := a.server.Group(id)
-	targetAdapter := NewGroupServerAdapter(target, a.router.PathPrefix("/{id}").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *GroupsServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchGroups(w, r, a.server, segments)
+}
+
+// dispatchGroups navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchGroups(w http.ResponseWriter, r *http.Request, server GroupsServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptGroupsListRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		target := server.Group(segments[0])
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchGroup(w, r, target, segments[1:])
+	}
 }
-func (a *GroupsServerAdapter) readGroupsListServerRequest(r *http.Request) (*GroupsListServerRequest, error) {
+func readGroupsListServerRequest(r *http.Request) (*GroupsListServerRequest, error) {
 	result := new(GroupsListServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *GroupsServerAdapter) writeGroupsListServerResponse(w http.ResponseWriter, r *GroupsListServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeGroupsListServerResponse(w http.ResponseWriter, r *GroupsListServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *GroupsServerAdapter) listHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readGroupsListServerRequest(r)
+func adaptGroupsListRequest(w http.ResponseWriter, r *http.Request, server GroupsServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readGroupsListServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(GroupsListServerResponse)
-	err = a.server.List(r.Context(), req, resp)
+	err = server.List(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method List: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "List", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusOK
 	}
-	err = a.writeGroupsListServerResponse(w, resp)
+	err = writeGroupsListServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // IdentityProviderBuilder contains the data and logic needed to build 'identity_provider' objects.
 //
 // Representation of an identity provider.
@@ -36,6 +40,7 @@ type IdentityProviderBuilder struct {
 	google        *GoogleIdentityProviderBuilder
 	ldap          *LdapidentityProviderBuilder
 	openID        *OpenIdidentityProviderBuilder
+	extra         map[string]interface{}
 }
 
 // NewIdentityProvider creates a new builder of 'identity_provider' objects.
@@ -43,6 +48,41 @@ func NewIdentityProvider() *IdentityProviderBuilder {
 	return new(IdentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'identity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *IdentityProvider) Builder() *IdentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(IdentityProviderBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.type_ = o.type_
+	b.name = o.name
+	b.challenge = o.challenge
+	b.login = o.login
+	b.mappingMethod = o.mappingMethod
+	if o.github != nil {
+		b.github = o.github.Builder()
+	}
+	if o.gitlab != nil {
+		b.gitlab = o.gitlab.Builder()
+	}
+	if o.google != nil {
+		b.google = o.google.Builder()
+	}
+	if o.ldap != nil {
+		b.ldap = o.ldap.Builder()
+	}
+	if o.openID != nil {
+		b.openID = o.openID.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *IdentityProviderBuilder) ID(value string) *IdentityProviderBuilder {
 	b.id = &value
@@ -151,9 +191,17 @@ func (b *IdentityProviderBuilder) OpenID(value *OpenIdidentityProviderBuilder) *
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'identity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *IdentityProviderBuilder) Extra(value map[string]interface{}) *IdentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'identity_provider' object using the configuration stored in the builder.
 func (b *IdentityProviderBuilder) Build() (object *IdentityProvider, err error) {
 	object = new(IdentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'identity_provider' objects.
 type IdentityProviderListBuilder struct {
 	items []*IdentityProviderBuilder
+	link  bool
+	href  *string
 }
 
 // NewIdentityProviderList creates a new builder of 'identity_provider' objects.
@@ -30,8 +32,25 @@ func NewIdentityProviderList() *IdentityProviderListBuilder {
 	return new(IdentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *IdentityProviderList) Builder() *IdentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(IdentityProviderListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*IdentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *IdentityProviderListBuilder) Items(values ...*IdentityProviderBuilder) *IdentityProviderListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*IdentityProviderBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *IdentityProviderListBuilder) Build() (list *IdentityProviderList, err e
 		}
 	}
 	list = new(IdentityProviderList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// identityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'identity_provider'.
-type identityProviderListData []*identityProviderData
-
 // UnmarshalIdentityProviderList reads a list of values of the 'identity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalIdentityProviderList(source interface{}) (list *IdentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalIdentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *IdentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data identityProviderListData
-	err = decoder.Decode(&data)
+	list = readIdentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'identity_provider' value to a JSON document.
-func (l *IdentityProviderList) wrap() (data identityProviderListData, err error) {
-	if l == nil {
+// MarshalIdentityProviderList writes a list of values of the 'identity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalIdentityProviderList(list *IdentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeIdentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *IdentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeIdentityProviderListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalIdentityProviderList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *IdentityProviderList) UnmarshalJSON(data []byte) error {
+	var list *IdentityProviderList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalIdentityProviderList(data)
+	} else {
+		list, err = unmarshalIdentityProviderListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = IdentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *IdentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *IdentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalIdentityProviderListLink reads a list of values of the 'identity_provider' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalIdentityProviderListLink(source interface{}, options ...helpers.DecodingOptions) (list *IdentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(identityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readIdentityProviderListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'identity_provider' type.
-func (d identityProviderListData) unwrap() (list *IdentityProviderList, err error) {
-	if d == nil {
+// writeIdentityProviderList writes a list of values of the 'identity_provider' type to the given
+// stream, as a JSON array.
+func writeIdentityProviderList(list *IdentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*IdentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeIdentityProvider(item, stream)
 	}
-	list = new(IdentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// identityProviderListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'identity_provider'.
-type identityProviderListLinkData struct {
-	Kind  *string                 "json:\"kind,omitempty\""
-	HREF  *string                 "json:\"href,omitempty\""
-	Items []*identityProviderData "json:\"items,omitempty\""
+// readIdentityProviderList reads a list of values of the 'identity_provider' type from the given
+// iterator, as a JSON array.
+func readIdentityProviderList(iterator *helpers.Iterator) *IdentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(IdentityProviderList)
+	list.items = []*IdentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readIdentityProvider(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'identity_provider' value to a link.
-func (l *IdentityProviderList) wrapLink() (data *identityProviderListLinkData, err error) {
-	if l == nil {
+// writeIdentityProviderListLink writes a list of values of the 'identity_provider' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeIdentityProviderListLink(list *IdentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*identityProviderData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(identityProviderListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = IdentityProviderListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(IdentityProviderListLinkKind)
 	} else {
-		*data.Kind = IdentityProviderListKind
+		stream.WriteString(IdentityProviderListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeIdentityProviderList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'identity_provider' type to a list.
-func (d *identityProviderListLinkData) unwrapLink() (list *IdentityProviderList, err error) {
-	if d == nil {
-		return
+// readIdentityProviderListLink reads a list of values of the 'identity_provider' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readIdentityProviderListLink(iterator *helpers.Iterator) *IdentityProviderList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*IdentityProvider, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(IdentityProviderList)
+	list.items = []*IdentityProvider{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(IdentityProviderList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case IdentityProviderListKind:
-			list.link = false
-		case IdentityProviderListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(IdentityProviderListKind, IdentityProviderListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readIdentityProviderList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				IdentityProviderListKind,
-				IdentityProviderListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
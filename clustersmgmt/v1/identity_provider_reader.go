@@ -20,151 +20,214 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// identityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'identity_provider'.
-type identityProviderData struct {
-	Kind          *string                        "json:\"kind,omitempty\""
-	ID            *string                        "json:\"id,omitempty\""
-	HREF          *string                        "json:\"href,omitempty\""
-	Type          *IdentityProviderType          "json:\"type,omitempty\""
-	Name          *string                        "json:\"name,omitempty\""
-	Challenge     *bool                          "json:\"challenge,omitempty\""
-	Login         *bool                          "json:\"login,omitempty\""
-	MappingMethod *IdentityProviderMappingMethod "json:\"mapping_method,omitempty\""
-	Github        *githubIdentityProviderData    "json:\"github,omitempty\""
-	Gitlab        *gitlabIdentityProviderData    "json:\"gitlab,omitempty\""
-	Google        *googleIdentityProviderData    "json:\"google,omitempty\""
-	LDAP          *ldapidentityProviderData      "json:\"ldap,omitempty\""
-	OpenID        *openIdidentityProviderData    "json:\"open_id,omitempty\""
-}
-
 // MarshalIdentityProvider writes a value of the 'identity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalIdentityProvider(object *IdentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeIdentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'identity_provider'
-// value to a JSON document.
-func (o *IdentityProvider) wrap() (data *identityProviderData, err error) {
-	if o == nil {
+// writeIdentityProvider writes a value of the 'identity_provider' type to the given stream.
+func writeIdentityProvider(object *IdentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(identityProviderData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = IdentityProviderLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(IdentityProviderLinkKind)
 	} else {
-		*data.Kind = IdentityProviderKind
-	}
-	data.Type = o.type_
-	data.Name = o.name
-	data.Challenge = o.challenge
-	data.Login = o.login
-	data.MappingMethod = o.mappingMethod
-	data.Github, err = o.github.wrap()
-	if err != nil {
-		return
+		stream.WriteString(IdentityProviderKind)
 	}
-	data.Gitlab, err = o.gitlab.wrap()
-	if err != nil {
-		return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
 	}
-	data.Google, err = o.google.wrap()
-	if err != nil {
-		return
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
 	}
-	data.LDAP, err = o.ldap.wrap()
-	if err != nil {
-		return
+	if object.type_ != nil {
+		stream.WriteObjectField("type")
+		stream.WriteString(string(*object.type_))
 	}
-	data.OpenID, err = o.openID.wrap()
-	if err != nil {
-		return
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
 	}
-	return
+	if object.challenge != nil {
+		stream.WriteObjectField("challenge")
+		stream.WriteBool(*object.challenge)
+	}
+	if object.login != nil {
+		stream.WriteObjectField("login")
+		stream.WriteBool(*object.login)
+	}
+	if object.mappingMethod != nil {
+		stream.WriteObjectField("mapping_method")
+		stream.WriteString(string(*object.mappingMethod))
+	}
+	if object.github != nil {
+		stream.WriteObjectField("github")
+		writeGithubIdentityProvider(object.github, stream)
+	}
+	if object.gitlab != nil {
+		stream.WriteObjectField("gitlab")
+		writeGitlabIdentityProvider(object.gitlab, stream)
+	}
+	if object.google != nil {
+		stream.WriteObjectField("google")
+		writeGoogleIdentityProvider(object.google, stream)
+	}
+	if object.ldap != nil {
+		stream.WriteObjectField("ldap")
+		writeLdapidentityProvider(object.ldap, stream)
+	}
+	if object.openID != nil {
+		stream.WriteObjectField("open_id")
+		writeOpenIdidentityProvider(object.openID, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalIdentityProvider reads a value of the 'identity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalIdentityProvider(source interface{}) (object *IdentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalIdentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *IdentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(identityProviderData)
-	err = decoder.Decode(data)
+	object = readIdentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(IdentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'identity_provider' type.
-func (d *identityProviderData) unwrap() (object *IdentityProvider, err error) {
-	if d == nil {
-		return
-	}
-	object = new(IdentityProvider)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case IdentityProviderKind:
-			object.link = false
-		case IdentityProviderLinkKind:
-			object.link = true
+// readIdentityProvider reads a value of the 'identity_provider' type from the given iterator.
+func readIdentityProvider(iterator *helpers.Iterator) *IdentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(IdentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(IdentityProviderKind, IdentityProviderLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "type":
+			if !iterator.ReadNull() {
+				value := IdentityProviderType(iterator.ReadString())
+				object.type_ = &value
+			}
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "challenge":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.challenge = &value
+			}
+		case "login":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.login = &value
+			}
+		case "mapping_method":
+			if !iterator.ReadNull() {
+				value := IdentityProviderMappingMethod(iterator.ReadString())
+				object.mappingMethod = &value
+			}
+		case "github":
+			object.github = readGithubIdentityProvider(iterator)
+		case "gitlab":
+			object.gitlab = readGitlabIdentityProvider(iterator)
+		case "google":
+			object.google = readGoogleIdentityProvider(iterator)
+		case "ldap":
+			object.ldap = readLdapidentityProvider(iterator)
+		case "open_id":
+			object.openID = readOpenIdidentityProvider(iterator)
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				IdentityProviderKind,
-				IdentityProviderLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.type_ = d.Type
-	object.name = d.Name
-	object.challenge = d.Challenge
-	object.login = d.Login
-	object.mappingMethod = d.MappingMethod
-	object.github, err = d.Github.unwrap()
-	if err != nil {
-		return
-	}
-	object.gitlab, err = d.Gitlab.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalIdentityProvider function.
+func (o *IdentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeIdentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalIdentityProvider function, using the
+// default decoding options.
+func (o *IdentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalIdentityProvider(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.google, err = d.Google.unwrap()
-	if err != nil {
-		return
+	if object == nil {
+		*o = IdentityProvider{}
+		return nil
 	}
-	object.ldap, err = d.LDAP.unwrap()
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *IdentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	object.openID, err = d.OpenID.unwrap()
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *IdentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	return o.UnmarshalJSON(data)
 }
@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // IdentityProviderServer represents the interface the manages the 'identity_provider' resource.
@@ -75,14 +77,12 @@ func (r *IdentityProviderGetServerResponse) SetStatusCode(status int) *IdentityP
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *IdentityProviderGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeIdentityProvider(r.body, stream)
+	return stream.Flush()
 }
 
 // IdentityProviderDeleteServerRequest is the request for the 'delete' method.
@@ -103,57 +103,98 @@ func (r *IdentityProviderDeleteServerResponse) SetStatusCode(status int) *Identi
 	return r
 }
 
-// IdentityProviderServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// IdentityProviderServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type IdentityProviderServerAdapter struct {
 	server IdentityProviderServer
 	router *mux.Router
+	prefix string
 }
 
+// NewIdentityProviderServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewIdentityProviderServerAdapter(server IdentityProviderServer, router *mux.Router) *IdentityProviderServerAdapter {
 	adapter := new(IdentityProviderServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *IdentityProviderServerAdapter) readIdentityProviderGetServerRequest(r *http.Request) (*IdentityProviderGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *IdentityProviderServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchIdentityProvider(w, r, a.server, segments)
+}
+
+// dispatchIdentityProvider navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchIdentityProvider(w http.ResponseWriter, r *http.Request, server IdentityProviderServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptIdentityProviderGetRequest(w, r, server)
+		case http.MethodDelete:
+			adaptIdentityProviderDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readIdentityProviderGetServerRequest(r *http.Request) (*IdentityProviderGetServerRequest, error) {
 	result := new(IdentityProviderGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *IdentityProviderServerAdapter) writeIdentityProviderGetServerResponse(w http.ResponseWriter, r *IdentityProviderGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeIdentityProviderGetServerResponse(w http.ResponseWriter, r *IdentityProviderGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *IdentityProviderServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readIdentityProviderGetServerRequest(r)
+func adaptIdentityProviderGetRequest(w http.ResponseWriter, r *http.Request, server IdentityProviderServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readIdentityProviderGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(IdentityProviderGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeIdentityProviderGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeIdentityProviderGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -163,38 +204,38 @@ func (a *IdentityProviderServerAdapter) getHandler(w http.ResponseWriter, r *htt
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *IdentityProviderServerAdapter) readIdentityProviderDeleteServerRequest(r *http.Request) (*IdentityProviderDeleteServerRequest, error) {
+func readIdentityProviderDeleteServerRequest(r *http.Request) (*IdentityProviderDeleteServerRequest, error) {
 	result := new(IdentityProviderDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *IdentityProviderServerAdapter) writeIdentityProviderDeleteServerResponse(w http.ResponseWriter, r *IdentityProviderDeleteServerResponse) error {
+func writeIdentityProviderDeleteServerResponse(w http.ResponseWriter, r *IdentityProviderDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *IdentityProviderServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readIdentityProviderDeleteServerRequest(r)
+func adaptIdentityProviderDeleteRequest(w http.ResponseWriter, r *http.Request, server IdentityProviderServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readIdentityProviderDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(IdentityProviderDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeIdentityProviderDeleteServerResponse(w, resp)
+	err = writeIdentityProviderDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
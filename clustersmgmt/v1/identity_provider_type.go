@@ -19,6 +19,14 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // IdentityProviderKind is the name of the type used to represent objects
 // of type 'identity_provider'.
 const IdentityProviderKind = "IdentityProvider"
@@ -48,6 +56,7 @@ type IdentityProvider struct {
 	google        *GoogleIdentityProvider
 	ldap          *LdapidentityProvider
 	openID        *OpenIdidentityProvider
+	extra         map[string]interface{}
 }
 
 // Kind returns the name of the type of the object.
@@ -102,6 +111,27 @@ func (o *IdentityProvider) GetHREF() (value string, ok bool) {
 	return
 }
 
+// Resolve retrieves the complete identity provider that this link points to, using
+// the given transport to send the request. If the object isn't a link it is
+// returned unchanged, without sending any request.
+func (o *IdentityProvider) Resolve(ctx context.Context, transport http.RoundTripper) (result *IdentityProvider, err error) {
+	if !o.Link() {
+		result = o
+		return
+	}
+	href, ok := o.GetHREF()
+	if !ok {
+		err = fmt.Errorf("can't resolve link to identity provider '%s' because it doesn't have an HREF", o.ID())
+		return
+	}
+	response, err := NewIdentityProviderClient(transport, href, helpers.MetricPath(href)).Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
 // Empty returns true if the object is empty, i.e. no attribute has a value.
 func (o *IdentityProvider) Empty() bool {
 	return o == nil || (o.id == nil &&
@@ -118,6 +148,59 @@ func (o *IdentityProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'identity_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *IdentityProvider) Metadata() *helpers.TypeMetadata {
+	return identityProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *IdentityProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *IdentityProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printIdentityProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *IdentityProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printIdentityProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *IdentityProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printIdentityProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'identity_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *IdentityProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Type returns the value of the 'type' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -370,6 +453,327 @@ const IdentityProviderListLinkKind = "IdentityProviderListLink"
 // objects of type 'identity_provider'.
 const IdentityProviderListNilKind = "IdentityProviderListNil"
 
+// Copy returns a deep copy of the 'identity_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *IdentityProvider) Copy() *IdentityProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(IdentityProvider)
+	if o.id != nil {
+		result.id = new(string)
+		*result.id = *o.id
+	}
+	if o.href != nil {
+		result.href = new(string)
+		*result.href = *o.href
+	}
+	result.link = o.link
+	if o.type_ != nil {
+		result.type_ = new(IdentityProviderType)
+		*result.type_ = *o.type_
+	}
+	if o.name != nil {
+		result.name = new(string)
+		*result.name = *o.name
+	}
+	if o.challenge != nil {
+		result.challenge = new(bool)
+		*result.challenge = *o.challenge
+	}
+	if o.login != nil {
+		result.login = new(bool)
+		*result.login = *o.login
+	}
+	if o.mappingMethod != nil {
+		result.mappingMethod = new(IdentityProviderMappingMethod)
+		*result.mappingMethod = *o.mappingMethod
+	}
+	result.github = o.github.Copy()
+	result.gitlab = o.gitlab.Copy()
+	result.google = o.google.Copy()
+	result.ldap = o.ldap.Copy()
+	result.openID = o.openID.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'identity_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *IdentityProvider) Equal(other *IdentityProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if o.link != other.link {
+		return false
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		return false
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		return false
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		return false
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		return false
+	}
+	if (o.challenge == nil) != (other.challenge == nil) ||
+		(o.challenge != nil && *o.challenge != *other.challenge) {
+		return false
+	}
+	if (o.login == nil) != (other.login == nil) ||
+		(o.login != nil && *o.login != *other.login) {
+		return false
+	}
+	if (o.mappingMethod == nil) != (other.mappingMethod == nil) ||
+		(o.mappingMethod != nil && *o.mappingMethod != *other.mappingMethod) {
+		return false
+	}
+	if !o.github.Equal(other.github) {
+		return false
+	}
+	if !o.gitlab.Equal(other.gitlab) {
+		return false
+	}
+	if !o.google.Equal(other.google) {
+		return false
+	}
+	if !o.ldap.Equal(other.ldap) {
+		return false
+	}
+	if !o.openID.Equal(other.openID) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'identity_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *IdentityProvider) Diff(other *IdentityProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'identity_provider' object and the given one, using the given path as prefix.
+func (o *IdentityProvider) diff(path string, other *IdentityProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if o.link != other.link {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  o.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if (o.id == nil) != (other.id == nil) ||
+		(o.id != nil && *o.id != *other.id) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "id"),
+			Old:  helpers.Value(o.id),
+			New:  helpers.Value(other.id),
+		})
+	}
+	if (o.href == nil) != (other.href == nil) ||
+		(o.href != nil && *o.href != *other.href) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  helpers.Value(o.href),
+			New:  helpers.Value(other.href),
+		})
+	}
+	if (o.type_ == nil) != (other.type_ == nil) ||
+		(o.type_ != nil && *o.type_ != *other.type_) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "type"),
+			Old:  helpers.Value(o.type_),
+			New:  helpers.Value(other.type_),
+		})
+	}
+	if (o.name == nil) != (other.name == nil) ||
+		(o.name != nil && *o.name != *other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  helpers.Value(o.name),
+			New:  helpers.Value(other.name),
+		})
+	}
+	if (o.challenge == nil) != (other.challenge == nil) ||
+		(o.challenge != nil && *o.challenge != *other.challenge) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "challenge"),
+			Old:  helpers.Value(o.challenge),
+			New:  helpers.Value(other.challenge),
+		})
+	}
+	if (o.login == nil) != (other.login == nil) ||
+		(o.login != nil && *o.login != *other.login) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "login"),
+			Old:  helpers.Value(o.login),
+			New:  helpers.Value(other.login),
+		})
+	}
+	if (o.mappingMethod == nil) != (other.mappingMethod == nil) ||
+		(o.mappingMethod != nil && *o.mappingMethod != *other.mappingMethod) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "mapping_method"),
+			Old:  helpers.Value(o.mappingMethod),
+			New:  helpers.Value(other.mappingMethod),
+		})
+	}
+	result = o.github.diff(helpers.Path(path, "github"), other.github, result)
+	result = o.gitlab.diff(helpers.Path(path, "gitlab"), other.gitlab, result)
+	result = o.google.diff(helpers.Path(path, "google"), other.google, result)
+	result = o.ldap.diff(helpers.Path(path, "ldap"), other.ldap, result)
+	result = o.openID.diff(helpers.Path(path, "open_id"), other.openID, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'identity_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *IdentityProvider) Changes(modified *IdentityProvider) *IdentityProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(IdentityProvider)
+	result.link = modified.link
+	if modified.id != nil && (o.id == nil || *o.id != *modified.id) {
+		result.id = new(string)
+		*result.id = *modified.id
+	}
+	if modified.href != nil && (o.href == nil || *o.href != *modified.href) {
+		result.href = new(string)
+		*result.href = *modified.href
+	}
+	if modified.type_ != nil && (o.type_ == nil || *o.type_ != *modified.type_) {
+		result.type_ = new(IdentityProviderType)
+		*result.type_ = *modified.type_
+	}
+	if modified.name != nil && (o.name == nil || *o.name != *modified.name) {
+		result.name = new(string)
+		*result.name = *modified.name
+	}
+	if modified.challenge != nil && (o.challenge == nil || *o.challenge != *modified.challenge) {
+		result.challenge = new(bool)
+		*result.challenge = *modified.challenge
+	}
+	if modified.login != nil && (o.login == nil || *o.login != *modified.login) {
+		result.login = new(bool)
+		*result.login = *modified.login
+	}
+	if modified.mappingMethod != nil && (o.mappingMethod == nil || *o.mappingMethod != *modified.mappingMethod) {
+		result.mappingMethod = new(IdentityProviderMappingMethod)
+		*result.mappingMethod = *modified.mappingMethod
+	}
+	if modified.github != nil && !o.github.Equal(modified.github) {
+		result.github = o.github.Changes(modified.github)
+	}
+	if modified.gitlab != nil && !o.gitlab.Equal(modified.gitlab) {
+		result.gitlab = o.gitlab.Changes(modified.gitlab)
+	}
+	if modified.google != nil && !o.google.Equal(modified.google) {
+		result.google = o.google.Changes(modified.google)
+	}
+	if modified.ldap != nil && !o.ldap.Equal(modified.ldap) {
+		result.ldap = o.ldap.Changes(modified.ldap)
+	}
+	if modified.openID != nil && !o.openID.Equal(modified.openID) {
+		result.openID = o.openID.Changes(modified.openID)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printIdentityProvider prints a value of the 'identity_provider' type to the given printer.
+func printIdentityProvider(object *IdentityProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.IdentityProvider")
+	if object.id != nil {
+		printer.PrintObjectField("ID")
+		printer.PrintString(*object.id)
+	}
+	if object.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*object.href)
+	}
+	if object.type_ != nil {
+		printer.PrintObjectField("Type")
+		printer.PrintSymbol(string(*object.type_))
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintString(*object.name)
+	}
+	if object.challenge != nil {
+		printer.PrintObjectField("Challenge")
+		printer.PrintBool(*object.challenge)
+	}
+	if object.login != nil {
+		printer.PrintObjectField("Login")
+		printer.PrintBool(*object.login)
+	}
+	if object.mappingMethod != nil {
+		printer.PrintObjectField("MappingMethod")
+		printer.PrintSymbol(string(*object.mappingMethod))
+	}
+	if object.github != nil {
+		printer.PrintObjectField("Github")
+		printGithubIdentityProvider(object.github, printer)
+	}
+	if object.gitlab != nil {
+		printer.PrintObjectField("Gitlab")
+		printGitlabIdentityProvider(object.gitlab, printer)
+	}
+	if object.google != nil {
+		printer.PrintObjectField("Google")
+		printGoogleIdentityProvider(object.google, printer)
+	}
+	if object.ldap != nil {
+		printer.PrintObjectField("LDAP")
+		printLdapidentityProvider(object.ldap, printer)
+	}
+	if object.openID != nil {
+		printer.PrintObjectField("OpenID")
+		printOpenIdidentityProvider(object.openID, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // IdentityProviderList is a list of values of the 'identity_provider' type.
 type IdentityProviderList struct {
 	href  *string
@@ -477,3 +881,146 @@ func (l *IdentityProviderList) Range(f func(index int, item *IdentityProvider) b
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *IdentityProviderList) Copy() *IdentityProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(IdentityProviderList)
+	result.link = l.link
+	if l.href != nil {
+		result.href = new(string)
+		*result.href = *l.href
+	}
+	if l.items != nil {
+		result.items = make([]*IdentityProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *IdentityProviderList) Equal(other *IdentityProviderList) bool {
+	if l.Link() != other.Link() || l.HREF() != other.HREF() {
+		return false
+	}
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *IdentityProviderList) Diff(other *IdentityProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *IdentityProviderList) diff(path string, other *IdentityProviderList, result []helpers.Difference) []helpers.Difference {
+	if l.Link() != other.Link() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "kind"),
+			Old:  l.Kind(),
+			New:  other.Kind(),
+		})
+	}
+	if l.HREF() != other.HREF() {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "href"),
+			Old:  l.HREF(),
+			New:  other.HREF(),
+		})
+	}
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *IdentityProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printIdentityProviderListLink(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *IdentityProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printIdentityProviderListLink(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *IdentityProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printIdentityProviderListLink(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *IdentityProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printIdentityProviderListLink(l, printer)
+	}
+}
+
+// printIdentityProviderList prints a list of values of the 'identity_provider' type to the given printer.
+func printIdentityProviderList(list *IdentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printIdentityProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
+
+// printIdentityProviderListLink prints a list of values of the 'identity_provider' type to the
+// given printer, including the link to the list.
+func printIdentityProviderListLink(list *IdentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.IdentityProviderList")
+	if list.href != nil {
+		printer.PrintObjectField("HREF")
+		printer.PrintString(*list.href)
+	}
+	if len(list.items) > 0 {
+		printer.PrintObjectField("Items")
+		printIdentityProviderList(list, printer)
+	}
+	printer.PrintObjectEnd()
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Validate checks that the object can be used to create an identity provider. It returns nil if
+// it is valid, or a *helpers.ValidationError containing all the violations if it isn't.
+func (o *IdentityProvider) Validate() error {
+	validator := new(helpers.Validator)
+	if o == nil {
+		validator.Report("", "identity provider is required")
+	} else {
+		o.validate("", validator)
+	}
+	return validator.Error()
+}
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes.
+func (o *IdentityProvider) validate(path string, validator *helpers.Validator) {
+	validator.Required(helpers.Path(path, "name"), o.name)
+
+	// The type determines which of the provider specific attributes is required:
+	if o.type_ == nil {
+		validator.Missing(helpers.Path(path, "type"))
+	} else {
+		switch *o.type_ {
+		case IdentityProviderTypeGithub:
+			if o.github == nil {
+				validator.Report(helpers.Path(path, "github"), "is required when 'type' is '%s'", *o.type_)
+			} else {
+				validator.Required(helpers.Path(path, "github.client_id"), o.github.clientID)
+			}
+		case IdentityProviderTypeGitlab:
+			if o.gitlab == nil {
+				validator.Report(helpers.Path(path, "gitlab"), "is required when 'type' is '%s'", *o.type_)
+			} else {
+				validator.Required(helpers.Path(path, "gitlab.client_id"), o.gitlab.clientID)
+				validator.Required(helpers.Path(path, "gitlab.client_secret"), o.gitlab.clientSecret)
+				validator.Required(helpers.Path(path, "gitlab.url"), o.gitlab.url)
+			}
+		case IdentityProviderTypeGoogle:
+			if o.google == nil {
+				validator.Report(helpers.Path(path, "google"), "is required when 'type' is '%s'", *o.type_)
+			} else {
+				validator.Required(helpers.Path(path, "google.client_id"), o.google.clientID)
+				validator.Required(helpers.Path(path, "google.client_secret"), o.google.clientSecret)
+			}
+		case IdentityProviderTypeLDAP:
+			if o.ldap == nil {
+				validator.Report(helpers.Path(path, "ldap"), "is required when 'type' is '%s'", *o.type_)
+			} else {
+				validator.Required(helpers.Path(path, "ldap.url"), o.ldap.url)
+			}
+		case IdentityProviderTypeOpenID:
+			if o.openID == nil {
+				validator.Report(helpers.Path(path, "open_id"), "is required when 'type' is '%s'", *o.type_)
+			} else {
+				validator.Required(helpers.Path(path, "open_id.client_id"), o.openID.clientID)
+				validator.Required(helpers.Path(path, "open_id.client_secret"), o.openID.clientSecret)
+			}
+		default:
+			validator.Report(
+				helpers.Path(path, "type"),
+				"'%s' isn't a valid type, it should be '%s', '%s', '%s', '%s' or '%s'",
+				*o.type_,
+				IdentityProviderTypeGithub,
+				IdentityProviderTypeGitlab,
+				IdentityProviderTypeGoogle,
+				IdentityProviderTypeLDAP,
+				IdentityProviderTypeOpenID,
+			)
+		}
+	}
+
+	// The mapping method is optional:
+	if o.mappingMethod != nil {
+		switch *o.mappingMethod {
+		case IdentityProviderMappingMethodAdd,
+			IdentityProviderMappingMethodClaim,
+			IdentityProviderMappingMethodGenerate,
+			IdentityProviderMappingMethodLookup:
+		default:
+			validator.Report(
+				helpers.Path(path, "mapping_method"),
+				"'%s' isn't a valid mapping method, it should be '%s', '%s', '%s' or '%s'",
+				*o.mappingMethod,
+				IdentityProviderMappingMethodAdd,
+				IdentityProviderMappingMethodClaim,
+				IdentityProviderMappingMethodGenerate,
+				IdentityProviderMappingMethodLookup,
+			)
+		}
+	}
+}
@@ -22,7 +22,6 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -93,6 +92,9 @@ type IdentityProvidersListRequest struct {
 	metric    string
 	query     url.Values
 	header    http.Header
+	decoding  helpers.DecodingOptions
+	stream    func(item *IdentityProvider) bool
+	channel   chan<- *IdentityProvider
 }
 
 // Parameter adds a query parameter.
@@ -107,6 +109,42 @@ func (r *IdentityProvidersListRequest) Header(name string, value interface{}) *I
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *IdentityProvidersListRequest) Decoding(value helpers.DecodingOptions) *IdentityProvidersListRequest {
+	r.decoding = value
+	return r
+}
+
+// Stream sets a function that will be called for each item of the response as soon as it is
+// read, instead of collecting all the items in memory, so that the memory used doesn't depend
+// on the size of the page. If the function returns false the rest of the response isn't read,
+// so the page, size and total of the response will be missing if the server sends them after the
+// items. When this is used the Items method of the response returns nil.
+//
+// Note that when the debug log of the connection is enabled the complete response is still read
+// in memory in order to write it to the log.
+func (r *IdentityProvidersListRequest) Stream(handler func(item *IdentityProvider) bool) *IdentityProvidersListRequest {
+	r.stream = handler
+	r.channel = nil
+	return r
+}
+
+// StreamTo is like Stream, but sends each item of the response to the given channel. The
+// channel isn't closed when the response ends. If the context used to send the request is
+// cancelled while waiting to send an item the rest of the response is ignored and the SendContext
+// method returns the error of the context. If all the items have been sent the context isn't
+// checked again.
+func (r *IdentityProvidersListRequest) StreamTo(channel chan<- *IdentityProvider) *IdentityProvidersListRequest {
+	r.stream = nil
+	r.channel = channel
+	return r
+}
+
 // Send sends this request, waits for the response, and returns it.
 //
 // This is a potentially lengthy operation, as it requires network communication.
@@ -140,17 +178,46 @@ func (r *IdentityProvidersListRequest) SendContext(ctx context.Context) (result
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	handler, stopped := r.handler(ctx)
+	err = result.unmarshal(response.Body, r.decoding, handler)
 	if err != nil {
 		return
 	}
+	err = *stopped
+	return
+}
+
+// handler returns the function that should be called for each item of the response, or nil if
+// the items should be collected in the list returned by the Items method of the response. When
+// the items are sent to a channel, the second result is set to the error of the context if it is
+// cancelled before all the items have been sent.
+func (r *IdentityProvidersListRequest) handler(ctx context.Context) (handler func(item *IdentityProvider) bool, stopped *error) {
+	stopped = new(error)
+	if r.channel == nil {
+		handler = r.stream
+		return
+	}
+	channel := r.channel
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	handler = func(item *IdentityProvider) bool {
+		select {
+		case channel <- item:
+			return true
+		case <-done:
+			*stopped = ctx.Err()
+			return false
+		}
+	}
 	return
 }
 
@@ -269,32 +336,59 @@ func (r *IdentityProvidersListResponse) GetItems() (value *IdentityProviderList,
 }
 
 // unmarshal is the method used internally to unmarshal responses to the
-// 'list' method.
-func (r *IdentityProvidersListResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(identityProvidersListResponseData)
-	err = decoder.Decode(data)
+// 'list' method. If the stream function isn't nil it is called for each item,
+// instead of collecting the items in memory.
+func (r *IdentityProvidersListResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions,
+	stream func(item *IdentityProvider) bool) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.page = data.Page
-	r.size = data.Size
-	r.total = data.Total
-	r.items, err = data.Items.unwrap()
-	if err != nil {
-		return err
+	if !iterator.ReadObjectStart() {
+		return iterator.Error()
 	}
-	return err
-}
-
-// identityProvidersListResponseData is the structure used internally to unmarshal
-// the response of the 'list' method.
-type identityProvidersListResponseData struct {
-	Page  *int                     "json:\"page,omitempty\""
-	Size  *int                     "json:\"size,omitempty\""
-	Total *int                     "json:\"total,omitempty\""
-	Items identityProviderListData "json:\"items,omitempty\""
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			iterator.Skip()
+		case "page":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.page = &value
+			}
+		case "size":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.size = &value
+			}
+		case "total":
+			if !iterator.ReadNull() {
+				value := iterator.ReadInt()
+				r.total = &value
+			}
+		case "items":
+			if stream == nil {
+				r.items = readIdentityProviderList(iterator)
+				break
+			}
+			if !iterator.ReadArrayStart() {
+				break
+			}
+			for iterator.ReadArrayNext() {
+				item := readIdentityProvider(iterator)
+				if iterator.Error() != nil || !stream(item) {
+					return iterator.Error()
+				}
+			}
+		default:
+			iterator.SkipField(field)
+		}
+	}
+	return iterator.Error()
 }
 
 // IdentityProvidersAddRequest is the request for the 'add' method.
@@ -305,6 +399,7 @@ type IdentityProvidersAddRequest struct {
 	query     url.Values
 	header    http.Header
 	body      *IdentityProvider
+	decoding  helpers.DecodingOptions
 }
 
 // Parameter adds a query parameter.
@@ -319,6 +414,17 @@ func (r *IdentityProvidersAddRequest) Header(name string, value interface{}) *Id
 	return r
 }
 
+// Decoding sets the options that control how the body of the response is decoded. For example,
+// to keep the attributes that the server returns and that this version of the SDK doesn't know:
+//
+//	request.Decoding(helpers.DecodingOptions{
+//		Mode: helpers.LenientDecoding,
+//	})
+func (r *IdentityProvidersAddRequest) Decoding(value helpers.DecodingOptions) *IdentityProvidersAddRequest {
+	r.decoding = value
+	return r
+}
+
 // Body sets the value of the 'body' parameter.
 //
 // Description of the cluster.
@@ -366,14 +472,14 @@ func (r *IdentityProvidersAddRequest) SendContext(ctx context.Context) (result *
 	result.status = response.StatusCode
 	result.header = response.Header
 	if result.status >= 400 {
-		result.err, err = errors.UnmarshalError(response.Body)
+		result.err, err = errors.ReadError(response)
 		if err != nil {
 			return
 		}
 		err = result.err
 		return
 	}
-	err = result.unmarshal(response.Body)
+	err = result.unmarshal(response.Body, r.decoding)
 	if err != nil {
 		return
 	}
@@ -383,14 +489,12 @@ func (r *IdentityProvidersAddRequest) SendContext(ctx context.Context) (result *
 // marshall is the method used internally to marshal requests for the
 // 'add' method.
 func (r *IdentityProvidersAddRequest) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeIdentityProvider(r.body, stream)
+	return stream.Flush()
 }
 
 // IdentityProvidersAddResponse is the response for the 'add' method.
@@ -440,17 +544,29 @@ func (r *IdentityProvidersAddResponse) GetBody() (value *IdentityProvider, ok bo
 
 // unmarshal is the method used internally to unmarshal responses to the
 // 'add' method.
-func (r *IdentityProvidersAddResponse) unmarshal(reader io.Reader) error {
-	var err error
-	decoder := json.NewDecoder(reader)
-	data := new(identityProviderData)
-	err = decoder.Decode(data)
+func (r *IdentityProvidersAddResponse) unmarshal(reader io.Reader, options helpers.DecodingOptions) error {
+	iterator, err := helpers.NewIterator(reader, options)
 	if err != nil {
 		return err
 	}
-	r.body, err = data.unwrap()
-	if err != nil {
-		return err
+	r.body = readIdentityProvider(iterator)
+	return iterator.Error()
+}
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *IdentityProvidersClient) Metadata() *helpers.ResourceMetadata {
+	return identityProvidersResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *IdentityProvidersClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
 	}
-	return err
+	return c.IdentityProvider(segments[0]).route(segments[1:], append(ids, segments[0]))
 }
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// kinds contains the names of the kinds of objects supported by this package,
+// sorted alphabetically.
+var kinds = []string{
+	CloudRegionKind,
+	CloudRegionLinkKind,
+	CloudRegionListKind,
+	CloudRegionListLinkKind,
+	ClusterKind,
+	ClusterCredentialsKind,
+	ClusterCredentialsLinkKind,
+	ClusterCredentialsListKind,
+	ClusterCredentialsListLinkKind,
+	ClusterLinkKind,
+	ClusterListKind,
+	ClusterListLinkKind,
+	ClusterStatusKind,
+	ClusterStatusLinkKind,
+	ClusterStatusListKind,
+	ClusterStatusListLinkKind,
+	DashboardKind,
+	DashboardLinkKind,
+	DashboardListKind,
+	DashboardListLinkKind,
+	FlavourKind,
+	FlavourLinkKind,
+	FlavourListKind,
+	FlavourListLinkKind,
+	GroupKind,
+	GroupLinkKind,
+	GroupListKind,
+	GroupListLinkKind,
+	IdentityProviderKind,
+	IdentityProviderLinkKind,
+	IdentityProviderListKind,
+	IdentityProviderListLinkKind,
+	LogKind,
+	LogLinkKind,
+	LogListKind,
+	LogListLinkKind,
+	SubscriptionKind,
+	SubscriptionLinkKind,
+	SubscriptionListKind,
+	SubscriptionListLinkKind,
+	UserKind,
+	UserLinkKind,
+	UserListKind,
+	UserListLinkKind,
+	VersionKind,
+	VersionLinkKind,
+	VersionListKind,
+	VersionListLinkKind,
+}
+
+// Kinds returns the names of the kinds of objects supported by this package,
+// sorted alphabetically. This includes the kinds used for links and for lists.
+func Kinds() []string {
+	result := make([]string, len(kinds))
+	copy(result, kinds)
+	return result
+}
+
+// UnmarshalKind reads from the given source, which can be a slice of bytes, a
+// string, an io.Reader or a json.Decoder, an object of the type that
+// corresponds to the given kind. For example, if the kind is 'Cluster' or
+// 'ClusterLink' the result will be a *Cluster, and if the kind is
+// 'ClusterList' or 'ClusterListLink' the result will be a *ClusterList. The
+// optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled. An error is returned if the kind isn't
+// supported by this package.
+func UnmarshalKind(kind string, source interface{}, options ...helpers.DecodingOptions) (object interface{}, err error) {
+	switch kind {
+	case CloudRegionKind, CloudRegionLinkKind:
+		object, err = UnmarshalCloudRegion(source, options...)
+	case CloudRegionListKind, CloudRegionListLinkKind:
+		object, err = unmarshalCloudRegionListLink(source, options...)
+	case ClusterKind, ClusterLinkKind:
+		object, err = UnmarshalCluster(source, options...)
+	case ClusterListKind, ClusterListLinkKind:
+		object, err = unmarshalClusterListLink(source, options...)
+	case ClusterCredentialsKind, ClusterCredentialsLinkKind:
+		object, err = UnmarshalClusterCredentials(source, options...)
+	case ClusterCredentialsListKind, ClusterCredentialsListLinkKind:
+		object, err = unmarshalClusterCredentialsListLink(source, options...)
+	case ClusterStatusKind, ClusterStatusLinkKind:
+		object, err = UnmarshalClusterStatus(source, options...)
+	case ClusterStatusListKind, ClusterStatusListLinkKind:
+		object, err = unmarshalClusterStatusListLink(source, options...)
+	case DashboardKind, DashboardLinkKind:
+		object, err = UnmarshalDashboard(source, options...)
+	case DashboardListKind, DashboardListLinkKind:
+		object, err = unmarshalDashboardListLink(source, options...)
+	case FlavourKind, FlavourLinkKind:
+		object, err = UnmarshalFlavour(source, options...)
+	case FlavourListKind, FlavourListLinkKind:
+		object, err = unmarshalFlavourListLink(source, options...)
+	case GroupKind, GroupLinkKind:
+		object, err = UnmarshalGroup(source, options...)
+	case GroupListKind, GroupListLinkKind:
+		object, err = unmarshalGroupListLink(source, options...)
+	case IdentityProviderKind, IdentityProviderLinkKind:
+		object, err = UnmarshalIdentityProvider(source, options...)
+	case IdentityProviderListKind, IdentityProviderListLinkKind:
+		object, err = unmarshalIdentityProviderListLink(source, options...)
+	case LogKind, LogLinkKind:
+		object, err = UnmarshalLog(source, options...)
+	case LogListKind, LogListLinkKind:
+		object, err = unmarshalLogListLink(source, options...)
+	case SubscriptionKind, SubscriptionLinkKind:
+		object, err = UnmarshalSubscription(source, options...)
+	case SubscriptionListKind, SubscriptionListLinkKind:
+		object, err = unmarshalSubscriptionListLink(source, options...)
+	case UserKind, UserLinkKind:
+		object, err = UnmarshalUser(source, options...)
+	case UserListKind, UserListLinkKind:
+		object, err = unmarshalUserListLink(source, options...)
+	case VersionKind, VersionLinkKind:
+		object, err = UnmarshalVersion(source, options...)
+	case VersionListKind, VersionListLinkKind:
+		object, err = unmarshalVersionListLink(source, options...)
+	default:
+		err = fmt.Errorf("kind '%s' isn't supported", kind)
+	}
+	if err != nil {
+		object = nil
+	}
+	return
+}
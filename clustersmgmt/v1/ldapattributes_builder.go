@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // LdapattributesBuilder contains the data and logic needed to build 'ldapattributes' objects.
 //
 // LDAP attributes used to configure the LDAP identity provider.
@@ -27,6 +31,7 @@ type LdapattributesBuilder struct {
 	id                []string
 	name              []string
 	preferredUsername []string
+	extra             map[string]interface{}
 }
 
 // NewLdapattributes creates a new builder of 'ldapattributes' objects.
@@ -34,6 +39,30 @@ func NewLdapattributes() *LdapattributesBuilder {
 	return new(LdapattributesBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'ldapattributes' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Ldapattributes) Builder() *LdapattributesBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(LdapattributesBuilder)
+	if o.email != nil {
+		b.email = make([]string, len(o.email))
+		copy(b.email, o.email)
+	}
+	if o.name != nil {
+		b.name = make([]string, len(o.name))
+		copy(b.name, o.name)
+	}
+	if o.preferredUsername != nil {
+		b.preferredUsername = make([]string, len(o.preferredUsername))
+		copy(b.preferredUsername, o.preferredUsername)
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Email sets the value of the 'email' attribute
 // to the given values.
 //
@@ -74,9 +103,17 @@ func (b *LdapattributesBuilder) PreferredUsername(values ...string) *Ldapattribu
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'ldapattributes' type, and
+// that will be written as they are when the object is encoded.
+func (b *LdapattributesBuilder) Extra(value map[string]interface{}) *LdapattributesBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'ldapattributes' object using the configuration stored in the builder.
 func (b *LdapattributesBuilder) Build() (object *Ldapattributes, err error) {
 	object = new(Ldapattributes)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.email != nil {
 		object.email = make([]string, len(b.email))
 		copy(object.email, b.email)
@@ -30,6 +30,19 @@ func NewLdapattributesList() *LdapattributesListBuilder {
 	return new(LdapattributesListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *LdapattributesList) Builder() *LdapattributesListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(LdapattributesListBuilder)
+	b.items = make([]*LdapattributesBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *LdapattributesListBuilder) Items(values ...*LdapattributesBuilder) *LdapattributesListBuilder {
 	b.items = make([]*LdapattributesBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// ldapattributesListData is type used internally to marshal and unmarshal lists of objects
-// of type 'ldapattributes'.
-type ldapattributesListData []*ldapattributesData
-
 // UnmarshalLdapattributesList reads a list of values of the 'ldapattributes'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalLdapattributesList(source interface{}) (list *LdapattributesList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalLdapattributesList(source interface{}, options ...helpers.DecodingOptions) (list *LdapattributesList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data ldapattributesListData
-	err = decoder.Decode(&data)
+	list = readLdapattributesList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'ldapattributes' value to a JSON document.
-func (l *LdapattributesList) wrap() (data ldapattributesListData, err error) {
-	if l == nil {
-		return
+// MarshalLdapattributesList writes a list of values of the 'ldapattributes' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalLdapattributesList(list *LdapattributesList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(ldapattributesListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeLdapattributesList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *LdapattributesList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLdapattributesList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalLdapattributesList function,
+// using the default decoding options.
+func (l *LdapattributesList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalLdapattributesList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = LdapattributesList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'ldapattributes' type.
-func (d ldapattributesListData) unwrap() (list *LdapattributesList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *LdapattributesList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *LdapattributesList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeLdapattributesList writes a list of values of the 'ldapattributes' type to the given
+// stream, as a JSON array.
+func writeLdapattributesList(list *LdapattributesList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Ldapattributes, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeLdapattributes(item, stream)
 	}
-	list = new(LdapattributesList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readLdapattributesList reads a list of values of the 'ldapattributes' type from the given
+// iterator, as a JSON array.
+func readLdapattributesList(iterator *helpers.Iterator) *LdapattributesList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(LdapattributesList)
+	list.items = []*Ldapattributes{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readLdapattributes(iterator))
+	}
+	return list
 }
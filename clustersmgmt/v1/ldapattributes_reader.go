@@ -23,69 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// ldapattributesData is the data structure used internally to marshal and unmarshal
-// objects of type 'ldapattributes'.
-type ldapattributesData struct {
-	Email             []string "json:\"email,omitempty\""
-	ID                []string "json:\"id,omitempty\""
-	Name              []string "json:\"name,omitempty\""
-	PreferredUsername []string "json:\"preferred_username,omitempty\""
-}
-
 // MarshalLdapattributes writes a value of the 'ldapattributes' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalLdapattributes(object *Ldapattributes, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeLdapattributes(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'ldapattributes'
-// value to a JSON document.
-func (o *Ldapattributes) wrap() (data *ldapattributesData, err error) {
-	if o == nil {
+// writeLdapattributes writes a value of the 'ldapattributes' type to the given stream.
+func writeLdapattributes(object *Ldapattributes, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(ldapattributesData)
-	data.Email = o.email
-	data.ID = o.id
-	data.Name = o.name
-	data.PreferredUsername = o.preferredUsername
-	return
+	stream.WriteObjectStart()
+	if len(object.email) > 0 {
+		stream.WriteObjectField("email")
+		stream.WriteStringSlice(object.email)
+	}
+	if len(object.name) > 0 {
+		stream.WriteObjectField("name")
+		stream.WriteStringSlice(object.name)
+	}
+	if len(object.preferredUsername) > 0 {
+		stream.WriteObjectField("preferred_username")
+		stream.WriteStringSlice(object.preferredUsername)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalLdapattributes reads a value of the 'ldapattributes' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalLdapattributes(source interface{}) (object *Ldapattributes, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalLdapattributes(source interface{}, options ...helpers.DecodingOptions) (object *Ldapattributes, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(ldapattributesData)
-	err = decoder.Decode(data)
+	object = readLdapattributes(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Ldapattributes)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'ldapattributes' type.
-func (d *ldapattributesData) unwrap() (object *Ldapattributes, err error) {
-	if d == nil {
-		return
+// readLdapattributes reads a value of the 'ldapattributes' type from the given iterator.
+func readLdapattributes(iterator *helpers.Iterator) *Ldapattributes {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Ldapattributes)
-	object.email = d.Email
-	object.id = d.ID
-	object.name = d.Name
-	object.preferredUsername = d.PreferredUsername
-	return
+	object := new(Ldapattributes)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "email":
+			object.email = iterator.ReadStringSlice()
+		case "name":
+			object.name = iterator.ReadStringSlice()
+		case "preferred_username":
+			object.preferredUsername = iterator.ReadStringSlice()
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalLdapattributes function.
+func (o *Ldapattributes) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLdapattributes(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalLdapattributes function, using the
+// default decoding options.
+func (o *Ldapattributes) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalLdapattributes(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Ldapattributes{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Ldapattributes) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Ldapattributes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
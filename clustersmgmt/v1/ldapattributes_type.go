@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // Ldapattributes represents the values of the 'ldapattributes' type.
 //
 // LDAP attributes used to configure the LDAP identity provider.
@@ -27,6 +33,7 @@ type Ldapattributes struct {
 	id                []string
 	name              []string
 	preferredUsername []string
+	extra             map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +45,59 @@ func (o *Ldapattributes) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'ldapattributes' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Ldapattributes) Metadata() *helpers.TypeMetadata {
+	return ldapattributesMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Ldapattributes) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printLdapattributes(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Ldapattributes) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printLdapattributes(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Ldapattributes) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printLdapattributes(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Ldapattributes) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printLdapattributes(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'ldapattributes' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Ldapattributes) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Email returns the value of the 'email' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -130,6 +190,168 @@ func (o *Ldapattributes) GetPreferredUsername() (value []string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'ldapattributes' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Ldapattributes) Copy() *Ldapattributes {
+	if o == nil {
+		return nil
+	}
+	result := new(Ldapattributes)
+	if o.email != nil {
+		result.email = make([]string, len(o.email))
+		copy(result.email, o.email)
+	}
+	if o.name != nil {
+		result.name = make([]string, len(o.name))
+		copy(result.name, o.name)
+	}
+	if o.preferredUsername != nil {
+		result.preferredUsername = make([]string, len(o.preferredUsername))
+		copy(result.preferredUsername, o.preferredUsername)
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'ldapattributes' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Ldapattributes) Equal(other *Ldapattributes) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if len(o.email) != len(other.email) {
+		return false
+	}
+	for i, value := range o.email {
+		if value != other.email[i] {
+			return false
+		}
+	}
+	if len(o.name) != len(other.name) {
+		return false
+	}
+	for i, value := range o.name {
+		if value != other.name[i] {
+			return false
+		}
+	}
+	if len(o.preferredUsername) != len(other.preferredUsername) {
+		return false
+	}
+	for i, value := range o.preferredUsername {
+		if value != other.preferredUsername[i] {
+			return false
+		}
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'ldapattributes' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Ldapattributes) Diff(other *Ldapattributes) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'ldapattributes' object and the given one, using the given path as prefix.
+func (o *Ldapattributes) diff(path string, other *Ldapattributes, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if !equalStrings(o.email, other.email) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "email"),
+			Old:  o.email,
+			New:  other.email,
+		})
+	}
+	if !equalStrings(o.name, other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  o.name,
+			New:  other.name,
+		})
+	}
+	if !equalStrings(o.preferredUsername, other.preferredUsername) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "preferred_username"),
+			Old:  o.preferredUsername,
+			New:  other.preferredUsername,
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'ldapattributes' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Ldapattributes) Changes(modified *Ldapattributes) *Ldapattributes {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Ldapattributes)
+	if modified.email != nil && !equalStrings(o.email, modified.email) {
+		result.email = make([]string, len(modified.email))
+		copy(result.email, modified.email)
+	}
+	if modified.name != nil && !equalStrings(o.name, modified.name) {
+		result.name = make([]string, len(modified.name))
+		copy(result.name, modified.name)
+	}
+	if modified.preferredUsername != nil && !equalStrings(o.preferredUsername, modified.preferredUsername) {
+		result.preferredUsername = make([]string, len(modified.preferredUsername))
+		copy(result.preferredUsername, modified.preferredUsername)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printLdapattributes prints a value of the 'ldapattributes' type to the given printer.
+func printLdapattributes(object *Ldapattributes, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Ldapattributes")
+	if object.email != nil {
+		printer.PrintObjectField("Email")
+		printer.PrintStringSlice(object.email)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintStringSlice(object.name)
+	}
+	if object.preferredUsername != nil {
+		printer.PrintObjectField("PreferredUsername")
+		printer.PrintStringSlice(object.preferredUsername)
+	}
+	printer.PrintObjectEnd()
+}
+
 // LdapattributesList is a list of values of the 'ldapattributes' type.
 type LdapattributesList struct {
 	items []*Ldapattributes
@@ -201,3 +423,105 @@ func (l *LdapattributesList) Range(f func(index int, item *Ldapattributes) bool)
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *LdapattributesList) Copy() *LdapattributesList {
+	if l == nil {
+		return nil
+	}
+	result := new(LdapattributesList)
+	if l.items != nil {
+		result.items = make([]*Ldapattributes, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *LdapattributesList) Equal(other *LdapattributesList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *LdapattributesList) Diff(other *LdapattributesList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *LdapattributesList) diff(path string, other *LdapattributesList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *LdapattributesList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printLdapattributesList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *LdapattributesList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printLdapattributesList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *LdapattributesList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printLdapattributesList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *LdapattributesList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printLdapattributesList(l, printer)
+	}
+}
+
+// printLdapattributesList prints a list of values of the 'ldapattributes' type to the given printer.
+func printLdapattributesList(list *LdapattributesList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printLdapattributes(item, printer)
+	}
+	printer.PrintListEnd()
+}
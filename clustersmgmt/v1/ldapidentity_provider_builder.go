@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // LdapidentityProviderBuilder contains the data and logic needed to build 'ldapidentity_provider' objects.
 //
 // Details for `ldap` identity providers.
@@ -29,6 +33,7 @@ type LdapidentityProviderBuilder struct {
 	ca             *string
 	url            *string
 	insecure       *bool
+	extra          map[string]interface{}
 }
 
 // NewLdapidentityProvider creates a new builder of 'ldapidentity_provider' objects.
@@ -36,6 +41,26 @@ func NewLdapidentityProvider() *LdapidentityProviderBuilder {
 	return new(LdapidentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'ldapidentity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *LdapidentityProvider) Builder() *LdapidentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(LdapidentityProviderBuilder)
+	if o.ldapattributes != nil {
+		b.ldapattributes = o.ldapattributes.Builder()
+	}
+	b.bindDN = o.bindDN
+	b.bindPassword = o.bindPassword
+	b.ca = o.ca
+	b.url = o.url
+	b.insecure = o.insecure
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Ldapattributes sets the value of the 'ldapattributes' attribute
 // to the given value.
 //
@@ -90,9 +115,17 @@ func (b *LdapidentityProviderBuilder) Insecure(value bool) *LdapidentityProvider
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'ldapidentity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *LdapidentityProviderBuilder) Extra(value map[string]interface{}) *LdapidentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'ldapidentity_provider' object using the configuration stored in the builder.
 func (b *LdapidentityProviderBuilder) Build() (object *LdapidentityProvider, err error) {
 	object = new(LdapidentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.ldapattributes != nil {
 		object.ldapattributes, err = b.ldapattributes.Build()
 		if err != nil {
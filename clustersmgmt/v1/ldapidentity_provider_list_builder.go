@@ -30,6 +30,19 @@ func NewLdapidentityProviderList() *LdapidentityProviderListBuilder {
 	return new(LdapidentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *LdapidentityProviderList) Builder() *LdapidentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(LdapidentityProviderListBuilder)
+	b.items = make([]*LdapidentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *LdapidentityProviderListBuilder) Items(values ...*LdapidentityProviderBuilder) *LdapidentityProviderListBuilder {
 	b.items = make([]*LdapidentityProviderBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// ldapidentityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'ldapidentity_provider'.
-type ldapidentityProviderListData []*ldapidentityProviderData
-
 // UnmarshalLdapidentityProviderList reads a list of values of the 'ldapidentity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalLdapidentityProviderList(source interface{}) (list *LdapidentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalLdapidentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *LdapidentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data ldapidentityProviderListData
-	err = decoder.Decode(&data)
+	list = readLdapidentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'ldapidentity_provider' value to a JSON document.
-func (l *LdapidentityProviderList) wrap() (data ldapidentityProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalLdapidentityProviderList writes a list of values of the 'ldapidentity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalLdapidentityProviderList(list *LdapidentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(ldapidentityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeLdapidentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *LdapidentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLdapidentityProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalLdapidentityProviderList function,
+// using the default decoding options.
+func (l *LdapidentityProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalLdapidentityProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = LdapidentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'ldapidentity_provider' type.
-func (d ldapidentityProviderListData) unwrap() (list *LdapidentityProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *LdapidentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *LdapidentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeLdapidentityProviderList writes a list of values of the 'ldapidentity_provider' type to the given
+// stream, as a JSON array.
+func writeLdapidentityProviderList(list *LdapidentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*LdapidentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeLdapidentityProvider(item, stream)
 	}
-	list = new(LdapidentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readLdapidentityProviderList reads a list of values of the 'ldapidentity_provider' type from the given
+// iterator, as a JSON array.
+func readLdapidentityProviderList(iterator *helpers.Iterator) *LdapidentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(LdapidentityProviderList)
+	list.items = []*LdapidentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readLdapidentityProvider(iterator))
+	}
+	return list
 }
@@ -23,81 +23,161 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// ldapidentityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'ldapidentity_provider'.
-type ldapidentityProviderData struct {
-	Ldapattributes *ldapattributesData "json:\"ldapattributes,omitempty\""
-	BindDN         *string             "json:\"bind_dn,omitempty\""
-	BindPassword   *string             "json:\"bind_password,omitempty\""
-	CA             *string             "json:\"ca,omitempty\""
-	URL            *string             "json:\"url,omitempty\""
-	Insecure       *bool               "json:\"insecure,omitempty\""
-}
-
 // MarshalLdapidentityProvider writes a value of the 'ldapidentity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalLdapidentityProvider(object *LdapidentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeLdapidentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'ldapidentity_provider'
-// value to a JSON document.
-func (o *LdapidentityProvider) wrap() (data *ldapidentityProviderData, err error) {
-	if o == nil {
+// writeLdapidentityProvider writes a value of the 'ldapidentity_provider' type to the given stream.
+func writeLdapidentityProvider(object *LdapidentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(ldapidentityProviderData)
-	data.Ldapattributes, err = o.ldapattributes.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.ldapattributes != nil {
+		stream.WriteObjectField("ldapattributes")
+		writeLdapattributes(object.ldapattributes, stream)
 	}
-	data.BindDN = o.bindDN
-	data.BindPassword = o.bindPassword
-	data.CA = o.ca
-	data.URL = o.url
-	data.Insecure = o.insecure
-	return
+	if object.bindDN != nil {
+		stream.WriteObjectField("bind_dn")
+		stream.WriteString(*object.bindDN)
+	}
+	if object.bindPassword != nil {
+		stream.WriteObjectField("bind_password")
+		stream.WriteString(*object.bindPassword)
+	}
+	if object.ca != nil {
+		stream.WriteObjectField("ca")
+		stream.WriteString(*object.ca)
+	}
+	if object.url != nil {
+		stream.WriteObjectField("url")
+		stream.WriteString(*object.url)
+	}
+	if object.insecure != nil {
+		stream.WriteObjectField("insecure")
+		stream.WriteBool(*object.insecure)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalLdapidentityProvider reads a value of the 'ldapidentity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalLdapidentityProvider(source interface{}) (object *LdapidentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalLdapidentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *LdapidentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(ldapidentityProviderData)
-	err = decoder.Decode(data)
+	object = readLdapidentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(LdapidentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'ldapidentity_provider' type.
-func (d *ldapidentityProviderData) unwrap() (object *LdapidentityProvider, err error) {
-	if d == nil {
-		return
+// readLdapidentityProvider reads a value of the 'ldapidentity_provider' type from the given iterator.
+func readLdapidentityProvider(iterator *helpers.Iterator) *LdapidentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(LdapidentityProvider)
-	object.ldapattributes, err = d.Ldapattributes.unwrap()
+	object := new(LdapidentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "ldapattributes":
+			object.ldapattributes = readLdapattributes(iterator)
+		case "bind_dn":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.bindDN = &value
+			}
+		case "bind_password":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.bindPassword = &value
+			}
+		case "ca":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.ca = &value
+			}
+		case "url":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.url = &value
+			}
+		case "insecure":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.insecure = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalLdapidentityProvider function.
+func (o *LdapidentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLdapidentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalLdapidentityProvider function, using the
+// default decoding options.
+func (o *LdapidentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalLdapidentityProvider(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.bindDN = d.BindDN
-	object.bindPassword = d.BindPassword
-	object.ca = d.CA
-	object.url = d.URL
-	object.insecure = d.Insecure
-	return
+	if object == nil {
+		*o = LdapidentityProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *LdapidentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *LdapidentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // LdapidentityProvider represents the values of the 'ldapidentity_provider' type.
 //
 // Details for `ldap` identity providers.
@@ -29,6 +35,7 @@ type LdapidentityProvider struct {
 	ca             *string
 	url            *string
 	insecure       *bool
+	extra          map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -42,6 +49,59 @@ func (o *LdapidentityProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'ldapidentity_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *LdapidentityProvider) Metadata() *helpers.TypeMetadata {
+	return ldapidentityProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *LdapidentityProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printLdapidentityProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *LdapidentityProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printLdapidentityProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *LdapidentityProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printLdapidentityProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *LdapidentityProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printLdapidentityProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'ldapidentity_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *LdapidentityProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Ldapattributes returns the value of the 'ldapattributes' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -184,6 +244,219 @@ func (o *LdapidentityProvider) GetInsecure() (value bool, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'ldapidentity_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *LdapidentityProvider) Copy() *LdapidentityProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(LdapidentityProvider)
+	result.ldapattributes = o.ldapattributes.Copy()
+	if o.bindDN != nil {
+		result.bindDN = new(string)
+		*result.bindDN = *o.bindDN
+	}
+	if o.bindPassword != nil {
+		result.bindPassword = new(string)
+		*result.bindPassword = *o.bindPassword
+	}
+	if o.ca != nil {
+		result.ca = new(string)
+		*result.ca = *o.ca
+	}
+	if o.url != nil {
+		result.url = new(string)
+		*result.url = *o.url
+	}
+	if o.insecure != nil {
+		result.insecure = new(bool)
+		*result.insecure = *o.insecure
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'ldapidentity_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *LdapidentityProvider) Equal(other *LdapidentityProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if !o.ldapattributes.Equal(other.ldapattributes) {
+		return false
+	}
+	if (o.bindDN == nil) != (other.bindDN == nil) ||
+		(o.bindDN != nil && *o.bindDN != *other.bindDN) {
+		return false
+	}
+	if (o.bindPassword == nil) != (other.bindPassword == nil) ||
+		(o.bindPassword != nil && *o.bindPassword != *other.bindPassword) {
+		return false
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		return false
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		return false
+	}
+	if (o.insecure == nil) != (other.insecure == nil) ||
+		(o.insecure != nil && *o.insecure != *other.insecure) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'ldapidentity_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *LdapidentityProvider) Diff(other *LdapidentityProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'ldapidentity_provider' object and the given one, using the given path as prefix.
+func (o *LdapidentityProvider) diff(path string, other *LdapidentityProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	result = o.ldapattributes.diff(helpers.Path(path, "ldapattributes"), other.ldapattributes, result)
+	if (o.bindDN == nil) != (other.bindDN == nil) ||
+		(o.bindDN != nil && *o.bindDN != *other.bindDN) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "bind_dn"),
+			Old:  helpers.Value(o.bindDN),
+			New:  helpers.Value(other.bindDN),
+		})
+	}
+	if (o.bindPassword == nil) != (other.bindPassword == nil) ||
+		(o.bindPassword != nil && *o.bindPassword != *other.bindPassword) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "bind_password"),
+			Old:  helpers.Value(o.bindPassword),
+			New:  helpers.Value(other.bindPassword),
+		})
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "ca"),
+			Old:  helpers.Value(o.ca),
+			New:  helpers.Value(other.ca),
+		})
+	}
+	if (o.url == nil) != (other.url == nil) ||
+		(o.url != nil && *o.url != *other.url) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "url"),
+			Old:  helpers.Value(o.url),
+			New:  helpers.Value(other.url),
+		})
+	}
+	if (o.insecure == nil) != (other.insecure == nil) ||
+		(o.insecure != nil && *o.insecure != *other.insecure) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "insecure"),
+			Old:  helpers.Value(o.insecure),
+			New:  helpers.Value(other.insecure),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'ldapidentity_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *LdapidentityProvider) Changes(modified *LdapidentityProvider) *LdapidentityProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(LdapidentityProvider)
+	if modified.ldapattributes != nil && !o.ldapattributes.Equal(modified.ldapattributes) {
+		result.ldapattributes = o.ldapattributes.Changes(modified.ldapattributes)
+	}
+	if modified.bindDN != nil && (o.bindDN == nil || *o.bindDN != *modified.bindDN) {
+		result.bindDN = new(string)
+		*result.bindDN = *modified.bindDN
+	}
+	if modified.bindPassword != nil && (o.bindPassword == nil || *o.bindPassword != *modified.bindPassword) {
+		result.bindPassword = new(string)
+		*result.bindPassword = *modified.bindPassword
+	}
+	if modified.ca != nil && (o.ca == nil || *o.ca != *modified.ca) {
+		result.ca = new(string)
+		*result.ca = *modified.ca
+	}
+	if modified.url != nil && (o.url == nil || *o.url != *modified.url) {
+		result.url = new(string)
+		*result.url = *modified.url
+	}
+	if modified.insecure != nil && (o.insecure == nil || *o.insecure != *modified.insecure) {
+		result.insecure = new(bool)
+		*result.insecure = *modified.insecure
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printLdapidentityProvider prints a value of the 'ldapidentity_provider' type to the given printer.
+func printLdapidentityProvider(object *LdapidentityProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.LdapidentityProvider")
+	if object.ldapattributes != nil {
+		printer.PrintObjectField("Ldapattributes")
+		printLdapattributes(object.ldapattributes, printer)
+	}
+	if object.bindDN != nil {
+		printer.PrintObjectField("BindDN")
+		printer.PrintString(*object.bindDN)
+	}
+	if object.bindPassword != nil {
+		printer.PrintObjectField("BindPassword")
+		printer.PrintSensitive(*object.bindPassword)
+	}
+	if object.ca != nil {
+		printer.PrintObjectField("CA")
+		printer.PrintString(*object.ca)
+	}
+	if object.url != nil {
+		printer.PrintObjectField("URL")
+		printer.PrintString(*object.url)
+	}
+	if object.insecure != nil {
+		printer.PrintObjectField("Insecure")
+		printer.PrintBool(*object.insecure)
+	}
+	printer.PrintObjectEnd()
+}
+
 // LdapidentityProviderList is a list of values of the 'ldapidentity_provider' type.
 type LdapidentityProviderList struct {
 	items []*LdapidentityProvider
@@ -255,3 +528,105 @@ func (l *LdapidentityProviderList) Range(f func(index int, item *LdapidentityPro
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *LdapidentityProviderList) Copy() *LdapidentityProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(LdapidentityProviderList)
+	if l.items != nil {
+		result.items = make([]*LdapidentityProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *LdapidentityProviderList) Equal(other *LdapidentityProviderList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *LdapidentityProviderList) Diff(other *LdapidentityProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *LdapidentityProviderList) diff(path string, other *LdapidentityProviderList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *LdapidentityProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printLdapidentityProviderList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *LdapidentityProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printLdapidentityProviderList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *LdapidentityProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printLdapidentityProviderList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *LdapidentityProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printLdapidentityProviderList(l, printer)
+	}
+}
+
+// printLdapidentityProviderList prints a list of values of the 'ldapidentity_provider' type to the given printer.
+func printLdapidentityProviderList(list *LdapidentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printLdapidentityProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
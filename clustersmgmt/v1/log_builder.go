@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // LogBuilder contains the data and logic needed to build 'log' objects.
 //
 // Log of the cluster.
@@ -27,6 +31,7 @@ type LogBuilder struct {
 	href    *string
 	link    bool
 	content *string
+	extra   map[string]interface{}
 }
 
 // NewLog creates a new builder of 'log' objects.
@@ -34,6 +39,22 @@ func NewLog() *LogBuilder {
 	return new(LogBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'log' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Log) Builder() *LogBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(LogBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.content = o.content
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *LogBuilder) ID(value string) *LogBuilder {
 	b.id = &value
@@ -61,9 +82,17 @@ func (b *LogBuilder) Content(value string) *LogBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'log' type, and
+// that will be written as they are when the object is encoded.
+func (b *LogBuilder) Extra(value map[string]interface{}) *LogBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'log' object using the configuration stored in the builder.
 func (b *LogBuilder) Build() (object *Log, err error) {
 	object = new(Log)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
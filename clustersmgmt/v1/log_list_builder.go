@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'log' objects.
 type LogListBuilder struct {
 	items []*LogBuilder
+	link  bool
+	href  *string
 }
 
 // NewLogList creates a new builder of 'log' objects.
@@ -30,8 +32,25 @@ func NewLogList() *LogListBuilder {
 	return new(LogListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *LogList) Builder() *LogListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(LogListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*LogBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *LogListBuilder) Items(values ...*LogBuilder) *LogListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*LogBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *LogListBuilder) Build() (list *LogList, err error) {
 		}
 	}
 	list = new(LogList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// logListData is type used internally to marshal and unmarshal lists of objects
-// of type 'log'.
-type logListData []*logData
-
 // UnmarshalLogList reads a list of values of the 'log'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalLogList(source interface{}) (list *LogList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalLogList(source interface{}, options ...helpers.DecodingOptions) (list *LogList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data logListData
-	err = decoder.Decode(&data)
+	list = readLogList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'log' value to a JSON document.
-func (l *LogList) wrap() (data logListData, err error) {
-	if l == nil {
+// MarshalLogList writes a list of values of the 'log' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalLogList(list *LogList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeLogList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *LogList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLogListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalLogList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *LogList) UnmarshalJSON(data []byte) error {
+	var list *LogList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalLogList(data)
+	} else {
+		list, err = unmarshalLogListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = LogList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *LogList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *LogList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalLogListLink reads a list of values of the 'log' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalLogListLink(source interface{}, options ...helpers.DecodingOptions) (list *LogList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(logListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readLogListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'log' type.
-func (d logListData) unwrap() (list *LogList, err error) {
-	if d == nil {
+// writeLogList writes a list of values of the 'log' type to the given
+// stream, as a JSON array.
+func writeLogList(list *LogList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Log, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeLog(item, stream)
 	}
-	list = new(LogList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// logListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'log'.
-type logListLinkData struct {
-	Kind  *string    "json:\"kind,omitempty\""
-	HREF  *string    "json:\"href,omitempty\""
-	Items []*logData "json:\"items,omitempty\""
+// readLogList reads a list of values of the 'log' type from the given
+// iterator, as a JSON array.
+func readLogList(iterator *helpers.Iterator) *LogList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(LogList)
+	list.items = []*Log{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readLog(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'log' value to a link.
-func (l *LogList) wrapLink() (data *logListLinkData, err error) {
-	if l == nil {
+// writeLogListLink writes a list of values of the 'log' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeLogListLink(list *LogList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*logData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(logListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = LogListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(LogListLinkKind)
 	} else {
-		*data.Kind = LogListKind
+		stream.WriteString(LogListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeLogList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'log' type to a list.
-func (d *logListLinkData) unwrapLink() (list *LogList, err error) {
-	if d == nil {
-		return
+// readLogListLink reads a list of values of the 'log' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readLogListLink(iterator *helpers.Iterator) *LogList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Log, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(LogList)
+	list.items = []*Log{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(LogList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case LogListKind:
-			list.link = false
-		case LogListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(LogListKind, LogListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readLogList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				LogListKind,
-				LogListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
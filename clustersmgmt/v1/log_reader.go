@@ -20,94 +20,148 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// logData is the data structure used internally to marshal and unmarshal
-// objects of type 'log'.
-type logData struct {
-	Kind    *string "json:\"kind,omitempty\""
-	ID      *string "json:\"id,omitempty\""
-	HREF    *string "json:\"href,omitempty\""
-	Content *string "json:\"content,omitempty\""
-}
-
 // MarshalLog writes a value of the 'log' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalLog(object *Log, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeLog(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'log'
-// value to a JSON document.
-func (o *Log) wrap() (data *logData, err error) {
-	if o == nil {
+// writeLog writes a value of the 'log' type to the given stream.
+func writeLog(object *Log, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(logData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = LogLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(LogLinkKind)
 	} else {
-		*data.Kind = LogKind
+		stream.WriteString(LogKind)
 	}
-	data.Content = o.content
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.content != nil {
+		stream.WriteObjectField("content")
+		stream.WriteString(*object.content)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalLog reads a value of the 'log' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalLog(source interface{}) (object *Log, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalLog(source interface{}, options ...helpers.DecodingOptions) (object *Log, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(logData)
-	err = decoder.Decode(data)
+	object = readLog(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Log)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'log' type.
-func (d *logData) unwrap() (object *Log, err error) {
-	if d == nil {
-		return
+// readLog reads a value of the 'log' type from the given iterator.
+func readLog(iterator *helpers.Iterator) *Log {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Log)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case LogKind:
-			object.link = false
-		case LogLinkKind:
-			object.link = true
+	object := new(Log)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(LogKind, LogLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "content":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.content = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				LogKind,
-				LogLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.content = d.Content
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalLog function.
+func (o *Log) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeLog(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalLog function, using the
+// default decoding options.
+func (o *Log) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalLog(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Log{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Log) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Log) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
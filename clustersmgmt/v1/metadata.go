@@ -0,0 +1,1670 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Descriptions of the types of this package. The attributes are populated in the
+// init function because the types can reference each other.
+var (
+	awsMetadata = &helpers.TypeMetadata{
+		Name: "AWS",
+	}
+	adminCredentialsMetadata = &helpers.TypeMetadata{
+		Name: "AdminCredentials",
+	}
+	cloudProviderMetadata = &helpers.TypeMetadata{
+		Name: "CloudProvider",
+	}
+	cloudRegionMetadata = &helpers.TypeMetadata{
+		Name: "CloudRegion",
+		Kind: CloudRegionKind,
+	}
+	clusterMetadata = &helpers.TypeMetadata{
+		Name: "Cluster",
+		Kind: ClusterKind,
+	}
+	clusterAPIMetadata = &helpers.TypeMetadata{
+		Name: "ClusterAPI",
+	}
+	clusterConsoleMetadata = &helpers.TypeMetadata{
+		Name: "ClusterConsole",
+	}
+	clusterCredentialsMetadata = &helpers.TypeMetadata{
+		Name: "ClusterCredentials",
+		Kind: ClusterCredentialsKind,
+	}
+	clusterMetricMetadata = &helpers.TypeMetadata{
+		Name: "ClusterMetric",
+	}
+	clusterMetricsMetadata = &helpers.TypeMetadata{
+		Name: "ClusterMetrics",
+	}
+	clusterNodesMetadata = &helpers.TypeMetadata{
+		Name: "ClusterNodes",
+	}
+	clusterRegistrationMetadata = &helpers.TypeMetadata{
+		Name: "ClusterRegistration",
+	}
+	clusterStatusMetadata = &helpers.TypeMetadata{
+		Name: "ClusterStatus",
+		Kind: ClusterStatusKind,
+	}
+	dnsMetadata = &helpers.TypeMetadata{
+		Name: "DNS",
+	}
+	dashboardMetadata = &helpers.TypeMetadata{
+		Name: "Dashboard",
+		Kind: DashboardKind,
+	}
+	flavourMetadata = &helpers.TypeMetadata{
+		Name: "Flavour",
+		Kind: FlavourKind,
+	}
+	githubIdentityProviderMetadata = &helpers.TypeMetadata{
+		Name: "GithubIdentityProvider",
+	}
+	gitlabIdentityProviderMetadata = &helpers.TypeMetadata{
+		Name: "GitlabIdentityProvider",
+	}
+	googleIdentityProviderMetadata = &helpers.TypeMetadata{
+		Name: "GoogleIdentityProvider",
+	}
+	groupMetadata = &helpers.TypeMetadata{
+		Name: "Group",
+		Kind: GroupKind,
+	}
+	identityProviderMetadata = &helpers.TypeMetadata{
+		Name: "IdentityProvider",
+		Kind: IdentityProviderKind,
+	}
+	ldapattributesMetadata = &helpers.TypeMetadata{
+		Name: "Ldapattributes",
+	}
+	ldapidentityProviderMetadata = &helpers.TypeMetadata{
+		Name: "LdapidentityProvider",
+	}
+	logMetadata = &helpers.TypeMetadata{
+		Name: "Log",
+		Kind: LogKind,
+	}
+	metricMetadata = &helpers.TypeMetadata{
+		Name: "Metric",
+	}
+	networkMetadata = &helpers.TypeMetadata{
+		Name: "Network",
+	}
+	openIdclaimsMetadata = &helpers.TypeMetadata{
+		Name: "OpenIdclaims",
+	}
+	openIdidentityProviderMetadata = &helpers.TypeMetadata{
+		Name: "OpenIdidentityProvider",
+	}
+	openIdurlsMetadata = &helpers.TypeMetadata{
+		Name: "OpenIdurls",
+	}
+	sampleMetadata = &helpers.TypeMetadata{
+		Name: "Sample",
+	}
+	sshcredentialsMetadata = &helpers.TypeMetadata{
+		Name: "Sshcredentials",
+	}
+	subscriptionMetadata = &helpers.TypeMetadata{
+		Name: "Subscription",
+		Kind: SubscriptionKind,
+	}
+	userMetadata = &helpers.TypeMetadata{
+		Name: "User",
+		Kind: UserKind,
+	}
+	valueMetadata = &helpers.TypeMetadata{
+		Name: "Value",
+	}
+	versionMetadata = &helpers.TypeMetadata{
+		Name: "Version",
+		Kind: VersionKind,
+	}
+)
+
+// types contains the descriptions of the types of this package, sorted by name.
+var types = []*helpers.TypeMetadata{
+	awsMetadata,
+	adminCredentialsMetadata,
+	cloudProviderMetadata,
+	cloudRegionMetadata,
+	clusterMetadata,
+	clusterAPIMetadata,
+	clusterConsoleMetadata,
+	clusterCredentialsMetadata,
+	clusterMetricMetadata,
+	clusterMetricsMetadata,
+	clusterNodesMetadata,
+	clusterRegistrationMetadata,
+	clusterStatusMetadata,
+	dnsMetadata,
+	dashboardMetadata,
+	flavourMetadata,
+	githubIdentityProviderMetadata,
+	gitlabIdentityProviderMetadata,
+	googleIdentityProviderMetadata,
+	groupMetadata,
+	identityProviderMetadata,
+	ldapattributesMetadata,
+	ldapidentityProviderMetadata,
+	logMetadata,
+	metricMetadata,
+	networkMetadata,
+	openIdclaimsMetadata,
+	openIdidentityProviderMetadata,
+	openIdurlsMetadata,
+	sampleMetadata,
+	sshcredentialsMetadata,
+	subscriptionMetadata,
+	userMetadata,
+	valueMetadata,
+	versionMetadata,
+}
+
+// Types returns the descriptions of the types of this package, sorted by
+// name. The descriptions can be used to inspect the attributes of objects without
+// using reflection.
+func Types() []*helpers.TypeMetadata {
+	result := make([]*helpers.TypeMetadata, len(types))
+	copy(result, types)
+	return result
+}
+
+func init() {
+	awsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "AccessKeyID",
+			JSONName: "access_key_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*AWS).GetAccessKeyID()
+			},
+		},
+		{
+			Name:      "SecretAccessKey",
+			JSONName:  "secret_access_key",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*AWS).GetSecretAccessKey()
+			},
+		},
+	}
+
+	adminCredentialsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "User",
+			JSONName: "user",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*AdminCredentials).GetUser()
+			},
+		},
+		{
+			Name:      "Password",
+			JSONName:  "password",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*AdminCredentials).GetPassword()
+			},
+		},
+	}
+
+	cloudProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudProvider).GetName()
+			},
+		},
+		{
+			Name:     "DisplayName",
+			JSONName: "display_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudProvider).GetDisplayName()
+			},
+		},
+	}
+
+	cloudRegionMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudRegion).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudRegion).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudRegion).GetName()
+			},
+		},
+		{
+			Name:     "DisplayName",
+			JSONName: "display_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudRegion).GetDisplayName()
+			},
+		},
+		{
+			Name:     "CloudProvider",
+			JSONName: "cloud_provider",
+			Type:     "*CloudProvider",
+			Element:  cloudProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*CloudRegion).GetCloudProvider()
+			},
+		},
+	}
+
+	clusterMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetName()
+			},
+		},
+		{
+			Name:     "Flavour",
+			JSONName: "flavour",
+			Type:     "*Flavour",
+			Element:  flavourMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetFlavour()
+			},
+		},
+		{
+			Name:     "Console",
+			JSONName: "console",
+			Type:     "*ClusterConsole",
+			Element:  clusterConsoleMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetConsole()
+			},
+		},
+		{
+			Name:     "MultiAZ",
+			JSONName: "multi_az",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetMultiAZ()
+			},
+		},
+		{
+			Name:     "Nodes",
+			JSONName: "nodes",
+			Type:     "*ClusterNodes",
+			Element:  clusterNodesMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetNodes()
+			},
+		},
+		{
+			Name:     "API",
+			JSONName: "api",
+			Type:     "*ClusterAPI",
+			Element:  clusterAPIMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetAPI()
+			},
+		},
+		{
+			Name:     "Region",
+			JSONName: "region",
+			Type:     "*CloudRegion",
+			Element:  cloudRegionMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetRegion()
+			},
+		},
+		{
+			Name:     "DisplayName",
+			JSONName: "display_name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetDisplayName()
+			},
+		},
+		{
+			Name:     "DNS",
+			JSONName: "dns",
+			Type:     "*DNS",
+			Element:  dnsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetDNS()
+			},
+		},
+		{
+			Name:     "Properties",
+			JSONName: "properties",
+			Type:     "map[string]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetProperties()
+			},
+		},
+		{
+			Name:     "State",
+			JSONName: "state",
+			Type:     "ClusterState",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetState()
+			},
+		},
+		{
+			Name:     "Managed",
+			JSONName: "managed",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetManaged()
+			},
+		},
+		{
+			Name:     "ExternalID",
+			JSONName: "external_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetExternalID()
+			},
+		},
+		{
+			Name:     "AWS",
+			JSONName: "aws",
+			Type:     "*AWS",
+			Element:  awsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetAWS()
+			},
+		},
+		{
+			Name:     "Network",
+			JSONName: "network",
+			Type:     "*Network",
+			Element:  networkMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetNetwork()
+			},
+		},
+		{
+			Name:     "CreationTimestamp",
+			JSONName: "creation_timestamp",
+			Type:     "time.Time",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetCreationTimestamp()
+			},
+		},
+		{
+			Name:     "ExpirationTimestamp",
+			JSONName: "expiration_timestamp",
+			Type:     "time.Time",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetExpirationTimestamp()
+			},
+		},
+		{
+			Name:     "CloudProvider",
+			JSONName: "cloud_provider",
+			Type:     "*CloudProvider",
+			Element:  cloudProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetCloudProvider()
+			},
+		},
+		{
+			Name:     "OpenshiftVersion",
+			JSONName: "openshift_version",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetOpenshiftVersion()
+			},
+		},
+		{
+			Name:     "Subscription",
+			JSONName: "subscription",
+			Type:     "*Subscription",
+			Element:  subscriptionMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetSubscription()
+			},
+		},
+		{
+			Name:     "Groups",
+			JSONName: "groups",
+			Type:     "*GroupList",
+			Element:  groupMetadata,
+			Link:     true,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetGroups()
+			},
+		},
+		{
+			Name:     "Creator",
+			JSONName: "creator",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetCreator()
+			},
+		},
+		{
+			Name:     "Version",
+			JSONName: "version",
+			Type:     "*Version",
+			Element:  versionMetadata,
+			Link:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetVersion()
+			},
+		},
+		{
+			Name:     "IdentityProviders",
+			JSONName: "identity_providers",
+			Type:     "*IdentityProviderList",
+			Element:  identityProviderMetadata,
+			Link:     true,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetIdentityProviders()
+			},
+		},
+		{
+			Name:     "Metrics",
+			JSONName: "metrics",
+			Type:     "*ClusterMetrics",
+			Element:  clusterMetricsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Cluster).GetMetrics()
+			},
+		},
+	}
+
+	clusterAPIMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "URL",
+			JSONName: "url",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterAPI).GetURL()
+			},
+		},
+	}
+
+	clusterConsoleMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "URL",
+			JSONName: "url",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterConsole).GetURL()
+			},
+		},
+	}
+
+	clusterCredentialsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterCredentials).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterCredentials).GetHREF()
+			},
+		},
+		{
+			Name:      "Kubeconfig",
+			JSONName:  "kubeconfig",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterCredentials).GetKubeconfig()
+			},
+		},
+		{
+			Name:     "SSH",
+			JSONName: "ssh",
+			Type:     "*Sshcredentials",
+			Element:  sshcredentialsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterCredentials).GetSSH()
+			},
+		},
+		{
+			Name:     "Admin",
+			JSONName: "admin",
+			Type:     "*AdminCredentials",
+			Element:  adminCredentialsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterCredentials).GetAdmin()
+			},
+		},
+	}
+
+	clusterMetricMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "UpdatedTimestamp",
+			JSONName: "updated_timestamp",
+			Type:     "time.Time",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetric).GetUpdatedTimestamp()
+			},
+		},
+		{
+			Name:     "Total",
+			JSONName: "total",
+			Type:     "*Value",
+			Element:  valueMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetric).GetTotal()
+			},
+		},
+		{
+			Name:     "Used",
+			JSONName: "used",
+			Type:     "*Value",
+			Element:  valueMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetric).GetUsed()
+			},
+		},
+	}
+
+	clusterMetricsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "CPU",
+			JSONName: "cpu",
+			Type:     "*ClusterMetric",
+			Element:  clusterMetricMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetCPU()
+			},
+		},
+		{
+			Name:     "Memory",
+			JSONName: "memory",
+			Type:     "*ClusterMetric",
+			Element:  clusterMetricMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetMemory()
+			},
+		},
+		{
+			Name:     "Storage",
+			JSONName: "storage",
+			Type:     "*ClusterMetric",
+			Element:  clusterMetricMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetStorage()
+			},
+		},
+		{
+			Name:     "ComputeNodesCPU",
+			JSONName: "compute_nodes_cpu",
+			Type:     "*ClusterMetric",
+			Element:  clusterMetricMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetComputeNodesCPU()
+			},
+		},
+		{
+			Name:     "ComputeNodesMemory",
+			JSONName: "compute_nodes_memory",
+			Type:     "*ClusterMetric",
+			Element:  clusterMetricMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetComputeNodesMemory()
+			},
+		},
+		{
+			Name:     "Nodes",
+			JSONName: "nodes",
+			Type:     "*ClusterNodes",
+			Element:  clusterNodesMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterMetrics).GetNodes()
+			},
+		},
+	}
+
+	clusterNodesMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Total",
+			JSONName: "total",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterNodes).GetTotal()
+			},
+		},
+		{
+			Name:     "Master",
+			JSONName: "master",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterNodes).GetMaster()
+			},
+		},
+		{
+			Name:     "Infra",
+			JSONName: "infra",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterNodes).GetInfra()
+			},
+		},
+		{
+			Name:     "Compute",
+			JSONName: "compute",
+			Type:     "int",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterNodes).GetCompute()
+			},
+		},
+	}
+
+	clusterRegistrationMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "SubscriptionID",
+			JSONName: "subscription_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistration).GetSubscriptionID()
+			},
+		},
+		{
+			Name:     "ExternalID",
+			JSONName: "external_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterRegistration).GetExternalID()
+			},
+		},
+	}
+
+	clusterStatusMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterStatus).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterStatus).GetHREF()
+			},
+		},
+		{
+			Name:     "State",
+			JSONName: "state",
+			Type:     "ClusterState",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterStatus).GetState()
+			},
+		},
+		{
+			Name:     "Description",
+			JSONName: "description",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*ClusterStatus).GetDescription()
+			},
+		},
+	}
+
+	dnsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "BaseDomain",
+			JSONName: "base_domain",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*DNS).GetBaseDomain()
+			},
+		},
+	}
+
+	dashboardMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Dashboard).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Dashboard).GetHREF()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Dashboard).GetName()
+			},
+		},
+		{
+			Name:     "Metrics",
+			JSONName: "metrics",
+			Type:     "*MetricList",
+			Element:  metricMetadata,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Dashboard).GetMetrics()
+			},
+		},
+	}
+
+	flavourMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetHREF()
+			},
+		},
+		{
+			Name:     "AWS",
+			JSONName: "aws",
+			Type:     "*AWS",
+			Element:  awsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetAWS()
+			},
+		},
+		{
+			Name:     "Version",
+			JSONName: "version",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetVersion()
+			},
+		},
+		{
+			Name:     "Nodes",
+			JSONName: "nodes",
+			Type:     "*ClusterNodes",
+			Element:  clusterNodesMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetNodes()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetName()
+			},
+		},
+		{
+			Name:     "Network",
+			JSONName: "network",
+			Type:     "*Network",
+			Element:  networkMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Flavour).GetNetwork()
+			},
+		},
+	}
+
+	githubIdentityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "CA",
+			JSONName: "ca",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GithubIdentityProvider).GetCA()
+			},
+		},
+		{
+			Name:     "ClientID",
+			JSONName: "client_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GithubIdentityProvider).GetClientID()
+			},
+		},
+		{
+			Name:     "Hostname",
+			JSONName: "hostname",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GithubIdentityProvider).GetHostname()
+			},
+		},
+		{
+			Name:     "Teams",
+			JSONName: "teams",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GithubIdentityProvider).GetTeams()
+			},
+		},
+	}
+
+	gitlabIdentityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "CA",
+			JSONName: "ca",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GitlabIdentityProvider).GetCA()
+			},
+		},
+		{
+			Name:     "ClientID",
+			JSONName: "client_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GitlabIdentityProvider).GetClientID()
+			},
+		},
+		{
+			Name:      "ClientSecret",
+			JSONName:  "client_secret",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GitlabIdentityProvider).GetClientSecret()
+			},
+		},
+		{
+			Name:     "URL",
+			JSONName: "url",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GitlabIdentityProvider).GetURL()
+			},
+		},
+	}
+
+	googleIdentityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ClientID",
+			JSONName: "client_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GoogleIdentityProvider).GetClientID()
+			},
+		},
+		{
+			Name:      "ClientSecret",
+			JSONName:  "client_secret",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GoogleIdentityProvider).GetClientSecret()
+			},
+		},
+		{
+			Name:     "HostedDomain",
+			JSONName: "hosted_domain",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*GoogleIdentityProvider).GetHostedDomain()
+			},
+		},
+	}
+
+	groupMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Group).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Group).GetHREF()
+			},
+		},
+		{
+			Name:     "Users",
+			JSONName: "users",
+			Type:     "*UserList",
+			Element:  userMetadata,
+			Link:     true,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Group).GetUsers()
+			},
+		},
+	}
+
+	identityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetHREF()
+			},
+		},
+		{
+			Name:     "Type",
+			JSONName: "type",
+			Type:     "IdentityProviderType",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetType()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetName()
+			},
+		},
+		{
+			Name:     "Challenge",
+			JSONName: "challenge",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetChallenge()
+			},
+		},
+		{
+			Name:     "Login",
+			JSONName: "login",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetLogin()
+			},
+		},
+		{
+			Name:     "MappingMethod",
+			JSONName: "mapping_method",
+			Type:     "IdentityProviderMappingMethod",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetMappingMethod()
+			},
+		},
+		{
+			Name:     "Github",
+			JSONName: "github",
+			Type:     "*GithubIdentityProvider",
+			Element:  githubIdentityProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetGithub()
+			},
+		},
+		{
+			Name:     "Gitlab",
+			JSONName: "gitlab",
+			Type:     "*GitlabIdentityProvider",
+			Element:  gitlabIdentityProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetGitlab()
+			},
+		},
+		{
+			Name:     "Google",
+			JSONName: "google",
+			Type:     "*GoogleIdentityProvider",
+			Element:  googleIdentityProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetGoogle()
+			},
+		},
+		{
+			Name:     "LDAP",
+			JSONName: "ldap",
+			Type:     "*LdapidentityProvider",
+			Element:  ldapidentityProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetLDAP()
+			},
+		},
+		{
+			Name:     "OpenID",
+			JSONName: "open_id",
+			Type:     "*OpenIdidentityProvider",
+			Element:  openIdidentityProviderMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*IdentityProvider).GetOpenID()
+			},
+		},
+	}
+
+	ldapattributesMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Email",
+			JSONName: "email",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Ldapattributes).GetEmail()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Ldapattributes).GetName()
+			},
+		},
+		{
+			Name:     "PreferredUsername",
+			JSONName: "preferred_username",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Ldapattributes).GetPreferredUsername()
+			},
+		},
+	}
+
+	ldapidentityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Ldapattributes",
+			JSONName: "ldapattributes",
+			Type:     "*Ldapattributes",
+			Element:  ldapattributesMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetLdapattributes()
+			},
+		},
+		{
+			Name:     "BindDN",
+			JSONName: "bind_dn",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetBindDN()
+			},
+		},
+		{
+			Name:      "BindPassword",
+			JSONName:  "bind_password",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetBindPassword()
+			},
+		},
+		{
+			Name:     "CA",
+			JSONName: "ca",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetCA()
+			},
+		},
+		{
+			Name:     "URL",
+			JSONName: "url",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetURL()
+			},
+		},
+		{
+			Name:     "Insecure",
+			JSONName: "insecure",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*LdapidentityProvider).GetInsecure()
+			},
+		},
+	}
+
+	logMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Log).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Log).GetHREF()
+			},
+		},
+		{
+			Name:     "Content",
+			JSONName: "content",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Log).GetContent()
+			},
+		},
+	}
+
+	metricMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Metric).GetName()
+			},
+		},
+		{
+			Name:     "Vector",
+			JSONName: "vector",
+			Type:     "*SampleList",
+			Element:  sampleMetadata,
+			List:     true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Metric).GetVector()
+			},
+		},
+	}
+
+	networkMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "PodCIDR",
+			JSONName: "pod_cidr",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Network).GetPodCIDR()
+			},
+		},
+		{
+			Name:     "MachineCIDR",
+			JSONName: "machine_cidr",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Network).GetMachineCIDR()
+			},
+		},
+		{
+			Name:     "ServiceCIDR",
+			JSONName: "service_cidr",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Network).GetServiceCIDR()
+			},
+		},
+	}
+
+	openIdclaimsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Email",
+			JSONName: "email",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdclaims).GetEmail()
+			},
+		},
+		{
+			Name:     "Name",
+			JSONName: "name",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdclaims).GetName()
+			},
+		},
+		{
+			Name:     "PreferredUsername",
+			JSONName: "preferred_username",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdclaims).GetPreferredUsername()
+			},
+		},
+	}
+
+	openIdidentityProviderMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "CA",
+			JSONName: "ca",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetCA()
+			},
+		},
+		{
+			Name:     "Claims",
+			JSONName: "claims",
+			Type:     "*OpenIdclaims",
+			Element:  openIdclaimsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetClaims()
+			},
+		},
+		{
+			Name:     "ClientID",
+			JSONName: "client_id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetClientID()
+			},
+		},
+		{
+			Name:      "ClientSecret",
+			JSONName:  "client_secret",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetClientSecret()
+			},
+		},
+		{
+			Name:     "ExtraAuthorizeParameters",
+			JSONName: "extra_authorize_parameters",
+			Type:     "map[string]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetExtraAuthorizeParameters()
+			},
+		},
+		{
+			Name:     "ExtraScopes",
+			JSONName: "extra_scopes",
+			Type:     "[]string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetExtraScopes()
+			},
+		},
+		{
+			Name:     "URLS",
+			JSONName: "urls",
+			Type:     "*OpenIdurls",
+			Element:  openIdurlsMetadata,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdidentityProvider).GetURLS()
+			},
+		},
+	}
+
+	openIdurlsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Authorize",
+			JSONName: "authorize",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdurls).GetAuthorize()
+			},
+		},
+		{
+			Name:     "Token",
+			JSONName: "token",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdurls).GetToken()
+			},
+		},
+		{
+			Name:     "UserInfo",
+			JSONName: "user_info",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*OpenIdurls).GetUserInfo()
+			},
+		},
+	}
+
+	sampleMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Time",
+			JSONName: "time",
+			Type:     "time.Time",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Sample).GetTime()
+			},
+		},
+		{
+			Name:     "Value",
+			JSONName: "value",
+			Type:     "float64",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Sample).GetValue()
+			},
+		},
+	}
+
+	sshcredentialsMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "PublicKey",
+			JSONName: "public_key",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Sshcredentials).GetPublicKey()
+			},
+		},
+		{
+			Name:      "PrivateKey",
+			JSONName:  "private_key",
+			Type:      "string",
+			Sensitive: true,
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Sshcredentials).GetPrivateKey()
+			},
+		},
+	}
+
+	subscriptionMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Subscription).GetHREF()
+			},
+		},
+	}
+
+	userMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*User).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*User).GetHREF()
+			},
+		},
+	}
+
+	valueMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "Value",
+			JSONName: "value",
+			Type:     "float64",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Value).GetValue()
+			},
+		},
+		{
+			Name:     "Unit",
+			JSONName: "unit",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Value).GetUnit()
+			},
+		},
+	}
+
+	versionMetadata.Attributes = []*helpers.AttributeMetadata{
+		{
+			Name:     "ID",
+			JSONName: "id",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Version).GetID()
+			},
+		},
+		{
+			Name:     "HREF",
+			JSONName: "href",
+			Type:     "string",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Version).GetHREF()
+			},
+		},
+		{
+			Name:     "Enabled",
+			JSONName: "enabled",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Version).GetEnabled()
+			},
+		},
+		{
+			Name:     "Default",
+			JSONName: "default",
+			Type:     "bool",
+			Get: func(object interface{}) (interface{}, bool) {
+				return object.(*Version).GetDefault()
+			},
+		},
+	}
+}
+
+// clusterResource describes the resource managed by the ClusterClient type.
+var clusterResource = &helpers.ResourceMetadata{
+	Type: clusterMetadata,
+	Resources: []*helpers.ResourceMetadata{
+		clusterStatusResource,
+		credentialsResource,
+		logsResource,
+		groupsResource,
+		identityProvidersResource,
+	},
+}
+
+// clusterStatusResource describes the resource managed by the ClusterStatusClient type.
+var clusterStatusResource = &helpers.ResourceMetadata{
+	Name: "status",
+	Type: clusterStatusMetadata,
+}
+
+// clustersResource describes the resource managed by the ClustersClient type.
+var clustersResource = &helpers.ResourceMetadata{
+	Name:       "clusters",
+	Type:       clusterMetadata,
+	Collection: true,
+	Item:       clusterResource,
+}
+
+// credentialsResource describes the resource managed by the CredentialsClient type.
+var credentialsResource = &helpers.ResourceMetadata{
+	Name: "credentials",
+	Type: clusterCredentialsMetadata,
+}
+
+// dashboardResource describes the resource managed by the DashboardClient type.
+var dashboardResource = &helpers.ResourceMetadata{
+	Type: dashboardMetadata,
+}
+
+// dashboardsResource describes the resource managed by the DashboardsClient type.
+var dashboardsResource = &helpers.ResourceMetadata{
+	Name:       "dashboards",
+	Type:       dashboardMetadata,
+	Collection: true,
+	Item:       dashboardResource,
+}
+
+// flavourResource describes the resource managed by the FlavourClient type.
+var flavourResource = &helpers.ResourceMetadata{
+	Type: flavourMetadata,
+}
+
+// flavoursResource describes the resource managed by the FlavoursClient type.
+var flavoursResource = &helpers.ResourceMetadata{
+	Name:       "flavours",
+	Type:       flavourMetadata,
+	Collection: true,
+	Item:       flavourResource,
+}
+
+// groupResource describes the resource managed by the GroupClient type.
+var groupResource = &helpers.ResourceMetadata{
+	Type: groupMetadata,
+	Resources: []*helpers.ResourceMetadata{
+		usersResource,
+	},
+}
+
+// groupsResource describes the resource managed by the GroupsClient type.
+var groupsResource = &helpers.ResourceMetadata{
+	Name:       "groups",
+	Type:       groupMetadata,
+	Collection: true,
+	Item:       groupResource,
+}
+
+// identityProviderResource describes the resource managed by the IdentityProviderClient type.
+var identityProviderResource = &helpers.ResourceMetadata{
+	Type: identityProviderMetadata,
+}
+
+// identityProvidersResource describes the resource managed by the IdentityProvidersClient type.
+var identityProvidersResource = &helpers.ResourceMetadata{
+	Name:       "identity_providers",
+	Type:       identityProviderMetadata,
+	Collection: true,
+	Item:       identityProviderResource,
+}
+
+// logResource describes the resource managed by the LogClient type.
+var logResource = &helpers.ResourceMetadata{
+	Type: logMetadata,
+}
+
+// logsResource describes the resource managed by the LogsClient type.
+var logsResource = &helpers.ResourceMetadata{
+	Name:       "logs",
+	Type:       logMetadata,
+	Collection: true,
+	Item:       logResource,
+}
+
+// rootResource describes the resource managed by the RootClient type.
+var rootResource = &helpers.ResourceMetadata{
+	Resources: []*helpers.ResourceMetadata{
+		clustersResource,
+		dashboardsResource,
+		flavoursResource,
+		versionsResource,
+	},
+}
+
+// userResource describes the resource managed by the UserClient type.
+var userResource = &helpers.ResourceMetadata{
+	Type: userMetadata,
+}
+
+// usersResource describes the resource managed by the UsersClient type.
+var usersResource = &helpers.ResourceMetadata{
+	Name:       "users",
+	Type:       userMetadata,
+	Collection: true,
+	Item:       userResource,
+}
+
+// versionResource describes the resource managed by the VersionClient type.
+var versionResource = &helpers.ResourceMetadata{
+	Type: versionMetadata,
+}
+
+// versionsResource describes the resource managed by the VersionsClient type.
+var versionsResource = &helpers.ResourceMetadata{
+	Name:       "versions",
+	Type:       versionMetadata,
+	Collection: true,
+	Item:       versionResource,
+}
+
+// Register the tree of resources, so that it can be used to calculate the paths reported in
+// metrics.
+func init() {
+	helpers.RegisterService("/api/clusters_mgmt/v1", rootResource)
+}
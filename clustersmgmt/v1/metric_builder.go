@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // MetricBuilder contains the data and logic needed to build 'metric' objects.
 //
 // Metric included in a dashboard.
 type MetricBuilder struct {
 	name   *string
 	vector []*SampleBuilder
+	extra  map[string]interface{}
 }
 
 // NewMetric creates a new builder of 'metric' objects.
@@ -32,6 +37,25 @@ func NewMetric() *MetricBuilder {
 	return new(MetricBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'metric' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Metric) Builder() *MetricBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(MetricBuilder)
+	b.name = o.name
+	if o.vector != nil {
+		b.vector = make([]*SampleBuilder, len(o.vector.items))
+		for i, item := range o.vector.items {
+			b.vector[i] = item.Builder()
+		}
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Name sets the value of the 'name' attribute
 // to the given value.
 //
@@ -51,9 +75,17 @@ func (b *MetricBuilder) Vector(values ...*SampleBuilder) *MetricBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'metric' type, and
+// that will be written as they are when the object is encoded.
+func (b *MetricBuilder) Extra(value map[string]interface{}) *MetricBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'metric' object using the configuration stored in the builder.
 func (b *MetricBuilder) Build() (object *Metric, err error) {
 	object = new(Metric)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.name != nil {
 		object.name = b.name
 	}
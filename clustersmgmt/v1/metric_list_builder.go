@@ -30,6 +30,19 @@ func NewMetricList() *MetricListBuilder {
 	return new(MetricListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *MetricList) Builder() *MetricListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(MetricListBuilder)
+	b.items = make([]*MetricBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *MetricListBuilder) Items(values ...*MetricBuilder) *MetricListBuilder {
 	b.items = make([]*MetricBuilder, len(values))
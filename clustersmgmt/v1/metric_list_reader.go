@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// metricListData is type used internally to marshal and unmarshal lists of objects
-// of type 'metric'.
-type metricListData []*metricData
-
 // UnmarshalMetricList reads a list of values of the 'metric'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalMetricList(source interface{}) (list *MetricList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalMetricList(source interface{}, options ...helpers.DecodingOptions) (list *MetricList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data metricListData
-	err = decoder.Decode(&data)
+	list = readMetricList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'metric' value to a JSON document.
-func (l *MetricList) wrap() (data metricListData, err error) {
-	if l == nil {
-		return
+// MarshalMetricList writes a list of values of the 'metric' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalMetricList(list *MetricList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(metricListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeMetricList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *MetricList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeMetricList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalMetricList function,
+// using the default decoding options.
+func (l *MetricList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalMetricList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = MetricList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'metric' type.
-func (d metricListData) unwrap() (list *MetricList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *MetricList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *MetricList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeMetricList writes a list of values of the 'metric' type to the given
+// stream, as a JSON array.
+func writeMetricList(list *MetricList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Metric, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeMetric(item, stream)
 	}
-	list = new(MetricList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readMetricList reads a list of values of the 'metric' type from the given
+// iterator, as a JSON array.
+func readMetricList(iterator *helpers.Iterator) *MetricList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(MetricList)
+	list.items = []*Metric{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readMetric(iterator))
+	}
+	return list
 }
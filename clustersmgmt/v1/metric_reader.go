@@ -23,69 +23,125 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// metricData is the data structure used internally to marshal and unmarshal
-// objects of type 'metric'.
-type metricData struct {
-	Name   *string        "json:\"name,omitempty\""
-	Vector sampleListData "json:\"vector,omitempty\""
-}
-
 // MarshalMetric writes a value of the 'metric' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalMetric(object *Metric, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeMetric(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'metric'
-// value to a JSON document.
-func (o *Metric) wrap() (data *metricData, err error) {
-	if o == nil {
+// writeMetric writes a value of the 'metric' type to the given stream.
+func writeMetric(object *Metric, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(metricData)
-	data.Name = o.name
-	data.Vector, err = o.vector.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.name != nil {
+		stream.WriteObjectField("name")
+		stream.WriteString(*object.name)
 	}
-	return
+	if object.vector != nil && len(object.vector.items) > 0 {
+		stream.WriteObjectField("vector")
+		writeSampleList(object.vector, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalMetric reads a value of the 'metric' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalMetric(source interface{}) (object *Metric, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalMetric(source interface{}, options ...helpers.DecodingOptions) (object *Metric, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(metricData)
-	err = decoder.Decode(data)
+	object = readMetric(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Metric)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'metric' type.
-func (d *metricData) unwrap() (object *Metric, err error) {
-	if d == nil {
-		return
+// readMetric reads a value of the 'metric' type from the given iterator.
+func readMetric(iterator *helpers.Iterator) *Metric {
+	if !iterator.ReadObjectStart() {
+		return nil
+	}
+	object := new(Metric)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "name":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.name = &value
+			}
+		case "vector":
+			object.vector = readSampleList(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
 	}
-	object = new(Metric)
-	object.name = d.Name
-	object.vector, err = d.Vector.unwrap()
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalMetric function.
+func (o *Metric) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeMetric(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalMetric function, using the
+// default decoding options.
+func (o *Metric) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalMetric(data)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if object == nil {
+		*o = Metric{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Metric) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Metric) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // NetworkBuilder contains the data and logic needed to build 'network' objects.
 //
 // Network configuration of a cluster.
@@ -26,6 +30,7 @@ type NetworkBuilder struct {
 	podCIDR     *string
 	machineCIDR *string
 	serviceCIDR *string
+	extra       map[string]interface{}
 }
 
 // NewNetwork creates a new builder of 'network' objects.
@@ -33,6 +38,21 @@ func NewNetwork() *NetworkBuilder {
 	return new(NetworkBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'network' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Network) Builder() *NetworkBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(NetworkBuilder)
+	b.podCIDR = o.podCIDR
+	b.machineCIDR = o.machineCIDR
+	b.serviceCIDR = o.serviceCIDR
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // PodCIDR sets the value of the 'pod_CIDR' attribute
 // to the given value.
 //
@@ -60,9 +80,17 @@ func (b *NetworkBuilder) ServiceCIDR(value string) *NetworkBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'network' type, and
+// that will be written as they are when the object is encoded.
+func (b *NetworkBuilder) Extra(value map[string]interface{}) *NetworkBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'network' object using the configuration stored in the builder.
 func (b *NetworkBuilder) Build() (object *Network, err error) {
 	object = new(Network)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.podCIDR != nil {
 		object.podCIDR = b.podCIDR
 	}
@@ -30,6 +30,19 @@ func NewNetworkList() *NetworkListBuilder {
 	return new(NetworkListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *NetworkList) Builder() *NetworkListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(NetworkListBuilder)
+	b.items = make([]*NetworkBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *NetworkListBuilder) Items(values ...*NetworkBuilder) *NetworkListBuilder {
 	b.items = make([]*NetworkBuilder, len(values))
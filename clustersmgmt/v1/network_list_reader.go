@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// networkListData is type used internally to marshal and unmarshal lists of objects
-// of type 'network'.
-type networkListData []*networkData
-
 // UnmarshalNetworkList reads a list of values of the 'network'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalNetworkList(source interface{}) (list *NetworkList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalNetworkList(source interface{}, options ...helpers.DecodingOptions) (list *NetworkList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data networkListData
-	err = decoder.Decode(&data)
+	list = readNetworkList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'network' value to a JSON document.
-func (l *NetworkList) wrap() (data networkListData, err error) {
-	if l == nil {
-		return
+// MarshalNetworkList writes a list of values of the 'network' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalNetworkList(list *NetworkList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(networkListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeNetworkList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *NetworkList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeNetworkList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalNetworkList function,
+// using the default decoding options.
+func (l *NetworkList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalNetworkList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = NetworkList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'network' type.
-func (d networkListData) unwrap() (list *NetworkList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *NetworkList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *NetworkList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeNetworkList writes a list of values of the 'network' type to the given
+// stream, as a JSON array.
+func writeNetworkList(list *NetworkList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Network, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeNetwork(item, stream)
 	}
-	list = new(NetworkList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readNetworkList reads a list of values of the 'network' type from the given
+// iterator, as a JSON array.
+func readNetworkList(iterator *helpers.Iterator) *NetworkList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(NetworkList)
+	list.items = []*Network{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readNetwork(iterator))
+	}
+	return list
 }
@@ -23,66 +23,137 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// networkData is the data structure used internally to marshal and unmarshal
-// objects of type 'network'.
-type networkData struct {
-	PodCIDR     *string "json:\"pod_cidr,omitempty\""
-	MachineCIDR *string "json:\"machine_cidr,omitempty\""
-	ServiceCIDR *string "json:\"service_cidr,omitempty\""
-}
-
 // MarshalNetwork writes a value of the 'network' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalNetwork(object *Network, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeNetwork(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'network'
-// value to a JSON document.
-func (o *Network) wrap() (data *networkData, err error) {
-	if o == nil {
+// writeNetwork writes a value of the 'network' type to the given stream.
+func writeNetwork(object *Network, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(networkData)
-	data.PodCIDR = o.podCIDR
-	data.MachineCIDR = o.machineCIDR
-	data.ServiceCIDR = o.serviceCIDR
-	return
+	stream.WriteObjectStart()
+	if object.podCIDR != nil {
+		stream.WriteObjectField("pod_cidr")
+		stream.WriteString(*object.podCIDR)
+	}
+	if object.machineCIDR != nil {
+		stream.WriteObjectField("machine_cidr")
+		stream.WriteString(*object.machineCIDR)
+	}
+	if object.serviceCIDR != nil {
+		stream.WriteObjectField("service_cidr")
+		stream.WriteString(*object.serviceCIDR)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalNetwork reads a value of the 'network' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalNetwork(source interface{}) (object *Network, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalNetwork(source interface{}, options ...helpers.DecodingOptions) (object *Network, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(networkData)
-	err = decoder.Decode(data)
+	object = readNetwork(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Network)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'network' type.
-func (d *networkData) unwrap() (object *Network, err error) {
-	if d == nil {
-		return
+// readNetwork reads a value of the 'network' type from the given iterator.
+func readNetwork(iterator *helpers.Iterator) *Network {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Network)
-	object.podCIDR = d.PodCIDR
-	object.machineCIDR = d.MachineCIDR
-	object.serviceCIDR = d.ServiceCIDR
-	return
+	object := new(Network)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "pod_cidr":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.podCIDR = &value
+			}
+		case "machine_cidr":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.machineCIDR = &value
+			}
+		case "service_cidr":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.serviceCIDR = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalNetwork function.
+func (o *Network) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeNetwork(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalNetwork function, using the
+// default decoding options.
+func (o *Network) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalNetwork(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Network{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Network) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Network) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
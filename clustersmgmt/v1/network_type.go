@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // Network represents the values of the 'network' type.
 //
 // Network configuration of a cluster.
@@ -26,6 +32,7 @@ type Network struct {
 	podCIDR     *string
 	machineCIDR *string
 	serviceCIDR *string
+	extra       map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -36,6 +43,59 @@ func (o *Network) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'network' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Network) Metadata() *helpers.TypeMetadata {
+	return networkMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Network) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printNetwork(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Network) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printNetwork(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Network) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printNetwork(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Network) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printNetwork(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'network' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Network) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // PodCIDR returns the value of the 'pod_CIDR' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -105,6 +165,159 @@ func (o *Network) GetServiceCIDR() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'network' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Network) Copy() *Network {
+	if o == nil {
+		return nil
+	}
+	result := new(Network)
+	if o.podCIDR != nil {
+		result.podCIDR = new(string)
+		*result.podCIDR = *o.podCIDR
+	}
+	if o.machineCIDR != nil {
+		result.machineCIDR = new(string)
+		*result.machineCIDR = *o.machineCIDR
+	}
+	if o.serviceCIDR != nil {
+		result.serviceCIDR = new(string)
+		*result.serviceCIDR = *o.serviceCIDR
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'network' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Network) Equal(other *Network) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.podCIDR == nil) != (other.podCIDR == nil) ||
+		(o.podCIDR != nil && *o.podCIDR != *other.podCIDR) {
+		return false
+	}
+	if (o.machineCIDR == nil) != (other.machineCIDR == nil) ||
+		(o.machineCIDR != nil && *o.machineCIDR != *other.machineCIDR) {
+		return false
+	}
+	if (o.serviceCIDR == nil) != (other.serviceCIDR == nil) ||
+		(o.serviceCIDR != nil && *o.serviceCIDR != *other.serviceCIDR) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'network' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Network) Diff(other *Network) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'network' object and the given one, using the given path as prefix.
+func (o *Network) diff(path string, other *Network, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.podCIDR == nil) != (other.podCIDR == nil) ||
+		(o.podCIDR != nil && *o.podCIDR != *other.podCIDR) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "pod_cidr"),
+			Old:  helpers.Value(o.podCIDR),
+			New:  helpers.Value(other.podCIDR),
+		})
+	}
+	if (o.machineCIDR == nil) != (other.machineCIDR == nil) ||
+		(o.machineCIDR != nil && *o.machineCIDR != *other.machineCIDR) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "machine_cidr"),
+			Old:  helpers.Value(o.machineCIDR),
+			New:  helpers.Value(other.machineCIDR),
+		})
+	}
+	if (o.serviceCIDR == nil) != (other.serviceCIDR == nil) ||
+		(o.serviceCIDR != nil && *o.serviceCIDR != *other.serviceCIDR) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "service_cidr"),
+			Old:  helpers.Value(o.serviceCIDR),
+			New:  helpers.Value(other.serviceCIDR),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'network' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Network) Changes(modified *Network) *Network {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Network)
+	if modified.podCIDR != nil && (o.podCIDR == nil || *o.podCIDR != *modified.podCIDR) {
+		result.podCIDR = new(string)
+		*result.podCIDR = *modified.podCIDR
+	}
+	if modified.machineCIDR != nil && (o.machineCIDR == nil || *o.machineCIDR != *modified.machineCIDR) {
+		result.machineCIDR = new(string)
+		*result.machineCIDR = *modified.machineCIDR
+	}
+	if modified.serviceCIDR != nil && (o.serviceCIDR == nil || *o.serviceCIDR != *modified.serviceCIDR) {
+		result.serviceCIDR = new(string)
+		*result.serviceCIDR = *modified.serviceCIDR
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printNetwork prints a value of the 'network' type to the given printer.
+func printNetwork(object *Network, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Network")
+	if object.podCIDR != nil {
+		printer.PrintObjectField("PodCIDR")
+		printer.PrintString(*object.podCIDR)
+	}
+	if object.machineCIDR != nil {
+		printer.PrintObjectField("MachineCIDR")
+		printer.PrintString(*object.machineCIDR)
+	}
+	if object.serviceCIDR != nil {
+		printer.PrintObjectField("ServiceCIDR")
+		printer.PrintString(*object.serviceCIDR)
+	}
+	printer.PrintObjectEnd()
+}
+
 // NetworkList is a list of values of the 'network' type.
 type NetworkList struct {
 	items []*Network
@@ -176,3 +389,105 @@ func (l *NetworkList) Range(f func(index int, item *Network) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *NetworkList) Copy() *NetworkList {
+	if l == nil {
+		return nil
+	}
+	result := new(NetworkList)
+	if l.items != nil {
+		result.items = make([]*Network, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *NetworkList) Equal(other *NetworkList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *NetworkList) Diff(other *NetworkList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *NetworkList) diff(path string, other *NetworkList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *NetworkList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printNetworkList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *NetworkList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printNetworkList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *NetworkList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printNetworkList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *NetworkList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printNetworkList(l, printer)
+	}
+}
+
+// printNetworkList prints a list of values of the 'network' type to the given printer.
+func printNetworkList(list *NetworkList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printNetwork(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
+
+import (
+	"net"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// validate reports to the validator the violations of the object, using the given path as the
+// prefix for the paths of the attributes. Nil objects are valid.
+func (o *Network) validate(path string, validator *helpers.Validator) {
+	if o == nil {
+		return
+	}
+
+	// Check that the blocks are valid and that they don't overlap. Overlaps are reported for
+	// the second block of each pair:
+	blocks := []struct {
+		name  string
+		value *string
+	}{
+		{"machine_cidr", o.machineCIDR},
+		{"service_cidr", o.serviceCIDR},
+		{"pod_cidr", o.podCIDR},
+	}
+	networks := make([]*net.IPNet, len(blocks))
+	for i, block := range blocks {
+		if block.value == nil {
+			continue
+		}
+		networks[i] = validator.ParseCIDR(helpers.Path(path, block.name), *block.value)
+		if networks[i] == nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if networks[j] != nil && helpers.Overlap(networks[j], networks[i]) {
+				validator.Report(
+					helpers.Path(path, block.name),
+					"'%s' overlaps with '%s' of '%s'",
+					*block.value, *blocks[j].value, blocks[j].name,
+				)
+			}
+		}
+	}
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdclaimsBuilder contains the data and logic needed to build 'open_idclaims' objects.
 //
 // _OpenID_ identity provider claims.
@@ -26,6 +30,7 @@ type OpenIdclaimsBuilder struct {
 	email             []string
 	name              []string
 	preferredUsername []string
+	extra             map[string]interface{}
 }
 
 // NewOpenIdclaims creates a new builder of 'open_idclaims' objects.
@@ -33,6 +38,30 @@ func NewOpenIdclaims() *OpenIdclaimsBuilder {
 	return new(OpenIdclaimsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'open_idclaims' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *OpenIdclaims) Builder() *OpenIdclaimsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(OpenIdclaimsBuilder)
+	if o.email != nil {
+		b.email = make([]string, len(o.email))
+		copy(b.email, o.email)
+	}
+	if o.name != nil {
+		b.name = make([]string, len(o.name))
+		copy(b.name, o.name)
+	}
+	if o.preferredUsername != nil {
+		b.preferredUsername = make([]string, len(o.preferredUsername))
+		copy(b.preferredUsername, o.preferredUsername)
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Email sets the value of the 'email' attribute
 // to the given values.
 //
@@ -63,9 +92,17 @@ func (b *OpenIdclaimsBuilder) PreferredUsername(values ...string) *OpenIdclaimsB
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'open_idclaims' type, and
+// that will be written as they are when the object is encoded.
+func (b *OpenIdclaimsBuilder) Extra(value map[string]interface{}) *OpenIdclaimsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'open_idclaims' object using the configuration stored in the builder.
 func (b *OpenIdclaimsBuilder) Build() (object *OpenIdclaims, err error) {
 	object = new(OpenIdclaims)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.email != nil {
 		object.email = make([]string, len(b.email))
 		copy(object.email, b.email)
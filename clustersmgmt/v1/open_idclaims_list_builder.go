@@ -30,6 +30,19 @@ func NewOpenIdclaimsList() *OpenIdclaimsListBuilder {
 	return new(OpenIdclaimsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *OpenIdclaimsList) Builder() *OpenIdclaimsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(OpenIdclaimsListBuilder)
+	b.items = make([]*OpenIdclaimsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *OpenIdclaimsListBuilder) Items(values ...*OpenIdclaimsBuilder) *OpenIdclaimsListBuilder {
 	b.items = make([]*OpenIdclaimsBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdclaimsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'open_idclaims'.
-type openIdclaimsListData []*openIdclaimsData
-
 // UnmarshalOpenIdclaimsList reads a list of values of the 'open_idclaims'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalOpenIdclaimsList(source interface{}) (list *OpenIdclaimsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalOpenIdclaimsList(source interface{}, options ...helpers.DecodingOptions) (list *OpenIdclaimsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data openIdclaimsListData
-	err = decoder.Decode(&data)
+	list = readOpenIdclaimsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'open_idclaims' value to a JSON document.
-func (l *OpenIdclaimsList) wrap() (data openIdclaimsListData, err error) {
-	if l == nil {
-		return
+// MarshalOpenIdclaimsList writes a list of values of the 'open_idclaims' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalOpenIdclaimsList(list *OpenIdclaimsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(openIdclaimsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeOpenIdclaimsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *OpenIdclaimsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdclaimsList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdclaimsList function,
+// using the default decoding options.
+func (l *OpenIdclaimsList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalOpenIdclaimsList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = OpenIdclaimsList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'open_idclaims' type.
-func (d openIdclaimsListData) unwrap() (list *OpenIdclaimsList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *OpenIdclaimsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *OpenIdclaimsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeOpenIdclaimsList writes a list of values of the 'open_idclaims' type to the given
+// stream, as a JSON array.
+func writeOpenIdclaimsList(list *OpenIdclaimsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*OpenIdclaims, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeOpenIdclaims(item, stream)
 	}
-	list = new(OpenIdclaimsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readOpenIdclaimsList reads a list of values of the 'open_idclaims' type from the given
+// iterator, as a JSON array.
+func readOpenIdclaimsList(iterator *helpers.Iterator) *OpenIdclaimsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(OpenIdclaimsList)
+	list.items = []*OpenIdclaims{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readOpenIdclaims(iterator))
+	}
+	return list
 }
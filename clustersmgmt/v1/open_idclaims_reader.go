@@ -23,66 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdclaimsData is the data structure used internally to marshal and unmarshal
-// objects of type 'open_idclaims'.
-type openIdclaimsData struct {
-	Email             []string "json:\"email,omitempty\""
-	Name              []string "json:\"name,omitempty\""
-	PreferredUsername []string "json:\"preferred_username,omitempty\""
-}
-
 // MarshalOpenIdclaims writes a value of the 'open_idclaims' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalOpenIdclaims(object *OpenIdclaims, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeOpenIdclaims(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'open_idclaims'
-// value to a JSON document.
-func (o *OpenIdclaims) wrap() (data *openIdclaimsData, err error) {
-	if o == nil {
+// writeOpenIdclaims writes a value of the 'open_idclaims' type to the given stream.
+func writeOpenIdclaims(object *OpenIdclaims, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(openIdclaimsData)
-	data.Email = o.email
-	data.Name = o.name
-	data.PreferredUsername = o.preferredUsername
-	return
+	stream.WriteObjectStart()
+	if len(object.email) > 0 {
+		stream.WriteObjectField("email")
+		stream.WriteStringSlice(object.email)
+	}
+	if len(object.name) > 0 {
+		stream.WriteObjectField("name")
+		stream.WriteStringSlice(object.name)
+	}
+	if len(object.preferredUsername) > 0 {
+		stream.WriteObjectField("preferred_username")
+		stream.WriteStringSlice(object.preferredUsername)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalOpenIdclaims reads a value of the 'open_idclaims' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalOpenIdclaims(source interface{}) (object *OpenIdclaims, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalOpenIdclaims(source interface{}, options ...helpers.DecodingOptions) (object *OpenIdclaims, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(openIdclaimsData)
-	err = decoder.Decode(data)
+	object = readOpenIdclaims(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(OpenIdclaims)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'open_idclaims' type.
-func (d *openIdclaimsData) unwrap() (object *OpenIdclaims, err error) {
-	if d == nil {
-		return
+// readOpenIdclaims reads a value of the 'open_idclaims' type from the given iterator.
+func readOpenIdclaims(iterator *helpers.Iterator) *OpenIdclaims {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(OpenIdclaims)
-	object.email = d.Email
-	object.name = d.Name
-	object.preferredUsername = d.PreferredUsername
-	return
+	object := new(OpenIdclaims)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "email":
+			object.email = iterator.ReadStringSlice()
+		case "name":
+			object.name = iterator.ReadStringSlice()
+		case "preferred_username":
+			object.preferredUsername = iterator.ReadStringSlice()
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalOpenIdclaims function.
+func (o *OpenIdclaims) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdclaims(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdclaims function, using the
+// default decoding options.
+func (o *OpenIdclaims) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalOpenIdclaims(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = OpenIdclaims{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *OpenIdclaims) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *OpenIdclaims) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdclaims represents the values of the 'open_idclaims' type.
 //
 // _OpenID_ identity provider claims.
@@ -26,6 +32,7 @@ type OpenIdclaims struct {
 	email             []string
 	name              []string
 	preferredUsername []string
+	extra             map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -36,6 +43,59 @@ func (o *OpenIdclaims) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'open_idclaims' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *OpenIdclaims) Metadata() *helpers.TypeMetadata {
+	return openIdclaimsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *OpenIdclaims) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdclaims(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *OpenIdclaims) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdclaims(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *OpenIdclaims) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdclaims(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *OpenIdclaims) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdclaims(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'open_idclaims' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *OpenIdclaims) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Email returns the value of the 'email' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -105,6 +165,168 @@ func (o *OpenIdclaims) GetPreferredUsername() (value []string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'open_idclaims' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *OpenIdclaims) Copy() *OpenIdclaims {
+	if o == nil {
+		return nil
+	}
+	result := new(OpenIdclaims)
+	if o.email != nil {
+		result.email = make([]string, len(o.email))
+		copy(result.email, o.email)
+	}
+	if o.name != nil {
+		result.name = make([]string, len(o.name))
+		copy(result.name, o.name)
+	}
+	if o.preferredUsername != nil {
+		result.preferredUsername = make([]string, len(o.preferredUsername))
+		copy(result.preferredUsername, o.preferredUsername)
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'open_idclaims' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *OpenIdclaims) Equal(other *OpenIdclaims) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if len(o.email) != len(other.email) {
+		return false
+	}
+	for i, value := range o.email {
+		if value != other.email[i] {
+			return false
+		}
+	}
+	if len(o.name) != len(other.name) {
+		return false
+	}
+	for i, value := range o.name {
+		if value != other.name[i] {
+			return false
+		}
+	}
+	if len(o.preferredUsername) != len(other.preferredUsername) {
+		return false
+	}
+	for i, value := range o.preferredUsername {
+		if value != other.preferredUsername[i] {
+			return false
+		}
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'open_idclaims' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *OpenIdclaims) Diff(other *OpenIdclaims) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'open_idclaims' object and the given one, using the given path as prefix.
+func (o *OpenIdclaims) diff(path string, other *OpenIdclaims, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if !equalStrings(o.email, other.email) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "email"),
+			Old:  o.email,
+			New:  other.email,
+		})
+	}
+	if !equalStrings(o.name, other.name) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "name"),
+			Old:  o.name,
+			New:  other.name,
+		})
+	}
+	if !equalStrings(o.preferredUsername, other.preferredUsername) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "preferred_username"),
+			Old:  o.preferredUsername,
+			New:  other.preferredUsername,
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'open_idclaims' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *OpenIdclaims) Changes(modified *OpenIdclaims) *OpenIdclaims {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(OpenIdclaims)
+	if modified.email != nil && !equalStrings(o.email, modified.email) {
+		result.email = make([]string, len(modified.email))
+		copy(result.email, modified.email)
+	}
+	if modified.name != nil && !equalStrings(o.name, modified.name) {
+		result.name = make([]string, len(modified.name))
+		copy(result.name, modified.name)
+	}
+	if modified.preferredUsername != nil && !equalStrings(o.preferredUsername, modified.preferredUsername) {
+		result.preferredUsername = make([]string, len(modified.preferredUsername))
+		copy(result.preferredUsername, modified.preferredUsername)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printOpenIdclaims prints a value of the 'open_idclaims' type to the given printer.
+func printOpenIdclaims(object *OpenIdclaims, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.OpenIdclaims")
+	if object.email != nil {
+		printer.PrintObjectField("Email")
+		printer.PrintStringSlice(object.email)
+	}
+	if object.name != nil {
+		printer.PrintObjectField("Name")
+		printer.PrintStringSlice(object.name)
+	}
+	if object.preferredUsername != nil {
+		printer.PrintObjectField("PreferredUsername")
+		printer.PrintStringSlice(object.preferredUsername)
+	}
+	printer.PrintObjectEnd()
+}
+
 // OpenIdclaimsList is a list of values of the 'open_idclaims' type.
 type OpenIdclaimsList struct {
 	items []*OpenIdclaims
@@ -176,3 +398,105 @@ func (l *OpenIdclaimsList) Range(f func(index int, item *OpenIdclaims) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *OpenIdclaimsList) Copy() *OpenIdclaimsList {
+	if l == nil {
+		return nil
+	}
+	result := new(OpenIdclaimsList)
+	if l.items != nil {
+		result.items = make([]*OpenIdclaims, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *OpenIdclaimsList) Equal(other *OpenIdclaimsList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *OpenIdclaimsList) Diff(other *OpenIdclaimsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *OpenIdclaimsList) diff(path string, other *OpenIdclaimsList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *OpenIdclaimsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdclaimsList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *OpenIdclaimsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdclaimsList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *OpenIdclaimsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdclaimsList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *OpenIdclaimsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdclaimsList(l, printer)
+	}
+}
+
+// printOpenIdclaimsList prints a list of values of the 'open_idclaims' type to the given printer.
+func printOpenIdclaimsList(list *OpenIdclaimsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printOpenIdclaims(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdidentityProviderBuilder contains the data and logic needed to build 'open_ididentity_provider' objects.
 //
 // Details for `openid` identity providers.
@@ -30,6 +34,7 @@ type OpenIdidentityProviderBuilder struct {
 	extraAuthorizeParameters map[string]string
 	extraScopes              []string
 	urls                     *OpenIdurlsBuilder
+	extra                    map[string]interface{}
 }
 
 // NewOpenIdidentityProvider creates a new builder of 'open_ididentity_provider' objects.
@@ -37,6 +42,37 @@ func NewOpenIdidentityProvider() *OpenIdidentityProviderBuilder {
 	return new(OpenIdidentityProviderBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'open_ididentity_provider' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *OpenIdidentityProvider) Builder() *OpenIdidentityProviderBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(OpenIdidentityProviderBuilder)
+	b.ca = o.ca
+	if o.claims != nil {
+		b.claims = o.claims.Builder()
+	}
+	b.clientID = o.clientID
+	b.clientSecret = o.clientSecret
+	if o.extraAuthorizeParameters != nil {
+		b.extraAuthorizeParameters = make(map[string]string, len(o.extraAuthorizeParameters))
+		for key, value := range o.extraAuthorizeParameters {
+			b.extraAuthorizeParameters[key] = value
+		}
+	}
+	if o.extraScopes != nil {
+		b.extraScopes = make([]string, len(o.extraScopes))
+		copy(b.extraScopes, o.extraScopes)
+	}
+	if o.urls != nil {
+		b.urls = o.urls.Builder()
+	}
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // CA sets the value of the 'CA' attribute
 // to the given value.
 //
@@ -101,9 +137,17 @@ func (b *OpenIdidentityProviderBuilder) URLS(value *OpenIdurlsBuilder) *OpenIdid
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'open_ididentity_provider' type, and
+// that will be written as they are when the object is encoded.
+func (b *OpenIdidentityProviderBuilder) Extra(value map[string]interface{}) *OpenIdidentityProviderBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'open_ididentity_provider' object using the configuration stored in the builder.
 func (b *OpenIdidentityProviderBuilder) Build() (object *OpenIdidentityProvider, err error) {
 	object = new(OpenIdidentityProvider)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.ca != nil {
 		object.ca = b.ca
 	}
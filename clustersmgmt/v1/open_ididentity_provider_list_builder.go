@@ -30,6 +30,19 @@ func NewOpenIdidentityProviderList() *OpenIdidentityProviderListBuilder {
 	return new(OpenIdidentityProviderListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *OpenIdidentityProviderList) Builder() *OpenIdidentityProviderListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(OpenIdidentityProviderListBuilder)
+	b.items = make([]*OpenIdidentityProviderBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *OpenIdidentityProviderListBuilder) Items(values ...*OpenIdidentityProviderBuilder) *OpenIdidentityProviderListBuilder {
 	b.items = make([]*OpenIdidentityProviderBuilder, len(values))
@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdidentityProviderListData is type used internally to marshal and unmarshal lists of objects
-// of type 'open_ididentity_provider'.
-type openIdidentityProviderListData []*openIdidentityProviderData
-
 // UnmarshalOpenIdidentityProviderList reads a list of values of the 'open_ididentity_provider'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalOpenIdidentityProviderList(source interface{}) (list *OpenIdidentityProviderList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalOpenIdidentityProviderList(source interface{}, options ...helpers.DecodingOptions) (list *OpenIdidentityProviderList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data openIdidentityProviderListData
-	err = decoder.Decode(&data)
+	list = readOpenIdidentityProviderList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'open_ididentity_provider' value to a JSON document.
-func (l *OpenIdidentityProviderList) wrap() (data openIdidentityProviderListData, err error) {
-	if l == nil {
-		return
+// MarshalOpenIdidentityProviderList writes a list of values of the 'open_ididentity_provider' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalOpenIdidentityProviderList(list *OpenIdidentityProviderList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(openIdidentityProviderListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeOpenIdidentityProviderList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *OpenIdidentityProviderList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdidentityProviderList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdidentityProviderList function,
+// using the default decoding options.
+func (l *OpenIdidentityProviderList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalOpenIdidentityProviderList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = OpenIdidentityProviderList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'open_ididentity_provider' type.
-func (d openIdidentityProviderListData) unwrap() (list *OpenIdidentityProviderList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *OpenIdidentityProviderList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *OpenIdidentityProviderList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeOpenIdidentityProviderList writes a list of values of the 'open_ididentity_provider' type to the given
+// stream, as a JSON array.
+func writeOpenIdidentityProviderList(list *OpenIdidentityProviderList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*OpenIdidentityProvider, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeOpenIdidentityProvider(item, stream)
 	}
-	list = new(OpenIdidentityProviderList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readOpenIdidentityProviderList reads a list of values of the 'open_ididentity_provider' type from the given
+// iterator, as a JSON array.
+func readOpenIdidentityProviderList(iterator *helpers.Iterator) *OpenIdidentityProviderList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(OpenIdidentityProviderList)
+	list.items = []*OpenIdidentityProvider{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readOpenIdidentityProvider(iterator))
+	}
+	return list
 }
@@ -23,90 +23,161 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdidentityProviderData is the data structure used internally to marshal and unmarshal
-// objects of type 'open_ididentity_provider'.
-type openIdidentityProviderData struct {
-	CA                       *string           "json:\"ca,omitempty\""
-	Claims                   *openIdclaimsData "json:\"claims,omitempty\""
-	ClientID                 *string           "json:\"client_id,omitempty\""
-	ClientSecret             *string           "json:\"client_secret,omitempty\""
-	ExtraAuthorizeParameters map[string]string "json:\"extra_authorize_parameters,omitempty\""
-	ExtraScopes              []string          "json:\"extra_scopes,omitempty\""
-	URLS                     *openIdurlsData   "json:\"urls,omitempty\""
-}
-
 // MarshalOpenIdidentityProvider writes a value of the 'open_ididentity_provider' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalOpenIdidentityProvider(object *OpenIdidentityProvider, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeOpenIdidentityProvider(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'open_ididentity_provider'
-// value to a JSON document.
-func (o *OpenIdidentityProvider) wrap() (data *openIdidentityProviderData, err error) {
-	if o == nil {
+// writeOpenIdidentityProvider writes a value of the 'open_ididentity_provider' type to the given stream.
+func writeOpenIdidentityProvider(object *OpenIdidentityProvider, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(openIdidentityProviderData)
-	data.CA = o.ca
-	data.Claims, err = o.claims.wrap()
-	if err != nil {
-		return
+	stream.WriteObjectStart()
+	if object.ca != nil {
+		stream.WriteObjectField("ca")
+		stream.WriteString(*object.ca)
 	}
-	data.ClientID = o.clientID
-	data.ClientSecret = o.clientSecret
-	data.ExtraAuthorizeParameters = o.extraAuthorizeParameters
-	data.ExtraScopes = o.extraScopes
-	data.URLS, err = o.urls.wrap()
-	if err != nil {
-		return
+	if object.claims != nil {
+		stream.WriteObjectField("claims")
+		writeOpenIdclaims(object.claims, stream)
 	}
-	return
+	if object.clientID != nil {
+		stream.WriteObjectField("client_id")
+		stream.WriteString(*object.clientID)
+	}
+	if object.clientSecret != nil {
+		stream.WriteObjectField("client_secret")
+		stream.WriteString(*object.clientSecret)
+	}
+	if len(object.extraAuthorizeParameters) > 0 {
+		stream.WriteObjectField("extra_authorize_parameters")
+		stream.WriteStringMap(object.extraAuthorizeParameters)
+	}
+	if len(object.extraScopes) > 0 {
+		stream.WriteObjectField("extra_scopes")
+		stream.WriteStringSlice(object.extraScopes)
+	}
+	if object.urls != nil {
+		stream.WriteObjectField("urls")
+		writeOpenIdurls(object.urls, stream)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalOpenIdidentityProvider reads a value of the 'open_ididentity_provider' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalOpenIdidentityProvider(source interface{}) (object *OpenIdidentityProvider, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalOpenIdidentityProvider(source interface{}, options ...helpers.DecodingOptions) (object *OpenIdidentityProvider, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(openIdidentityProviderData)
-	err = decoder.Decode(data)
+	object = readOpenIdidentityProvider(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(OpenIdidentityProvider)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'open_ididentity_provider' type.
-func (d *openIdidentityProviderData) unwrap() (object *OpenIdidentityProvider, err error) {
-	if d == nil {
-		return
+// readOpenIdidentityProvider reads a value of the 'open_ididentity_provider' type from the given iterator.
+func readOpenIdidentityProvider(iterator *helpers.Iterator) *OpenIdidentityProvider {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(OpenIdidentityProvider)
-	object.ca = d.CA
-	object.claims, err = d.Claims.unwrap()
+	object := new(OpenIdidentityProvider)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "ca":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.ca = &value
+			}
+		case "claims":
+			object.claims = readOpenIdclaims(iterator)
+		case "client_id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientID = &value
+			}
+		case "client_secret":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.clientSecret = &value
+			}
+		case "extra_authorize_parameters":
+			object.extraAuthorizeParameters = iterator.ReadStringMap()
+		case "extra_scopes":
+			object.extraScopes = iterator.ReadStringSlice()
+		case "urls":
+			object.urls = readOpenIdurls(iterator)
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalOpenIdidentityProvider function.
+func (o *OpenIdidentityProvider) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdidentityProvider(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdidentityProvider function, using the
+// default decoding options.
+func (o *OpenIdidentityProvider) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalOpenIdidentityProvider(data)
 	if err != nil {
-		return
+		return err
 	}
-	object.clientID = d.ClientID
-	object.clientSecret = d.ClientSecret
-	object.extraAuthorizeParameters = d.ExtraAuthorizeParameters
-	object.extraScopes = d.ExtraScopes
-	object.urls, err = d.URLS.unwrap()
+	if object == nil {
+		*o = OpenIdidentityProvider{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *OpenIdidentityProvider) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
 	if err != nil {
-		return
+		return nil, err
 	}
-	return
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *OpenIdidentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
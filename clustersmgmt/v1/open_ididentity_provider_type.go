@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdidentityProvider represents the values of the 'open_ididentity_provider' type.
 //
 // Details for `openid` identity providers.
@@ -30,6 +36,7 @@ type OpenIdidentityProvider struct {
 	extraAuthorizeParameters map[string]string
 	extraScopes              []string
 	urls                     *OpenIdurls
+	extra                    map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -44,6 +51,59 @@ func (o *OpenIdidentityProvider) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'open_ididentity_provider' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *OpenIdidentityProvider) Metadata() *helpers.TypeMetadata {
+	return openIdidentityProviderMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *OpenIdidentityProvider) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdidentityProvider(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *OpenIdidentityProvider) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdidentityProvider(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *OpenIdidentityProvider) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdidentityProvider(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *OpenIdidentityProvider) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdidentityProvider(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'open_ididentity_provider' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *OpenIdidentityProvider) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // CA returns the value of the 'CA' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -207,6 +267,245 @@ func (o *OpenIdidentityProvider) GetURLS() (value *OpenIdurls, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'open_ididentity_provider' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *OpenIdidentityProvider) Copy() *OpenIdidentityProvider {
+	if o == nil {
+		return nil
+	}
+	result := new(OpenIdidentityProvider)
+	if o.ca != nil {
+		result.ca = new(string)
+		*result.ca = *o.ca
+	}
+	result.claims = o.claims.Copy()
+	if o.clientID != nil {
+		result.clientID = new(string)
+		*result.clientID = *o.clientID
+	}
+	if o.clientSecret != nil {
+		result.clientSecret = new(string)
+		*result.clientSecret = *o.clientSecret
+	}
+	if o.extraAuthorizeParameters != nil {
+		result.extraAuthorizeParameters = make(map[string]string, len(o.extraAuthorizeParameters))
+		for key, value := range o.extraAuthorizeParameters {
+			result.extraAuthorizeParameters[key] = value
+		}
+	}
+	if o.extraScopes != nil {
+		result.extraScopes = make([]string, len(o.extraScopes))
+		copy(result.extraScopes, o.extraScopes)
+	}
+	result.urls = o.urls.Copy()
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'open_ididentity_provider' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *OpenIdidentityProvider) Equal(other *OpenIdidentityProvider) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		return false
+	}
+	if !o.claims.Equal(other.claims) {
+		return false
+	}
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		return false
+	}
+	if (o.clientSecret == nil) != (other.clientSecret == nil) ||
+		(o.clientSecret != nil && *o.clientSecret != *other.clientSecret) {
+		return false
+	}
+	if !helpers.EqualStrings(o.extraAuthorizeParameters, other.extraAuthorizeParameters) {
+		return false
+	}
+	if len(o.extraScopes) != len(other.extraScopes) {
+		return false
+	}
+	for i, value := range o.extraScopes {
+		if value != other.extraScopes[i] {
+			return false
+		}
+	}
+	if !o.urls.Equal(other.urls) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'open_ididentity_provider' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *OpenIdidentityProvider) Diff(other *OpenIdidentityProvider) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'open_ididentity_provider' object and the given one, using the given path as prefix.
+func (o *OpenIdidentityProvider) diff(path string, other *OpenIdidentityProvider, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.ca == nil) != (other.ca == nil) ||
+		(o.ca != nil && *o.ca != *other.ca) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "ca"),
+			Old:  helpers.Value(o.ca),
+			New:  helpers.Value(other.ca),
+		})
+	}
+	result = o.claims.diff(helpers.Path(path, "claims"), other.claims, result)
+	if (o.clientID == nil) != (other.clientID == nil) ||
+		(o.clientID != nil && *o.clientID != *other.clientID) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "client_id"),
+			Old:  helpers.Value(o.clientID),
+			New:  helpers.Value(other.clientID),
+		})
+	}
+	if (o.clientSecret == nil) != (other.clientSecret == nil) ||
+		(o.clientSecret != nil && *o.clientSecret != *other.clientSecret) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "client_secret"),
+			Old:  helpers.Value(o.clientSecret),
+			New:  helpers.Value(other.clientSecret),
+		})
+	}
+	for _, key := range mergeKeys(o.extraAuthorizeParameters, other.extraAuthorizeParameters) {
+		value, ok := o.extraAuthorizeParameters[key]
+		otherValue, otherOk := other.extraAuthorizeParameters[key]
+		if ok != otherOk || value != otherValue {
+			difference := helpers.Difference{
+				Path: helpers.Key(helpers.Path(path, "extra_authorize_parameters"), key),
+			}
+			if ok {
+				difference.Old = value
+			}
+			if otherOk {
+				difference.New = otherValue
+			}
+			result = append(result, difference)
+		}
+	}
+	if !equalStrings(o.extraScopes, other.extraScopes) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "extra_scopes"),
+			Old:  o.extraScopes,
+			New:  other.extraScopes,
+		})
+	}
+	result = o.urls.diff(helpers.Path(path, "urls"), other.urls, result)
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'open_ididentity_provider' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *OpenIdidentityProvider) Changes(modified *OpenIdidentityProvider) *OpenIdidentityProvider {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(OpenIdidentityProvider)
+	if modified.ca != nil && (o.ca == nil || *o.ca != *modified.ca) {
+		result.ca = new(string)
+		*result.ca = *modified.ca
+	}
+	if modified.claims != nil && !o.claims.Equal(modified.claims) {
+		result.claims = o.claims.Changes(modified.claims)
+	}
+	if modified.clientID != nil && (o.clientID == nil || *o.clientID != *modified.clientID) {
+		result.clientID = new(string)
+		*result.clientID = *modified.clientID
+	}
+	if modified.clientSecret != nil && (o.clientSecret == nil || *o.clientSecret != *modified.clientSecret) {
+		result.clientSecret = new(string)
+		*result.clientSecret = *modified.clientSecret
+	}
+	if modified.extraAuthorizeParameters != nil && !helpers.EqualStrings(o.extraAuthorizeParameters, modified.extraAuthorizeParameters) {
+		result.extraAuthorizeParameters = make(map[string]string, len(modified.extraAuthorizeParameters))
+		for key, value := range modified.extraAuthorizeParameters {
+			result.extraAuthorizeParameters[key] = value
+		}
+	}
+	if modified.extraScopes != nil && !equalStrings(o.extraScopes, modified.extraScopes) {
+		result.extraScopes = make([]string, len(modified.extraScopes))
+		copy(result.extraScopes, modified.extraScopes)
+	}
+	if modified.urls != nil && !o.urls.Equal(modified.urls) {
+		result.urls = o.urls.Changes(modified.urls)
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printOpenIdidentityProvider prints a value of the 'open_ididentity_provider' type to the given printer.
+func printOpenIdidentityProvider(object *OpenIdidentityProvider, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.OpenIdidentityProvider")
+	if object.ca != nil {
+		printer.PrintObjectField("CA")
+		printer.PrintString(*object.ca)
+	}
+	if object.claims != nil {
+		printer.PrintObjectField("Claims")
+		printOpenIdclaims(object.claims, printer)
+	}
+	if object.clientID != nil {
+		printer.PrintObjectField("ClientID")
+		printer.PrintString(*object.clientID)
+	}
+	if object.clientSecret != nil {
+		printer.PrintObjectField("ClientSecret")
+		printer.PrintSensitive(*object.clientSecret)
+	}
+	if object.extraAuthorizeParameters != nil {
+		printer.PrintObjectField("ExtraAuthorizeParameters")
+		printer.PrintStringMap(object.extraAuthorizeParameters)
+	}
+	if object.extraScopes != nil {
+		printer.PrintObjectField("ExtraScopes")
+		printer.PrintStringSlice(object.extraScopes)
+	}
+	if object.urls != nil {
+		printer.PrintObjectField("URLS")
+		printOpenIdurls(object.urls, printer)
+	}
+	printer.PrintObjectEnd()
+}
+
 // OpenIdidentityProviderList is a list of values of the 'open_ididentity_provider' type.
 type OpenIdidentityProviderList struct {
 	items []*OpenIdidentityProvider
@@ -278,3 +577,105 @@ func (l *OpenIdidentityProviderList) Range(f func(index int, item *OpenIdidentit
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *OpenIdidentityProviderList) Copy() *OpenIdidentityProviderList {
+	if l == nil {
+		return nil
+	}
+	result := new(OpenIdidentityProviderList)
+	if l.items != nil {
+		result.items = make([]*OpenIdidentityProvider, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *OpenIdidentityProviderList) Equal(other *OpenIdidentityProviderList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *OpenIdidentityProviderList) Diff(other *OpenIdidentityProviderList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *OpenIdidentityProviderList) diff(path string, other *OpenIdidentityProviderList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *OpenIdidentityProviderList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdidentityProviderList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *OpenIdidentityProviderList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdidentityProviderList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *OpenIdidentityProviderList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdidentityProviderList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *OpenIdidentityProviderList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdidentityProviderList(l, printer)
+	}
+}
+
+// printOpenIdidentityProviderList prints a list of values of the 'open_ididentity_provider' type to the given printer.
+func printOpenIdidentityProviderList(list *OpenIdidentityProviderList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printOpenIdidentityProvider(item, printer)
+	}
+	printer.PrintListEnd()
+}
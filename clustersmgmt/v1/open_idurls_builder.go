@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdurlsBuilder contains the data and logic needed to build 'open_idurls' objects.
 //
 // _OpenID_ identity provider URLs.
@@ -26,6 +30,7 @@ type OpenIdurlsBuilder struct {
 	authorize *string
 	token     *string
 	userInfo  *string
+	extra     map[string]interface{}
 }
 
 // NewOpenIdurls creates a new builder of 'open_idurls' objects.
@@ -33,6 +38,21 @@ func NewOpenIdurls() *OpenIdurlsBuilder {
 	return new(OpenIdurlsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'open_idurls' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *OpenIdurls) Builder() *OpenIdurlsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(OpenIdurlsBuilder)
+	b.authorize = o.authorize
+	b.token = o.token
+	b.userInfo = o.userInfo
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Authorize sets the value of the 'authorize' attribute
 // to the given value.
 //
@@ -60,9 +80,17 @@ func (b *OpenIdurlsBuilder) UserInfo(value string) *OpenIdurlsBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'open_idurls' type, and
+// that will be written as they are when the object is encoded.
+func (b *OpenIdurlsBuilder) Extra(value map[string]interface{}) *OpenIdurlsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'open_idurls' object using the configuration stored in the builder.
 func (b *OpenIdurlsBuilder) Build() (object *OpenIdurls, err error) {
 	object = new(OpenIdurls)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.authorize != nil {
 		object.authorize = b.authorize
 	}
@@ -30,6 +30,19 @@ func NewOpenIdurlsList() *OpenIdurlsListBuilder {
 	return new(OpenIdurlsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *OpenIdurlsList) Builder() *OpenIdurlsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(OpenIdurlsListBuilder)
+	b.items = make([]*OpenIdurlsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *OpenIdurlsListBuilder) Items(values ...*OpenIdurlsBuilder) *OpenIdurlsListBuilder {
 	b.items = make([]*OpenIdurlsBuilder, len(values))
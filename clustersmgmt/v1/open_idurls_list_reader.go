@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdurlsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'open_idurls'.
-type openIdurlsListData []*openIdurlsData
-
 // UnmarshalOpenIdurlsList reads a list of values of the 'open_idurls'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalOpenIdurlsList(source interface{}) (list *OpenIdurlsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalOpenIdurlsList(source interface{}, options ...helpers.DecodingOptions) (list *OpenIdurlsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data openIdurlsListData
-	err = decoder.Decode(&data)
+	list = readOpenIdurlsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'open_idurls' value to a JSON document.
-func (l *OpenIdurlsList) wrap() (data openIdurlsListData, err error) {
-	if l == nil {
-		return
+// MarshalOpenIdurlsList writes a list of values of the 'open_idurls' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalOpenIdurlsList(list *OpenIdurlsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(openIdurlsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeOpenIdurlsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *OpenIdurlsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdurlsList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdurlsList function,
+// using the default decoding options.
+func (l *OpenIdurlsList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalOpenIdurlsList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = OpenIdurlsList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'open_idurls' type.
-func (d openIdurlsListData) unwrap() (list *OpenIdurlsList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *OpenIdurlsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *OpenIdurlsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeOpenIdurlsList writes a list of values of the 'open_idurls' type to the given
+// stream, as a JSON array.
+func writeOpenIdurlsList(list *OpenIdurlsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*OpenIdurls, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeOpenIdurls(item, stream)
 	}
-	list = new(OpenIdurlsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readOpenIdurlsList reads a list of values of the 'open_idurls' type from the given
+// iterator, as a JSON array.
+func readOpenIdurlsList(iterator *helpers.Iterator) *OpenIdurlsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(OpenIdurlsList)
+	list.items = []*OpenIdurls{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readOpenIdurls(iterator))
+	}
+	return list
 }
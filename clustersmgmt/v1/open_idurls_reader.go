@@ -23,66 +23,137 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// openIdurlsData is the data structure used internally to marshal and unmarshal
-// objects of type 'open_idurls'.
-type openIdurlsData struct {
-	Authorize *string "json:\"authorize,omitempty\""
-	Token     *string "json:\"token,omitempty\""
-	UserInfo  *string "json:\"user_info,omitempty\""
-}
-
 // MarshalOpenIdurls writes a value of the 'open_idurls' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalOpenIdurls(object *OpenIdurls, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeOpenIdurls(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'open_idurls'
-// value to a JSON document.
-func (o *OpenIdurls) wrap() (data *openIdurlsData, err error) {
-	if o == nil {
+// writeOpenIdurls writes a value of the 'open_idurls' type to the given stream.
+func writeOpenIdurls(object *OpenIdurls, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(openIdurlsData)
-	data.Authorize = o.authorize
-	data.Token = o.token
-	data.UserInfo = o.userInfo
-	return
+	stream.WriteObjectStart()
+	if object.authorize != nil {
+		stream.WriteObjectField("authorize")
+		stream.WriteString(*object.authorize)
+	}
+	if object.token != nil {
+		stream.WriteObjectField("token")
+		stream.WriteString(*object.token)
+	}
+	if object.userInfo != nil {
+		stream.WriteObjectField("user_info")
+		stream.WriteString(*object.userInfo)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalOpenIdurls reads a value of the 'open_idurls' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalOpenIdurls(source interface{}) (object *OpenIdurls, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalOpenIdurls(source interface{}, options ...helpers.DecodingOptions) (object *OpenIdurls, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(openIdurlsData)
-	err = decoder.Decode(data)
+	object = readOpenIdurls(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(OpenIdurls)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'open_idurls' type.
-func (d *openIdurlsData) unwrap() (object *OpenIdurls, err error) {
-	if d == nil {
-		return
+// readOpenIdurls reads a value of the 'open_idurls' type from the given iterator.
+func readOpenIdurls(iterator *helpers.Iterator) *OpenIdurls {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(OpenIdurls)
-	object.authorize = d.Authorize
-	object.token = d.Token
-	object.userInfo = d.UserInfo
-	return
+	object := new(OpenIdurls)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "authorize":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.authorize = &value
+			}
+		case "token":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.token = &value
+			}
+		case "user_info":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.userInfo = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalOpenIdurls function.
+func (o *OpenIdurls) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeOpenIdurls(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalOpenIdurls function, using the
+// default decoding options.
+func (o *OpenIdurls) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalOpenIdurls(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = OpenIdurls{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *OpenIdurls) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *OpenIdurls) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
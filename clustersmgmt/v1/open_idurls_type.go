@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // OpenIdurls represents the values of the 'open_idurls' type.
 //
 // _OpenID_ identity provider URLs.
@@ -26,6 +32,7 @@ type OpenIdurls struct {
 	authorize *string
 	token     *string
 	userInfo  *string
+	extra     map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -36,6 +43,59 @@ func (o *OpenIdurls) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'open_idurls' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *OpenIdurls) Metadata() *helpers.TypeMetadata {
+	return openIdurlsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *OpenIdurls) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdurls(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *OpenIdurls) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdurls(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *OpenIdurls) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdurls(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *OpenIdurls) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdurls(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'open_idurls' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *OpenIdurls) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Authorize returns the value of the 'authorize' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -105,6 +165,159 @@ func (o *OpenIdurls) GetUserInfo() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'open_idurls' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *OpenIdurls) Copy() *OpenIdurls {
+	if o == nil {
+		return nil
+	}
+	result := new(OpenIdurls)
+	if o.authorize != nil {
+		result.authorize = new(string)
+		*result.authorize = *o.authorize
+	}
+	if o.token != nil {
+		result.token = new(string)
+		*result.token = *o.token
+	}
+	if o.userInfo != nil {
+		result.userInfo = new(string)
+		*result.userInfo = *o.userInfo
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'open_idurls' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *OpenIdurls) Equal(other *OpenIdurls) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.authorize == nil) != (other.authorize == nil) ||
+		(o.authorize != nil && *o.authorize != *other.authorize) {
+		return false
+	}
+	if (o.token == nil) != (other.token == nil) ||
+		(o.token != nil && *o.token != *other.token) {
+		return false
+	}
+	if (o.userInfo == nil) != (other.userInfo == nil) ||
+		(o.userInfo != nil && *o.userInfo != *other.userInfo) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'open_idurls' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *OpenIdurls) Diff(other *OpenIdurls) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'open_idurls' object and the given one, using the given path as prefix.
+func (o *OpenIdurls) diff(path string, other *OpenIdurls, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.authorize == nil) != (other.authorize == nil) ||
+		(o.authorize != nil && *o.authorize != *other.authorize) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "authorize"),
+			Old:  helpers.Value(o.authorize),
+			New:  helpers.Value(other.authorize),
+		})
+	}
+	if (o.token == nil) != (other.token == nil) ||
+		(o.token != nil && *o.token != *other.token) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "token"),
+			Old:  helpers.Value(o.token),
+			New:  helpers.Value(other.token),
+		})
+	}
+	if (o.userInfo == nil) != (other.userInfo == nil) ||
+		(o.userInfo != nil && *o.userInfo != *other.userInfo) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "user_info"),
+			Old:  helpers.Value(o.userInfo),
+			New:  helpers.Value(other.userInfo),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'open_idurls' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *OpenIdurls) Changes(modified *OpenIdurls) *OpenIdurls {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(OpenIdurls)
+	if modified.authorize != nil && (o.authorize == nil || *o.authorize != *modified.authorize) {
+		result.authorize = new(string)
+		*result.authorize = *modified.authorize
+	}
+	if modified.token != nil && (o.token == nil || *o.token != *modified.token) {
+		result.token = new(string)
+		*result.token = *modified.token
+	}
+	if modified.userInfo != nil && (o.userInfo == nil || *o.userInfo != *modified.userInfo) {
+		result.userInfo = new(string)
+		*result.userInfo = *modified.userInfo
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printOpenIdurls prints a value of the 'open_idurls' type to the given printer.
+func printOpenIdurls(object *OpenIdurls, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.OpenIdurls")
+	if object.authorize != nil {
+		printer.PrintObjectField("Authorize")
+		printer.PrintString(*object.authorize)
+	}
+	if object.token != nil {
+		printer.PrintObjectField("Token")
+		printer.PrintString(*object.token)
+	}
+	if object.userInfo != nil {
+		printer.PrintObjectField("UserInfo")
+		printer.PrintString(*object.userInfo)
+	}
+	printer.PrintObjectEnd()
+}
+
 // OpenIdurlsList is a list of values of the 'open_idurls' type.
 type OpenIdurlsList struct {
 	items []*OpenIdurls
@@ -176,3 +389,105 @@ func (l *OpenIdurlsList) Range(f func(index int, item *OpenIdurls) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *OpenIdurlsList) Copy() *OpenIdurlsList {
+	if l == nil {
+		return nil
+	}
+	result := new(OpenIdurlsList)
+	if l.items != nil {
+		result.items = make([]*OpenIdurls, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *OpenIdurlsList) Equal(other *OpenIdurlsList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *OpenIdurlsList) Diff(other *OpenIdurlsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *OpenIdurlsList) diff(path string, other *OpenIdurlsList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *OpenIdurlsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printOpenIdurlsList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *OpenIdurlsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printOpenIdurlsList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *OpenIdurlsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printOpenIdurlsList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *OpenIdurlsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printOpenIdurlsList(l, printer)
+	}
+}
+
+// printOpenIdurlsList prints a list of values of the 'open_idurls' type to the given printer.
+func printOpenIdurlsList(list *OpenIdurlsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printOpenIdurls(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -20,8 +20,11 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"fmt"
 	"net/http"
 	"path"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RootClient is the client of the 'root' resource.
@@ -87,3 +90,42 @@ func (c *RootClient) Versions() *VersionsClient {
 		path.Join(c.metric, "versions"),
 	)
 }
+
+// Metadata returns the description of the resource managed by this client,
+// including its sub-resources.
+func (c *RootClient) Metadata() *helpers.ResourceMetadata {
+	return rootResource
+}
+
+// route returns the client that corresponds to the given path segments,
+// relative to the path of this client, together with the identifiers
+// extracted from the path.
+func (c *RootClient) route(segments []string, ids []string) (client interface{}, result []string, err error) {
+	if len(segments) == 0 {
+		client = c
+		result = ids
+		return
+	}
+	switch segments[0] {
+	case "clusters":
+		return c.Clusters().route(segments[1:], ids)
+	case "dashboards":
+		return c.Dashboards().route(segments[1:], ids)
+	case "flavours":
+		return c.Flavours().route(segments[1:], ids)
+	case "versions":
+		return c.Versions().route(segments[1:], ids)
+	default:
+		err = fmt.Errorf("can't find resource '%s' inside '%s'", segments[0], c.path)
+		return
+	}
+}
+
+// Route returns the client that corresponds to the given path segments,
+// relative to the root of this version of the service, together with the
+// identifiers extracted from the path. For example, for the segments
+// `clusters`, `123` and `groups` it returns the client for the groups of
+// cluster `123` and the identifier `123`.
+func (c *RootClient) Route(segments []string) (client interface{}, ids []string, err error) {
+	return c.route(segments, nil)
+}
@@ -23,6 +23,8 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // RootServer represents the interface the manages the 'root' resource.
@@ -49,46 +51,77 @@ type RootServer interface {
 	Versions() VersionsServer
 }
 
-// RootServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// RootServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type RootServerAdapter struct {
 	server RootServer
 	router *mux.Router
+	prefix string
 }
 
+// NewRootServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewRootServerAdapter(server RootServer, router *mux.Router) *RootServerAdapter {
 	adapter := new(RootServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.PathPrefix("/clusters").HandlerFunc(adapter.clustersHandler)
-	adapter.router.PathPrefix("/dashboards").HandlerFunc(adapter.dashboardsHandler)
-	adapter.router.PathPrefix("/flavours").HandlerFunc(adapter.flavoursHandler)
-	adapter.router.PathPrefix("/versions").HandlerFunc(adapter.versionsHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *RootServerAdapter) clustersHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Clusters()
-	targetAdapter := NewClustersServerAdapter(target, a.router.PathPrefix("/clusters").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) dashboardsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Dashboards()
-	targetAdapter := NewDashboardsServerAdapter(target, a.router.PathPrefix("/dashboards").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
-}
-func (a *RootServerAdapter) flavoursHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Flavours()
-	targetAdapter := NewFlavoursServerAdapter(target, a.router.PathPrefix("/flavours").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *RootServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	helpers.SetRoute(r, a.prefix, rootResource, segments)
+	dispatchRoot(w, r, a.server, segments)
 }
-func (a *RootServerAdapter) versionsHandler(w http.ResponseWriter, r *http.Request) {
-	target := a.server.Versions()
-	targetAdapter := NewVersionsServerAdapter(target, a.router.PathPrefix("/versions").Subrouter())
-	targetAdapter.ServeHTTP(w, r)
-	return
+
+// dispatchRoot navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchRoot(w http.ResponseWriter, r *http.Request, server RootServer, segments []string) {
+	if len(segments) == 0 {
+		errors.SendNotFound(w, r)
+		return
+	}
+	switch segments[0] {
+	case "clusters":
+		target := server.Clusters()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchClusters(w, r, target, segments[1:])
+	case "dashboards":
+		target := server.Dashboards()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchDashboards(w, r, target, segments[1:])
+	case "flavours":
+		target := server.Flavours()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchFlavours(w, r, target, segments[1:])
+	case "versions":
+		target := server.Versions()
+		if target == nil {
+			errors.SendNotFound(w, r)
+			return
+		}
+		dispatchVersions(w, r, target, segments[1:])
+	default:
+		errors.SendNotFound(w, r)
+	}
 }
 func (a *RootServerAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	a.router.ServeHTTP(w, r)
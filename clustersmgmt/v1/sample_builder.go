@@ -21,6 +21,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // SampleBuilder contains the data and logic needed to build 'sample' objects.
@@ -29,6 +31,7 @@ import (
 type SampleBuilder struct {
 	time  *time.Time
 	value *float64
+	extra map[string]interface{}
 }
 
 // NewSample creates a new builder of 'sample' objects.
@@ -36,6 +39,20 @@ func NewSample() *SampleBuilder {
 	return new(SampleBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'sample' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Sample) Builder() *SampleBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(SampleBuilder)
+	b.time = o.time
+	b.value = o.value
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Time sets the value of the 'time' attribute
 // to the given value.
 //
@@ -54,9 +71,17 @@ func (b *SampleBuilder) Value(value float64) *SampleBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'sample' type, and
+// that will be written as they are when the object is encoded.
+func (b *SampleBuilder) Extra(value map[string]interface{}) *SampleBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'sample' object using the configuration stored in the builder.
 func (b *SampleBuilder) Build() (object *Sample, err error) {
 	object = new(Sample)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.time != nil {
 		object.time = b.time
 	}
@@ -30,6 +30,19 @@ func NewSampleList() *SampleListBuilder {
 	return new(SampleListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *SampleList) Builder() *SampleListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(SampleListBuilder)
+	b.items = make([]*SampleBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *SampleListBuilder) Items(values ...*SampleBuilder) *SampleListBuilder {
 	b.items = make([]*SampleBuilder, len(values))
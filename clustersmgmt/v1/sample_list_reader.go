@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// sampleListData is type used internally to marshal and unmarshal lists of objects
-// of type 'sample'.
-type sampleListData []*sampleData
-
 // UnmarshalSampleList reads a list of values of the 'sample'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalSampleList(source interface{}) (list *SampleList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalSampleList(source interface{}, options ...helpers.DecodingOptions) (list *SampleList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data sampleListData
-	err = decoder.Decode(&data)
+	list = readSampleList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'sample' value to a JSON document.
-func (l *SampleList) wrap() (data sampleListData, err error) {
-	if l == nil {
-		return
+// MarshalSampleList writes a list of values of the 'sample' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalSampleList(list *SampleList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(sampleListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeSampleList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *SampleList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSampleList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalSampleList function,
+// using the default decoding options.
+func (l *SampleList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalSampleList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = SampleList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'sample' type.
-func (d sampleListData) unwrap() (list *SampleList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *SampleList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *SampleList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeSampleList writes a list of values of the 'sample' type to the given
+// stream, as a JSON array.
+func writeSampleList(list *SampleList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Sample, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeSample(item, stream)
 	}
-	list = new(SampleList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readSampleList reads a list of values of the 'sample' type from the given
+// iterator, as a JSON array.
+func readSampleList(iterator *helpers.Iterator) *SampleList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(SampleList)
+	list.items = []*Sample{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readSample(iterator))
+	}
+	return list
 }
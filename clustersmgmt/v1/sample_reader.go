@@ -20,68 +20,131 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	time "time"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// sampleData is the data structure used internally to marshal and unmarshal
-// objects of type 'sample'.
-type sampleData struct {
-	Time  *time.Time "json:\"time,omitempty\""
-	Value *float64   "json:\"value,omitempty\""
-}
-
 // MarshalSample writes a value of the 'sample' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalSample(object *Sample, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeSample(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'sample'
-// value to a JSON document.
-func (o *Sample) wrap() (data *sampleData, err error) {
-	if o == nil {
+// writeSample writes a value of the 'sample' type to the given stream.
+func writeSample(object *Sample, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(sampleData)
-	data.Time = o.time
-	data.Value = o.value
-	return
+	stream.WriteObjectStart()
+	if object.time != nil {
+		stream.WriteObjectField("time")
+		stream.WriteTime(*object.time)
+	}
+	if object.value != nil {
+		stream.WriteObjectField("value")
+		stream.WriteFloat64(*object.value)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalSample reads a value of the 'sample' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalSample(source interface{}) (object *Sample, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalSample(source interface{}, options ...helpers.DecodingOptions) (object *Sample, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(sampleData)
-	err = decoder.Decode(data)
+	object = readSample(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Sample)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'sample' type.
-func (d *sampleData) unwrap() (object *Sample, err error) {
-	if d == nil {
-		return
+// readSample reads a value of the 'sample' type from the given iterator.
+func readSample(iterator *helpers.Iterator) *Sample {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Sample)
-	object.time = d.Time
-	object.value = d.Value
-	return
+	object := new(Sample)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "time":
+			if !iterator.ReadNull() {
+				value := iterator.ReadTime()
+				object.time = &value
+			}
+		case "value":
+			if !iterator.ReadNull() {
+				value := iterator.ReadFloat64()
+				object.value = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalSample function.
+func (o *Sample) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSample(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalSample function, using the
+// default decoding options.
+func (o *Sample) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalSample(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Sample{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Sample) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Sample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
@@ -20,7 +20,10 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"fmt"
 	time "time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // Sample represents the values of the 'sample' type.
@@ -29,6 +32,7 @@ import (
 type Sample struct {
 	time  *time.Time
 	value *float64
+	extra map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -38,6 +42,59 @@ func (o *Sample) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'sample' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Sample) Metadata() *helpers.TypeMetadata {
+	return sampleMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Sample) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSample(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Sample) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSample(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Sample) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSample(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Sample) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSample(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'sample' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Sample) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Time returns the value of the 'time' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -84,6 +141,135 @@ func (o *Sample) GetValue() (value float64, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'sample' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Sample) Copy() *Sample {
+	if o == nil {
+		return nil
+	}
+	result := new(Sample)
+	if o.time != nil {
+		result.time = new(time.Time)
+		*result.time = *o.time
+	}
+	if o.value != nil {
+		result.value = new(float64)
+		*result.value = *o.value
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'sample' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Sample) Equal(other *Sample) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.time == nil) != (other.time == nil) ||
+		(o.time != nil && !o.time.Equal(*other.time)) {
+		return false
+	}
+	if (o.value == nil) != (other.value == nil) ||
+		(o.value != nil && *o.value != *other.value) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'sample' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Sample) Diff(other *Sample) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'sample' object and the given one, using the given path as prefix.
+func (o *Sample) diff(path string, other *Sample, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.time == nil) != (other.time == nil) ||
+		(o.time != nil && !o.time.Equal(*other.time)) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "time"),
+			Old:  helpers.Value(o.time),
+			New:  helpers.Value(other.time),
+		})
+	}
+	if (o.value == nil) != (other.value == nil) ||
+		(o.value != nil && *o.value != *other.value) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "value"),
+			Old:  helpers.Value(o.value),
+			New:  helpers.Value(other.value),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'sample' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Sample) Changes(modified *Sample) *Sample {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Sample)
+	if modified.time != nil && (o.time == nil || !o.time.Equal(*modified.time)) {
+		result.time = new(time.Time)
+		*result.time = *modified.time
+	}
+	if modified.value != nil && (o.value == nil || *o.value != *modified.value) {
+		result.value = new(float64)
+		*result.value = *modified.value
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printSample prints a value of the 'sample' type to the given printer.
+func printSample(object *Sample, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Sample")
+	if object.time != nil {
+		printer.PrintObjectField("Time")
+		printer.PrintTime(*object.time)
+	}
+	if object.value != nil {
+		printer.PrintObjectField("Value")
+		printer.PrintFloat64(*object.value)
+	}
+	printer.PrintObjectEnd()
+}
+
 // SampleList is a list of values of the 'sample' type.
 type SampleList struct {
 	items []*Sample
@@ -155,3 +341,105 @@ func (l *SampleList) Range(f func(index int, item *Sample) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *SampleList) Copy() *SampleList {
+	if l == nil {
+		return nil
+	}
+	result := new(SampleList)
+	if l.items != nil {
+		result.items = make([]*Sample, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *SampleList) Equal(other *SampleList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *SampleList) Diff(other *SampleList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *SampleList) diff(path string, other *SampleList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *SampleList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSampleList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *SampleList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSampleList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *SampleList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSampleList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *SampleList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSampleList(l, printer)
+	}
+}
+
+// printSampleList prints a list of values of the 'sample' type to the given printer.
+func printSampleList(list *SampleList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printSample(item, printer)
+	}
+	printer.PrintListEnd()
+}
@@ -19,12 +19,17 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // SshcredentialsBuilder contains the data and logic needed to build 'sshcredentials' objects.
 //
 // SSH key pair of a cluster.
 type SshcredentialsBuilder struct {
 	publicKey  *string
 	privateKey *string
+	extra      map[string]interface{}
 }
 
 // NewSshcredentials creates a new builder of 'sshcredentials' objects.
@@ -32,6 +37,20 @@ func NewSshcredentials() *SshcredentialsBuilder {
 	return new(SshcredentialsBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'sshcredentials' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Sshcredentials) Builder() *SshcredentialsBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(SshcredentialsBuilder)
+	b.publicKey = o.publicKey
+	b.privateKey = o.privateKey
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // PublicKey sets the value of the 'public_key' attribute
 // to the given value.
 //
@@ -50,9 +69,17 @@ func (b *SshcredentialsBuilder) PrivateKey(value string) *SshcredentialsBuilder
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'sshcredentials' type, and
+// that will be written as they are when the object is encoded.
+func (b *SshcredentialsBuilder) Extra(value map[string]interface{}) *SshcredentialsBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'sshcredentials' object using the configuration stored in the builder.
 func (b *SshcredentialsBuilder) Build() (object *Sshcredentials, err error) {
 	object = new(Sshcredentials)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.publicKey != nil {
 		object.publicKey = b.publicKey
 	}
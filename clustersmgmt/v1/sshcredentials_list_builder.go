@@ -30,6 +30,19 @@ func NewSshcredentialsList() *SshcredentialsListBuilder {
 	return new(SshcredentialsListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *SshcredentialsList) Builder() *SshcredentialsListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(SshcredentialsListBuilder)
+	b.items = make([]*SshcredentialsBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *SshcredentialsListBuilder) Items(values ...*SshcredentialsBuilder) *SshcredentialsListBuilder {
 	b.items = make([]*SshcredentialsBuilder, len(values))
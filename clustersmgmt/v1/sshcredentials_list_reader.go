@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// sshcredentialsListData is type used internally to marshal and unmarshal lists of objects
-// of type 'sshcredentials'.
-type sshcredentialsListData []*sshcredentialsData
-
 // UnmarshalSshcredentialsList reads a list of values of the 'sshcredentials'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalSshcredentialsList(source interface{}) (list *SshcredentialsList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalSshcredentialsList(source interface{}, options ...helpers.DecodingOptions) (list *SshcredentialsList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data sshcredentialsListData
-	err = decoder.Decode(&data)
+	list = readSshcredentialsList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'sshcredentials' value to a JSON document.
-func (l *SshcredentialsList) wrap() (data sshcredentialsListData, err error) {
-	if l == nil {
-		return
+// MarshalSshcredentialsList writes a list of values of the 'sshcredentials' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalSshcredentialsList(list *SshcredentialsList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(sshcredentialsListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeSshcredentialsList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *SshcredentialsList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSshcredentialsList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalSshcredentialsList function,
+// using the default decoding options.
+func (l *SshcredentialsList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalSshcredentialsList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = SshcredentialsList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'sshcredentials' type.
-func (d sshcredentialsListData) unwrap() (list *SshcredentialsList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *SshcredentialsList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *SshcredentialsList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeSshcredentialsList writes a list of values of the 'sshcredentials' type to the given
+// stream, as a JSON array.
+func writeSshcredentialsList(list *SshcredentialsList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Sshcredentials, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeSshcredentials(item, stream)
 	}
-	list = new(SshcredentialsList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readSshcredentialsList reads a list of values of the 'sshcredentials' type from the given
+// iterator, as a JSON array.
+func readSshcredentialsList(iterator *helpers.Iterator) *SshcredentialsList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(SshcredentialsList)
+	list.items = []*Sshcredentials{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readSshcredentials(iterator))
+	}
+	return list
 }
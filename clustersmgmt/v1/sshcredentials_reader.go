@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// sshcredentialsData is the data structure used internally to marshal and unmarshal
-// objects of type 'sshcredentials'.
-type sshcredentialsData struct {
-	PublicKey  *string "json:\"public_key,omitempty\""
-	PrivateKey *string "json:\"private_key,omitempty\""
-}
-
 // MarshalSshcredentials writes a value of the 'sshcredentials' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalSshcredentials(object *Sshcredentials, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeSshcredentials(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'sshcredentials'
-// value to a JSON document.
-func (o *Sshcredentials) wrap() (data *sshcredentialsData, err error) {
-	if o == nil {
+// writeSshcredentials writes a value of the 'sshcredentials' type to the given stream.
+func writeSshcredentials(object *Sshcredentials, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(sshcredentialsData)
-	data.PublicKey = o.publicKey
-	data.PrivateKey = o.privateKey
-	return
+	stream.WriteObjectStart()
+	if object.publicKey != nil {
+		stream.WriteObjectField("public_key")
+		stream.WriteString(*object.publicKey)
+	}
+	if object.privateKey != nil {
+		stream.WriteObjectField("private_key")
+		stream.WriteString(*object.privateKey)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalSshcredentials reads a value of the 'sshcredentials' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalSshcredentials(source interface{}) (object *Sshcredentials, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalSshcredentials(source interface{}, options ...helpers.DecodingOptions) (object *Sshcredentials, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(sshcredentialsData)
-	err = decoder.Decode(data)
+	object = readSshcredentials(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Sshcredentials)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'sshcredentials' type.
-func (d *sshcredentialsData) unwrap() (object *Sshcredentials, err error) {
-	if d == nil {
-		return
+// readSshcredentials reads a value of the 'sshcredentials' type from the given iterator.
+func readSshcredentials(iterator *helpers.Iterator) *Sshcredentials {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Sshcredentials)
-	object.publicKey = d.PublicKey
-	object.privateKey = d.PrivateKey
-	return
+	object := new(Sshcredentials)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "public_key":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.publicKey = &value
+			}
+		case "private_key":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.privateKey = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalSshcredentials function.
+func (o *Sshcredentials) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeSshcredentials(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalSshcredentials function, using the
+// default decoding options.
+func (o *Sshcredentials) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalSshcredentials(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Sshcredentials{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Sshcredentials) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Sshcredentials) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
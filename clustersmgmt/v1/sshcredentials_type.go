@@ -19,12 +19,19 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // Sshcredentials represents the values of the 'sshcredentials' type.
 //
 // SSH key pair of a cluster.
 type Sshcredentials struct {
 	publicKey  *string
 	privateKey *string
+	extra      map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -34,6 +41,59 @@ func (o *Sshcredentials) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'sshcredentials' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Sshcredentials) Metadata() *helpers.TypeMetadata {
+	return sshcredentialsMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Sshcredentials) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSshcredentials(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Sshcredentials) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSshcredentials(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Sshcredentials) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSshcredentials(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Sshcredentials) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSshcredentials(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'sshcredentials' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Sshcredentials) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // PublicKey returns the value of the 'public_key' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -80,6 +140,135 @@ func (o *Sshcredentials) GetPrivateKey() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'sshcredentials' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Sshcredentials) Copy() *Sshcredentials {
+	if o == nil {
+		return nil
+	}
+	result := new(Sshcredentials)
+	if o.publicKey != nil {
+		result.publicKey = new(string)
+		*result.publicKey = *o.publicKey
+	}
+	if o.privateKey != nil {
+		result.privateKey = new(string)
+		*result.privateKey = *o.privateKey
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'sshcredentials' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Sshcredentials) Equal(other *Sshcredentials) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.publicKey == nil) != (other.publicKey == nil) ||
+		(o.publicKey != nil && *o.publicKey != *other.publicKey) {
+		return false
+	}
+	if (o.privateKey == nil) != (other.privateKey == nil) ||
+		(o.privateKey != nil && *o.privateKey != *other.privateKey) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'sshcredentials' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Sshcredentials) Diff(other *Sshcredentials) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'sshcredentials' object and the given one, using the given path as prefix.
+func (o *Sshcredentials) diff(path string, other *Sshcredentials, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.publicKey == nil) != (other.publicKey == nil) ||
+		(o.publicKey != nil && *o.publicKey != *other.publicKey) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "public_key"),
+			Old:  helpers.Value(o.publicKey),
+			New:  helpers.Value(other.publicKey),
+		})
+	}
+	if (o.privateKey == nil) != (other.privateKey == nil) ||
+		(o.privateKey != nil && *o.privateKey != *other.privateKey) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "private_key"),
+			Old:  helpers.Value(o.privateKey),
+			New:  helpers.Value(other.privateKey),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'sshcredentials' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Sshcredentials) Changes(modified *Sshcredentials) *Sshcredentials {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Sshcredentials)
+	if modified.publicKey != nil && (o.publicKey == nil || *o.publicKey != *modified.publicKey) {
+		result.publicKey = new(string)
+		*result.publicKey = *modified.publicKey
+	}
+	if modified.privateKey != nil && (o.privateKey == nil || *o.privateKey != *modified.privateKey) {
+		result.privateKey = new(string)
+		*result.privateKey = *modified.privateKey
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printSshcredentials prints a value of the 'sshcredentials' type to the given printer.
+func printSshcredentials(object *Sshcredentials, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Sshcredentials")
+	if object.publicKey != nil {
+		printer.PrintObjectField("PublicKey")
+		printer.PrintString(*object.publicKey)
+	}
+	if object.privateKey != nil {
+		printer.PrintObjectField("PrivateKey")
+		printer.PrintSensitive(*object.privateKey)
+	}
+	printer.PrintObjectEnd()
+}
+
 // SshcredentialsList is a list of values of the 'sshcredentials' type.
 type SshcredentialsList struct {
 	items []*Sshcredentials
@@ -151,3 +340,105 @@ func (l *SshcredentialsList) Range(f func(index int, item *Sshcredentials) bool)
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *SshcredentialsList) Copy() *SshcredentialsList {
+	if l == nil {
+		return nil
+	}
+	result := new(SshcredentialsList)
+	if l.items != nil {
+		result.items = make([]*Sshcredentials, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *SshcredentialsList) Equal(other *SshcredentialsList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *SshcredentialsList) Diff(other *SshcredentialsList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *SshcredentialsList) diff(path string, other *SshcredentialsList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *SshcredentialsList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printSshcredentialsList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *SshcredentialsList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printSshcredentialsList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *SshcredentialsList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printSshcredentialsList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *SshcredentialsList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printSshcredentialsList(l, printer)
+	}
+}
+
+// printSshcredentialsList prints a list of values of the 'sshcredentials' type to the given printer.
+func printSshcredentialsList(list *SshcredentialsList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printSshcredentials(item, printer)
+	}
+	printer.PrintListEnd()
+}
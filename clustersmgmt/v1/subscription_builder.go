@@ -19,13 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // SubscriptionBuilder contains the data and logic needed to build 'subscription' objects.
 //
 // Definition of a subscription.
 type SubscriptionBuilder struct {
-	id   *string
-	href *string
-	link bool
+	id    *string
+	href  *string
+	link  bool
+	extra map[string]interface{}
 }
 
 // NewSubscription creates a new builder of 'subscription' objects.
@@ -33,6 +38,21 @@ func NewSubscription() *SubscriptionBuilder {
 	return new(SubscriptionBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'subscription' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Subscription) Builder() *SubscriptionBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(SubscriptionBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *SubscriptionBuilder) ID(value string) *SubscriptionBuilder {
 	b.id = &value
@@ -51,9 +71,17 @@ func (b *SubscriptionBuilder) Link(value bool) *SubscriptionBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'subscription' type, and
+// that will be written as they are when the object is encoded.
+func (b *SubscriptionBuilder) Extra(value map[string]interface{}) *SubscriptionBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'subscription' object using the configuration stored in the builder.
 func (b *SubscriptionBuilder) Build() (object *Subscription, err error) {
 	object = new(Subscription)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'subscription' objects.
 type SubscriptionListBuilder struct {
 	items []*SubscriptionBuilder
+	link  bool
+	href  *string
 }
 
 // NewSubscriptionList creates a new builder of 'subscription' objects.
@@ -30,8 +32,25 @@ func NewSubscriptionList() *SubscriptionListBuilder {
 	return new(SubscriptionListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *SubscriptionList) Builder() *SubscriptionListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(SubscriptionListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*SubscriptionBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *SubscriptionListBuilder) Items(values ...*SubscriptionBuilder) *SubscriptionListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*SubscriptionBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *SubscriptionListBuilder) Build() (list *SubscriptionList, err error) {
 		}
 	}
 	list = new(SubscriptionList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
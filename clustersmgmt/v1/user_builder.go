@@ -19,13 +19,18 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // UserBuilder contains the data and logic needed to build 'user' objects.
 //
 // Representation of a user.
 type UserBuilder struct {
-	id   *string
-	href *string
-	link bool
+	id    *string
+	href  *string
+	link  bool
+	extra map[string]interface{}
 }
 
 // NewUser creates a new builder of 'user' objects.
@@ -33,6 +38,21 @@ func NewUser() *UserBuilder {
 	return new(UserBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'user' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *User) Builder() *UserBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(UserBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *UserBuilder) ID(value string) *UserBuilder {
 	b.id = &value
@@ -51,9 +71,17 @@ func (b *UserBuilder) Link(value bool) *UserBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'user' type, and
+// that will be written as they are when the object is encoded.
+func (b *UserBuilder) Extra(value map[string]interface{}) *UserBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'user' object using the configuration stored in the builder.
 func (b *UserBuilder) Build() (object *User, err error) {
 	object = new(User)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
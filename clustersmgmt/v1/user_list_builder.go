@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'user' objects.
 type UserListBuilder struct {
 	items []*UserBuilder
+	link  bool
+	href  *string
 }
 
 // NewUserList creates a new builder of 'user' objects.
@@ -30,8 +32,25 @@ func NewUserList() *UserListBuilder {
 	return new(UserListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *UserList) Builder() *UserListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(UserListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*UserBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *UserListBuilder) Items(values ...*UserBuilder) *UserListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*UserBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *UserListBuilder) Build() (list *UserList, err error) {
 		}
 	}
 	list = new(UserList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// userListData is type used internally to marshal and unmarshal lists of objects
-// of type 'user'.
-type userListData []*userData
-
 // UnmarshalUserList reads a list of values of the 'user'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalUserList(source interface{}) (list *UserList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalUserList(source interface{}, options ...helpers.DecodingOptions) (list *UserList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data userListData
-	err = decoder.Decode(&data)
+	list = readUserList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'user' value to a JSON document.
-func (l *UserList) wrap() (data userListData, err error) {
-	if l == nil {
+// MarshalUserList writes a list of values of the 'user' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalUserList(list *UserList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeUserList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *UserList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeUserListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalUserList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *UserList) UnmarshalJSON(data []byte) error {
+	var list *UserList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalUserList(data)
+	} else {
+		list, err = unmarshalUserListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = UserList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *UserList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *UserList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalUserListLink reads a list of values of the 'user' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalUserListLink(source interface{}, options ...helpers.DecodingOptions) (list *UserList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(userListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readUserListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'user' type.
-func (d userListData) unwrap() (list *UserList, err error) {
-	if d == nil {
+// writeUserList writes a list of values of the 'user' type to the given
+// stream, as a JSON array.
+func writeUserList(list *UserList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*User, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeUser(item, stream)
 	}
-	list = new(UserList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// userListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'user'.
-type userListLinkData struct {
-	Kind  *string     "json:\"kind,omitempty\""
-	HREF  *string     "json:\"href,omitempty\""
-	Items []*userData "json:\"items,omitempty\""
+// readUserList reads a list of values of the 'user' type from the given
+// iterator, as a JSON array.
+func readUserList(iterator *helpers.Iterator) *UserList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(UserList)
+	list.items = []*User{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readUser(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'user' value to a link.
-func (l *UserList) wrapLink() (data *userListLinkData, err error) {
-	if l == nil {
+// writeUserListLink writes a list of values of the 'user' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeUserListLink(list *UserList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*userData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(userListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = UserListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(UserListLinkKind)
 	} else {
-		*data.Kind = UserListKind
+		stream.WriteString(UserListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeUserList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'user' type to a list.
-func (d *userListLinkData) unwrapLink() (list *UserList, err error) {
-	if d == nil {
-		return
+// readUserListLink reads a list of values of the 'user' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readUserListLink(iterator *helpers.Iterator) *UserList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*User, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(UserList)
+	list.items = []*User{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(UserList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case UserListKind:
-			list.link = false
-		case UserListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(UserListKind, UserListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readUserList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				UserListKind,
-				UserListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
@@ -20,91 +20,139 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// userData is the data structure used internally to marshal and unmarshal
-// objects of type 'user'.
-type userData struct {
-	Kind *string "json:\"kind,omitempty\""
-	ID   *string "json:\"id,omitempty\""
-	HREF *string "json:\"href,omitempty\""
-}
-
 // MarshalUser writes a value of the 'user' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalUser(object *User, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeUser(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'user'
-// value to a JSON document.
-func (o *User) wrap() (data *userData, err error) {
-	if o == nil {
+// writeUser writes a value of the 'user' type to the given stream.
+func writeUser(object *User, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(userData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = UserLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(UserLinkKind)
 	} else {
-		*data.Kind = UserKind
+		stream.WriteString(UserKind)
 	}
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalUser reads a value of the 'user' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalUser(source interface{}) (object *User, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalUser(source interface{}, options ...helpers.DecodingOptions) (object *User, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(userData)
-	err = decoder.Decode(data)
+	object = readUser(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(User)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'user' type.
-func (d *userData) unwrap() (object *User, err error) {
-	if d == nil {
-		return
+// readUser reads a value of the 'user' type from the given iterator.
+func readUser(iterator *helpers.Iterator) *User {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(User)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case UserKind:
-			object.link = false
-		case UserLinkKind:
-			object.link = true
+	object := new(User)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(UserKind, UserLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				UserKind,
-				UserLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalUser function.
+func (o *User) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeUser(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalUser function, using the
+// default decoding options.
+func (o *User) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalUser(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = User{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *User) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *User) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
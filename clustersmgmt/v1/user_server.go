@@ -20,15 +20,17 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
 // UserServer represents the interface the manages the 'user' resource.
@@ -75,14 +77,12 @@ func (r *UserGetServerResponse) SetStatusCode(status int) *UserGetServerResponse
 // marshall is the method used internally to marshal responses for the
 // 'get' method.
 func (r *UserGetServerResponse) marshal(writer io.Writer) error {
-	var err error
-	encoder := json.NewEncoder(writer)
-	data, err := r.body.wrap()
+	stream, err := helpers.NewStream(writer)
 	if err != nil {
 		return err
 	}
-	err = encoder.Encode(data)
-	return err
+	writeUser(r.body, stream)
+	return stream.Flush()
 }
 
 // UserDeleteServerRequest is the request for the 'delete' method.
@@ -103,57 +103,98 @@ func (r *UserDeleteServerResponse) SetStatusCode(status int) *UserDeleteServerRe
 	return r
 }
 
-// UserServerAdapter represents the structs that adapts Requests and Response to internal
-// structs.
+// UserServerAdapter is an HTTP handler that dispatches the requests to the given server. The
+// routes are created once, when the adapter is created, and the path parameters are extracted
+// from the segments of the path of each request.
 type UserServerAdapter struct {
 	server UserServer
 	router *mux.Router
+	prefix string
 }
 
+// NewUserServerAdapter creates a new adapter that dispatches the requests received by the given
+// router to the given server.
 func NewUserServerAdapter(server UserServer, router *mux.Router) *UserServerAdapter {
 	adapter := new(UserServerAdapter)
 	adapter.server = server
 	adapter.router = router
-	adapter.router.Methods("GET").HandlerFunc(adapter.getHandler)
-	adapter.router.Methods("DELETE").HandlerFunc(adapter.deleteHandler)
+	adapter.prefix = helpers.Mount(router, adapter.dispatchHandler)
 	return adapter
 }
-func (a *UserServerAdapter) readUserGetServerRequest(r *http.Request) (*UserGetServerRequest, error) {
+
+// dispatchHandler removes the prefix of the router from the path of the request and dispatches
+// the rest of the path to the server.
+func (a *UserServerAdapter) dispatchHandler(w http.ResponseWriter, r *http.Request) {
+	defer errors.RecoverPanic(w, r)
+	segments, ok := helpers.Segments(r.URL.Path, a.prefix)
+	if !ok {
+		errors.SendNotFound(w, r)
+		return
+	}
+	dispatchUser(w, r, a.server, segments)
+}
+
+// dispatchUser navigates the servers tree rooted at the given server till it finds one that
+// matches the given set of path segments, and then invokes the corresponding server.
+func dispatchUser(w http.ResponseWriter, r *http.Request, server UserServer, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			adaptUserGetRequest(w, r, server)
+		case http.MethodDelete:
+			adaptUserDeleteRequest(w, r, server)
+		default:
+			errors.SendMethodNotAllowed(w, r)
+		}
+		return
+	}
+	switch segments[0] {
+	default:
+		errors.SendNotFound(w, r)
+	}
+}
+func readUserGetServerRequest(r *http.Request) (*UserGetServerRequest, error) {
 	result := new(UserGetServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *UserServerAdapter) writeUserGetServerResponse(w http.ResponseWriter, r *UserGetServerResponse) error {
-	w.WriteHeader(r.status)
-	err := r.marshal(w)
+func writeUserGetServerResponse(w http.ResponseWriter, r *UserGetServerResponse) error {
+	buffer := new(bytes.Buffer)
+	err := r.marshal(buffer)
 	if err != nil {
 		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.status)
+	_, err = buffer.WriteTo(w)
+	if err != nil {
+		glog.Errorf("Can't send response body: %v", err)
+	}
 	return nil
 }
-func (a *UserServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readUserGetServerRequest(r)
+func adaptUserGetRequest(w http.ResponseWriter, r *http.Request, server UserServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readUserGetServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(UserGetServerResponse)
-	err = a.server.Get(r.Context(), req, resp)
+	err = server.Get(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Get: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Get", err)
+		return
 	}
-	err = a.writeUserGetServerResponse(w, resp)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	err = writeUserGetServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
@@ -163,38 +204,38 @@ func (a *UserServerAdapter) getHandler(w http.ResponseWriter, r *http.Request) {
 		errors.SendError(w, r, errorBody)
 	}
 }
-func (a *UserServerAdapter) readUserDeleteServerRequest(r *http.Request) (*UserDeleteServerRequest, error) {
+func readUserDeleteServerRequest(r *http.Request) (*UserDeleteServerRequest, error) {
 	result := new(UserDeleteServerRequest)
-	result.query = r.Form
+	result.query = r.URL.Query()
 	result.path = r.URL.Path
 	return result, nil
 }
-func (a *UserServerAdapter) writeUserDeleteServerResponse(w http.ResponseWriter, r *UserDeleteServerResponse) error {
+func writeUserDeleteServerResponse(w http.ResponseWriter, r *UserDeleteServerResponse) error {
 	w.WriteHeader(r.status)
 	return nil
 }
-func (a *UserServerAdapter) deleteHandler(w http.ResponseWriter, r *http.Request) {
-	req, err := a.readUserDeleteServerRequest(r)
+func adaptUserDeleteRequest(w http.ResponseWriter, r *http.Request, server UserServer) {
+	defer errors.RecoverPanic(w, r)
+	req, err := readUserDeleteServerRequest(r)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to read request from client: %v", err)
 		errorBody, _ := errors.NewError().
 			Reason(reason).
-			ID("500").
+			ID("400").
 			Build()
 		errors.SendError(w, r, errorBody)
 		return
 	}
 	resp := new(UserDeleteServerResponse)
-	err = a.server.Delete(r.Context(), req, resp)
+	err = server.Delete(r.Context(), req, resp)
 	if err != nil {
-		reason := fmt.Sprintf("An error occured while trying to run method Delete: %v", err)
-		errorBody, _ := errors.NewError().
-			Reason(reason).
-			ID("500").
-			Build()
-		errors.SendError(w, r, errorBody)
+		errors.SendMethodError(w, r, "Delete", err)
+		return
+	}
+	if resp.status == 0 {
+		resp.status = http.StatusNoContent
 	}
-	err = a.writeUserDeleteServerResponse(w, resp)
+	err = writeUserDeleteServerResponse(w, resp)
 	if err != nil {
 		reason := fmt.Sprintf("An error occured while trying to write response for client: %v", err)
 		errorBody, _ := errors.NewError().
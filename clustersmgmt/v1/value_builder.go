@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // ValueBuilder contains the data and logic needed to build 'value' objects.
 //
 // Numeric value and the unit used to measure it.
@@ -42,6 +46,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 type ValueBuilder struct {
 	value *float64
 	unit  *string
+	extra map[string]interface{}
 }
 
 // NewValue creates a new builder of 'value' objects.
@@ -49,6 +54,20 @@ func NewValue() *ValueBuilder {
 	return new(ValueBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'value' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Value) Builder() *ValueBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(ValueBuilder)
+	b.value = o.value
+	b.unit = o.unit
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // Value sets the value of the 'value' attribute
 // to the given value.
 //
@@ -67,9 +86,17 @@ func (b *ValueBuilder) Unit(value string) *ValueBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'value' type, and
+// that will be written as they are when the object is encoded.
+func (b *ValueBuilder) Extra(value map[string]interface{}) *ValueBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'value' object using the configuration stored in the builder.
 func (b *ValueBuilder) Build() (object *Value, err error) {
 	object = new(Value)
+	object.extra = helpers.CopyExtra(b.extra)
 	if b.value != nil {
 		object.value = b.value
 	}
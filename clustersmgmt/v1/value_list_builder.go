@@ -30,6 +30,19 @@ func NewValueList() *ValueListBuilder {
 	return new(ValueListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *ValueList) Builder() *ValueListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(ValueListBuilder)
+	b.items = make([]*ValueBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *ValueListBuilder) Items(values ...*ValueBuilder) *ValueListBuilder {
 	b.items = make([]*ValueBuilder, len(values))
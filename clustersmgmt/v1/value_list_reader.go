@@ -23,57 +23,107 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// valueListData is type used internally to marshal and unmarshal lists of objects
-// of type 'value'.
-type valueListData []*valueData
-
 // UnmarshalValueList reads a list of values of the 'value'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalValueList(source interface{}) (list *ValueList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalValueList(source interface{}, options ...helpers.DecodingOptions) (list *ValueList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data valueListData
-	err = decoder.Decode(&data)
+	list = readValueList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'value' value to a JSON document.
-func (l *ValueList) wrap() (data valueListData, err error) {
-	if l == nil {
-		return
+// MarshalValueList writes a list of values of the 'value' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalValueList(list *ValueList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
 	}
-	data = make(valueListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	writeValueList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON array.
+func (l *ValueList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeValueList(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalValueList function,
+// using the default decoding options.
+func (l *ValueList) UnmarshalJSON(data []byte) error {
+	list, err := UnmarshalValueList(data)
+	if err != nil {
+		return err
 	}
-	return
+	if list == nil {
+		*l = ValueList{}
+		return nil
+	}
+	*l = *list
+	return nil
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'value' type.
-func (d valueListData) unwrap() (list *ValueList, err error) {
-	if d == nil {
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *ValueList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *ValueList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// writeValueList writes a list of values of the 'value' type to the given
+// stream, as a JSON array.
+func writeValueList(list *ValueList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Value, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeValue(item, stream)
 	}
-	list = new(ValueList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
+}
+
+// readValueList reads a list of values of the 'value' type from the given
+// iterator, as a JSON array.
+func readValueList(iterator *helpers.Iterator) *ValueList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(ValueList)
+	list.items = []*Value{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readValue(iterator))
+	}
+	return list
 }
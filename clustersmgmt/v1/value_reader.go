@@ -23,63 +23,128 @@ import (
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// valueData is the data structure used internally to marshal and unmarshal
-// objects of type 'value'.
-type valueData struct {
-	Value *float64 "json:\"value,omitempty\""
-	Unit  *string  "json:\"unit,omitempty\""
-}
-
 // MarshalValue writes a value of the 'value' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalValue(object *Value, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeValue(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'value'
-// value to a JSON document.
-func (o *Value) wrap() (data *valueData, err error) {
-	if o == nil {
+// writeValue writes a value of the 'value' type to the given stream.
+func writeValue(object *Value, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(valueData)
-	data.Value = o.value
-	data.Unit = o.unit
-	return
+	stream.WriteObjectStart()
+	if object.value != nil {
+		stream.WriteObjectField("value")
+		stream.WriteFloat64(*object.value)
+	}
+	if object.unit != nil {
+		stream.WriteObjectField("unit")
+		stream.WriteString(*object.unit)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalValue reads a value of the 'value' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalValue(source interface{}) (object *Value, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalValue(source interface{}, options ...helpers.DecodingOptions) (object *Value, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(valueData)
-	err = decoder.Decode(data)
+	object = readValue(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Value)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'value' type.
-func (d *valueData) unwrap() (object *Value, err error) {
-	if d == nil {
-		return
+// readValue reads a value of the 'value' type from the given iterator.
+func readValue(iterator *helpers.Iterator) *Value {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Value)
-	object.value = d.Value
-	object.unit = d.Unit
-	return
+	object := new(Value)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "value":
+			if !iterator.ReadNull() {
+				value := iterator.ReadFloat64()
+				object.value = &value
+			}
+		case "unit":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.unit = &value
+			}
+		default:
+			object.extra = iterator.ReadExtra(object.extra, field)
+		}
+	}
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalValue function.
+func (o *Value) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeValue(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalValue function, using the
+// default decoding options.
+func (o *Value) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalValue(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Value{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Value) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
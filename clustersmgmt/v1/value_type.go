@@ -19,6 +19,12 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"fmt"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // Value represents the values of the 'value' type.
 //
 // Numeric value and the unit used to measure it.
@@ -42,6 +48,7 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 type Value struct {
 	value *float64
 	unit  *string
+	extra map[string]interface{}
 }
 
 // Empty returns true if the object is empty, i.e. no attribute has a value.
@@ -51,6 +58,59 @@ func (o *Value) Empty() bool {
 		true)
 }
 
+// Metadata returns the description of the 'value' type, which can be used to
+// inspect the attributes of objects without using reflection.
+func (o *Value) Metadata() *helpers.TypeMetadata {
+	return valueMetadata
+}
+
+// String returns a readable representation of the object. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (o *Value) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printValue(o, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (o *Value) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printValue(o, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the object is printed with the functions of the fmt package.
+func (o *Value) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printValue(o, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the object, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (o *Value) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printValue(o, printer)
+	}
+}
+
+// Extra returns the attributes of the JSON document that don't correspond to attributes of the
+// 'value' type. They are only populated when the object is decoded in lenient mode, and
+// they are written back when the object is encoded. The result is a copy, so changes to it don't
+// affect the object.
+func (o *Value) Extra() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return helpers.CopyExtra(o.extra)
+}
+
 // Value returns the value of the 'value' attribute, or
 // the zero value of the type if the attribute doesn't have a value.
 //
@@ -97,6 +157,135 @@ func (o *Value) GetUnit() (value string, ok bool) {
 	return
 }
 
+// Copy returns a deep copy of the 'value' object. Modifying the copy doesn't affect
+// the original, and vice versa.
+func (o *Value) Copy() *Value {
+	if o == nil {
+		return nil
+	}
+	result := new(Value)
+	if o.value != nil {
+		result.value = new(float64)
+		*result.value = *o.value
+	}
+	if o.unit != nil {
+		result.unit = new(string)
+		*result.unit = *o.unit
+	}
+	result.extra = helpers.CopyExtra(o.extra)
+	return result
+}
+
+// Equal returns true if this 'value' object and the given one have the same values
+// for all the attributes. Two nil objects are equal, but a nil object isn't equal to an empty one.
+// List attributes are compared like the Empty method does, so nil and empty lists are equal.
+func (o *Value) Equal(other *Value) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.value == nil) != (other.value == nil) ||
+		(o.value != nil && *o.value != *other.value) {
+		return false
+	}
+	if (o.unit == nil) != (other.unit == nil) ||
+		(o.unit != nil && *o.unit != *other.unit) {
+		return false
+	}
+	if !helpers.EqualExtra(o.extra, other.extra) {
+		return false
+	}
+	return true
+}
+
+// Diff returns the attributes that have different values in this 'value' object and
+// the given one, using the same rules than the Equal method. The result is empty if the objects
+// are equal.
+func (o *Value) Diff(other *Value) []helpers.Difference {
+	return o.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this
+// 'value' object and the given one, using the given path as prefix.
+func (o *Value) diff(path string, other *Value, result []helpers.Difference) []helpers.Difference {
+	if o == nil && other == nil {
+		return result
+	}
+	if o == nil || other == nil {
+		difference := helpers.Difference{
+			Path: path,
+		}
+		if o != nil {
+			difference.Old = o
+		}
+		if other != nil {
+			difference.New = other
+		}
+		return append(result, difference)
+	}
+	if (o.value == nil) != (other.value == nil) ||
+		(o.value != nil && *o.value != *other.value) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "value"),
+			Old:  helpers.Value(o.value),
+			New:  helpers.Value(other.value),
+		})
+	}
+	if (o.unit == nil) != (other.unit == nil) ||
+		(o.unit != nil && *o.unit != *other.unit) {
+		result = append(result, helpers.Difference{
+			Path: helpers.Path(path, "unit"),
+			Old:  helpers.Value(o.unit),
+			New:  helpers.Value(other.unit),
+		})
+	}
+	result = helpers.DiffExtra(path, o.extra, other.extra, result)
+	return result
+}
+
+// Changes returns a new 'value' object that contains only the attributes of the modified
+// object that have values different to the values in this object. The result is intended
+// to be used as the body of an update request, so that the attributes that haven't been
+// changed aren't sent to the server. Attributes that have a value in this object but not
+// in the modified object aren't included, use a JSON merge patch to remove them. Lists
+// and maps that are different are included completely.
+func (o *Value) Changes(modified *Value) *Value {
+	if modified == nil {
+		return nil
+	}
+	if o == nil {
+		return modified.Copy()
+	}
+	result := new(Value)
+	if modified.value != nil && (o.value == nil || *o.value != *modified.value) {
+		result.value = new(float64)
+		*result.value = *modified.value
+	}
+	if modified.unit != nil && (o.unit == nil || *o.unit != *modified.unit) {
+		result.unit = new(string)
+		*result.unit = *modified.unit
+	}
+	result.extra = helpers.ChangedExtra(o.extra, modified.extra)
+	return result
+}
+
+// printValue prints a value of the 'value' type to the given printer.
+func printValue(object *Value, printer *helpers.Printer) {
+	if object == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintObjectStart("v1.Value")
+	if object.value != nil {
+		printer.PrintObjectField("Value")
+		printer.PrintFloat64(*object.value)
+	}
+	if object.unit != nil {
+		printer.PrintObjectField("Unit")
+		printer.PrintString(*object.unit)
+	}
+	printer.PrintObjectEnd()
+}
+
 // ValueList is a list of values of the 'value' type.
 type ValueList struct {
 	items []*Value
@@ -168,3 +357,105 @@ func (l *ValueList) Range(f func(index int, item *Value) bool) {
 		}
 	}
 }
+
+// Copy returns a deep copy of the list, including copies of the items. Modifying the copy doesn't
+// affect the original, and vice versa.
+func (l *ValueList) Copy() *ValueList {
+	if l == nil {
+		return nil
+	}
+	result := new(ValueList)
+	if l.items != nil {
+		result.items = make([]*Value, len(l.items))
+		for i, item := range l.items {
+			result.items[i] = item.Copy()
+		}
+	}
+	return result
+}
+
+// Equal returns true if this list and the given one contain equal items, in the same order. Nil and
+// empty lists are equal.
+func (l *ValueList) Equal(other *ValueList) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Get(i).Equal(other.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the differences between the items of this list and the items of the given one,
+// compared by position. The paths of the differences start with the index of the item, for example
+// `[1].name`.
+func (l *ValueList) Diff(other *ValueList) []helpers.Difference {
+	return l.diff("", other, nil)
+}
+
+// diff is the method used internally to add to the given list the differences between this list
+// and the given one, using the given path as prefix.
+func (l *ValueList) diff(path string, other *ValueList, result []helpers.Difference) []helpers.Difference {
+	count := l.Len()
+	if other.Len() > count {
+		count = other.Len()
+	}
+	for i := 0; i < count; i++ {
+		result = l.Get(i).diff(helpers.Index(path, i), other.Get(i), result)
+	}
+	return result
+}
+
+// String returns a readable representation of the list. The values of sensitive attributes,
+// like passwords, are masked. Use the Reveal method to print them.
+func (l *ValueList) String() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{})
+	printValueList(l, printer)
+	return printer.String()
+}
+
+// GoString is like String, but the name of the type is qualified with the name of the package.
+// It is used by the '%#v' verb of the fmt package.
+func (l *ValueList) GoString() string {
+	printer := helpers.NewPrinter(helpers.PrintingOptions{
+		GoSyntax: true,
+	})
+	printValueList(l, printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, so that the values of sensitive attributes are
+// also masked when the list is printed with the functions of the fmt package.
+func (l *ValueList) Format(state fmt.State, verb rune) {
+	write := func(printer *helpers.Printer) {
+		printValueList(l, printer)
+	}
+	helpers.Format(state, verb, write, helpers.PrintingOptions{})
+}
+
+// Reveal returns a value that is printed like the list, but without masking the values of
+// sensitive attributes. Use it only when those values are really needed, never when writing to
+// logs.
+func (l *ValueList) Reveal() helpers.Revealed {
+	return func(printer *helpers.Printer) {
+		printValueList(l, printer)
+	}
+}
+
+// printValueList prints a list of values of the 'value' type to the given printer.
+func printValueList(list *ValueList, printer *helpers.Printer) {
+	if list == nil {
+		printer.PrintNil()
+		return
+	}
+	printer.PrintListStart()
+	for i, item := range list.items {
+		if i > 0 {
+			printer.PrintMore()
+		}
+		printValue(item, printer)
+	}
+	printer.PrintListEnd()
+}
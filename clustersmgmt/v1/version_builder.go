@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
+import (
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
 // VersionBuilder contains the data and logic needed to build 'version' objects.
 //
 // Representation of an _OpenShift_ version.
@@ -28,6 +32,7 @@ type VersionBuilder struct {
 	link     bool
 	enabled  *bool
 	default_ *bool
+	extra    map[string]interface{}
 }
 
 // NewVersion creates a new builder of 'version' objects.
@@ -35,6 +40,23 @@ func NewVersion() *VersionBuilder {
 	return new(VersionBuilder)
 }
 
+// Builder returns a new builder populated with the values of the attributes of this
+// 'version' object, including builders for the nested objects and lists. It can be
+// used to change some of the attributes and then build a modified copy of the object.
+func (o *Version) Builder() *VersionBuilder {
+	if o == nil {
+		return nil
+	}
+	b := new(VersionBuilder)
+	b.id = o.id
+	b.href = o.href
+	b.link = o.link
+	b.enabled = o.enabled
+	b.default_ = o.default_
+	b.extra = helpers.CopyExtra(o.extra)
+	return b
+}
+
 // ID sets the identifier of the object.
 func (b *VersionBuilder) ID(value string) *VersionBuilder {
 	b.id = &value
@@ -71,9 +93,17 @@ func (b *VersionBuilder) Default(value bool) *VersionBuilder {
 	return b
 }
 
+// Extra sets the attributes that don't correspond to attributes of the 'version' type, and
+// that will be written as they are when the object is encoded.
+func (b *VersionBuilder) Extra(value map[string]interface{}) *VersionBuilder {
+	b.extra = value
+	return b
+}
+
 // Build creates a 'version' object using the configuration stored in the builder.
 func (b *VersionBuilder) Build() (object *Version, err error) {
 	object = new(Version)
+	object.extra = helpers.CopyExtra(b.extra)
 	object.id = b.id
 	object.href = b.href
 	object.link = b.link
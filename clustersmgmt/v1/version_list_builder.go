@@ -23,6 +23,8 @@ package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 // 'version' objects.
 type VersionListBuilder struct {
 	items []*VersionBuilder
+	link  bool
+	href  *string
 }
 
 // NewVersionList creates a new builder of 'version' objects.
@@ -30,8 +32,25 @@ func NewVersionList() *VersionListBuilder {
 	return new(VersionListBuilder)
 }
 
+// Builder returns a new builder populated with builders for the items of this list.
+func (l *VersionList) Builder() *VersionListBuilder {
+	if l == nil {
+		return nil
+	}
+	b := new(VersionListBuilder)
+	b.link = l.link
+	b.href = l.href
+	b.items = make([]*VersionBuilder, len(l.items))
+	for i, item := range l.items {
+		b.items[i] = item.Builder()
+	}
+	return b
+}
+
 // Items sets the items of the list.
 func (b *VersionListBuilder) Items(values ...*VersionBuilder) *VersionListBuilder {
+	b.link = false
+	b.href = nil
 	b.items = make([]*VersionBuilder, len(values))
 	copy(b.items, values)
 	return b
@@ -48,6 +67,8 @@ func (b *VersionListBuilder) Build() (list *VersionList, err error) {
 		}
 	}
 	list = new(VersionList)
+	list.link = b.link
+	list.href = b.href
 	list.items = items
 	return
 }
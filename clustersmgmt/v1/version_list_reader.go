@@ -20,130 +20,195 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
+	"bytes"
 
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// versionListData is type used internally to marshal and unmarshal lists of objects
-// of type 'version'.
-type versionListData []*versionData
-
 // UnmarshalVersionList reads a list of values of the 'version'
 // from the given source, which can be a slice of bytes, a string, an io.Reader or a
-// json.Decoder.
-func UnmarshalVersionList(source interface{}) (list *VersionList, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// json.Decoder. The optional decoding options control how JSON fields that don't correspond to
+// attributes of the type are handled.
+func UnmarshalVersionList(source interface{}, options ...helpers.DecodingOptions) (list *VersionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	var data versionListData
-	err = decoder.Decode(&data)
+	list = readVersionList(iterator)
+	err = iterator.Error()
 	if err != nil {
-		return
+		list = nil
 	}
-	list, err = data.unwrap()
 	return
 }
 
-// wrap is the method used internally to convert a list of values of the
-// 'version' value to a JSON document.
-func (l *VersionList) wrap() (data versionListData, err error) {
-	if l == nil {
+// MarshalVersionList writes a list of values of the 'version' type to the given
+// target, which can be a writer or a JSON encoder. The list is written as a JSON
+// array.
+func MarshalVersionList(list *VersionList, target interface{}) error {
+	stream, err := helpers.NewStream(target)
+	if err != nil {
+		return err
+	}
+	writeVersionList(list, stream)
+	return stream.Flush()
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. The list
+// is written as a JSON object containing the kind, the link and the items.
+func (l *VersionList) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeVersionListLink(l, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts JSON arrays, like the UnmarshalVersionList function, and also JSON
+// objects containing the kind, the link and the items, like the ones used for
+// links to lists.
+func (l *VersionList) UnmarshalJSON(data []byte) error {
+	var list *VersionList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		list, err = UnmarshalVersionList(data)
+	} else {
+		list, err = unmarshalVersionListLink(data)
+	}
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		*l = VersionList{}
+		return nil
+	}
+	*l = *list
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (l *VersionList) MarshalYAML() (interface{}, error) {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (l *VersionList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalJSON(data)
+}
+
+// unmarshalVersionListLink reads a list of values of the 'version' type from
+// the given source, using the format of links to lists and of the responses of
+// list methods, where the items are inside the 'items' field of a JSON object.
+func unmarshalVersionListLink(source interface{}, options ...helpers.DecodingOptions) (list *VersionList, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
+	if err != nil {
 		return
 	}
-	data = make(versionListData, len(l.items))
-	for i, item := range l.items {
-		data[i], err = item.wrap()
-		if err != nil {
-			return
-		}
+	list = readVersionListLink(iterator)
+	err = iterator.Error()
+	if err != nil {
+		list = nil
 	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// list of values of the 'version' type.
-func (d versionListData) unwrap() (list *VersionList, err error) {
-	if d == nil {
+// writeVersionList writes a list of values of the 'version' type to the given
+// stream, as a JSON array.
+func writeVersionList(list *VersionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*Version, len(d))
-	for i, item := range d {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	stream.WriteArrayStart()
+	for i, item := range list.items {
+		if i > 0 {
+			stream.WriteMore()
 		}
+		writeVersion(item, stream)
 	}
-	list = new(VersionList)
-	list.items = items
-	return
+	stream.WriteArrayEnd()
 }
 
-// versionListLinkData is type used internally to marshal and unmarshal links
-// to lists of objects of type 'version'.
-type versionListLinkData struct {
-	Kind  *string        "json:\"kind,omitempty\""
-	HREF  *string        "json:\"href,omitempty\""
-	Items []*versionData "json:\"items,omitempty\""
+// readVersionList reads a list of values of the 'version' type from the given
+// iterator, as a JSON array.
+func readVersionList(iterator *helpers.Iterator) *VersionList {
+	if !iterator.ReadArrayStart() {
+		return nil
+	}
+	list := new(VersionList)
+	list.items = []*Version{}
+	for iterator.ReadArrayNext() {
+		list.items = append(list.items, readVersion(iterator))
+	}
+	return list
 }
 
-// wrapLink is the method used internally to convert a list of values of the
-// 'version' value to a link.
-func (l *VersionList) wrapLink() (data *versionListLinkData, err error) {
-	if l == nil {
+// writeVersionListLink writes a list of values of the 'version' type to the
+// given stream, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func writeVersionListLink(list *VersionList, stream *helpers.Stream) {
+	if list == nil {
+		stream.WriteNull()
 		return
 	}
-	items := make([]*versionData, len(l.items))
-	for i, item := range l.items {
-		items[i], err = item.wrap()
-		if err != nil {
-			return
-		}
-	}
-	data = new(versionListLinkData)
-	data.Items = items
-	data.HREF = l.href
-	data.Kind = new(string)
-	if l.link {
-		*data.Kind = VersionListLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if list.link {
+		stream.WriteString(VersionListLinkKind)
 	} else {
-		*data.Kind = VersionListKind
+		stream.WriteString(VersionListKind)
 	}
-	return
+	if list.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*list.href)
+	}
+	if len(list.items) > 0 {
+		stream.WriteObjectField("items")
+		writeVersionList(list, stream)
+	}
+	stream.WriteObjectEnd()
 }
 
-// unwrapLink is the function used internally to convert a JSON link to a list
-// of values of the 'version' type to a list.
-func (d *versionListLinkData) unwrapLink() (list *VersionList, err error) {
-	if d == nil {
-		return
+// readVersionListLink reads a list of values of the 'version' type from the
+// given iterator, using the format of links to lists, where the items are inside the
+// 'items' field of a JSON object.
+func readVersionListLink(iterator *helpers.Iterator) *VersionList {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	items := make([]*Version, len(d.Items))
-	for i, item := range d.Items {
-		items[i], err = item.unwrap()
-		if err != nil {
-			return
+	list := new(VersionList)
+	list.items = []*Version{}
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
 		}
-	}
-	list = new(VersionList)
-	list.items = items
-	list.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case VersionListKind:
-			list.link = false
-		case VersionListLinkKind:
-			list.link = true
+		switch string(field) {
+		case "kind":
+			list.link = iterator.ReadKind(VersionListKind, VersionListLinkKind)
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				list.href = &value
+			}
+		case "items":
+			items := readVersionList(iterator)
+			if items != nil {
+				list.items = items.items
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				VersionListKind,
-				VersionListLinkKind,
-				*d.Kind,
-			)
-			return
+			iterator.SkipField(field)
 		}
 	}
-	return
+	return list
 }
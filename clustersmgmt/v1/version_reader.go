@@ -20,97 +20,157 @@ limitations under the License.
 package v1 // github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1
 
 import (
-	"fmt"
-
 	"github.com/openshift-online/uhc-sdk-go/helpers"
 )
 
-// versionData is the data structure used internally to marshal and unmarshal
-// objects of type 'version'.
-type versionData struct {
-	Kind    *string "json:\"kind,omitempty\""
-	ID      *string "json:\"id,omitempty\""
-	HREF    *string "json:\"href,omitempty\""
-	Enabled *bool   "json:\"enabled,omitempty\""
-	Default *bool   "json:\"default,omitempty\""
-}
-
 // MarshalVersion writes a value of the 'version' to the given target,
 // which can be a writer or a JSON encoder.
 func MarshalVersion(object *Version, target interface{}) error {
-	encoder, err := helpers.NewEncoder(target)
-	if err != nil {
-		return err
-	}
-	data, err := object.wrap()
+	stream, err := helpers.NewStream(target)
 	if err != nil {
 		return err
 	}
-	return encoder.Encode(data)
+	writeVersion(object, stream)
+	return stream.Flush()
 }
 
-// wrap is the method used internally to convert a value of the 'version'
-// value to a JSON document.
-func (o *Version) wrap() (data *versionData, err error) {
-	if o == nil {
+// writeVersion writes a value of the 'version' type to the given stream.
+func writeVersion(object *Version, stream *helpers.Stream) {
+	if object == nil {
+		stream.WriteNull()
 		return
 	}
-	data = new(versionData)
-	data.ID = o.id
-	data.HREF = o.href
-	data.Kind = new(string)
-	if o.link {
-		*data.Kind = VersionLinkKind
+	stream.WriteObjectStart()
+	stream.WriteObjectField("kind")
+	if object.link {
+		stream.WriteString(VersionLinkKind)
 	} else {
-		*data.Kind = VersionKind
+		stream.WriteString(VersionKind)
 	}
-	data.Enabled = o.enabled
-	data.Default = o.default_
-	return
+	if object.id != nil {
+		stream.WriteObjectField("id")
+		stream.WriteString(*object.id)
+	}
+	if object.href != nil {
+		stream.WriteObjectField("href")
+		stream.WriteString(*object.href)
+	}
+	if object.enabled != nil {
+		stream.WriteObjectField("enabled")
+		stream.WriteBool(*object.enabled)
+	}
+	if object.default_ != nil {
+		stream.WriteObjectField("default")
+		stream.WriteBool(*object.default_)
+	}
+	stream.WriteExtra(object.extra)
+	stream.WriteObjectEnd()
 }
 
 // UnmarshalVersion reads a value of the 'version' type from the given
-// source, which can be an slice of bytes, a string, a reader or a JSON decoder.
-func UnmarshalVersion(source interface{}) (object *Version, err error) {
-	decoder, err := helpers.NewDecoder(source)
+// source, which can be an slice of bytes, a string, a reader or a JSON decoder. The optional
+// decoding options control how JSON fields that don't correspond to attributes of the type are
+// handled.
+func UnmarshalVersion(source interface{}, options ...helpers.DecodingOptions) (object *Version, err error) {
+	iterator, err := helpers.NewIterator(source, options...)
 	if err != nil {
 		return
 	}
-	data := new(versionData)
-	err = decoder.Decode(data)
+	object = readVersion(iterator)
+	err = iterator.Error()
 	if err != nil {
+		object = nil
 		return
 	}
-	object, err = data.unwrap()
+	if object == nil {
+		// A null document is read as an empty object.
+		object = new(Version)
+	}
 	return
 }
 
-// unwrap is the function used internally to convert the JSON unmarshalled data to a
-// value of the 'version' type.
-func (d *versionData) unwrap() (object *Version, err error) {
-	if d == nil {
-		return
+// readVersion reads a value of the 'version' type from the given iterator.
+func readVersion(iterator *helpers.Iterator) *Version {
+	if !iterator.ReadObjectStart() {
+		return nil
 	}
-	object = new(Version)
-	object.id = d.ID
-	object.href = d.HREF
-	if d.Kind != nil {
-		switch *d.Kind {
-		case VersionKind:
-			object.link = false
-		case VersionLinkKind:
-			object.link = true
+	object := new(Version)
+	for {
+		field, ok := iterator.ReadObjectField()
+		if !ok {
+			break
+		}
+		switch string(field) {
+		case "kind":
+			object.link = iterator.ReadKind(VersionKind, VersionLinkKind)
+		case "id":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.id = &value
+			}
+		case "href":
+			if !iterator.ReadNull() {
+				value := iterator.ReadString()
+				object.href = &value
+			}
+		case "enabled":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.enabled = &value
+			}
+		case "default":
+			if !iterator.ReadNull() {
+				value := iterator.ReadBool()
+				object.default_ = &value
+			}
 		default:
-			err = fmt.Errorf(
-				"expected kind '%s' or '%s' but got '%s'",
-				VersionKind,
-				VersionLinkKind,
-				*d.Kind,
-			)
-			return
+			object.extra = iterator.ReadExtra(object.extra, field)
 		}
 	}
-	object.enabled = d.Enabled
-	object.default_ = d.Default
-	return
+	return object
+}
+
+// MarshalJSON is the implementation of the json.Marshaler interface. It
+// generates the same JSON document as the MarshalVersion function.
+func (o *Version) MarshalJSON() ([]byte, error) {
+	stream := new(helpers.Stream)
+	writeVersion(o, stream)
+	return stream.Bytes()
+}
+
+// UnmarshalJSON is the implementation of the json.Unmarshaler interface. It
+// accepts the same JSON documents as the UnmarshalVersion function, using the
+// default decoding options.
+func (o *Version) UnmarshalJSON(data []byte) error {
+	object, err := UnmarshalVersion(data)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		*o = Version{}
+		return nil
+	}
+	*o = *object
+	return nil
+}
+
+// MarshalYAML is the implementation of the yaml.Marshaler interface. The
+// document uses the same attribute names as the JSON representation.
+func (o *Version) MarshalYAML() (interface{}, error) {
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.YAMLValue(data)
+}
+
+// UnmarshalYAML is the implementation of the yaml.Unmarshaler interface. It
+// accepts documents that use the same attribute names as the JSON
+// representation.
+func (o *Version) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data, err := helpers.YAMLToJSON(unmarshal)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
 }
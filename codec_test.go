@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the token based JSON encoder and decoder used by the model types.
+
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing/iotest"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Codec", func() {
+	It("Writes the same bytes as the encoding/json package", func() {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("<my&cluster>\u2028").
+			DisplayName("\"quoted\" \\ é 日本").
+			MultiAZ(false).
+			Properties(map[string]string{
+				"z": "last",
+				"a": "first",
+			}).
+			CreationTimestamp(time.Date(2019, 7, 1, 10, 20, 30, 123000000, time.UTC)).
+			Metrics(
+				cmv1.NewClusterMetrics().Memory(
+					cmv1.NewClusterMetric().
+						Used(cmv1.NewValue().Value(1.5e-7)).
+						Total(cmv1.NewValue().Value(1e21)),
+				),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		buffer := new(bytes.Buffer)
+		err = cmv1.MarshalCluster(cluster, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal(
+			`{"kind":"Cluster","id":"123",` +
+				`"name":"\u003cmy\u0026cluster\u003e\u2028",` +
+				`"multi_az":false,` +
+				`"display_name":"\"quoted\" \\ é 日本",` +
+				`"properties":{"a":"first","z":"last"},` +
+				`"creation_timestamp":"2019-07-01T10:20:30.123Z",` +
+				`"metrics":{"memory":{"total":{"value":1e+21},"used":{"value":1.5e-7}}}}` +
+				"\n",
+		))
+	})
+
+	It("Writes to JSON encoders", func() {
+		nodes, err := cmv1.NewClusterNodes().Compute(3).Build()
+		Expect(err).ToNot(HaveOccurred())
+		buffer := new(bytes.Buffer)
+		encoder := json.NewEncoder(buffer)
+		encoder.SetIndent("", "  ")
+		err = cmv1.MarshalClusterNodes(nodes, encoder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("{\n  \"compute\": 3\n}\n"))
+	})
+
+	It("Writes list of structs as array", func() {
+		list, err := cmv1.NewClusterNodesList().
+			Items(
+				cmv1.NewClusterNodes().Compute(3),
+				cmv1.NewClusterNodes().Infra(2),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		buffer := new(bytes.Buffer)
+		err = cmv1.MarshalClusterNodesList(list, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal(`[{"compute":3},{"infra":2}]` + "\n"))
+	})
+
+	It("Reads the same from slow readers and from slices", func() {
+		data := benchmarkClusterList(20)
+		expected, err := cmv1.UnmarshalClusterList(data)
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := cmv1.UnmarshalClusterList(
+			iotest.OneByteReader(bytes.NewReader(data)),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+		Expect(actual.Len()).To(Equal(20))
+		Expect(actual.Get(19).Name()).To(Equal("mycluster-19"))
+	})
+
+	It("Decodes escaped strings", func() {
+		cluster, err := cmv1.UnmarshalCluster(
+			`{"name": "aé😀\n\"b\"", "name_x": "x"}`,
+			helpers.DecodingOptions{
+				Mode: helpers.LenientDecoding,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("aé😀\n\"b\""))
+		data, err := json.Marshal(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"kind": "Cluster", "name": "aé😀\n\"b\"", "name_x": "x"}`))
+	})
+
+	It("Reads null document as empty object", func() {
+		cluster, err := cmv1.UnmarshalCluster(`null`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster).ToNot(BeNil())
+		Expect(cluster.Empty()).To(BeTrue())
+	})
+
+	DescribeTable(
+		"Reports the path of type errors",
+		func(text string, path string, message string) {
+			_, err := cmv1.UnmarshalCluster(text)
+			Expect(err).To(HaveOccurred())
+			typeErr, ok := err.(*helpers.TypeError)
+			Expect(ok).To(BeTrue())
+			Expect(typeErr.Path).To(Equal(path))
+			Expect(typeErr.Error()).To(Equal(message))
+		},
+		Entry(
+			"Top level attribute",
+			`{"name": 123}`,
+			"name",
+			"json: cannot unmarshal number into attribute 'name' of type string",
+		),
+		Entry(
+			"Nested attribute",
+			`{"nodes": {"compute": "three"}}`,
+			"nodes.compute",
+			"json: cannot unmarshal string into attribute 'nodes.compute' of type int",
+		),
+		Entry(
+			"Attribute inside list",
+			`{"groups": {"items": [{"id": "a"}, {"id": true}]}}`,
+			"groups.items.id",
+			"json: cannot unmarshal bool into attribute 'groups.items.id' of type string",
+		),
+		Entry(
+			"Whole document",
+			`[]`,
+			"",
+			"json: cannot unmarshal array into value of type object",
+		),
+	)
+
+	It("Reports the path of unknown fields in strict mode", func() {
+		_, err := cmv1.UnmarshalCluster(
+			`{"groups": {"items": [{"id": "a"}, {"id": "b", "admin": true}]}}`,
+			helpers.DecodingOptions{
+				Mode: helpers.StrictDecoding,
+			},
+		)
+		Expect(err).To(HaveOccurred())
+		fieldErr, ok := err.(*helpers.UnknownFieldError)
+		Expect(ok).To(BeTrue())
+		Expect(fieldErr.Field).To(Equal("admin"))
+		Expect(fieldErr.Path).To(Equal("groups.items.1.admin"))
+		Expect(fieldErr.Error()).To(Equal(`json: unknown field "admin"`))
+	})
+
+	DescribeTable(
+		"Reports syntax errors",
+		func(text string, message string) {
+			cluster, err := cmv1.UnmarshalCluster(text)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+			Expect(cluster).To(BeNil())
+		},
+		Entry("Empty document", ``, "EOF"),
+		Entry("Truncated document", `{"kind":`, "unexpected EOF"),
+		Entry("Trailing comma", `{"kind":"Cluster",}`, "invalid character '}'"),
+	)
+})
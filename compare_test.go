@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the methods that copy and compare model types.
+
+package sdk
+
+import (
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Compare", func() {
+	// Cluster used by most of the tests:
+	const clusterJSON = `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "mycluster",
+		"nodes": {
+			"compute": 3
+		},
+		"properties": {
+			"owner": "me"
+		},
+		"groups": {
+			"kind": "GroupListLink",
+			"items": [
+				{
+					"kind": "Group",
+					"id": "456"
+				}
+			]
+		}
+	}`
+
+	var cluster *cmv1.Cluster
+
+	BeforeEach(func() {
+		var err error
+		cluster, err = cmv1.UnmarshalCluster(clusterJSON)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Copy is equal to the original", func() {
+		copy := cluster.Copy()
+		Expect(copy).ToNot(BeIdenticalTo(cluster))
+		Expect(copy.Equal(cluster)).To(BeTrue())
+		Expect(cluster.Equal(copy)).To(BeTrue())
+		Expect(copy.Diff(cluster)).To(BeEmpty())
+	})
+
+	It("Copy of nil is nil", func() {
+		var nilCluster *cmv1.Cluster
+		Expect(nilCluster.Copy()).To(BeNil())
+	})
+
+	It("Modifying the copy doesn't affect the original", func() {
+		copy := cluster.Copy()
+		copy.Properties()["owner"] = "you"
+		Expect(cluster.Properties()).To(HaveKeyWithValue("owner", "me"))
+	})
+
+	It("Detects changed scalar attribute", func() {
+		other, err := cmv1.UnmarshalCluster(`{
+			"kind": "Cluster",
+			"id": "123",
+			"name": "yourcluster",
+			"nodes": {
+				"compute": 3
+			},
+			"properties": {
+				"owner": "me"
+			},
+			"groups": {
+				"kind": "GroupListLink",
+				"items": [
+					{
+						"kind": "Group",
+						"id": "456"
+					}
+				]
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Equal(other)).To(BeFalse())
+		Expect(cluster.Diff(other)).To(ConsistOf(helpers.Difference{
+			Path: "name",
+			Old:  "mycluster",
+			New:  "yourcluster",
+		}))
+	})
+
+	It("Detects changed nested attribute", func() {
+		other, err := cmv1.UnmarshalCluster(`{
+			"kind": "Cluster",
+			"id": "123",
+			"name": "mycluster",
+			"nodes": {
+				"compute": 4
+			},
+			"properties": {
+				"owner": "me"
+			},
+			"groups": {
+				"kind": "GroupListLink",
+				"items": [
+					{
+						"kind": "Group",
+						"id": "789"
+					}
+				]
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Diff(other)).To(ConsistOf(
+			helpers.Difference{
+				Path: "nodes.compute",
+				Old:  3,
+				New:  4,
+			},
+			helpers.Difference{
+				Path: "groups[0].id",
+				Old:  "456",
+				New:  "789",
+			},
+		))
+	})
+
+	It("Detects changed map keys", func() {
+		other, err := cmv1.UnmarshalCluster(`{
+			"kind": "Cluster",
+			"id": "123",
+			"name": "mycluster",
+			"nodes": {
+				"compute": 3
+			},
+			"properties": {
+				"color": "blue"
+			},
+			"groups": {
+				"kind": "GroupListLink",
+				"items": [
+					{
+						"kind": "Group",
+						"id": "456"
+					}
+				]
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Diff(other)).To(Equal([]helpers.Difference{
+			{
+				Path: "properties[color]",
+				New:  "blue",
+			},
+			{
+				Path: "properties[owner]",
+				Old:  "me",
+			},
+		}))
+	})
+
+	It("Nil and empty lists are equal", func() {
+		a, err := cmv1.NewCluster().Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewCluster().Groups().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a.Equal(b)).To(BeTrue())
+		Expect(a.Diff(b)).To(BeEmpty())
+	})
+
+	It("Nil and empty objects aren't equal", func() {
+		a, err := cmv1.NewCluster().Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewCluster().Nodes(cmv1.NewClusterNodes()).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a.Equal(b)).To(BeFalse())
+		Expect(a.Diff(b)).To(HaveLen(1))
+		Expect(a.Diff(b)[0].Path).To(Equal("nodes"))
+	})
+
+	It("Compares lists by position", func() {
+		a, err := cmv1.NewClusterList().
+			Items(
+				cmv1.NewCluster().ID("123"),
+				cmv1.NewCluster().ID("456"),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		b, err := cmv1.NewClusterList().
+			Items(
+				cmv1.NewCluster().ID("123"),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a.Copy().Equal(a)).To(BeTrue())
+		Expect(a.Equal(b)).To(BeFalse())
+		diff := a.Diff(b)
+		Expect(diff).To(HaveLen(1))
+		Expect(diff[0].Path).To(Equal("[1]"))
+		Expect(diff[0].New).To(BeNil())
+	})
+})
@@ -598,6 +598,53 @@ func (c *Connection) ClustersMgmt() *clustersmgmt.Client {
 	return clustersmgmt.NewClient(c, "/api/clusters_mgmt", "/api/clusters_mgmt")
 }
 
+// Route returns the typed client that corresponds to the given link, together with the
+// identifiers extracted from it. The link can be a path or a complete URL, for example the HREF of
+// an object or of an error. For example, for the link
+// `/api/clusters_mgmt/v1/clusters/123/identity_providers/abc` it returns a
+// *v1.IdentityProviderClient and the identifiers `123` and `abc`. An error is returned if the
+// link doesn't correspond to any resource known by the SDK.
+func (c *Connection) Route(href string) (client interface{}, ids []string, err error) {
+	return route(c, href)
+}
+
+// route returns the typed client that corresponds to the given link, using the given transport to
+// create it.
+func route(transport http.RoundTripper, href string) (client interface{}, ids []string, err error) {
+	parsed, err := url.Parse(href)
+	if err != nil {
+		err = fmt.Errorf("can't parse link '%s': %v", href, err)
+		return
+	}
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			err = fmt.Errorf("link '%s' contains empty path segments", href)
+			return
+		}
+	}
+	if len(segments) < 2 || segments[0] != "api" {
+		err = fmt.Errorf("link '%s' doesn't start with the '/api' prefix and a service name", href)
+		return
+	}
+	switch segments[1] {
+	case "accounts_mgmt":
+		client, ids, err = accountsmgmt.NewClient(
+			transport, "/api/accounts_mgmt", "/api/accounts_mgmt",
+		).Route(segments[2:])
+	case "clusters_mgmt":
+		client, ids, err = clustersmgmt.NewClient(
+			transport, "/api/clusters_mgmt", "/api/clusters_mgmt",
+		).Route(segments[2:])
+	default:
+		err = fmt.Errorf("can't find service '%s'", segments[1])
+	}
+	if err != nil {
+		err = fmt.Errorf("can't route link '%s': %v", href, err)
+	}
+	return
+}
+
 // Close releases all the resources used by the connection. It is very important to allways close it
 // once it is no longer needed, as otherwise those resources may be leaked. Trying to use a
 // connection that has been closed will result in a error.
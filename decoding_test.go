@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the strict and lenient decoding modes of the model types.
+
+package sdk
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	// nolint
+	. "github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Decoding", func() {
+	// Cluster containing attributes that the SDK doesn't know:
+	const clusterJSON = `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "mycluster",
+		"color": "blue",
+		"nodes": {
+			"compute": 3,
+			"gpu": 2
+		},
+		"groups": {
+			"kind": "GroupListLink",
+			"items": [
+				{
+					"kind": "Group",
+					"id": "456",
+					"admin": true
+				}
+			]
+		}
+	}`
+
+	It("Ignores unknown attributes by default", func() {
+		cluster, err := cmv1.UnmarshalCluster(clusterJSON)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("mycluster"))
+		Expect(cluster.Extra()).To(BeNil())
+		Expect(cluster.Nodes().Extra()).To(BeNil())
+	})
+
+	It("Rejects unknown attributes in strict mode", func() {
+		_, err := cmv1.UnmarshalCluster(clusterJSON, helpers.DecodingOptions{
+			Mode: helpers.StrictDecoding,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("color"))
+	})
+
+	It("Accepts known attributes in strict mode", func() {
+		cluster, err := cmv1.UnmarshalCluster(
+			`{
+				"kind": "Cluster",
+				"id": "123",
+				"name": "mycluster"
+			}`,
+			helpers.DecodingOptions{
+				Mode: helpers.StrictDecoding,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("mycluster"))
+	})
+
+	It("Rejects unknown attributes of list items in strict mode", func() {
+		_, err := cmv1.UnmarshalClusterList(
+			`[
+				{
+					"kind": "Cluster",
+					"id": "123",
+					"color": "blue"
+				}
+			]`,
+			helpers.DecodingOptions{
+				Mode: helpers.StrictDecoding,
+			},
+		)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Keeps unknown attributes in lenient mode", func() {
+		cluster, err := cmv1.UnmarshalCluster(clusterJSON, helpers.DecodingOptions{
+			Mode: helpers.LenientDecoding,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("mycluster"))
+		Expect(cluster.Extra()).To(Equal(map[string]interface{}{
+			"color": "blue",
+		}))
+		Expect(cluster.Nodes().Compute()).To(Equal(3))
+		Expect(cluster.Nodes().Extra()).To(Equal(map[string]interface{}{
+			"gpu": 2.0,
+		}))
+		Expect(cluster.Groups().Get(0).Extra()).To(Equal(map[string]interface{}{
+			"admin": true,
+		}))
+	})
+
+	It("Returns a copy of the extra attributes", func() {
+		cluster, err := cmv1.UnmarshalCluster(clusterJSON, helpers.DecodingOptions{
+			Mode: helpers.LenientDecoding,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		extra := cluster.Extra()
+		extra["color"] = "red"
+		extra["size"] = "big"
+		Expect(cluster.Extra()).To(Equal(map[string]interface{}{
+			"color": "blue",
+		}))
+	})
+
+	It("Rejects unknown kind by default", func() {
+		_, err := cmv1.UnmarshalCluster(`{
+			"kind": "ClusterSummary",
+			"id": "123"
+		}`)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ClusterSummary"))
+	})
+
+	It("Ignores unknown kind in lenient mode", func() {
+		cluster, err := cmv1.UnmarshalCluster(
+			`{
+				"kind": "ClusterSummary",
+				"id": "123"
+			}`,
+			helpers.DecodingOptions{
+				Mode: helpers.LenientDecoding,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.ID()).To(Equal("123"))
+		Expect(cluster.Link()).To(BeFalse())
+	})
+
+	It("Writes back unknown attributes kept in lenient mode", func() {
+		cluster, err := cmv1.UnmarshalCluster(clusterJSON, helpers.DecodingOptions{
+			Mode: helpers.LenientDecoding,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		buffer := new(bytes.Buffer)
+		err = cmv1.MarshalCluster(cluster, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(clusterJSON))
+	})
+
+	It("Writes extra attributes set with the builder", func() {
+		cluster, err := cmv1.NewCluster().
+			Name("mycluster").
+			Extra(map[string]interface{}{
+				"color": "blue",
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		buffer := new(bytes.Buffer)
+		err = cmv1.MarshalCluster(cluster, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`{
+			"kind": "Cluster",
+			"name": "mycluster",
+			"color": "blue"
+		}`))
+	})
+
+	Describe("Responses", func() {
+		// Servers used during the tests:
+		var oidServer *Server
+		var apiServer *Server
+
+		// Connection used during the tests:
+		var connection *Connection
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the tokens:
+			accessToken := DefaultToken("Bearer", 5*time.Minute)
+			refreshToken := DefaultToken("Refresh", 10*time.Hour)
+
+			// Create the OpenID server:
+			oidServer = NewServer()
+			oidServer.AppendHandlers(
+				CombineHandlers(
+					RespondWithTokens(accessToken, refreshToken),
+				),
+			)
+
+			// Create the API server:
+			apiServer = NewServer()
+
+			// Create the connection:
+			logger, err := NewStdLoggerBuilder().
+				Streams(GinkgoWriter, GinkgoWriter).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			connection, err = NewConnectionBuilder().
+				Logger(logger).
+				TokenURL(oidServer.URL()).
+				URL(apiServer.URL()).
+				Tokens(refreshToken).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			// Stop the servers:
+			oidServer.Close()
+			apiServer.Close()
+
+			// Close the connection:
+			err := connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Keeps unknown attributes of list items in lenient mode", func() {
+			apiServer.AppendHandlers(
+				RespondWithJSONTemplate(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"color": "blue"
+							}
+						]
+					}`,
+				),
+			)
+			response, err := connection.ClustersMgmt().V1().Clusters().List().
+				Decoding(helpers.DecodingOptions{
+					Mode: helpers.LenientDecoding,
+				}).
+				Send()
+			Expect(err).ToNot(HaveOccurred())
+			cluster := response.Items().Get(0)
+			Expect(cluster.ID()).To(Equal("123"))
+			Expect(cluster.Extra()).To(HaveKeyWithValue("color", "blue"))
+		})
+
+		It("Rejects unknown attributes of response body in strict mode", func() {
+			apiServer.AppendHandlers(
+				RespondWithJSONTemplate(
+					http.StatusOK,
+					`{
+						"kind": "Cluster",
+						"id": "123",
+						"color": "blue"
+					}`,
+				),
+			)
+			_, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().
+				Decoding(helpers.DecodingOptions{
+					Mode: helpers.StrictDecoding,
+				}).
+				Send()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -100,7 +100,7 @@ func (c *Connection) dumpResponse(ctx context.Context, response *http.Response,
 // format suitable for that content type.
 func (c *Connection) dumpBody(ctx context.Context, header http.Header, body []byte) {
 	switch header.Get("Content-Type") {
-	case "application/json", "":
+	case "application/json", "application/merge-patch+json", "":
 		c.dumpJSON(ctx, body)
 	default:
 		c.dumpBytes(ctx, body)
@@ -20,9 +20,15 @@ limitations under the License.
 package errors // github.com/openshift-online/uhc-sdk-go/errors
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"runtime/debug"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/golang/glog"
 	"github.com/openshift-online/uhc-sdk-go/helpers"
@@ -40,6 +46,9 @@ type ErrorBuilder struct {
 	href   *string
 	code   *string
 	reason *string
+	status int
+	header http.Header
+	body   []byte
 }
 
 // Error represents errors.
@@ -48,6 +57,9 @@ type Error struct {
 	href   *string
 	code   *string
 	reason *string
+	status int
+	header http.Header
+	body   []byte
 }
 
 // NewError returns a new ErrorBuilder
@@ -79,6 +91,24 @@ func (e *ErrorBuilder) Reason(reason string) *ErrorBuilder {
 	return e
 }
 
+// Status sets the HTTP status code of the response that contained the error.
+func (e *ErrorBuilder) Status(status int) *ErrorBuilder {
+	e.status = status
+	return e
+}
+
+// Header sets the HTTP header of the response that contained the error.
+func (e *ErrorBuilder) Header(header http.Header) *ErrorBuilder {
+	e.header = header
+	return e
+}
+
+// Body sets the raw body of the response that contained the error.
+func (e *ErrorBuilder) Body(body []byte) *ErrorBuilder {
+	e.body = body
+	return e
+}
+
 // Build builds a new error type or returns an error.
 func (e *ErrorBuilder) Build() (*Error, error) {
 	err := new(Error)
@@ -86,6 +116,9 @@ func (e *ErrorBuilder) Build() (*Error, error) {
 	err.code = e.code
 	err.id = e.id
 	err.href = e.href
+	err.status = e.status
+	err.header = e.header
+	err.body = e.body
 	return err, nil
 }
 
@@ -169,6 +202,42 @@ func (e *Error) GetReason() (value string, ok bool) {
 	return
 }
 
+// Status returns the HTTP status code of the response that contained the error, or zero if the
+// error wasn't read from a response.
+func (e *Error) Status() int {
+	if e == nil {
+		return 0
+	}
+	return e.status
+}
+
+// Header returns the HTTP header of the response that contained the error, or nil if the error
+// wasn't read from a response.
+func (e *Error) Header() http.Header {
+	if e == nil {
+		return nil
+	}
+	return e.header
+}
+
+// ContentType returns the value of the `Content-Type` header of the response that contained the
+// error, or the empty string if the error wasn't read from a response.
+func (e *Error) ContentType() string {
+	if e == nil || e.header == nil {
+		return ""
+	}
+	return e.header.Get("Content-Type")
+}
+
+// Body returns the raw body of the response that contained the error. Note that for errors that
+// weren't read from a valid JSON error document the body is truncated to a maximum of 4 KiB.
+func (e *Error) Body() []byte {
+	if e == nil {
+		return nil
+	}
+	return e.body
+}
+
 // Error is the implementation of the error interface.
 func (e *Error) Error() string {
 	if e.reason != nil {
@@ -199,6 +268,71 @@ func UnmarshalError(source interface{}) (object *Error, err error) {
 	return
 }
 
+// ReadError reads an error from the body of the given HTTP response. If the body contains a valid
+// JSON error document the result will be that error, with the status code, header and body of the
+// response added. Otherwise, for example when a gateway returns an HTML page, the result will be a
+// synthetic error containing the status code, the header and the body of the response, truncated
+// to 4 KiB, so that callers always get a meaningful error. At most 1 MiB of the body is read. An
+// error is returned only when the body of the response can't be read.
+func ReadError(response *http.Response) (object *Error, err error) {
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, maxReadSize))
+	if err != nil {
+		return
+	}
+	object, err = UnmarshalError(body)
+	if err == nil && object != nil && (object.id != nil || object.code != nil || object.reason != nil) {
+		object.status = response.StatusCode
+		object.header = response.Header
+		object.body = body
+		return
+	}
+	if len(body) > maxBodySize {
+		body = body[:maxBodySize]
+	}
+	object = newResponseError(response.StatusCode, response.Header, body)
+	err = nil
+	return
+}
+
+// newResponseError creates the synthetic error that is used when a response doesn't contain a
+// valid JSON error document.
+func newResponseError(status int, header http.Header, body []byte) *Error {
+	id := strconv.Itoa(status)
+	reason := fmt.Sprintf("Unexpected response with status code %d", status)
+	contentType := header.Get("Content-Type")
+	if contentType != "" {
+		reason = fmt.Sprintf("%s and content type '%s'", reason, contentType)
+	}
+	summary := strings.Join(strings.Fields(string(body)), " ")
+	if len(summary) > maxSummarySize {
+		// Don't split multi-byte characters:
+		end := maxSummarySize
+		for end > 0 && !utf8.RuneStart(summary[end]) {
+			end--
+		}
+		summary = summary[:end] + "..."
+	}
+	if summary != "" {
+		reason = fmt.Sprintf("%s: %s", reason, summary)
+	}
+	return &Error{
+		id:     &id,
+		reason: &reason,
+		status: status,
+		header: header,
+		body:   bytes.TrimSpace(body),
+	}
+}
+
+// Maximum number of bytes of the body of a response that are read in order to parse the error,
+// maximum number of bytes that are kept in errors that aren't valid JSON error documents, and
+// maximum size of the summary of that body that is added to the reason:
+const (
+	maxReadSize    = 1 << 20
+	maxBodySize    = 4096
+	maxSummarySize = 200
+)
+
 // MarshalError writes an error to the given destination which can be an slice of bytes, a
 // string, a reader or a JSON decoder.
 func (e *Error) MarshalError(destination interface{}) error {
@@ -275,28 +409,58 @@ var panicError, _ = NewError().
 		"for details").
 	Build()
 
-// SendError writes a given error and status code to a response writer.
+// SendError writes a given error and status code to a response writer. The status code is the
+// value of the Status attribute of the error, or the identifier if the status isn't set.
 // if an error occured it will log the error and exit.
 // This methods is used internaly and no backwards compatibily is guaranteed.
 func SendError(w http.ResponseWriter, r *http.Request, error *Error) {
-	w.Header().Set("Content-Type", "application/json")
-	status, err := strconv.Atoi(error.ID())
-	if err != nil {
-		SendPanic(w, r)
-		return
+	status := error.Status()
+	if status == 0 {
+		id, err := strconv.Atoi(error.ID())
+		if err != nil {
+			SendPanic(w, r)
+			return
+		}
+		status = id
 	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
-	err = error.MarshalError(w)
+	err := error.MarshalError(w)
 	if err != nil {
 		glog.Errorf("Can't send response body for request '%s'", r.URL.Path)
 		return
 	}
 }
 
+// SendMethodError sends to the client the error returned by the given server method. Errors that
+// are already of type *Error are sent unchanged, validation errors, like the ones returned by the
+// Validate methods of the request bodies, are sent with status code 400 and any other error is
+// sent with status code 500.
+// This methods is used internaly and no backwards compatibily is guaranteed.
+func SendMethodError(w http.ResponseWriter, r *http.Request, method string, err error) {
+	body, ok := err.(*Error)
+	if ok && body != nil {
+		SendError(w, r, body)
+		return
+	}
+	reason := fmt.Sprintf("An error occured while trying to run method %s: %v", method, err)
+	id := "500"
+	if _, invalid := err.(*helpers.ValidationError); invalid {
+		reason = fmt.Sprintf("The request isn't valid: %v", err)
+		id = "400"
+	}
+	body, _ = NewError().
+		ID(id).
+		Reason(reason).
+		Build()
+	SendError(w, r, body)
+}
+
 // SendPanic sends a panic error response to the client, but it doesn't end the process.
 // This methods is used internaly and no backwards compatibily is guaranteed.
 func SendPanic(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
 	// Convert it to JSON:
 	err := panicError.MarshalError(w)
 	if err != nil {
@@ -307,3 +471,43 @@ func SendPanic(w http.ResponseWriter, r *http.Request) {
 		)
 	}
 }
+
+// SendNotFound sends an error response to the client indicating that the requested resource
+// doesn't exist.
+// This methods is used internaly and no backwards compatibily is guaranteed.
+func SendNotFound(w http.ResponseWriter, r *http.Request) {
+	reason := fmt.Sprintf("Can't find resource for path '%s'", r.URL.Path)
+	body, _ := NewError().
+		ID("404").
+		Reason(reason).
+		Build()
+	SendError(w, r, body)
+}
+
+// SendMethodNotAllowed sends an error response to the client indicating that the requested
+// resource doesn't support the method of the request.
+// This methods is used internaly and no backwards compatibily is guaranteed.
+func SendMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	reason := fmt.Sprintf("Method '%s' isn't supported for path '%s'", r.Method, r.URL.Path)
+	body, _ := NewError().
+		ID("405").
+		Reason(reason).
+		Build()
+	SendError(w, r, body)
+}
+
+// RecoverPanic recovers from a panic that happened while processing the given request, writes the
+// value and the stack trace to the log, and sends a panic error response to the client. It must be
+// called with defer at the beginning of the handler.
+// This methods is used internaly and no backwards compatibily is guaranteed.
+func RecoverPanic(w http.ResponseWriter, r *http.Request) {
+	value := recover()
+	if value == nil {
+		return
+	}
+	glog.Errorf(
+		"Panic while processing request '%s': %v\n%s",
+		r.URL.Path, value, debug.Stack(),
+	)
+	SendPanic(w, r)
+}
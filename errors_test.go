@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the errors returned by the generated clients.
+
+package sdk
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	// nolint
+	. "github.com/onsi/gomega/ghttp"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+)
+
+var _ = Describe("Errors", func() {
+	// Servers used during the tests:
+	var oidServer *Server
+	var apiServer *Server
+
+	// Connection used during the tests:
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the tokens:
+		accessToken := DefaultToken("Bearer", 5*time.Minute)
+		refreshToken := DefaultToken("Refresh", 10*time.Hour)
+
+		// Create the OpenID server:
+		oidServer = NewServer()
+		oidServer.AppendHandlers(
+			CombineHandlers(
+				RespondWithTokens(accessToken, refreshToken),
+			),
+		)
+
+		// Create the API server:
+		apiServer = NewServer()
+
+		// Create the logger:
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Debug(true).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Tokens(refreshToken).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Stop the servers:
+		oidServer.Close()
+		apiServer.Close()
+
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Reads JSON error", func() {
+		// Configure the server:
+		apiServer.AppendHandlers(
+			RespondWith(
+				http.StatusNotFound,
+				`{
+					"kind": "Error",
+					"id": "404",
+					"href": "/api/clusters_mgmt/v1/errors/404",
+					"code": "CLUSTERS-MGMT-404",
+					"reason": "Cluster '123' not found"
+				}`,
+				http.Header{
+					"Content-Type": []string{"application/json"},
+					"X-Request-Id": []string{"abc"},
+				},
+			),
+		)
+
+		// Send the request:
+		response, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.Status()).To(Equal(http.StatusNotFound))
+		apiErr, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr).To(BeIdenticalTo(response.Error()))
+		Expect(apiErr.ID()).To(Equal("404"))
+		Expect(apiErr.Code()).To(Equal("CLUSTERS-MGMT-404"))
+		Expect(apiErr.Reason()).To(Equal("Cluster '123' not found"))
+		Expect(apiErr.Status()).To(Equal(http.StatusNotFound))
+		Expect(apiErr.Header().Get("X-Request-Id")).To(Equal("abc"))
+	})
+
+	It("Preserves HTML error page", func() {
+		// Configure the server:
+		apiServer.AppendHandlers(
+			RespondWith(
+				http.StatusBadGateway,
+				`<html><body><h1>502 Bad Gateway</h1></body></html>`,
+				http.Header{
+					"Content-Type": []string{"text/html"},
+					"X-Request-Id": []string{"abc"},
+				},
+			),
+		)
+
+		// Send the request:
+		_, err := connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.ID()).To(Equal("502"))
+		Expect(apiErr.Status()).To(Equal(http.StatusBadGateway))
+		Expect(apiErr.ContentType()).To(Equal("text/html"))
+		Expect(apiErr.Header().Get("X-Request-Id")).To(Equal("abc"))
+		Expect(string(apiErr.Body())).To(ContainSubstring("502 Bad Gateway"))
+		Expect(apiErr.Error()).To(ContainSubstring("502"))
+		Expect(apiErr.Error()).To(ContainSubstring("text/html"))
+		Expect(apiErr.Error()).ToNot(ContainSubstring("invalid character"))
+	})
+
+	It("Preserves empty error body", func() {
+		// Configure the server:
+		apiServer.AppendHandlers(
+			RespondWith(http.StatusServiceUnavailable, nil),
+		)
+
+		// Send the request:
+		_, err := connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.Status()).To(Equal(http.StatusServiceUnavailable))
+		Expect(apiErr.Body()).To(BeEmpty())
+	})
+
+	It("Truncates large error body", func() {
+		// Configure the server:
+		body := make([]byte, 10000)
+		for i := range body {
+			body[i] = 'x'
+		}
+		apiServer.AppendHandlers(
+			RespondWith(
+				http.StatusBadGateway,
+				body,
+				http.Header{
+					"Content-Type": []string{"text/plain"},
+				},
+			),
+		)
+
+		// Send the request:
+		_, err := connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+		Expect(len(apiErr.Body())).To(Equal(4096))
+		Expect(len(apiErr.Error())).To(BeNumerically("<", 300))
+	})
+	It("Parses error document larger than the kept body", func() {
+		// Configure the server:
+		reason := strings.Repeat("x", 10000)
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusBadRequest,
+				`{
+					"kind": "Error",
+					"id": "400",
+					"reason": "{{ .Reason }}"
+				}`,
+				"Reason", reason,
+			),
+		)
+
+		// Send the request:
+		_, err := connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.ID()).To(Equal("400"))
+		Expect(apiErr.Reason()).To(Equal(reason))
+	})
+
+	It("Doesn't split characters when truncating the summary", func() {
+		// Configure the server:
+		apiServer.AppendHandlers(
+			RespondWith(
+				http.StatusBadGateway,
+				"x"+strings.Repeat("é", 1000),
+				http.Header{
+					"Content-Type": []string{"text/plain"},
+				},
+			),
+		)
+
+		// Send the request:
+		_, err := connection.ClustersMgmt().V1().Clusters().List().Send()
+		Expect(err).To(HaveOccurred())
+		Expect(utf8.ValidString(err.Error())).To(BeTrue())
+		Expect(err.Error()).To(HaveSuffix("é..."))
+	})
+})
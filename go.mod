@@ -12,5 +12,5 @@ require (
 	github.com/prometheus/procfs v0.0.0-20190516194456-169873baca24 // indirect
 	golang.org/x/net v0.0.0-20190322120337-addf6b3196f6 // indirect
 	golang.org/x/sys v0.0.0-20190322080309-f49334f85ddc // indirect
-	gopkg.in/yaml.v2 v2.2.2 // indirect
+	gopkg.in/yaml.v2 v2.2.2
 )
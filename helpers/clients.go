@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // AddValue creates the given set of query parameters if needed, an then adds
@@ -66,6 +67,29 @@ func SetHeader(header http.Header, metric string) http.Header {
 	return result
 }
 
+// MetricPath calculates the path that should be used to report metrics for requests sent to, or
+// received for, the given path. The path is matched against the trees of resources of the
+// registered services: the names of the resources are preserved and the identifiers of the items
+// of collections are replaced with dashes, as they would have a practically unlimited number of
+// values. For example, the metrics path for `/api/clusters_mgmt/v1/clusters/123/groups/admins` is
+// `/api/clusters_mgmt/v1/clusters/-/groups/-`. The paths where the services are mounted, and
+// their parents, like `/api`, are preserved. Any other path is replaced by `/-`, so that the
+// number of different values is bounded.
+func MetricPath(path string) string {
+	path = "/" + strings.Trim(path, "/")
+	for prefix, root := range services {
+		if prefix == path || strings.HasPrefix(prefix, path+"/") {
+			return path
+		}
+		segments, ok := Segments(path, prefix)
+		if !ok {
+			continue
+		}
+		return root.metricPath(prefix, segments)
+	}
+	return "/-"
+}
+
 // CopyValues copies a slice of strings.
 func CopyValues(values []string) []string {
 	if values == nil {
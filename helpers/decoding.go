@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+// DecodingMode indicates how JSON fields that don't correspond to attributes of the model types
+// are handled when decoding objects.
+type DecodingMode int
+
+const (
+	// DefaultDecoding ignores the JSON fields that don't correspond to attributes of the model
+	// types. This is the mode used when no decoding options are given.
+	DefaultDecoding DecodingMode = iota
+
+	// StrictDecoding rejects the JSON documents that contain fields that don't correspond to
+	// attributes of the model types. This is intended for tests, where it helps to detect
+	// differences between the server and the SDK.
+	StrictDecoding
+
+	// LenientDecoding keeps the JSON fields that don't correspond to attributes of the model types
+	// in the extra attributes of the objects, so that they are written back when the objects are
+	// encoded again. This is intended for read-modify-write cycles where the server may be newer
+	// than the SDK.
+	LenientDecoding
+)
+
+// DecodingOptions contains the options that control how JSON documents are decoded into objects
+// of the model types.
+type DecodingOptions struct {
+	// Mode indicates how JSON fields that don't correspond to attributes of the model types
+	// are handled.
+	Mode DecodingMode
+}
+
+// Field returns the value of the field of the given generic JSON object, or nil if the value isn't
+// an object or doesn't have that field.
+func Field(raw interface{}, name string) interface{} {
+	object, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return object[name]
+}
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Difference describes an attribute that has different values in two objects.
+type Difference struct {
+	// Path is the path of the attribute, using the JSON names of the attributes, for example
+	// `nodes.compute`, `groups[1].id` or `properties[owner]`. It is empty when the difference is
+	// that one of the objects is nil and the other isn't.
+	Path string
+
+	// Old is the value of the attribute in the first object, or nil if it doesn't have a value.
+	Old interface{}
+
+	// New is the value of the attribute in the second object, or nil if it doesn't have a value.
+	New interface{}
+}
+
+// String generates a human readable representation of the difference.
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %v -> %v", d.Path, d.Old, d.New)
+}
+
+// Path returns the path of the attribute with the given name inside the object with the given
+// path.
+func Path(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// Index returns the path of the item with the given index inside the list with the given path.
+func Index(path string, index int) string {
+	return path + "[" + strconv.Itoa(index) + "]"
+}
+
+// Key returns the path of the value with the given key inside the map with the given path.
+func Key(path string, key string) string {
+	return path + "[" + key + "]"
+}
+
+// Value returns the value that the given pointer points to, or nil if the pointer is nil.
+func Value(pointer interface{}) interface{} {
+	value := reflect.ValueOf(pointer)
+	if value.Kind() != reflect.Ptr {
+		return pointer
+	}
+	if value.IsNil() {
+		return nil
+	}
+	return value.Elem().Interface()
+}
+
+// CopyExtra returns a deep copy of the given generic JSON attributes.
+func CopyExtra(extra map[string]interface{}) map[string]interface{} {
+	if extra == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(extra))
+	for name, value := range extra {
+		result[name] = copyGeneric(value)
+	}
+	return result
+}
+
+func copyGeneric(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		return CopyExtra(typed)
+	case []interface{}:
+		result := make([]interface{}, len(typed))
+		for i, item := range typed {
+			result[i] = copyGeneric(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// EqualStrings returns true if the given maps of strings are equal. Nil and empty maps are
+// considered equal.
+func EqualStrings(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		otherValue, ok := b[key]
+		if !ok || value != otherValue {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualExtra returns true if the given generic JSON attributes are equal. Nil and empty sets of
+// attributes are considered equal.
+func EqualExtra(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// DiffExtra adds to the given list the differences between the given generic JSON attributes of
+// the object with the given path, in alphabetical order.
+func DiffExtra(path string, a, b map[string]interface{}, result []Difference) []Difference {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		old, new := a[name], b[name]
+		if !reflect.DeepEqual(old, new) {
+			result = append(result, Difference{
+				Path: Path(path, name),
+				Old:  old,
+				New:  new,
+			})
+		}
+	}
+	return result
+}
+
+// ChangedExtra returns a copy of the generic JSON attributes of the modified object that don't
+// have the same value in the original object, or nil if there are no such attributes.
+func ChangedExtra(original, modified map[string]interface{}) map[string]interface{} {
+	var result map[string]interface{}
+	for name, value := range modified {
+		if reflect.DeepEqual(original[name], value) {
+			continue
+		}
+		if result == nil {
+			result = map[string]interface{}{}
+		}
+		result[name] = copyGeneric(value)
+	}
+	return result
+}
@@ -0,0 +1,1123 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// iteratorBufferSize is the size of the buffer used when reading from an io.Reader.
+const iteratorBufferSize = 4096
+
+// Iterator reads a JSON document token by token. It is used by the generated code to read the
+// model types directly, without intermediate data structures and without reflection. Don't create
+// instances of this type directly, use the NewIterator function instead.
+//
+// The methods don't return errors. Instead the first error is saved and all the following reads
+// return zero values. Use the Error method to check it once the value has been read.
+type Iterator struct {
+	reader  io.Reader
+	buffer  []byte
+	head    int
+	tail    int
+	offset  int64
+	eof     bool
+	started bool
+	first   bool
+	mode    DecodingMode
+	scratch []byte
+	fields  []iteratorField
+	err     error
+}
+
+// iteratorField contains the name of one of the fields of the path that leads to the value that is
+// being read. The name is kept as a slice of the buffer, and copied to a string only when the
+// buffer is going to be overwritten. For arrays it contains the position of the item instead.
+type iteratorField struct {
+	view  []byte
+	name  string
+	array bool
+	index int
+}
+
+// TypeError is the error returned when a JSON value can't be stored in the attribute that
+// corresponds to it, for example when the JSON document contains a string and the attribute is an
+// integer.
+type TypeError struct {
+	// Value describes the JSON value, for example `string` or `number 1.5`.
+	Value string
+
+	// Type is the type of the attribute, for example `int` or `object`.
+	Type string
+
+	// Path is the path of the attribute, using dots as separators, for example `nodes.compute`.
+	// Positions of items of arrays aren't included.
+	Path string
+
+	// Offset is the number of bytes of the input that were read before the value.
+	Offset int64
+}
+
+// Error is the implementation of the error interface.
+func (e *TypeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("json: cannot unmarshal %s into value of type %s", e.Value, e.Type)
+	}
+	return fmt.Sprintf(
+		"json: cannot unmarshal %s into attribute '%s' of type %s",
+		e.Value, e.Path, e.Type,
+	)
+}
+
+// UnknownFieldError is the error returned in strict mode when a JSON document contains a field that
+// doesn't correspond to any attribute.
+type UnknownFieldError struct {
+	// Field is the name of the field.
+	Field string
+
+	// Path is the path of the field, including its name and using dots as separators, for
+	// example `nodes.gpu`. Positions of items of arrays are included, for example
+	// `groups.items.0.admin`.
+	Path string
+
+	// Offset is the number of bytes of the input that were read before the value of the field.
+	Offset int64
+}
+
+// Error is the implementation of the error interface.
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("json: unknown field %q", e.Field)
+}
+
+// NewIterator creates an iterator that reads the JSON document from the given source, which can be
+// a slice of bytes, a string, a reader or a JSON decoder. When the source is a reader the document
+// is read incrementally, using a buffer of limited size. The optional decoding options control how
+// JSON fields that don't correspond to attributes of the model types are handled. When more than
+// one set of options is given the last one is used.
+func NewIterator(source interface{}, options ...DecodingOptions) (iterator *Iterator, err error) {
+	iterator = new(Iterator)
+	switch input := source.(type) {
+	case []byte:
+		iterator.buffer = input
+		iterator.tail = len(input)
+		iterator.eof = true
+	case string:
+		iterator.buffer = []byte(input)
+		iterator.tail = len(input)
+		iterator.eof = true
+	case *json.Decoder:
+		var raw json.RawMessage
+		err = input.Decode(&raw)
+		if err != nil {
+			iterator = nil
+			return
+		}
+		iterator.buffer = raw
+		iterator.tail = len(raw)
+		iterator.eof = true
+	case io.Reader:
+		iterator.reader = input
+		iterator.buffer = make([]byte, iteratorBufferSize)
+	default:
+		err = fmt.Errorf(
+			"expected bytes, string, reader or JSON decoder, but got %T",
+			input,
+		)
+		iterator = nil
+		return
+	}
+	if len(options) > 0 {
+		iterator.mode = options[len(options)-1].Mode
+	}
+	return
+}
+
+// Error returns the first error that happened while reading the document, or nil if there was no
+// error.
+func (i *Iterator) Error() error {
+	return i.err
+}
+
+// SetError saves the given error, unless there is already one. Once an error has been saved all
+// the following reads return zero values.
+func (i *Iterator) SetError(err error) {
+	if i.err == nil {
+		i.err = err
+	}
+}
+
+// ReadNull checks if the next value is null. If it is it consumes it and returns true. Otherwise
+// it doesn't consume anything and returns false.
+func (i *Iterator) ReadNull() bool {
+	c, ok := i.begin()
+	if !ok || c != 'n' {
+		return false
+	}
+	i.readLiteral("null")
+	return i.err == nil
+}
+
+// ReadObjectStart reads the beginning of an object. It returns true if the next value is an
+// object, and then the fields should be read with the ReadObjectField method. It returns false if
+// the next value is null or if there is an error.
+func (i *Iterator) ReadObjectStart() bool {
+	c, ok := i.begin()
+	if !ok {
+		return false
+	}
+	switch c {
+	case '{':
+		i.head++
+		i.first = true
+		i.fields = append(i.fields, iteratorField{})
+		return true
+	case 'n':
+		i.readLiteral("null")
+		return false
+	default:
+		i.typeError(c, "", "object")
+		return false
+	}
+}
+
+// ReadObjectField reads the name of the next field of the current object, and the colon that
+// follows it. It returns false when there are no more fields or if there is an error. The returned
+// slice is valid only till the next call to any of the read methods.
+func (i *Iterator) ReadObjectField() (field []byte, ok bool) {
+	c, ok := i.next()
+	if !ok {
+		return
+	}
+	if i.first {
+		i.first = false
+		if c == '}' {
+			i.endObject()
+			ok = false
+			return
+		}
+	} else {
+		switch c {
+		case '}':
+			i.endObject()
+			ok = false
+			return
+		case ',':
+			i.head++
+			c, ok = i.next()
+			if !ok {
+				return
+			}
+		default:
+			i.syntaxError(c, "after object key:value pair")
+			ok = false
+			return
+		}
+	}
+	if c != '"' {
+		i.syntaxError(c, "looking for beginning of object key string")
+		ok = false
+		return
+	}
+	field, escaped := i.readStringBytes()
+	if i.err != nil {
+		field = nil
+		ok = false
+		return
+	}
+	current := &i.fields[len(i.fields)-1]
+	if escaped {
+		current.view = nil
+		current.name = string(field)
+	} else {
+		current.view = field
+		current.name = ""
+	}
+	c, ok = i.next()
+	if !ok {
+		field = nil
+		return
+	}
+	if c != ':' {
+		i.syntaxError(c, "after object key")
+		field = nil
+		ok = false
+		return
+	}
+	i.head++
+
+	// The buffer may have been overwritten while looking for the colon:
+	if !escaped && current.view == nil {
+		field = []byte(current.name)
+	}
+	return
+}
+
+// ReadArrayStart reads the beginning of an array. It returns true if the next value is an array,
+// and then the items should be read calling the ReadArrayNext method before each of them. It
+// returns false if the next value is null or if there is an error.
+func (i *Iterator) ReadArrayStart() bool {
+	c, ok := i.begin()
+	if !ok {
+		return false
+	}
+	switch c {
+	case '[':
+		i.head++
+		i.first = true
+		i.fields = append(i.fields, iteratorField{
+			array: true,
+			index: -1,
+		})
+		return true
+	case 'n':
+		i.readLiteral("null")
+		return false
+	default:
+		i.typeError(c, "", "array")
+		return false
+	}
+}
+
+// ReadArrayNext checks if the current array has more items. It returns true if it has, and then
+// the item should be read. It returns false when there are no more items or if there is an error.
+func (i *Iterator) ReadArrayNext() bool {
+	c, ok := i.next()
+	if !ok {
+		return false
+	}
+	if i.first {
+		i.first = false
+		if c == ']' {
+			i.endArray()
+			return false
+		}
+		i.fields[len(i.fields)-1].index++
+		return true
+	}
+	switch c {
+	case ']':
+		i.endArray()
+		return false
+	case ',':
+		i.head++
+		i.fields[len(i.fields)-1].index++
+		return true
+	default:
+		i.syntaxError(c, "after array element")
+		return false
+	}
+}
+
+// ReadString reads a string. It returns an empty string if the value is null.
+func (i *Iterator) ReadString() string {
+	c, ok := i.begin()
+	if !ok {
+		return ""
+	}
+	switch c {
+	case '"':
+		value, _ := i.readStringBytes()
+		return string(value)
+	case 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "string")
+	}
+	return ""
+}
+
+// ReadKind reads the value of the `kind` field of an object, and checks that it is one of the two
+// given kinds. It returns true if it is the link kind and false otherwise. In lenient mode other
+// kinds are ignored, as they may have been added by a server newer than the SDK, and the object is
+// treated as a complete object.
+func (i *Iterator) ReadKind(kind, linkKind string) bool {
+	c, ok := i.begin()
+	if !ok {
+		return false
+	}
+	switch c {
+	case '"':
+		value, _ := i.readStringBytes()
+		switch string(value) {
+		case kind:
+			return false
+		case linkKind:
+			return true
+		default:
+			if i.mode != LenientDecoding {
+				i.SetError(fmt.Errorf(
+					"expected kind '%s' or '%s' but got '%s'",
+					kind, linkKind, value,
+				))
+			}
+		}
+	case 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "string")
+	}
+	return false
+}
+
+// ReadBool reads a boolean. It returns false if the value is null.
+func (i *Iterator) ReadBool() bool {
+	c, ok := i.begin()
+	if !ok {
+		return false
+	}
+	switch c {
+	case 't':
+		i.readLiteral("true")
+		return i.err == nil
+	case 'f':
+		i.readLiteral("false")
+	case 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "bool")
+	}
+	return false
+}
+
+// ReadInt reads an integer number. It returns zero if the value is null.
+func (i *Iterator) ReadInt() int {
+	c, ok := i.begin()
+	if !ok {
+		return 0
+	}
+	switch {
+	case c == '-' || c >= '0' && c <= '9':
+		text := i.readNumberBytes()
+		if text == nil {
+			return 0
+		}
+		value, ok := parseInt(text)
+		if !ok {
+			i.typeError(c, string(text), "int")
+		}
+		return value
+	case c == 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "int")
+	}
+	return 0
+}
+
+// ReadFloat64 reads a floating point number. It returns zero if the value is null.
+func (i *Iterator) ReadFloat64() float64 {
+	c, ok := i.begin()
+	if !ok {
+		return 0
+	}
+	switch {
+	case c == '-' || c >= '0' && c <= '9':
+		text := i.readNumberBytes()
+		if text == nil {
+			return 0
+		}
+		value, ok := parseFloat(text)
+		if !ok {
+			i.typeError(c, string(text), "float64")
+		}
+		return value
+	case c == 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "float64")
+	}
+	return 0
+}
+
+// ReadTime reads a time written as a string using the RFC3339 format. It returns the zero time if
+// the value is null.
+func (i *Iterator) ReadTime() time.Time {
+	c, ok := i.begin()
+	if !ok {
+		return time.Time{}
+	}
+	switch c {
+	case '"':
+		text, _ := i.readStringBytes()
+		if i.err != nil {
+			return time.Time{}
+		}
+		value, err := time.Parse(time.RFC3339, string(text))
+		if err != nil {
+			i.SetError(err)
+		}
+		return value
+	case 'n':
+		i.readLiteral("null")
+	default:
+		i.typeError(c, "", "time.Time")
+	}
+	return time.Time{}
+}
+
+// ReadStringSlice reads an array of strings. It returns nil if the value is null.
+func (i *Iterator) ReadStringSlice() []string {
+	if !i.ReadArrayStart() {
+		return nil
+	}
+	values := []string{}
+	for i.ReadArrayNext() {
+		values = append(values, i.ReadString())
+	}
+	return values
+}
+
+// ReadStringMap reads an object whose values are strings. It returns nil if the value is null.
+func (i *Iterator) ReadStringMap() map[string]string {
+	if !i.ReadObjectStart() {
+		return nil
+	}
+	values := map[string]string{}
+	for {
+		field, ok := i.ReadObjectField()
+		if !ok {
+			break
+		}
+		name := string(field)
+		values[name] = i.ReadString()
+	}
+	return values
+}
+
+// ReadAny reads any JSON value, and returns the same generic representation that the
+// encoding/json package uses for the interface{} type: strings, float64 numbers, booleans, nil,
+// slices of interface{} and maps of interface{}.
+func (i *Iterator) ReadAny() interface{} {
+	c, ok := i.begin()
+	if !ok {
+		return nil
+	}
+	switch {
+	case c == '"':
+		return i.ReadString()
+	case c == '{':
+		values := map[string]interface{}{}
+		i.ReadObjectStart()
+		for {
+			field, ok := i.ReadObjectField()
+			if !ok {
+				break
+			}
+			name := string(field)
+			values[name] = i.ReadAny()
+		}
+		return values
+	case c == '[':
+		values := []interface{}{}
+		i.ReadArrayStart()
+		for i.ReadArrayNext() {
+			values = append(values, i.ReadAny())
+		}
+		return values
+	case c == 't' || c == 'f':
+		return i.ReadBool()
+	case c == 'n':
+		i.readLiteral("null")
+		return nil
+	case c == '-' || c >= '0' && c <= '9':
+		return i.ReadFloat64()
+	default:
+		i.syntaxError(c, "looking for beginning of value")
+		return nil
+	}
+}
+
+// Skip reads the next value, checking that it is syntactically correct, and discards it.
+func (i *Iterator) Skip() {
+	c, ok := i.begin()
+	if !ok {
+		return
+	}
+	switch {
+	case c == '"':
+		i.readStringBytes()
+	case c == '{':
+		i.ReadObjectStart()
+		for {
+			_, ok := i.ReadObjectField()
+			if !ok {
+				break
+			}
+			i.Skip()
+		}
+	case c == '[':
+		i.ReadArrayStart()
+		for i.ReadArrayNext() {
+			i.Skip()
+		}
+	case c == 't':
+		i.readLiteral("true")
+	case c == 'f':
+		i.readLiteral("false")
+	case c == 'n':
+		i.readLiteral("null")
+	case c == '-' || c >= '0' && c <= '9':
+		i.readNumberBytes()
+	default:
+		i.syntaxError(c, "looking for beginning of value")
+	}
+}
+
+// SkipField discards the value of a field that doesn't correspond to any attribute. In strict
+// mode it saves an error instead.
+func (i *Iterator) SkipField(field []byte) {
+	if i.mode == StrictDecoding {
+		i.unknownFieldError(field)
+		return
+	}
+	i.Skip()
+}
+
+// ReadExtra processes the value of a field that doesn't correspond to any attribute, according to
+// the decoding mode. In lenient mode it reads the value and adds it to the given map of extra
+// attributes, creating it if needed. In strict mode it saves an error. Otherwise it discards the
+// value. It returns the resulting map.
+func (i *Iterator) ReadExtra(extra map[string]interface{},
+	field []byte) map[string]interface{} {
+	switch i.mode {
+	case LenientDecoding:
+		name := string(field)
+		value := i.ReadAny()
+		if extra == nil {
+			extra = map[string]interface{}{}
+		}
+		extra[name] = value
+	case StrictDecoding:
+		i.unknownFieldError(field)
+	default:
+		i.Skip()
+	}
+	return extra
+}
+
+// begin skips white space and returns the first character of the next value, without consuming
+// it.
+func (i *Iterator) begin() (c byte, ok bool) {
+	if i.err != nil {
+		return
+	}
+	return i.next()
+}
+
+// next skips white space and returns the next character, without consuming it. It returns false
+// if there is an error or if the end of the input has been reached.
+func (i *Iterator) next() (c byte, ok bool) {
+	if i.err != nil {
+		return
+	}
+	for {
+		for i.head < i.tail {
+			c = i.buffer[i.head]
+			switch c {
+			case ' ', '\t', '\r', '\n':
+				i.head++
+			default:
+				i.started = true
+				ok = true
+				return
+			}
+		}
+		if !i.fill() {
+			i.endError()
+			return
+		}
+	}
+}
+
+// fill reads more data from the reader. The bytes of the buffer starting with the current
+// position are preserved, but moved to the beginning of the buffer, so positions relative to the
+// buffer need to be adjusted by the caller. Returns false if there is no more data.
+func (i *Iterator) fill() bool {
+	if i.eof || i.err != nil {
+		return false
+	}
+
+	// Names of fields that point to the buffer need to be copied before the buffer is
+	// overwritten:
+	for j := range i.fields {
+		field := &i.fields[j]
+		if field.view != nil {
+			field.name = string(field.view)
+			field.view = nil
+		}
+	}
+
+	// Move the pending bytes to the beginning of the buffer, and make it larger if it is full:
+	pending := i.tail - i.head
+	if pending == len(i.buffer) {
+		buffer := make([]byte, 2*len(i.buffer))
+		copy(buffer, i.buffer[i.head:i.tail])
+		i.buffer = buffer
+	} else if i.head > 0 {
+		copy(i.buffer, i.buffer[i.head:i.tail])
+	}
+	i.offset += int64(i.head)
+	i.head = 0
+	i.tail = pending
+
+	// Read till we get some data or the end of the input:
+	for {
+		n, err := i.reader.Read(i.buffer[i.tail:])
+		i.tail += n
+		if err == io.EOF {
+			i.eof = true
+			return n > 0
+		}
+		if err != nil {
+			i.SetError(err)
+			return false
+		}
+		if n > 0 {
+			return true
+		}
+	}
+}
+
+// readStringBytes reads a string, assuming that the next character is the opening quote. If the
+// string doesn't contain escape sequences or invalid UTF-8 sequences the result is a slice of the
+// buffer. Otherwise the result is decoded into the scratch buffer and the returned flag is true.
+// In both cases the result is valid only till the next read.
+func (i *Iterator) readStringBytes() (value []byte, escaped bool) {
+	i.head++
+	j := i.head
+	ascii := true
+	for {
+		if j >= i.tail {
+			start := i.head
+			if !i.fill() {
+				i.endError()
+				return
+			}
+			j -= start
+			continue
+		}
+		c := i.buffer[j]
+		switch {
+		case c == '"':
+			value = i.buffer[i.head:j]
+			i.head = j + 1
+			if !ascii && !utf8.Valid(value) {
+				value = i.unquote(value)
+				escaped = true
+			}
+			return
+		case c == '\\':
+			value, escaped = i.readEscapedStringBytes(j), true
+			return
+		case c < ' ':
+			i.head = j
+			i.syntaxError(c, "in string literal")
+			return
+		case c >= utf8.RuneSelf:
+			ascii = false
+		}
+		j++
+	}
+}
+
+// readEscapedStringBytes reads the rest of a string that contains escape sequences. The given
+// position is the position of the first backslash. The result is decoded into the scratch buffer.
+func (i *Iterator) readEscapedStringBytes(j int) []byte {
+	for {
+		if j >= i.tail {
+			start := i.head
+			if !i.fill() {
+				i.endError()
+				return nil
+			}
+			j -= start
+			continue
+		}
+		c := i.buffer[j]
+		switch {
+		case c == '"':
+			value := i.unquote(i.buffer[i.head:j])
+			i.head = j + 1
+			return value
+		case c == '\\':
+			// Skip the escaped character, so that an escaped quote isn't taken as the end of
+			// the string:
+			j++
+		case c < ' ':
+			i.head = j
+			i.syntaxError(c, "in string literal")
+			return nil
+		}
+		j++
+	}
+}
+
+// unquote decodes the escape sequences and replaces the invalid UTF-8 sequences of the given
+// string, which doesn't contain the quotes. The result is stored in the scratch buffer.
+func (i *Iterator) unquote(text []byte) []byte {
+	result := i.scratch[:0]
+	for j := 0; j < len(text); {
+		c := text[j]
+		switch {
+		case c == '\\':
+			if j+1 >= len(text) {
+				i.syntaxError(c, "in string escape code")
+				return nil
+			}
+			e := text[j+1]
+			j += 2
+			switch e {
+			case '"', '\\', '/':
+				result = append(result, e)
+			case 'b':
+				result = append(result, '\b')
+			case 'f':
+				result = append(result, '\f')
+			case 'n':
+				result = append(result, '\n')
+			case 'r':
+				result = append(result, '\r')
+			case 't':
+				result = append(result, '\t')
+			case 'u':
+				r, ok := parseHex(text[j:])
+				if !ok {
+					i.syntaxError(e, "in \\u hexadecimal character escape")
+					return nil
+				}
+				j += 4
+				if utf16.IsSurrogate(r) {
+					r2, ok := rune(-1), false
+					if j+6 <= len(text) && text[j] == '\\' && text[j+1] == 'u' {
+						r2, ok = parseHex(text[j+2:])
+					}
+					decoded := utf16.DecodeRune(r, r2)
+					if ok && decoded != utf8.RuneError {
+						r = decoded
+						j += 6
+					} else {
+						r = utf8.RuneError
+					}
+				}
+				result = appendRune(result, r)
+			default:
+				i.syntaxError(e, "in string escape code")
+				return nil
+			}
+		case c < utf8.RuneSelf:
+			result = append(result, c)
+			j++
+		default:
+			r, size := utf8.DecodeRune(text[j:])
+			if r == utf8.RuneError && size == 1 {
+				result = appendRune(result, utf8.RuneError)
+			} else {
+				result = append(result, text[j:j+size]...)
+			}
+			j += size
+		}
+	}
+	i.scratch = result
+	return result
+}
+
+// readNumberBytes reads a number, checking that it is syntactically correct. The result is a
+// slice of the buffer that is valid only till the next read.
+func (i *Iterator) readNumberBytes() []byte {
+	j := i.head
+	for {
+		if j >= i.tail {
+			start := i.head
+			if !i.fill() {
+				if i.err != nil {
+					return nil
+				}
+				break
+			}
+			j -= start
+			continue
+		}
+		c := i.buffer[j]
+		if c >= '0' && c <= '9' || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E' {
+			j++
+			continue
+		}
+		break
+	}
+	text := i.buffer[i.head:j]
+	if !validNumber(text) {
+		i.head = j
+		if j < i.tail {
+			i.syntaxError(i.buffer[j], "in numeric literal")
+		} else {
+			i.endError()
+		}
+		return nil
+	}
+	i.head = j
+	return text
+}
+
+// readLiteral reads the given literal, which can be `true`, `false` or `null`.
+func (i *Iterator) readLiteral(literal string) {
+	for i.tail-i.head < len(literal) {
+		if !i.fill() {
+			i.endError()
+			return
+		}
+	}
+	for j := 0; j < len(literal); j++ {
+		c := i.buffer[i.head+j]
+		if c != literal[j] {
+			i.head += j
+			i.syntaxError(c, fmt.Sprintf(
+				"in literal %s (expecting %s)",
+				literal, quoteChar(literal[j]),
+			))
+			return
+		}
+	}
+	i.head += len(literal)
+}
+
+// endObject consumes the closing brace of an object and removes its field from the path.
+func (i *Iterator) endObject() {
+	i.head++
+	i.fields = i.fields[:len(i.fields)-1]
+}
+
+// endArray consumes the closing bracket of an array and removes it from the path.
+func (i *Iterator) endArray() {
+	i.head++
+	i.fields = i.fields[:len(i.fields)-1]
+}
+
+// path returns the path of the value that is currently being read. The positions of the items of
+// arrays are included only if requested.
+func (i *Iterator) path(positions bool) string {
+	names := make([]string, 0, len(i.fields))
+	for _, field := range i.fields {
+		switch {
+		case field.array:
+			if positions {
+				names = append(names, strconv.Itoa(field.index))
+			}
+		case field.view != nil:
+			names = append(names, string(field.view))
+		case field.name != "":
+			names = append(names, field.name)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// unknownFieldError saves an error indicating that the given field, which is the one currently
+// being read, doesn't correspond to any attribute.
+func (i *Iterator) unknownFieldError(field []byte) {
+	i.SetError(&UnknownFieldError{
+		Field:  string(field),
+		Path:   i.path(true),
+		Offset: i.offset + int64(i.head),
+	})
+}
+
+// typeError saves an error indicating that the value that starts with the given character can't be
+// stored in a value of the given type. The text is used for numbers, to include them in the
+// message.
+func (i *Iterator) typeError(c byte, text string, kind string) {
+	var value string
+	switch {
+	case c == '"':
+		value = "string"
+	case c == '{':
+		value = "object"
+	case c == '[':
+		value = "array"
+	case c == 't' || c == 'f':
+		value = "bool"
+	case c == '-' || c >= '0' && c <= '9':
+		value = "number"
+		if text != "" {
+			value += " " + text
+		}
+	default:
+		i.syntaxError(c, "looking for beginning of value")
+		return
+	}
+	i.SetError(&TypeError{
+		Value:  value,
+		Type:   kind,
+		Path:   i.path(false),
+		Offset: i.offset + int64(i.head),
+	})
+}
+
+// syntaxError saves an error indicating that the given character is invalid in the given context.
+func (i *Iterator) syntaxError(c byte, context string) {
+	i.SetError(fmt.Errorf("invalid character %s %s", quoteChar(c), context))
+}
+
+// endError saves the error that corresponds to the end of the input. It is io.EOF if the input
+// was empty and io.ErrUnexpectedEOF otherwise.
+func (i *Iterator) endError() {
+	if i.started {
+		i.SetError(io.ErrUnexpectedEOF)
+	} else {
+		i.SetError(io.EOF)
+	}
+}
+
+// quoteChar formats the given character the same way that the encoding/json package does it in
+// error messages.
+func quoteChar(c byte) string {
+	if c == '\'' {
+		return `'\''`
+	}
+	if c == '"' {
+		return `'"'`
+	}
+	s := strconv.Quote(string(c))
+	return "'" + s[1:len(s)-1] + "'"
+}
+
+// appendRune appends the UTF-8 encoding of the given rune to the given slice.
+func appendRune(data []byte, r rune) []byte {
+	var buffer [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buffer[:], r)
+	return append(data, buffer[:n]...)
+}
+
+// parseHex parses the four hexadecimal digits at the beginning of the given text.
+func parseHex(text []byte) (r rune, ok bool) {
+	if len(text) < 4 {
+		return
+	}
+	for _, c := range text[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return
+		}
+		r = r*16 + rune(c)
+	}
+	ok = true
+	return
+}
+
+// parseInt parses an integer number. It returns false if the text contains a fraction or an
+// exponent, or if the value doesn't fit in an int.
+func parseInt(text []byte) (value int, ok bool) {
+	negative := text[0] == '-'
+	if negative {
+		text = text[1:]
+	}
+	const limit = uint64(1<<(strconv.IntSize-1) - 1)
+	var result uint64
+	for _, c := range text {
+		if c < '0' || c > '9' {
+			return
+		}
+		if result > (limit+1)/10 {
+			return
+		}
+		result = result*10 + uint64(c-'0')
+	}
+	switch {
+	case negative && result > limit+1:
+		return
+	case negative:
+		value = int(-result)
+	case result > limit:
+		return
+	default:
+		value = int(result)
+	}
+	ok = true
+	return
+}
+
+// parseFloat parses a floating point number. It returns false if the value is out of range.
+func parseFloat(text []byte) (value float64, ok bool) {
+	// Small positive integers are very common, and can be converted exactly without calling the
+	// more expensive strconv.ParseFloat function. Negative numbers are excluded because the
+	// result for `-0` would be positive.
+	if len(text) < 16 && text[0] != '-' {
+		integer, ok := parseInt(text)
+		if ok {
+			return float64(integer), true
+		}
+	}
+	value, err := strconv.ParseFloat(string(text), 64)
+	ok = err == nil
+	return
+}
+
+// validNumber checks if the given text is a number that satisfies the JSON grammar.
+func validNumber(text []byte) bool {
+	j := 0
+	n := len(text)
+	if j < n && text[j] == '-' {
+		j++
+	}
+	switch {
+	case j < n && text[j] == '0':
+		j++
+	case j < n && text[j] >= '1' && text[j] <= '9':
+		for j < n && text[j] >= '0' && text[j] <= '9' {
+			j++
+		}
+	default:
+		return false
+	}
+	if j < n && text[j] == '.' {
+		j++
+		if j >= n || text[j] < '0' || text[j] > '9' {
+			return false
+		}
+		for j < n && text[j] >= '0' && text[j] <= '9' {
+			j++
+		}
+	}
+	if j < n && (text[j] == 'e' || text[j] == 'E') {
+		j++
+		if j < n && (text[j] == '+' || text[j] == '-') {
+			j++
+		}
+		if j >= n || text[j] < '0' || text[j] > '9' {
+			return false
+		}
+		for j < n && text[j] >= '0' && text[j] <= '9' {
+			j++
+		}
+	}
+	return j == n
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"strings"
+)
+
+// TypeMetadata describes a type of the model. It is generated together with the type, so it can be
+// used to write generic code, like table printers or validators, without using reflection.
+type TypeMetadata struct {
+	// Name is the name of the Go type, for example `Cluster`.
+	Name string
+
+	// Kind is the value of the `kind` attribute of the objects of the type, for example
+	// `Cluster`. It is empty for types that don't have identifiers, like `ClusterNodes`.
+	Kind string
+
+	// Attributes describes the attributes of the type, in the same order that they are
+	// written to JSON documents. For types that have identifiers this includes the `id` and
+	// `href` attributes.
+	Attributes []*AttributeMetadata
+}
+
+// Attribute returns the description of the attribute that has the given Go or JSON name, or nil
+// if there is no such attribute.
+func (t *TypeMetadata) Attribute(name string) *AttributeMetadata {
+	if t == nil {
+		return nil
+	}
+	for _, attribute := range t.Attributes {
+		if attribute.Name == name || attribute.JSONName == name {
+			return attribute
+		}
+	}
+	return nil
+}
+
+// AttributeMetadata describes an attribute of a type of the model.
+type AttributeMetadata struct {
+	// Name is the Go name of the attribute, which is also the name of the getter, for
+	// example `DisplayName`.
+	Name string
+
+	// JSONName is the name of the attribute in JSON documents, for example `display_name`.
+	JSONName string
+
+	// Type is the Go type of the value returned by the getter, for example `string`,
+	// `*ClusterNodes`, `*GroupList` or `map[string]string`.
+	Type string
+
+	// Element describes the type of the value, for attributes whose value is an object, or
+	// the type of the items, for attributes whose value is a list. It is nil for other
+	// attributes.
+	Element *TypeMetadata
+
+	// Link is true if the value is a reference to an object or list that is managed by its
+	// own resource, so that usually only the identifier and the link are present.
+	Link bool
+
+	// List is true if the value is a list of objects.
+	List bool
+
+	// Sensitive is true if the value is a secret, like a password or a private key, that
+	// shouldn't be displayed or written to logs.
+	Sensitive bool
+
+	// Get returns the value of the attribute, and a flag indicating if it has a value. The
+	// object must be a pointer to the type that contains the attribute, otherwise it panics.
+	Get func(object interface{}) (value interface{}, ok bool)
+}
+
+// ResourceMetadata describes a resource of the API and its sub-resources. It is returned by the
+// Metadata method of the clients, and can be used to navigate the tree of resources without
+// creating clients.
+type ResourceMetadata struct {
+	// Name is the path segment that identifies the resource inside its parent, for example
+	// `clusters`. It is empty for the root resource and for the items of collections, as in
+	// that case the segment is the identifier of the item.
+	Name string
+
+	// Type describes the type of the objects returned by the resource: the type of the items
+	// for collections, and the type of the object returned by the `get` method, or by the
+	// `post` method if there is no `get` method, for other resources. It is nil for resources
+	// that don't return objects of the model, like the root.
+	Type *TypeMetadata
+
+	// Collection is true if the resource is a collection of items.
+	Collection bool
+
+	// Item describes the resource that manages each of the items of a collection. It is nil
+	// for resources that aren't collections.
+	Item *ResourceMetadata
+
+	// Resources describes the sub-resources that have fixed names.
+	Resources []*ResourceMetadata
+}
+
+// Resource returns the description of the sub-resource that has the given name, or nil if there
+// is no such sub-resource.
+func (r *ResourceMetadata) Resource(name string) *ResourceMetadata {
+	if r == nil {
+		return nil
+	}
+	for _, resource := range r.Resources {
+		if resource.Name == name {
+			return resource
+		}
+	}
+	return nil
+}
+
+// metricPath calculates the metrics path for the given segments of a path, relative to the prefix
+// where this resource is mounted. The names of the resources are preserved and the identifiers of
+// the items of collections are replaced with dashes. The result is `/-` if the segments don't
+// correspond to any resource.
+func (r *ResourceMetadata) metricPath(prefix string, segments []string) string {
+	result := prefix
+	current := r
+	for _, segment := range segments {
+		next := current.Resource(segment)
+		switch {
+		case next != nil:
+			result += "/" + segment
+		case current.Item != nil:
+			result += "/-"
+			next = current.Item
+		default:
+			return "/-"
+		}
+		current = next
+	}
+	if result == "" {
+		return "/"
+	}
+	return result
+}
+
+// services contains the trees of resources of the services, indexed by the path where they are
+// mounted, for example `/api/clusters_mgmt/v1`. It is populated by the init functions of the
+// generated packages, so it doesn't need to be protected by a mutex.
+var services = map[string]*ResourceMetadata{}
+
+// RegisterService is used by the generated packages to register the tree of resources of a
+// service, so that it can be used by the MetricPath function.
+func RegisterService(prefix string, root *ResourceMetadata) {
+	services[strings.TrimRight(prefix, "/")] = root
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// OrderItem is one of the items of the 'order' parameter of the list methods. For example, the
+// value `creation_timestamp desc, name asc` contains two items.
+type OrderItem struct {
+	// Attribute is the name of the attribute, using dots to separate the names of nested
+	// attributes, for example `region.id`.
+	Attribute string
+
+	// Descending is true if the direction of the item is `desc`.
+	Descending bool
+}
+
+// String generates the text of the item, using the syntax of the 'order' parameter.
+func (i *OrderItem) String() string {
+	if i.Descending {
+		return i.Attribute + " desc"
+	}
+	return i.Attribute + " asc"
+}
+
+// ParseOrder returns the value of the given query parameter parsed as a list of order items, or nil
+// if the parameter isn't present or is empty. The attribute of each item must be one of the given
+// attributes. If the parameter has multiple values only the first one is used. The returned error,
+// if any, contains the name of the parameter.
+func ParseOrder(query url.Values, name string, attributes map[string]bool) (result []*OrderItem,
+	err error) {
+	values, ok := query[name]
+	if !ok || len(values) == 0 || strings.TrimSpace(values[0]) == "" {
+		return
+	}
+	for _, text := range strings.Split(values[0], ",") {
+		fields := strings.Fields(text)
+		if len(fields) == 0 || len(fields) > 2 {
+			err = fmt.Errorf(
+				"value '%s' of query parameter '%s' isn't valid, each item should "+
+					"contain an attribute name optionally followed by 'asc' or 'desc'",
+				values[0], name,
+			)
+			result = nil
+			return
+		}
+		item := &OrderItem{
+			Attribute: fields[0],
+		}
+		if !attributes[item.Attribute] {
+			err = fmt.Errorf(
+				"attribute '%s' of query parameter '%s' isn't valid",
+				item.Attribute, name,
+			)
+			result = nil
+			return
+		}
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				item.Descending = true
+			default:
+				err = fmt.Errorf(
+					"direction '%s' of query parameter '%s' isn't valid, it should "+
+						"be 'asc' or 'desc'",
+					fields[1], name,
+				)
+				result = nil
+				return
+			}
+		}
+		result = append(result, item)
+	}
+	return
+}
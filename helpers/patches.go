@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// MergePatchContentType is the content type of JSON merge patch documents, as described in
+// RFC 7396.
+const MergePatchContentType = "application/merge-patch+json"
+
+// MergePatch calculates the JSON merge patch, as described in RFC 7396, that transforms the
+// original JSON document into the modified one. Attributes that are removed in the modified
+// document are set to null in the patch. Arrays are replaced completely when they are different.
+func MergePatch(original, modified []byte) (patch []byte, err error) {
+	originalValue, err := decodePatchDocument(original)
+	if err != nil {
+		return
+	}
+	modifiedValue, err := decodePatchDocument(modified)
+	if err != nil {
+		return
+	}
+	patch, err = json.Marshal(mergePatchValue(originalValue, modifiedValue))
+	return
+}
+
+// decodePatchDocument decodes the given JSON document preserving the exact representation of
+// numbers.
+func decodePatchDocument(data []byte) (value interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&value)
+	return
+}
+
+// mergePatchValue calculates the merge patch that transforms the original value into the
+// modified one.
+func mergePatchValue(original, modified interface{}) interface{} {
+	originalObject, ok := original.(map[string]interface{})
+	if !ok {
+		return modified
+	}
+	modifiedObject, ok := modified.(map[string]interface{})
+	if !ok {
+		return modified
+	}
+	patch := map[string]interface{}{}
+	for name := range originalObject {
+		if _, ok := modifiedObject[name]; !ok {
+			patch[name] = nil
+		}
+	}
+	for name, modifiedValue := range modifiedObject {
+		originalValue, ok := originalObject[name]
+		if !ok {
+			patch[name] = modifiedValue
+			continue
+		}
+		if reflect.DeepEqual(originalValue, modifiedValue) {
+			continue
+		}
+		patch[name] = mergePatchValue(originalValue, modifiedValue)
+	}
+	return patch
+}
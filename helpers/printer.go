@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mask is the text printed instead of the values of sensitive attributes.
+const Mask = "***"
+
+// PrintingOptions controls how objects are printed.
+type PrintingOptions struct {
+	// GoSyntax indicates if the names of the types should be printed qualified with the name
+	// of the package and preceded by an ampersand, like the `%#v` verb of the fmt package
+	// does, for example `&v1.Cluster{...}` instead of `Cluster{...}`.
+	GoSyntax bool
+
+	// Reveal indicates if the values of the sensitive attributes should be printed. By
+	// default they are replaced by the Mask text.
+	Reveal bool
+}
+
+// Printer writes a readable representation of an object. It is used by the generated code to
+// implement the String, GoString and Format methods of the model types. The result looks like
+// this:
+//
+//	Cluster{ID: "123", Name: "mycluster", Nodes: ClusterNodes{Compute: 3}}
+//
+// Only the attributes that have a value are printed, and the values of sensitive attributes, like
+// passwords, are masked unless the Reveal option is set. Fields that don't correspond to
+// attributes of the type aren't printed.
+type Printer struct {
+	options PrintingOptions
+	buffer  []byte
+	first   bool
+}
+
+// NewPrinter creates a printer that uses the given options.
+func NewPrinter(options PrintingOptions) *Printer {
+	return &Printer{
+		options: options,
+	}
+}
+
+// String returns the text written by the printer.
+func (p *Printer) String() string {
+	return string(p.buffer)
+}
+
+// PrintObjectStart prints the beginning of an object. The name is the name of the type qualified
+// with the name of the package, for example `v1.Cluster`. The package is only printed when the
+// GoSyntax option is set.
+func (p *Printer) PrintObjectStart(name string) {
+	if p.options.GoSyntax {
+		p.buffer = append(p.buffer, '&')
+	} else if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	p.buffer = append(p.buffer, name...)
+	p.buffer = append(p.buffer, '{')
+	p.first = true
+}
+
+// PrintObjectField prints the name of an attribute of the current object, preceded by a comma
+// if it isn't the first attribute.
+func (p *Printer) PrintObjectField(name string) {
+	if !p.first {
+		p.buffer = append(p.buffer, ',', ' ')
+	}
+	p.first = false
+	p.buffer = append(p.buffer, name...)
+	p.buffer = append(p.buffer, ':', ' ')
+}
+
+// PrintObjectEnd prints the end of an object.
+func (p *Printer) PrintObjectEnd() {
+	p.buffer = append(p.buffer, '}')
+	p.first = false
+}
+
+// PrintListStart prints the beginning of a list. Items other than the first need to be preceded
+// by a call to the PrintMore method.
+func (p *Printer) PrintListStart() {
+	p.buffer = append(p.buffer, '[')
+}
+
+// PrintMore prints the comma that separates the items of a list.
+func (p *Printer) PrintMore() {
+	p.buffer = append(p.buffer, ',', ' ')
+}
+
+// PrintListEnd prints the end of a list.
+func (p *Printer) PrintListEnd() {
+	p.buffer = append(p.buffer, ']')
+	p.first = false
+}
+
+// PrintNil prints a nil object or list.
+func (p *Printer) PrintNil() {
+	p.buffer = append(p.buffer, "nil"...)
+}
+
+// PrintString prints a string value, quoted.
+func (p *Printer) PrintString(value string) {
+	p.buffer = strconv.AppendQuote(p.buffer, value)
+}
+
+// PrintSensitive prints the value of a sensitive attribute. The value is replaced by the Mask
+// text unless the Reveal option is set.
+func (p *Printer) PrintSensitive(value string) {
+	if !p.options.Reveal {
+		p.buffer = append(p.buffer, Mask...)
+		return
+	}
+	p.PrintString(value)
+}
+
+// PrintSymbol prints the value of an enumerated type, without quotes.
+func (p *Printer) PrintSymbol(value string) {
+	p.buffer = append(p.buffer, value...)
+}
+
+// PrintBool prints a boolean value.
+func (p *Printer) PrintBool(value bool) {
+	p.buffer = strconv.AppendBool(p.buffer, value)
+}
+
+// PrintInt prints an integer value.
+func (p *Printer) PrintInt(value int) {
+	p.buffer = strconv.AppendInt(p.buffer, int64(value), 10)
+}
+
+// PrintFloat64 prints a floating point value.
+func (p *Printer) PrintFloat64(value float64) {
+	p.buffer = strconv.AppendFloat(p.buffer, value, 'g', -1, 64)
+}
+
+// PrintTime prints a time value using the RFC3339 format, without quotes.
+func (p *Printer) PrintTime(value time.Time) {
+	p.buffer = value.AppendFormat(p.buffer, time.RFC3339Nano)
+}
+
+// PrintStringSlice prints a list of strings.
+func (p *Printer) PrintStringSlice(values []string) {
+	p.PrintListStart()
+	for i, value := range values {
+		if i > 0 {
+			p.PrintMore()
+		}
+		p.PrintString(value)
+	}
+	p.PrintListEnd()
+}
+
+// PrintStringMap prints a map whose values are strings. The entries are sorted by key.
+func (p *Printer) PrintStringMap(values map[string]string) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	p.buffer = append(p.buffer, '{')
+	for i, key := range keys {
+		if i > 0 {
+			p.buffer = append(p.buffer, ',', ' ')
+		}
+		p.PrintString(key)
+		p.buffer = append(p.buffer, ':', ' ')
+		p.PrintString(values[key])
+	}
+	p.buffer = append(p.buffer, '}')
+	p.first = false
+}
+
+// Format implements the Format method of the model types, using the given function to write the
+// object. The `%v` and `%s` verbs print the readable representation, `%#v` prints it using the Go
+// syntax option and `%q` prints it quoted. Other verbs are reported as wrong, like the fmt
+// package does, for example `%!d(Cluster{ID: "123"})`.
+func Format(state fmt.State, verb rune, write func(printer *Printer), options PrintingOptions) {
+	if verb == 'v' && state.Flag('#') {
+		options.GoSyntax = true
+	}
+	printer := NewPrinter(options)
+	write(printer)
+	switch verb {
+	case 'v', 's':
+		state.Write(printer.buffer) // nolint
+	case 'q':
+		state.Write(strconv.AppendQuote(nil, printer.String())) // nolint
+	default:
+		fmt.Fprintf(state, "%%!%c(%s)", verb, printer.buffer)
+	}
+}
+
+// Revealed is the type returned by the Reveal methods of the model types. It is printed like the
+// object, but without masking the values of the sensitive attributes.
+type Revealed func(printer *Printer)
+
+// String returns the readable representation of the object, including the values of the
+// sensitive attributes.
+func (r Revealed) String() string {
+	printer := NewPrinter(PrintingOptions{
+		Reveal: true,
+	})
+	r(printer)
+	return printer.String()
+}
+
+// GoString is like String, but uses the Go syntax option.
+func (r Revealed) GoString() string {
+	printer := NewPrinter(PrintingOptions{
+		GoSyntax: true,
+		Reveal:   true,
+	})
+	r(printer)
+	return printer.String()
+}
+
+// Format implements the fmt.Formatter interface, including the values of the sensitive
+// attributes.
+func (r Revealed) Format(state fmt.State, verb rune) {
+	Format(state, verb, r, PrintingOptions{
+		Reveal: true,
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// ParseInteger returns the value of the given query parameter converted to an integer, or nil if
+// the parameter isn't present. If the parameter has multiple values only the first one is used.
+// The returned error, if any, contains the name of the parameter.
+func ParseInteger(query url.Values, name string) (result *int, err error) {
+	values, ok := query[name]
+	if !ok || len(values) == 0 {
+		return
+	}
+	value, err := strconv.Atoi(values[0])
+	if err != nil {
+		err = fmt.Errorf(
+			"value '%s' of query parameter '%s' isn't a valid integer",
+			values[0], name,
+		)
+		return
+	}
+	result = &value
+	return
+}
+
+// CheckMinimum checks that the value of the given query parameter isn't less than the given
+// minimum. The returned error, if any, contains the name of the parameter.
+func CheckMinimum(name string, value int, minimum int) error {
+	if value < minimum {
+		return fmt.Errorf(
+			"value %d of query parameter '%s' isn't valid, it should be greater than or "+
+				"equal to %d",
+			value, name, minimum,
+		)
+	}
+	return nil
+}
+
+// ParseString returns the value of the given query parameter, or nil if the parameter isn't
+// present. If the parameter has multiple values only the first one is used.
+func ParseString(query url.Values, name string) (result *string, err error) {
+	values, ok := query[name]
+	if !ok || len(values) == 0 {
+		return
+	}
+	value := values[0]
+	result = &value
+	return
+}
+
+// Mount adds to the given router a route that matches all the requests and sends them to the given
+// handler. It returns the path template of the router, so that the handler can remove it from the
+// paths of the requests using the Segments function. The template may contain variables, for
+// example `/api/{service}/v1`, and each of them will match exactly one segment of the path.
+func Mount(router *mux.Router, handler http.HandlerFunc) string {
+	route := router.NewRoute().HandlerFunc(handler)
+	prefix, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(prefix, "/")
+}
+
+// Segments removes the given prefix from the given path and splits the rest into segments. The
+// prefix may be a path template as returned by the Mount function, where segments like `{name}`
+// or `{name:pattern}` match any segment of the path. The second result will be false if the path
+// doesn't start with the prefix, or if it contains empty segments, like in `/clusters//status`.
+func Segments(path, prefix string) (segments []string, ok bool) {
+	actual := splitPath(path)
+	expected := splitPath(prefix)
+	if len(actual) < len(expected) {
+		return
+	}
+	for i, segment := range actual {
+		if segment == "" {
+			return
+		}
+		if i < len(expected) && !isPathVariable(expected[i]) && segment != expected[i] {
+			return
+		}
+	}
+	ok = true
+	if len(actual) > len(expected) {
+		segments = actual[len(expected):]
+	}
+	return
+}
+
+// splitPath splits the given path into segments, ignoring the leading and trailing slashes.
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// isPathVariable checks if the given segment of a path template is a variable.
+func isPathVariable(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// routeKey is the key used to store in the context of a request the metrics path of the route
+// that the request is dispatched to.
+type routeKey struct{}
+
+// TrackRoute returns a copy of the given request where the server adapters can record the route
+// that they dispatch it to, and a function that returns the metrics path of that route. The
+// function returns an empty string if no server adapter has dispatched the request. This is used
+// by the metrics middleware, so that the paths of the resources are reported correctly even when
+// the adapters are mounted with prefixes different to the ones of the services.
+func TrackRoute(r *http.Request) (*http.Request, func() string) {
+	route := new(string)
+	r = r.WithContext(context.WithValue(r.Context(), routeKey{}, route))
+	return r, func() string {
+		return *route
+	}
+}
+
+// SetRoute is used by the server adapters to record the route that they dispatch the given request
+// to. The prefix is the path template where the adapter is mounted, the root is the tree of
+// resources of the service and the segments are the rest of the path of the request. It does
+// nothing if the request isn't tracked.
+func SetRoute(r *http.Request, prefix string, root *ResourceMetadata, segments []string) {
+	route, ok := r.Context().Value(routeKey{}).(*string)
+	if ok {
+		*route = root.metricPath(prefix, segments)
+	}
+}
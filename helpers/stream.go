@@ -0,0 +1,311 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Stream writes a JSON document token by token. It is used by the generated code to write the
+// model types directly, without intermediate data structures and without reflection. The output
+// is the same that the encoding/json package generates.
+//
+// The zero value writes to an in-memory buffer, that can be retrieved with the Bytes method. To
+// write to a writer or to a JSON encoder use the NewStream function.
+//
+// The methods don't return errors. Instead the first error is saved and returned by the Bytes or
+// Flush methods.
+type Stream struct {
+	writer  io.Writer
+	encoder *json.Encoder
+	buffer  []byte
+	first   bool
+	err     error
+}
+
+// streamBuffers contains the buffers used by streams that write to writers or JSON encoders, so
+// that they can be reused.
+var streamBuffers = sync.Pool{
+	New: func() interface{} {
+		return make([]byte, 0, 1024)
+	},
+}
+
+// NewStream creates a stream that writes the JSON document to the given target, which can be a
+// writer or a JSON encoder. The document is written when the Flush method is called.
+func NewStream(target interface{}) (stream *Stream, err error) {
+	stream = new(Stream)
+	switch output := target.(type) {
+	case *json.Encoder:
+		stream.encoder = output
+	case io.Writer:
+		stream.writer = output
+	default:
+		err = fmt.Errorf(
+			"expected writer or JSON encoder, but got %T",
+			output,
+		)
+		stream = nil
+		return
+	}
+	stream.buffer = streamBuffers.Get().([]byte)[:0]
+	return
+}
+
+// Bytes returns the document written to the in-memory buffer, or the first error that happened
+// while writing it.
+func (s *Stream) Bytes() ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.buffer, nil
+}
+
+// Flush writes the document to the writer or JSON encoder that was used to create the stream. Like
+// the encoding/json encoder, it adds a new line after the document. The stream can't be used after
+// calling this method.
+func (s *Stream) Flush() error {
+	err := s.err
+	if err == nil {
+		if s.encoder != nil {
+			err = s.encoder.Encode(json.RawMessage(s.buffer))
+		} else {
+			s.buffer = append(s.buffer, '\n')
+			_, err = s.writer.Write(s.buffer)
+		}
+	}
+	streamBuffers.Put(s.buffer[:0])
+	s.buffer = nil
+	return err
+}
+
+// SetError saves the given error, unless there is already one.
+func (s *Stream) SetError(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// WriteObjectStart writes the beginning of an object.
+func (s *Stream) WriteObjectStart() {
+	s.buffer = append(s.buffer, '{')
+	s.first = true
+}
+
+// WriteObjectField writes the name of a field of the current object, preceded by a comma if it
+// isn't the first field, and followed by a colon. The name is written without escaping, so it
+// must not contain characters that need it. To write arbitrary names use the WriteExtra method.
+func (s *Stream) WriteObjectField(name string) {
+	if !s.first {
+		s.buffer = append(s.buffer, ',')
+	}
+	s.first = false
+	s.buffer = append(s.buffer, '"')
+	s.buffer = append(s.buffer, name...)
+	s.buffer = append(s.buffer, '"', ':')
+}
+
+// WriteObjectEnd writes the end of an object.
+func (s *Stream) WriteObjectEnd() {
+	s.buffer = append(s.buffer, '}')
+	s.first = false
+}
+
+// WriteArrayStart writes the beginning of an array. Items other than the first need to be
+// preceded by a call to the WriteMore method.
+func (s *Stream) WriteArrayStart() {
+	s.buffer = append(s.buffer, '[')
+}
+
+// WriteMore writes the comma that separates the items of an array.
+func (s *Stream) WriteMore() {
+	s.buffer = append(s.buffer, ',')
+}
+
+// WriteArrayEnd writes the end of an array.
+func (s *Stream) WriteArrayEnd() {
+	s.buffer = append(s.buffer, ']')
+	s.first = false
+}
+
+// WriteNull writes a null value.
+func (s *Stream) WriteNull() {
+	s.buffer = append(s.buffer, "null"...)
+}
+
+// WriteString writes a string value.
+func (s *Stream) WriteString(value string) {
+	if !safeString(value) {
+		// Strings that need escaping are rare, and using the encoding/json package for them
+		// guarantees that the result is exactly the same:
+		data, err := json.Marshal(value)
+		if err != nil {
+			s.SetError(err)
+			return
+		}
+		s.buffer = append(s.buffer, data...)
+		return
+	}
+	s.buffer = append(s.buffer, '"')
+	s.buffer = append(s.buffer, value...)
+	s.buffer = append(s.buffer, '"')
+}
+
+// WriteBool writes a boolean value.
+func (s *Stream) WriteBool(value bool) {
+	s.buffer = strconv.AppendBool(s.buffer, value)
+}
+
+// WriteInt writes an integer value.
+func (s *Stream) WriteInt(value int) {
+	s.buffer = strconv.AppendInt(s.buffer, int64(value), 10)
+}
+
+// WriteFloat64 writes a floating point value, using the same format that the encoding/json
+// package uses.
+func (s *Stream) WriteFloat64(value float64) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		s.SetError(fmt.Errorf(
+			"json: unsupported value: %s",
+			strconv.FormatFloat(value, 'g', -1, 64),
+		))
+		return
+	}
+	format := byte('f')
+	abs := math.Abs(value)
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	s.buffer = strconv.AppendFloat(s.buffer, value, format, -1, 64)
+	if format == 'e' {
+		// Convert exponents like `e-09` into `e-9`:
+		n := len(s.buffer)
+		if n >= 4 && s.buffer[n-4] == 'e' && s.buffer[n-3] == '-' && s.buffer[n-2] == '0' {
+			s.buffer[n-2] = s.buffer[n-1]
+			s.buffer = s.buffer[:n-1]
+		}
+	}
+}
+
+// WriteTime writes a time value as a string using the RFC3339 format.
+func (s *Stream) WriteTime(value time.Time) {
+	if year := value.Year(); year < 0 || year > 9999 {
+		_, err := value.MarshalJSON()
+		s.SetError(err)
+		return
+	}
+	s.buffer = append(s.buffer, '"')
+	s.buffer = value.AppendFormat(s.buffer, time.RFC3339Nano)
+	s.buffer = append(s.buffer, '"')
+}
+
+// WriteStringSlice writes an array of strings.
+func (s *Stream) WriteStringSlice(values []string) {
+	s.WriteArrayStart()
+	for i, value := range values {
+		if i > 0 {
+			s.WriteMore()
+		}
+		s.WriteString(value)
+	}
+	s.WriteArrayEnd()
+}
+
+// WriteStringMap writes an object whose values are strings. The fields are sorted by name.
+func (s *Stream) WriteStringMap(values map[string]string) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s.WriteObjectStart()
+	for _, name := range names {
+		s.writeField(name)
+		s.WriteString(values[name])
+	}
+	s.WriteObjectEnd()
+}
+
+// WriteAny writes any value, using the encoding/json package.
+func (s *Stream) WriteAny(value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		s.SetError(err)
+		return
+	}
+	s.buffer = append(s.buffer, data...)
+}
+
+// WriteExtra writes to the current object the fields that don't correspond to attributes of the
+// type, sorted by name.
+func (s *Stream) WriteExtra(extra map[string]interface{}) {
+	if len(extra) == 0 {
+		return
+	}
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.writeField(name)
+		s.WriteAny(extra[name])
+	}
+}
+
+// writeField is like WriteObjectField, but escapes the name if needed.
+func (s *Stream) writeField(name string) {
+	if !s.first {
+		s.buffer = append(s.buffer, ',')
+	}
+	s.first = false
+	s.WriteString(name)
+	s.buffer = append(s.buffer, ':')
+}
+
+// safeString checks if the given string can be written without escaping. Note that the
+// encoding/json package escapes the HTML special characters and the line and paragraph
+// separators.
+func safeString(value string) bool {
+	for i := 0; i < len(value); {
+		c := value[i]
+		if c < utf8.RuneSelf {
+			if c < ' ' || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+				return false
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(value[i:])
+		if r == utf8.RuneError && size == 1 || r == '\u2028' || r == '\u2029' {
+			return false
+		}
+		i += size
+	}
+	return true
+}
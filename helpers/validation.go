@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+)
+
+// FieldError describes a value that violates a validation rule.
+type FieldError struct {
+	// Path is the path of the attribute, using the JSON names of the attributes, for example
+	// `nodes.compute` or `identity_providers[1].name`. It is empty when the error applies to
+	// the complete object.
+	Path string
+
+	// Reason is a human readable description of the rule that the value violates, for
+	// example `is required`.
+	Reason string
+}
+
+// Error generates a human readable representation of the error.
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Reason
+	}
+	return e.Path + ": " + e.Reason
+}
+
+// ValidationError is the error returned by the Validate methods of the model types. It contains
+// all the rules that the object violates, not just the first one. The server adapters don't
+// validate the request bodies, but when a server method returns this error it is sent to the
+// client with status code 400.
+type ValidationError struct {
+	// Errors contains the violations, in the order that they were detected.
+	Errors []*FieldError
+}
+
+// Error generates a human readable representation of the error, containing all the violations
+// separated by semicolons.
+func (e *ValidationError) Error() string {
+	buffer := new(bytes.Buffer)
+	for i, field := range e.Errors {
+		if i > 0 {
+			buffer.WriteString("; ")
+		}
+		buffer.WriteString(field.Error())
+	}
+	return buffer.String()
+}
+
+// Validator collects the violations detected while validating an object. It is used to implement
+// the Validate methods of the model types.
+type Validator struct {
+	// Partial indicates that the object only contains the attributes that should be changed, as
+	// in the body of an update request. When it is true missing attributes aren't reported, but
+	// the values that are present are still checked.
+	Partial bool
+
+	errors []*FieldError
+}
+
+// Report adds a violation for the attribute with the given path. The reason is generated using
+// the given format and arguments, like the fmt.Sprintf function does.
+func (v *Validator) Report(path string, format string, args ...interface{}) {
+	v.errors = append(v.errors, &FieldError{
+		Path:   path,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// Error returns a ValidationError containing the violations reported, or nil if there are no
+// violations.
+func (v *Validator) Error() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{
+		Errors: v.errors,
+	}
+}
+
+// Missing reports that the attribute with the given path is required but doesn't have a value,
+// unless the validation is partial.
+func (v *Validator) Missing(path string) {
+	if !v.Partial {
+		v.Report(path, "is required")
+	}
+}
+
+// Required reports a violation for the attribute with the given path if the string doesn't have a
+// value or is empty. In partial validations only empty values are reported.
+func (v *Validator) Required(path string, value *string) {
+	switch {
+	case value == nil:
+		v.Missing(path)
+	case *value == "":
+		v.Report(path, "is required")
+	}
+}
+
+// ParseCIDR parses the given CIDR block, for example `10.0.0.0/16`. If it isn't valid it reports a
+// violation for the attribute with the given path and returns nil.
+func (v *Validator) ParseCIDR(path string, block string) *net.IPNet {
+	_, network, err := net.ParseCIDR(block)
+	if err != nil {
+		v.Report(path, "'%s' isn't a valid CIDR block", block)
+		return nil
+	}
+	return network
+}
+
+// IsDNSLabel checks if the given text is a valid DNS label as defined in RFC 1123: at most 63
+// lower case letters, digits or dashes, starting and ending with a letter or digit.
+func IsDNSLabel(text string) bool {
+	if len(text) == 0 || len(text) > 63 {
+		return false
+	}
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+		case c == '-' && i > 0 && i < len(text)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Overlap checks if two networks have addresses in common. As networks are aligned to their size
+// they overlap if and only if one of them contains the first address of the other.
+func Overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
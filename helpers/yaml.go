@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// IMPORTANT: This file has been generated automatically, refrain from modifying it manually as all
+// your changes will be lost when the file is generated again.
+
+package helpers // github.com/openshift-online/uhc-sdk-go/helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// YAMLValue converts the given JSON document into a value that the YAML encoder writes with the
+// same fields and in the same order. Integer numbers are converted into int64 values, so that they
+// aren't written as floating point numbers.
+func YAMLValue(data []byte) (result interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	result, err = yamlValue(decoder)
+	return
+}
+
+// yamlValue reads the next JSON value from the given decoder and converts it into a YAML value.
+func yamlValue(decoder *json.Decoder) (result interface{}, err error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return
+	}
+	switch typed := token.(type) {
+	case json.Delim:
+		switch typed {
+		case '{':
+			items := yaml.MapSlice{}
+			for decoder.More() {
+				token, err = decoder.Token()
+				if err != nil {
+					return
+				}
+				var value interface{}
+				value, err = yamlValue(decoder)
+				if err != nil {
+					return
+				}
+				items = append(items, yaml.MapItem{
+					Key:   token,
+					Value: value,
+				})
+			}
+			_, err = decoder.Token()
+			result = items
+		case '[':
+			items := []interface{}{}
+			for decoder.More() {
+				var value interface{}
+				value, err = yamlValue(decoder)
+				if err != nil {
+					return
+				}
+				items = append(items, value)
+			}
+			_, err = decoder.Token()
+			result = items
+		default:
+			err = fmt.Errorf("unexpected JSON delimiter '%s'", typed)
+		}
+	case json.Number:
+		result, err = typed.Int64()
+		if err != nil {
+			result, err = typed.Float64()
+		}
+	default:
+		result = typed
+	}
+	return
+}
+
+// JSONValue converts the given generic value, as returned by the YAML decoder, into the equivalent
+// generic JSON value. The difference is that the YAML decoder uses maps with keys of any type,
+// while JSON requires string keys. An error is returned if the value contains keys that aren't
+// strings.
+func JSONValue(value interface{}) (result interface{}, err error) {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			name, ok := key.(string)
+			if !ok {
+				err = fmt.Errorf("key '%v' isn't a string", key)
+				return
+			}
+			object[name], err = JSONValue(item)
+			if err != nil {
+				return
+			}
+		}
+		result = object
+	case []interface{}:
+		items := make([]interface{}, len(typed))
+		for i, item := range typed {
+			items[i], err = JSONValue(item)
+			if err != nil {
+				return
+			}
+		}
+		result = items
+	default:
+		result = value
+	}
+	return
+}
+
+// YAMLToJSON reads a YAML value using the given function, as received by the implementations of
+// the yaml.Unmarshaler interface, and converts it into a JSON document.
+func YAMLToJSON(unmarshal func(interface{}) error) (data []byte, err error) {
+	var value interface{}
+	err = unmarshal(&value)
+	if err != nil {
+		return
+	}
+	value, err = JSONValue(value)
+	if err != nil {
+		return
+	}
+	data, err = json.Marshal(value)
+	return
+}
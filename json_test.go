@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the implementation of the encoding/json interfaces by the model
+// types.
+
+package sdk
+
+import (
+	"encoding/json"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("JSON", func() {
+	It("Marshals object with json.Marshal", func() {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("mycluster").
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"kind": "Cluster",
+			"id": "123",
+			"name": "mycluster",
+			"nodes": {
+				"compute": 3
+			}
+		}`))
+	})
+
+	It("Marshals nil object as null", func() {
+		var cluster *cmv1.Cluster
+		data, err := json.Marshal(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("null"))
+	})
+
+	It("Unmarshals object with json.Unmarshal", func() {
+		var subscription amv1.Subscription
+		err := json.Unmarshal(
+			[]byte(`{
+				"kind": "SubscriptionLink",
+				"id": "123",
+				"href": "/api/accounts_mgmt/v1/subscriptions/123"
+			}`),
+			&subscription,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subscription.Link()).To(BeTrue())
+		Expect(subscription.ID()).To(Equal("123"))
+	})
+
+	It("Round trips objects embedded in other structs", func() {
+		type Payload struct {
+			Event   string        `json:"event"`
+			Cluster *cmv1.Cluster `json:"cluster"`
+			Missing *cmv1.Cluster `json:"missing"`
+		}
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("mycluster").
+			Flavour(cmv1.NewFlavour().Link(true).ID("osd-4")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(&Payload{
+			Event:   "created",
+			Cluster: cluster,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		var payload Payload
+		err = json.Unmarshal(data, &payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Event).To(Equal("created"))
+		Expect(payload.Cluster).To(Equal(cluster))
+		Expect(payload.Missing).To(BeNil())
+	})
+
+	It("Preserves attributes kept by lenient decoding", func() {
+		cluster, err := cmv1.UnmarshalCluster(
+			`{"kind": "Cluster", "id": "123", "color": "blue"}`,
+			helpers.DecodingOptions{
+				Mode: helpers.LenientDecoding,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"kind": "Cluster", "id": "123", "color": "blue"}`))
+	})
+
+	It("Returns unmarshalling errors", func() {
+		var cluster cmv1.Cluster
+		err := json.Unmarshal([]byte(`{"kind": "Cluster", "name": 123}`), &cluster)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Marshals list as object", func() {
+		list, err := amv1.NewRoleList().
+			Items(amv1.NewRole().ID("admin")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(list)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"kind": "RoleList",
+			"items": [
+				{
+					"kind": "Role",
+					"id": "admin"
+				}
+			]
+		}`))
+	})
+
+	It("Unmarshals list from object and from array", func() {
+		var list cmv1.ClusterList
+		err := json.Unmarshal(
+			[]byte(`{
+				"kind": "ClusterListLink",
+				"href": "/api/clusters_mgmt/v1/clusters",
+				"items": [{"id": "123"}]
+			}`),
+			&list,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Link()).To(BeTrue())
+		Expect(list.Get(0).ID()).To(Equal("123"))
+		err = json.Unmarshal([]byte(`[{"id": "123"}, {"id": "456"}]`), &list)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Link()).To(BeFalse())
+		Expect(list.Len()).To(Equal(2))
+	})
+
+	It("Marshals list of structs as array", func() {
+		list, err := cmv1.NewClusterNodesList().
+			Items(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(list)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`[{"compute": 3}]`))
+	})
+})
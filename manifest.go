@@ -0,0 +1,333 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that read YAML manifests containing model objects.
+
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// ManifestError is the error returned when a manifest can't be read. It contains the name of the
+// file, if known, and the number of the line where the problem was detected.
+type ManifestError struct {
+	// File is the name of the file, or an empty string if the manifest wasn't read from a file.
+	File string
+
+	// Line is the number of the line, starting with one.
+	Line int
+
+	// Message describes the problem.
+	Message string
+}
+
+// Error is the implementation of the error interface.
+func (e *ManifestError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// UnmarshalManifest reads the objects contained in the given YAML manifest, which can be a slice of
+// bytes, a string or an io.Reader. The manifest can contain multiple documents separated by `---`
+// lines, and the type of each object is selected according to the value of its `kind` attribute,
+// like in the Unmarshal function. The attributes use the same names as in the JSON representation,
+// for example:
+//
+//	kind: Cluster
+//	name: mycluster
+//	multi_az: true
+//	dns:
+//	  base_domain: example.com
+//	---
+//	kind: IdentityProvider
+//	name: github
+//	type: github
+//
+// Empty documents are ignored. Attributes that don't correspond to the type of the object are
+// rejected. Errors are of type *ManifestError and contain the number of the line of the problem.
+func UnmarshalManifest(source interface{}) (objects []interface{}, err error) {
+	var data []byte
+	switch typed := source.(type) {
+	case []byte:
+		data = typed
+	case string:
+		data = []byte(typed)
+	case io.Reader:
+		data, err = ioutil.ReadAll(typed)
+		if err != nil {
+			return
+		}
+	default:
+		err = fmt.Errorf("expected bytes, string or reader, but got %T", source)
+		return
+	}
+	objects, err = readManifest("", data)
+	return
+}
+
+// LoadManifest reads the objects contained in the given YAML file. See the UnmarshalManifest
+// function for details. Errors of type *ManifestError also contain the name of the file.
+func LoadManifest(file string) (objects []interface{}, err error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return
+	}
+	objects, err = readManifest(file, data)
+	return
+}
+
+// readManifest reads the objects contained in the given manifest.
+func readManifest(file string, data []byte) (objects []interface{}, err error) {
+	lines := strings.Split(string(data), "\n")
+	start := 0
+	for i := 0; i <= len(lines); i++ {
+		if i < len(lines) && !manifestSeparatorRE.MatchString(lines[i]) {
+			continue
+		}
+		var object interface{}
+		object, err = readManifestDocument(file, lines[start:i], start+1)
+		if err != nil {
+			objects = nil
+			return
+		}
+		if object != nil {
+			objects = append(objects, object)
+		}
+		start = i + 1
+	}
+	return
+}
+
+// readManifestDocument reads the object contained in one of the documents of a manifest. The first
+// line of the document has the given number. Returns nil if the document is empty.
+func readManifestDocument(file string, lines []string, first int) (object interface{}, err error) {
+	// Parse the YAML text, and translate the line numbers of syntax errors so that they are
+	// relative to the complete manifest instead of to the document:
+	var value interface{}
+	err = yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &value)
+	if err != nil {
+		line := first
+		message := strings.TrimPrefix(err.Error(), "yaml: ")
+		match := yamlLineRE.FindStringSubmatch(message)
+		if match != nil {
+			number, _ := strconv.Atoi(match[1])
+			line = first + number - 1
+			message = match[2]
+		}
+		err = &ManifestError{
+			File:    file,
+			Line:    line,
+			Message: message,
+		}
+		return
+	}
+	if value == nil {
+		return
+	}
+	index := indexManifestDocument(lines, first)
+	fail := func(path string, format string, args ...interface{}) {
+		line := first
+		if path != "" {
+			line = index.find(path)
+		}
+		err = &ManifestError{
+			File:    file,
+			Line:    line,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	// Convert the document to JSON and read the object from there:
+	value, err = helpers.JSONValue(value)
+	if err != nil {
+		fail("", "%v", err)
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		fail("", "%v", err)
+		return
+	}
+	object, err = Unmarshal(data, helpers.DecodingOptions{
+		Mode: helpers.StrictDecoding,
+	})
+	if err != nil {
+		switch typed := err.(type) {
+		case *helpers.UnknownFieldError:
+			kind, _ := helpers.Field(value, "kind").(string)
+			fail(
+				typed.Path, "attribute '%s' isn't valid for kind '%s'",
+				typed.Path, kind,
+			)
+		case *helpers.TypeError:
+			if typed.Path != "" {
+				fail(
+					typed.Path, "value of attribute '%s' can't be a %s",
+					typed.Path, typed.Value,
+				)
+			} else {
+				fail("", "%v", err)
+			}
+		default:
+			fail("", "%v", err)
+		}
+		object = nil
+		return
+	}
+
+	return
+}
+
+// joinManifestPath adds a name to the given attribute path.
+func joinManifestPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// manifestIndex contains the number of the line where each attribute of a document is defined.
+// The keys are the paths of the attributes, using dots as separators and the positions of the
+// items of arrays as names, for example `identity_providers.items.0.name`.
+type manifestIndex struct {
+	first int
+	lines map[string]int
+}
+
+// find returns the number of the line where the attribute with the given path is defined. If the
+// path isn't known, for example because the document uses the flow style, it returns the number
+// of the first line of the document.
+func (i *manifestIndex) find(path string) int {
+	line, ok := i.lines[path]
+	if ok {
+		return line
+	}
+
+	// Paths in the errors returned by the JSON decoder don't contain the positions of the items
+	// of arrays, so try to find an attribute with the same name:
+	segments := strings.Split(path, ".")
+	suffix := "." + segments[len(segments)-1]
+	result := 0
+	for candidate, line := range i.lines {
+		if strings.HasSuffix("."+candidate, suffix) && (result == 0 || line < result) {
+			result = line
+		}
+	}
+	if result != 0 {
+		return result
+	}
+	return i.first
+}
+
+// indexManifestDocument scans the lines of a document written in the YAML block style and finds
+// the lines where the attributes are defined. The first line has the given number.
+func indexManifestDocument(lines []string, first int) *manifestIndex {
+	type frame struct {
+		indent int
+		path   string
+		item   bool
+		count  int
+	}
+	index := &manifestIndex{
+		first: first,
+		lines: map[string]int{},
+	}
+	stack := []*frame{{indent: -1}}
+	for i, line := range lines {
+		number := first + i
+		content := strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimLeft(content, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(content) - len(trimmed)
+		for {
+			// Items of arrays:
+			if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+				for len(stack) > 1 {
+					top := stack[len(stack)-1]
+					if top.indent < indent || top.indent == indent && !top.item {
+						break
+					}
+					stack = stack[:len(stack)-1]
+				}
+				parent := stack[len(stack)-1]
+				path := joinManifestPath(parent.path, strconv.Itoa(parent.count))
+				parent.count++
+				if _, ok := index.lines[path]; !ok {
+					index.lines[path] = number
+				}
+				stack = append(stack, &frame{
+					indent: indent,
+					path:   path,
+					item:   true,
+				})
+				rest := trimmed[1:]
+				trimmed = strings.TrimLeft(rest, " ")
+				indent += 1 + len(rest) - len(trimmed)
+				if trimmed == "" {
+					break
+				}
+				continue
+			}
+
+			// Attributes of objects:
+			match := manifestKeyRE.FindStringSubmatch(trimmed)
+			if match == nil {
+				break
+			}
+			name := match[1] + match[2] + match[3]
+			for len(stack) > 1 && stack[len(stack)-1].indent >= indent {
+				stack = stack[:len(stack)-1]
+			}
+			path := joinManifestPath(stack[len(stack)-1].path, name)
+			if _, ok := index.lines[path]; !ok {
+				index.lines[path] = number
+			}
+			stack = append(stack, &frame{
+				indent: indent,
+				path:   path,
+			})
+			break
+		}
+	}
+	return index
+}
+
+// manifestSeparatorRE is the regular expression used to find the lines that separate the
+// documents of a manifest.
+var manifestSeparatorRE = regexp.MustCompile(`^(---|\.\.\.)(\s.*)?$`)
+
+// manifestKeyRE is the regular expression used to find the names of attributes in the lines of a
+// manifest.
+var manifestKeyRE = regexp.MustCompile(`^(?:"([^"]*)"|'([^']*)'|([^\s"'#{}\[\]-][^:#]*?))\s*:(?:\s|$)`)
+
+// yamlLineRE is the regular expression used to extract the line number from the errors returned
+// by the YAML parser.
+var yamlLineRE = regexp.MustCompile(`^line (\d+): (.*)$`)
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the YAML support of the model types and for the manifest loader.
+
+package sdk
+
+import (
+	"io/ioutil"
+	"os"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	"gopkg.in/yaml.v2"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("YAML", func() {
+	It("Marshals object using the JSON attribute names", func() {
+		cluster, err := cmv1.NewCluster().
+			Name("mycluster").
+			MultiAZ(true).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			DNS(cmv1.NewDNS().BaseDomain("example.com")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := yaml.Marshal(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(
+			"kind: Cluster\n" +
+				"name: mycluster\n" +
+				"multi_az: true\n" +
+				"nodes:\n" +
+				"  compute: 3\n" +
+				"dns:\n" +
+				"  base_domain: example.com\n",
+		))
+	})
+
+	It("Unmarshals object", func() {
+		var cluster cmv1.Cluster
+		err := yaml.Unmarshal(
+			[]byte("name: mycluster\nmulti_az: true\ndns:\n  base_domain: example.com\n"),
+			&cluster,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Name()).To(Equal("mycluster"))
+		Expect(cluster.MultiAZ()).To(BeTrue())
+		Expect(cluster.DNS().BaseDomain()).To(Equal("example.com"))
+	})
+
+	It("Round trips list", func() {
+		list, err := amv1.NewRoleList().
+			Items(
+				amv1.NewRole().ID("admin"),
+				amv1.NewRole().ID("viewer"),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := yaml.Marshal(list)
+		Expect(err).ToNot(HaveOccurred())
+		var copy amv1.RoleList
+		err = yaml.Unmarshal(data, &copy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(copy.Len()).To(Equal(2))
+		Expect(copy.Get(1).ID()).To(Equal("viewer"))
+	})
+})
+
+var _ = Describe("Manifest", func() {
+	It("Reads multiple documents", func() {
+		objects, err := UnmarshalManifest(`
+# The cluster:
+kind: Cluster
+name: mycluster
+multi_az: true
+dns:
+  base_domain: example.com
+---
+---
+kind: IdentityProvider
+name: github
+type: github
+github:
+  client_id: myclient
+  teams:
+  - myorg/myteam
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+		cluster, ok := objects[0].(*cmv1.Cluster)
+		Expect(ok).To(BeTrue())
+		Expect(cluster.Name()).To(Equal("mycluster"))
+		Expect(cluster.DNS().BaseDomain()).To(Equal("example.com"))
+		provider, ok := objects[1].(*cmv1.IdentityProvider)
+		Expect(ok).To(BeTrue())
+		Expect(provider.Type()).To(Equal(cmv1.IdentityProviderTypeGithub))
+		Expect(provider.Github().Teams()).To(ConsistOf("myorg/myteam"))
+	})
+
+	It("Loads file and reports its name in errors", func() {
+		file, err := ioutil.TempFile("", "manifest-*.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.Remove(file.Name())
+		_, err = file.WriteString("kind: Cluster\nname: mycluster\ncolor: blue\n")
+		Expect(err).ToNot(HaveOccurred())
+		err = file.Close()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = LoadManifest(file.Name())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			file.Name() + ":3: attribute 'color' isn't valid for kind 'Cluster'",
+		))
+	})
+
+	DescribeTable(
+		"Reports the line of the problem",
+		func(text string, line int, message string) {
+			objects, err := UnmarshalManifest(text)
+			Expect(err).To(HaveOccurred())
+			Expect(objects).To(BeNil())
+			manifestErr, ok := err.(*ManifestError)
+			Expect(ok).To(BeTrue())
+			Expect(manifestErr.Line).To(Equal(line))
+			Expect(manifestErr.Message).To(ContainSubstring(message))
+		},
+		Entry(
+			"Unknown nested attribute",
+			"kind: Cluster\n"+
+				"name: mycluster\n"+
+				"dns:\n"+
+				"  base_domain: example.com\n"+
+				"  base_domian: example.com\n",
+			5, "attribute 'dns.base_domian' isn't valid",
+		),
+		Entry(
+			"Unknown attribute in second document",
+			"kind: Cluster\n"+
+				"name: a\n"+
+				"---\n"+
+				"kind: Cluster\n"+
+				"name: b\n"+
+				"multiaz: true\n",
+			6, "attribute 'multiaz' isn't valid",
+		),
+		Entry(
+			"Unknown attribute inside array",
+			"kind: Cluster\n"+
+				"groups:\n"+
+				"  items:\n"+
+				"  - id: admins\n"+
+				"    users:\n"+
+				"      items:\n"+
+				"      - id: alice\n"+
+				"        mail: alice@example.com\n",
+			8, "attribute 'groups.items.0.users.items.0.mail' isn't valid",
+		),
+		Entry(
+			"Unknown attribute with null value",
+			"kind: Cluster\n"+
+				"name: mycluster\n"+
+				"color:\n",
+			3, "attribute 'color' isn't valid",
+		),
+		Entry(
+			"Unknown attribute with empty object",
+			"kind: Cluster\n"+
+				"name: mycluster\n"+
+				"labels: {}\n",
+			3, "attribute 'labels' isn't valid",
+		),
+		Entry(
+			"Unknown attribute with empty array",
+			"kind: Cluster\n"+
+				"name: mycluster\n"+
+				"nodes:\n"+
+				"  compute: 3\n"+
+				"  zones: []\n",
+			5, "attribute 'nodes.zones' isn't valid",
+		),
+		Entry(
+			"Wrong type",
+			"kind: Cluster\n"+
+				"name: mycluster\n"+
+				"nodes:\n"+
+				"  compute: three\n",
+			4, "can't be a string",
+		),
+		Entry(
+			"Syntax error",
+			"kind: Cluster\n"+
+				"---\n"+
+				"kind: Cluster\n"+
+				"name: [mycluster\n",
+			4, "did not find expected",
+		),
+		Entry(
+			"Missing kind",
+			"kind: Cluster\n"+
+				"---\n"+
+				"name: mycluster\n",
+			3, "doesn't have a kind",
+		),
+	)
+})
@@ -0,0 +1,553 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementation of the accounts management service and of the
+// servers that manage the current account, the access token and the cluster registrations.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+)
+
+// AccountsMgmtBuilder contains the configuration and logic needed to create an in-memory
+// implementation of the accounts management service. Don't create instances of this type
+// directly, use the NewAccountsMgmtBuilder function instead.
+type AccountsMgmtBuilder struct {
+	clock          Clock
+	currentAccount string
+	accounts       []*amv1.Account
+	organizations  []*amv1.Organization
+	quotas         []*amv1.ResourceQuota
+	registries     []*amv1.Registry
+	roles          []*amv1.Role
+}
+
+// AccountsMgmtServer is an in-memory implementation of the accounts management service. It
+// implements the amv1.RootServer interface, so it can be passed to the AccountsMgmtV1 method of
+// the server builder. Don't create instances of this type directly, use the builder instead.
+type AccountsMgmtServer struct {
+	mutex               *sync.Mutex
+	clock               Clock
+	currentAccount      string
+	accounts            []*amv1.Account
+	organizations       []*amv1.Organization
+	quotas              []*amv1.ResourceQuota
+	subscriptions       []*subscriptionEntry
+	roles               []*amv1.Role
+	roleBindings        []*amv1.RoleBinding
+	permissions         []*amv1.Permission
+	registries          []*amv1.Registry
+	registryCredentials []*amv1.RegistryCredential
+}
+
+// subscriptionEntry contains the data of a subscription stored in memory, including the quota that
+// has been reserved for it, so that it can be released when the subscription is deleted.
+type subscriptionEntry struct {
+	object       *amv1.Subscription
+	reservations []*reservation
+}
+
+// reservation is the number of resources reserved from a resource quota.
+type reservation struct {
+	quota string
+	count int
+}
+
+// Prefix of the paths of all the objects of the accounts management service:
+const accountsMgmtPrefix = "/api/accounts_mgmt/v1"
+
+// Identifier of the registry used for the cluster registration tokens:
+const clusterRegistrationRegistry = "cloud.openshift.com"
+
+// Time that the cluster registration tokens are valid:
+const clusterRegistrationLife = 24 * time.Hour
+
+// NewAccountsMgmtBuilder creates a builder that knows how to create in-memory implementations of
+// the accounts management service.
+func NewAccountsMgmtBuilder() *AccountsMgmtBuilder {
+	return new(AccountsMgmtBuilder)
+}
+
+// Clock sets the clock that will be used to calculate the expiration times of the cluster
+// registrations. The default is to use the real time.
+func (b *AccountsMgmtBuilder) Clock(value Clock) *AccountsMgmtBuilder {
+	b.clock = value
+	return b
+}
+
+// CurrentAccount sets the user name of the account that will be returned by the current account
+// server, and used to generate access tokens. The account must be one of the accounts added with
+// the Accounts method.
+func (b *AccountsMgmtBuilder) CurrentAccount(value string) *AccountsMgmtBuilder {
+	b.currentAccount = value
+	return b
+}
+
+// Accounts adds accounts that will be initially available in the service.
+func (b *AccountsMgmtBuilder) Accounts(values ...*amv1.Account) *AccountsMgmtBuilder {
+	b.accounts = append(b.accounts, values...)
+	return b
+}
+
+// Organizations adds organizations that will be initially available in the service.
+func (b *AccountsMgmtBuilder) Organizations(values ...*amv1.Organization) *AccountsMgmtBuilder {
+	b.organizations = append(b.organizations, values...)
+	return b
+}
+
+// ResourceQuotas adds resource quotas that will be initially available in the service. The
+// `organization_id` attribute of each quota must be the identifier of one of the organizations.
+func (b *AccountsMgmtBuilder) ResourceQuotas(values ...*amv1.ResourceQuota) *AccountsMgmtBuilder {
+	b.quotas = append(b.quotas, values...)
+	return b
+}
+
+// Registries adds registries that will be initially available in the service. If there is no
+// registry with identifier `cloud.openshift.com` it will be added automatically.
+func (b *AccountsMgmtBuilder) Registries(values ...*amv1.Registry) *AccountsMgmtBuilder {
+	b.registries = append(b.registries, values...)
+	return b
+}
+
+// Roles adds roles that will be initially available in the service.
+func (b *AccountsMgmtBuilder) Roles(values ...*amv1.Role) *AccountsMgmtBuilder {
+	b.roles = append(b.roles, values...)
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new in-memory implementation of
+// the accounts management service.
+func (b *AccountsMgmtBuilder) Build() (result *AccountsMgmtServer, err error) {
+	// Set the default clock, if needed:
+	clock := b.clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	// Create the server:
+	result = &AccountsMgmtServer{
+		mutex:          &sync.Mutex{},
+		clock:          clock,
+		currentAccount: b.currentAccount,
+	}
+
+	// Add the initial objects, generating the identifiers and links that are missing:
+	for _, organization := range b.organizations {
+		id := identifier(organization.ID())
+		organization, err = organization.Builder().
+			ID(id).
+			HREF(accountsMgmtPrefix + "/organizations/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.organizations = append(result.organizations, organization)
+	}
+	for _, account := range b.accounts {
+		err = result.checkAccount(account)
+		if err != nil {
+			return
+		}
+		id := identifier(account.ID())
+		account, err = account.Builder().
+			ID(id).
+			HREF(accountsMgmtPrefix + "/accounts/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.accounts = append(result.accounts, account)
+	}
+	for _, quota := range b.quotas {
+		organization := quota.OrganizationID()
+		if result.findOrganization(organization) == nil {
+			err = fmt.Errorf("organization '%s' of resource quota doesn't exist", organization)
+			return
+		}
+		id := identifier(quota.ID())
+		quota, err = quota.Builder().
+			ID(id).
+			HREF(accountsMgmtPrefix + "/organizations/" + organization + "/resource_quota/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.quotas = append(result.quotas, quota)
+	}
+	registries := b.registries
+	found := false
+	for _, registry := range registries {
+		if registry.ID() == clusterRegistrationRegistry {
+			found = true
+			break
+		}
+	}
+	if !found {
+		var registry *amv1.Registry
+		registry, err = amv1.NewRegistry().
+			ID(clusterRegistrationRegistry).
+			Name(clusterRegistrationRegistry).
+			URL("https://" + clusterRegistrationRegistry).
+			Build()
+		if err != nil {
+			return
+		}
+		registries = append(registries, registry)
+	}
+	for _, registry := range registries {
+		id := identifier(registry.ID())
+		registry, err = registry.Builder().
+			ID(id).
+			HREF(accountsMgmtPrefix + "/registries/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.registries = append(result.registries, registry)
+	}
+	for _, role := range b.roles {
+		id := identifier(role.ID())
+		role, err = role.Builder().
+			ID(id).
+			HREF(accountsMgmtPrefix + "/roles/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.roles = append(result.roles, role)
+	}
+	if b.currentAccount != "" && result.findAccountByUsername(b.currentAccount) == nil {
+		err = fmt.Errorf("current account '%s' doesn't exist", b.currentAccount)
+		return
+	}
+
+	return
+}
+
+// Accounts is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Accounts() amv1.AccountsServer {
+	return &accountsServer{
+		service: s,
+	}
+}
+
+// CurrentAccount is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) CurrentAccount() amv1.CurrentAccountServer {
+	return &currentAccountServer{
+		service: s,
+	}
+}
+
+// Organizations is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Organizations() amv1.OrganizationsServer {
+	return &organizationsServer{
+		service: s,
+	}
+}
+
+// AccessToken is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) AccessToken() amv1.AccessTokenServer {
+	return &accessTokenServer{
+		service: s,
+	}
+}
+
+// Permissions is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Permissions() amv1.PermissionsServer {
+	return &permissionsServer{
+		service: s,
+	}
+}
+
+// Registries is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Registries() amv1.RegistriesServer {
+	return &registriesServer{
+		service: s,
+	}
+}
+
+// RegistryCredentials is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) RegistryCredentials() amv1.RegistryCredentialsServer {
+	return &registryCredentialsServer{
+		service: s,
+	}
+}
+
+// ClusterAuthorizations is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) ClusterAuthorizations() amv1.ClusterAuthorizationsServer {
+	return &clusterAuthorizationsServer{
+		service: s,
+	}
+}
+
+// ClusterRegistrations is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) ClusterRegistrations() amv1.ClusterRegistrationsServer {
+	return &clusterRegistrationsServer{
+		service: s,
+	}
+}
+
+// Roles is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Roles() amv1.RolesServer {
+	return &rolesServer{
+		service: s,
+	}
+}
+
+// RoleBindings is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) RoleBindings() amv1.RoleBindingsServer {
+	return &roleBindingsServer{
+		service: s,
+	}
+}
+
+// Subscriptions is the implementation of the amv1.RootServer interface.
+func (s *AccountsMgmtServer) Subscriptions() amv1.SubscriptionsServer {
+	return &subscriptionsServer{
+		service: s,
+	}
+}
+
+// findAccount returns the account with the given identifier, or nil if it doesn't exist. It must
+// be called with the mutex locked.
+func (s *AccountsMgmtServer) findAccount(id string) *amv1.Account {
+	for _, account := range s.accounts {
+		if account.ID() == id {
+			return account
+		}
+	}
+	return nil
+}
+
+// findAccountByUsername returns the account with the given user name, or nil if it doesn't exist.
+// It must be called with the mutex locked.
+func (s *AccountsMgmtServer) findAccountByUsername(username string) *amv1.Account {
+	for _, account := range s.accounts {
+		if account.Username() == username {
+			return account
+		}
+	}
+	return nil
+}
+
+// findOrganization returns the organization with the given identifier, or nil if it doesn't
+// exist. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) findOrganization(id string) *amv1.Organization {
+	for _, organization := range s.organizations {
+		if organization.ID() == id {
+			return organization
+		}
+	}
+	return nil
+}
+
+// checkAccount checks that the given account can be added: the user name is mandatory and unique,
+// and the organization, if any, must exist. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) checkAccount(account *amv1.Account) error {
+	username := account.Username()
+	if username == "" {
+		return newError(http.StatusBadRequest, "Account user name is mandatory")
+	}
+	if s.findAccountByUsername(username) != nil {
+		return newError(http.StatusConflict, "Account with user name '%s' already exists", username)
+	}
+	organization, ok := account.GetOrganization()
+	if ok && s.findOrganization(organization.ID()) == nil {
+		return newError(
+			http.StatusBadRequest,
+			"Organization '%s' of account '%s' doesn't exist",
+			organization.ID(), username,
+		)
+	}
+	return nil
+}
+
+// registryToken returns the token of the registry credential of the given account for the given
+// registry, creating it if it doesn't exist yet. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) registryToken(account *amv1.Account, registry string) (string, error) {
+	for _, credential := range s.registryCredentials {
+		if credential.Account().ID() == account.ID() && credential.Registry().ID() == registry {
+			return credential.Token(), nil
+		}
+	}
+	id := newID()
+	credential, err := amv1.NewRegistryCredential().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/registry_credentials/" + id).
+		Username(account.Username()).
+		Token(newID()).
+		Account(amv1.NewAccount().
+			Link(true).
+			ID(account.ID()).
+			HREF(account.HREF())).
+		Registry(amv1.NewRegistry().
+			Link(true).
+			ID(registry).
+			HREF(accountsMgmtPrefix + "/registries/" + registry)).
+		Build()
+	if err != nil {
+		return "", err
+	}
+	s.registryCredentials = append(s.registryCredentials, credential)
+	return credential.Token(), nil
+}
+
+// currentAccountServer is the in-memory implementation of the amv1.CurrentAccountServer interface.
+type currentAccountServer struct {
+	service *AccountsMgmtServer
+}
+
+// Get is the implementation of the amv1.CurrentAccountServer interface.
+func (s *currentAccountServer) Get(ctx context.Context, request *amv1.CurrentAccountGetServerRequest,
+	response *amv1.CurrentAccountGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	account := s.service.findAccountByUsername(s.service.currentAccount)
+	if account == nil {
+		return newError(http.StatusNotFound, "There is no current account")
+	}
+	response.Body(account)
+	return nil
+}
+
+// accessTokenServer is the in-memory implementation of the amv1.AccessTokenServer interface.
+type accessTokenServer struct {
+	service *AccountsMgmtServer
+}
+
+// Post is the implementation of the amv1.AccessTokenServer interface. It generates an access token
+// for the current account, containing the credentials for all the registries. The token for the
+// `cloud.openshift.com` registry can be used to register clusters.
+func (s *accessTokenServer) Post(ctx context.Context, request *amv1.AccessTokenPostServerRequest,
+	response *amv1.AccessTokenPostServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	account := s.service.findAccountByUsername(s.service.currentAccount)
+	if account == nil {
+		return newError(http.StatusNotFound, "There is no current account")
+	}
+	auths := map[string]interface{}{}
+	for _, registry := range s.service.registries {
+		token, err := s.service.registryToken(account, registry.ID())
+		if err != nil {
+			return err
+		}
+		auths[registry.ID()] = map[string]interface{}{
+			"auth":  token,
+			"email": account.Email(),
+		}
+	}
+	token, err := amv1.NewAccessToken().
+		Extra(map[string]interface{}{
+			"auths": auths,
+		}).
+		Build()
+	if err != nil {
+		return err
+	}
+	response.Body(token)
+	return nil
+}
+
+// clusterRegistrationsServer is the in-memory implementation of the
+// amv1.ClusterRegistrationsServer interface.
+type clusterRegistrationsServer struct {
+	service *AccountsMgmtServer
+}
+
+// Post is the implementation of the amv1.ClusterRegistrationsServer interface. The authorization
+// token must be the token for the `cloud.openshift.com` registry of one of the accounts. The
+// cluster is registered creating a subscription, unless it already exists.
+func (s *clusterRegistrationsServer) Post(ctx context.Context,
+	request *amv1.ClusterRegistrationsPostServerRequest,
+	response *amv1.ClusterRegistrationsPostServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+
+	// Find the account that corresponds to the authorization token:
+	token := request.Request().AuthorizationToken()
+	var account *amv1.Account
+	for _, credential := range s.service.registryCredentials {
+		if credential.Registry().ID() == clusterRegistrationRegistry && credential.Token() == token {
+			account = s.service.findAccount(credential.Account().ID())
+			break
+		}
+	}
+	if account == nil {
+		return newError(http.StatusUnauthorized, "Authorization token isn't valid")
+	}
+
+	// Create the subscription, if it doesn't exist yet:
+	cluster := request.Request().ClusterID()
+	if cluster == "" {
+		return newError(http.StatusBadRequest, "Cluster identifier is mandatory")
+	}
+	if s.service.findSubscriptionByCluster(cluster) == nil {
+		_, err := s.service.addSubscription(cluster, account, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Send the result:
+	expires := s.service.clock.Now().Add(clusterRegistrationLife)
+	result, err := amv1.NewClusterRegistrationResponse().
+		ClusterID(cluster).
+		AccountID(account.ID()).
+		AuthorizationToken(newID()).
+		ExpiresAt(expires.UTC().Format(time.RFC3339)).
+		Build()
+	if err != nil {
+		return err
+	}
+	response.Response(result)
+	return nil
+}
+
+// Make sure that the in-memory servers implement the interfaces:
+var (
+	_ amv1.RootServer                  = &AccountsMgmtServer{}
+	_ amv1.AccountsServer              = &accountsServer{}
+	_ amv1.AccountServer               = &accountServer{}
+	_ amv1.CurrentAccountServer        = &currentAccountServer{}
+	_ amv1.OrganizationsServer         = &organizationsServer{}
+	_ amv1.OrganizationServer          = &organizationServer{}
+	_ amv1.ResourceQuotasServer        = &resourceQuotasServer{}
+	_ amv1.ResourceQuotaServer         = &resourceQuotaServer{}
+	_ amv1.QuotaSummaryServer          = &quotaSummaryServer{}
+	_ amv1.AccessTokenServer           = &accessTokenServer{}
+	_ amv1.PermissionsServer           = &permissionsServer{}
+	_ amv1.PermissionServer            = &permissionServer{}
+	_ amv1.RegistriesServer            = &registriesServer{}
+	_ amv1.RegistryServer              = &registryServer{}
+	_ amv1.RegistryCredentialsServer   = &registryCredentialsServer{}
+	_ amv1.RegistryCredentialServer    = &registryCredentialServer{}
+	_ amv1.ClusterAuthorizationsServer = &clusterAuthorizationsServer{}
+	_ amv1.ClusterRegistrationsServer  = &clusterRegistrationsServer{}
+	_ amv1.RolesServer                 = &rolesServer{}
+	_ amv1.RoleServer                  = &roleServer{}
+	_ amv1.RoleBindingsServer          = &roleBindingsServer{}
+	_ amv1.RoleBindingServer           = &roleBindingServer{}
+	_ amv1.SubscriptionsServer         = &subscriptionsServer{}
+	_ amv1.SubscriptionServer          = &subscriptionServer{}
+)
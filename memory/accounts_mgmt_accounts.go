@@ -0,0 +1,528 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementations of the servers that manage accounts,
+// organizations and resource quotas.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+)
+
+// accountsServer is the in-memory implementation of the amv1.AccountsServer interface.
+type accountsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.AccountsServer interface.
+func (s *accountsServer) List(ctx context.Context, request *amv1.AccountsListServerRequest,
+	response *amv1.AccountsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	accounts := s.service.accounts
+	indexes, total, err := selectPage(
+		len(accounts),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalAccount(accounts[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.AccountBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = accounts[index].Builder()
+	}
+	list, err := amv1.NewAccountList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.AccountsServer interface.
+func (s *accountsServer) Add(ctx context.Context, request *amv1.AccountsAddServerRequest,
+	response *amv1.AccountsAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	err := s.service.checkAccount(request.Body())
+	if err != nil {
+		return err
+	}
+	id := newID()
+	account, err := request.Body().Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/accounts/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.accounts = append(s.service.accounts, account)
+	response.Body(account)
+	return nil
+}
+
+// Account is the implementation of the amv1.AccountsServer interface.
+func (s *accountsServer) Account(id string) amv1.AccountServer {
+	return &accountServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// accountServer is the in-memory implementation of the amv1.AccountServer interface.
+type accountServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.AccountServer interface.
+func (s *accountServer) Get(ctx context.Context, request *amv1.AccountGetServerRequest,
+	response *amv1.AccountGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	account := s.service.findAccount(s.id)
+	if account == nil {
+		return notFound("Account", s.id)
+	}
+	response.Body(account)
+	return nil
+}
+
+// Update is the implementation of the amv1.AccountServer interface. The user name can't be
+// changed.
+func (s *accountServer) Update(ctx context.Context, request *amv1.AccountUpdateServerRequest,
+	response *amv1.AccountUpdateServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, account := range s.service.accounts {
+		if account.ID() != s.id {
+			continue
+		}
+		var updated *amv1.Account
+		err := mergeObjects(
+			func(target interface{}) error {
+				return amv1.MarshalAccount(account, target)
+			},
+			func(target interface{}) error {
+				return amv1.MarshalAccount(request.Body(), target)
+			},
+			func(source interface{}) (err error) {
+				updated, err = amv1.UnmarshalAccount(source)
+				return
+			},
+			"kind", "id", "href", "username",
+		)
+		if err != nil {
+			return err
+		}
+		organization, ok := updated.GetOrganization()
+		if ok && s.service.findOrganization(organization.ID()) == nil {
+			return newError(
+				http.StatusBadRequest,
+				"Organization '%s' doesn't exist",
+				organization.ID(),
+			)
+		}
+		s.service.accounts[i] = updated
+		response.Body(updated)
+		return nil
+	}
+	return notFound("Account", s.id)
+}
+
+// organizationsServer is the in-memory implementation of the amv1.OrganizationsServer interface.
+type organizationsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.OrganizationsServer interface.
+func (s *organizationsServer) List(ctx context.Context,
+	request *amv1.OrganizationsListServerRequest,
+	response *amv1.OrganizationsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	organizations := s.service.organizations
+	indexes, total, err := selectPage(
+		len(organizations),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalOrganization(organizations[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.OrganizationBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = organizations[index].Builder()
+	}
+	list, err := amv1.NewOrganizationList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.OrganizationsServer interface.
+func (s *organizationsServer) Add(ctx context.Context, request *amv1.OrganizationsAddServerRequest,
+	response *amv1.OrganizationsAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	id := newID()
+	organization, err := request.Body().Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/organizations/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.organizations = append(s.service.organizations, organization)
+	response.Body(organization)
+	return nil
+}
+
+// Organization is the implementation of the amv1.OrganizationsServer interface.
+func (s *organizationsServer) Organization(id string) amv1.OrganizationServer {
+	return &organizationServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// organizationServer is the in-memory implementation of the amv1.OrganizationServer interface.
+type organizationServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.OrganizationServer interface.
+func (s *organizationServer) Get(ctx context.Context, request *amv1.OrganizationGetServerRequest,
+	response *amv1.OrganizationGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	organization := s.service.findOrganization(s.id)
+	if organization == nil {
+		return notFound("Organization", s.id)
+	}
+	response.Body(organization)
+	return nil
+}
+
+// Update is the implementation of the amv1.OrganizationServer interface.
+func (s *organizationServer) Update(ctx context.Context,
+	request *amv1.OrganizationUpdateServerRequest,
+	response *amv1.OrganizationUpdateServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, organization := range s.service.organizations {
+		if organization.ID() != s.id {
+			continue
+		}
+		var updated *amv1.Organization
+		err := mergeObjects(
+			func(target interface{}) error {
+				return amv1.MarshalOrganization(organization, target)
+			},
+			func(target interface{}) error {
+				return amv1.MarshalOrganization(request.Body(), target)
+			},
+			func(source interface{}) (err error) {
+				updated, err = amv1.UnmarshalOrganization(source)
+				return
+			},
+			"kind", "id", "href",
+		)
+		if err != nil {
+			return err
+		}
+		s.service.organizations[i] = updated
+		response.Body(updated)
+		return nil
+	}
+	return notFound("Organization", s.id)
+}
+
+// ResourceQuota is the implementation of the amv1.OrganizationServer interface.
+func (s *organizationServer) ResourceQuota() amv1.ResourceQuotasServer {
+	return &resourceQuotasServer{
+		service:      s.service,
+		organization: s.id,
+	}
+}
+
+// QuotaSummary is the implementation of the amv1.OrganizationServer interface.
+func (s *organizationServer) QuotaSummary() amv1.QuotaSummaryServer {
+	return &quotaSummaryServer{
+		service:      s.service,
+		organization: s.id,
+	}
+}
+
+// resourceQuotasServer is the in-memory implementation of the amv1.ResourceQuotasServer
+// interface.
+type resourceQuotasServer struct {
+	service      *AccountsMgmtServer
+	organization string
+}
+
+// List is the implementation of the amv1.ResourceQuotasServer interface.
+func (s *resourceQuotasServer) List(ctx context.Context,
+	request *amv1.ResourceQuotasListServerRequest,
+	response *amv1.ResourceQuotasListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	if s.service.findOrganization(s.organization) == nil {
+		return notFound("Organization", s.organization)
+	}
+	quotas := s.service.organizationQuotas(s.organization)
+	indexes, total, err := selectPage(
+		len(quotas),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalResourceQuota(quotas[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.ResourceQuotaBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = quotas[index].Builder()
+	}
+	list, err := amv1.NewResourceQuotaList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.ResourceQuotasServer interface. The reserved amount of the
+// new quota is always zero, as it is calculated from the subscriptions.
+func (s *resourceQuotasServer) Add(ctx context.Context,
+	request *amv1.ResourceQuotasAddServerRequest,
+	response *amv1.ResourceQuotasAddServerResponse) error {
+	err := request.Body().Validate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	if s.service.findOrganization(s.organization) == nil {
+		return notFound("Organization", s.organization)
+	}
+	body := request.Body()
+	if body.ResourceName() == "" {
+		return newError(http.StatusBadRequest, "Resource name is mandatory")
+	}
+	if body.Allowed() < 0 {
+		return newError(http.StatusBadRequest, "Allowed amount can't be negative")
+	}
+	id := newID()
+	quota, err := body.Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/organizations/" + s.organization + "/resource_quota/" + id).
+		OrganizationID(s.organization).
+		Reserved(0).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.quotas = append(s.service.quotas, quota)
+	response.Body(quota)
+	return nil
+}
+
+// ResourceQuota is the implementation of the amv1.ResourceQuotasServer interface.
+func (s *resourceQuotasServer) ResourceQuota(id string) amv1.ResourceQuotaServer {
+	return &resourceQuotaServer{
+		service:      s.service,
+		organization: s.organization,
+		id:           id,
+	}
+}
+
+// resourceQuotaServer is the in-memory implementation of the amv1.ResourceQuotaServer interface.
+type resourceQuotaServer struct {
+	service      *AccountsMgmtServer
+	organization string
+	id           string
+}
+
+// Get is the implementation of the amv1.ResourceQuotaServer interface.
+func (s *resourceQuotaServer) Get(ctx context.Context, request *amv1.ResourceQuotaGetServerRequest,
+	response *amv1.ResourceQuotaGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, quota := range s.service.organizationQuotas(s.organization) {
+		if quota.ID() == s.id {
+			response.Body(quota)
+			return nil
+		}
+	}
+	return notFound("Resource quota", s.id)
+}
+
+// Update is the implementation of the amv1.ResourceQuotaServer interface. The organization and the
+// reserved amount can't be changed.
+func (s *resourceQuotaServer) Update(ctx context.Context,
+	request *amv1.ResourceQuotaUpdateServerRequest,
+	response *amv1.ResourceQuotaUpdateServerResponse) error {
+	err := request.Body().ValidateUpdate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, quota := range s.service.quotas {
+		if quota.ID() != s.id || quota.OrganizationID() != s.organization {
+			continue
+		}
+		var updated *amv1.ResourceQuota
+		err := mergeObjects(
+			func(target interface{}) error {
+				return amv1.MarshalResourceQuota(quota, target)
+			},
+			func(target interface{}) error {
+				return amv1.MarshalResourceQuota(request.Body(), target)
+			},
+			func(source interface{}) (err error) {
+				updated, err = amv1.UnmarshalResourceQuota(source)
+				return
+			},
+			"kind", "id", "href", "organization_id", "reserved",
+		)
+		if err != nil {
+			return err
+		}
+		if updated.Allowed() < 0 {
+			return newError(http.StatusBadRequest, "Allowed amount can't be negative")
+		}
+		s.service.quotas[i] = updated
+		response.Body(updated)
+		return nil
+	}
+	return notFound("Resource quota", s.id)
+}
+
+// organizationQuotas returns the resource quotas of the given organization. It must be called with
+// the mutex locked.
+func (s *AccountsMgmtServer) organizationQuotas(organization string) []*amv1.ResourceQuota {
+	var result []*amv1.ResourceQuota
+	for _, quota := range s.quotas {
+		if quota.OrganizationID() == organization {
+			result = append(result, quota)
+		}
+	}
+	return result
+}
+
+// quotaSummaryServer is the in-memory implementation of the amv1.QuotaSummaryServer interface.
+type quotaSummaryServer struct {
+	service      *AccountsMgmtServer
+	organization string
+}
+
+// List is the implementation of the amv1.QuotaSummaryServer interface. The resource quotas of the
+// organization are grouped by resource name, resource type, BYOC and availability zone type, and
+// the allowed and reserved amounts of each group are added.
+func (s *quotaSummaryServer) List(ctx context.Context, request *amv1.QuotaSummaryListServerRequest,
+	response *amv1.QuotaSummaryListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	if s.service.findOrganization(s.organization) == nil {
+		return notFound("Organization", s.organization)
+	}
+
+	// Aggregate the quotas, preserving the order in which the groups first appear:
+	var keys []quotaKey
+	allowed := map[quotaKey]int{}
+	reserved := map[quotaKey]int{}
+	for _, quota := range s.service.organizationQuotas(s.organization) {
+		key := quotaKeyOf(quota)
+		if _, ok := allowed[key]; !ok {
+			keys = append(keys, key)
+		}
+		allowed[key] += quota.Allowed()
+		reserved[key] += quota.Reserved()
+	}
+	summaries := make([]*amv1.QuotaSummary, len(keys))
+	for i, key := range keys {
+		summary, err := amv1.NewQuotaSummary().
+			OrganizationID(s.organization).
+			ResourceName(key.name).
+			ResourceType(key.typ).
+			BYOC(key.byoc).
+			AvailabilityZoneType(key.zone).
+			Allowed(allowed[key]).
+			Reserved(reserved[key]).
+			Build()
+		if err != nil {
+			return err
+		}
+		summaries[i] = summary
+	}
+
+	// Select the requested page:
+	indexes, total, err := selectPage(
+		len(summaries),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalQuotaSummary(summaries[i], target)
+			})
+		},
+		request.Search(), request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.QuotaSummaryBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = summaries[index].Builder()
+	}
+	list, err := amv1.NewQuotaSummaryList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
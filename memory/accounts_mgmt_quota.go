@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementation of the cluster authorizations server, which
+// checks and reserves resource quota.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+)
+
+// quotaKey identifies the kind of resource that a resource quota, a quota summary or a reserved
+// resource refers to.
+type quotaKey struct {
+	name string
+	typ  string
+	byoc bool
+	zone string
+}
+
+// quotaKeyOf returns the key of the given resource quota.
+func quotaKeyOf(quota *amv1.ResourceQuota) quotaKey {
+	return quotaKey{
+		name: quota.ResourceName(),
+		typ:  quota.ResourceType(),
+		byoc: quota.BYOC(),
+		zone: quota.AvailabilityZoneType(),
+	}
+}
+
+// clusterAuthorizationsServer is the in-memory implementation of the
+// amv1.ClusterAuthorizationsServer interface.
+type clusterAuthorizationsServer struct {
+	service *AccountsMgmtServer
+}
+
+// Post is the implementation of the amv1.ClusterAuthorizationsServer interface. The resources
+// requested are checked against the resource quotas of the organization of the account. If there
+// isn't enough quota the response will not be allowed and will contain the excess resources. If
+// there is enough quota and the request asks for it, the resources will be reserved and a
+// subscription will be created for the cluster. Requests for clusters that aren't managed are
+// always allowed.
+func (s *clusterAuthorizationsServer) Post(ctx context.Context,
+	request *amv1.ClusterAuthorizationsPostServerRequest,
+	response *amv1.ClusterAuthorizationsPostServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+
+	// Check the request:
+	body := request.Request()
+	cluster := body.ClusterID()
+	if cluster == "" {
+		return newError(http.StatusBadRequest, "Cluster identifier is mandatory")
+	}
+	username := body.AccountUsername()
+	account := s.service.findAccountByUsername(username)
+	if account == nil {
+		return notFound("Account", username)
+	}
+
+	// If the cluster already has a subscription then the resources have already been reserved:
+	existing := s.service.findSubscriptionByCluster(cluster)
+	if existing != nil {
+		result, err := amv1.NewClusterAuthorizationResponse().
+			Allowed(true).
+			Subscription(existing.object.Builder()).
+			Build()
+		if err != nil {
+			return err
+		}
+		response.Response(result)
+		return nil
+	}
+
+	// Add the requested resources, as the same kind of resource may appear multiple times:
+	var keys []quotaKey
+	requested := map[quotaKey]int{}
+	for _, resource := range body.Resources().Slice() {
+		if resource.Count() < 0 {
+			return newError(
+				http.StatusBadRequest,
+				"Count of resource '%s' can't be negative",
+				resource.ResourceName(),
+			)
+		}
+		key := quotaKey{
+			name: resource.ResourceName(),
+			typ:  resource.ResourceType(),
+			byoc: resource.BYOC(),
+			zone: resource.AvailabilityZoneType(),
+		}
+		if _, ok := requested[key]; !ok {
+			keys = append(keys, key)
+		}
+		requested[key] += resource.Count()
+	}
+
+	// Find the quotas that can be used for each kind of resource:
+	var quotas []*amv1.ResourceQuota
+	organization, ok := account.GetOrganization()
+	if ok {
+		quotas = s.service.organizationQuotas(organization.ID())
+	}
+	available := map[quotaKey]int{}
+	for _, quota := range quotas {
+		available[quotaKeyOf(quota)] += quota.Allowed() - quota.Reserved()
+	}
+
+	// Calculate the excess resources. Clusters that aren't managed don't consume quota.
+	var excess []*amv1.ReservedResourceBuilder
+	if body.Managed() {
+		for _, key := range keys {
+			missing := requested[key] - available[key]
+			if missing > 0 {
+				excess = append(excess, amv1.NewReservedResource().
+					ResourceName(key.name).
+					ResourceType(key.typ).
+					BYOC(key.byoc).
+					AvailabilityZoneType(key.zone).
+					Count(missing))
+			}
+		}
+	}
+	builder := amv1.NewClusterAuthorizationResponse().
+		Allowed(len(excess) == 0).
+		ExcessResources(excess...)
+
+	// Reserve the resources and create the subscription, if requested:
+	if len(excess) == 0 && body.Reserve() {
+		var reservations []*reservation
+		if body.Managed() {
+			for _, quota := range quotas {
+				key := quotaKeyOf(quota)
+				count := quota.Allowed() - quota.Reserved()
+				if count > requested[key] {
+					count = requested[key]
+				}
+				if count <= 0 {
+					continue
+				}
+				requested[key] -= count
+				reservations = append(reservations, &reservation{
+					quota: quota.ID(),
+					count: count,
+				})
+			}
+		}
+		for _, item := range reservations {
+			err := s.service.reserve(item.quota, item.count)
+			if err != nil {
+				return err
+			}
+		}
+		entry, err := s.service.addSubscription(cluster, account, reservations)
+		if err != nil {
+			return err
+		}
+		builder.Subscription(entry.object.Builder())
+	}
+
+	// Send the result:
+	result, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	response.Response(result)
+	return nil
+}
+
+// reserve adds the given count to the reserved amount of the resource quota with the given
+// identifier. Negative counts release resources. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) reserve(id string, count int) error {
+	for i, quota := range s.quotas {
+		if quota.ID() != id {
+			continue
+		}
+		reserved := quota.Reserved() + count
+		if reserved < 0 {
+			reserved = 0
+		}
+		updated, err := quota.Builder().
+			Reserved(reserved).
+			Build()
+		if err != nil {
+			return err
+		}
+		s.quotas[i] = updated
+		return nil
+	}
+	return notFound("Resource quota", id)
+}
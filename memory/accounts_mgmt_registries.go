@@ -0,0 +1,365 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementations of the servers that manage registries,
+// registry credentials and subscriptions.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+)
+
+// registriesServer is the in-memory implementation of the amv1.RegistriesServer interface.
+type registriesServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.RegistriesServer interface.
+func (s *registriesServer) List(ctx context.Context, request *amv1.RegistriesListServerRequest,
+	response *amv1.RegistriesListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	registries := s.service.registries
+	indexes, total, err := selectPage(
+		len(registries),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalRegistry(registries[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.RegistryBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = registries[index].Builder()
+	}
+	list, err := amv1.NewRegistryList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Registry is the implementation of the amv1.RegistriesServer interface.
+func (s *registriesServer) Registry(id string) amv1.RegistryServer {
+	return &registryServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// registryServer is the in-memory implementation of the amv1.RegistryServer interface.
+type registryServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.RegistryServer interface.
+func (s *registryServer) Get(ctx context.Context, request *amv1.RegistryGetServerRequest,
+	response *amv1.RegistryGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	registry := s.service.findRegistry(s.id)
+	if registry == nil {
+		return notFound("Registry", s.id)
+	}
+	response.Body(registry)
+	return nil
+}
+
+// findRegistry returns the registry with the given identifier, or nil if it doesn't exist. It
+// must be called with the mutex locked.
+func (s *AccountsMgmtServer) findRegistry(id string) *amv1.Registry {
+	for _, registry := range s.registries {
+		if registry.ID() == id {
+			return registry
+		}
+	}
+	return nil
+}
+
+// registryCredentialsServer is the in-memory implementation of the amv1.RegistryCredentialsServer
+// interface.
+type registryCredentialsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.RegistryCredentialsServer interface.
+func (s *registryCredentialsServer) List(ctx context.Context,
+	request *amv1.RegistryCredentialsListServerRequest,
+	response *amv1.RegistryCredentialsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	credentials := s.service.registryCredentials
+	indexes, total, err := selectPage(
+		len(credentials),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalRegistryCredential(credentials[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.RegistryCredentialBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = credentials[index].Builder()
+	}
+	list, err := amv1.NewRegistryCredentialList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.RegistryCredentialsServer interface. The account and the
+// registry are mandatory and must exist. If the body of the request doesn't contain a token a new
+// one will be generated.
+func (s *registryCredentialsServer) Add(ctx context.Context,
+	request *amv1.RegistryCredentialsAddServerRequest,
+	response *amv1.RegistryCredentialsAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	body := request.Body()
+	account := s.service.findAccount(body.Account().ID())
+	if account == nil {
+		return newError(http.StatusBadRequest, "Account '%s' doesn't exist", body.Account().ID())
+	}
+	registry := s.service.findRegistry(body.Registry().ID())
+	if registry == nil {
+		return newError(http.StatusBadRequest, "Registry '%s' doesn't exist", body.Registry().ID())
+	}
+	for _, credential := range s.service.registryCredentials {
+		if credential.Account().ID() == account.ID() && credential.Registry().ID() == registry.ID() {
+			return newError(
+				http.StatusConflict,
+				"Account '%s' already has credentials for registry '%s'",
+				account.ID(), registry.ID(),
+			)
+		}
+	}
+	username, ok := body.GetUsername()
+	if !ok {
+		username = account.Username()
+	}
+	token, ok := body.GetToken()
+	if !ok {
+		token = newID()
+	}
+	id := newID()
+	credential, err := body.Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/registry_credentials/" + id).
+		Username(username).
+		Token(token).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.registryCredentials = append(s.service.registryCredentials, credential)
+	response.Body(credential)
+	return nil
+}
+
+// RegistryCredential is the implementation of the amv1.RegistryCredentialsServer interface.
+func (s *registryCredentialsServer) RegistryCredential(id string) amv1.RegistryCredentialServer {
+	return &registryCredentialServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// registryCredentialServer is the in-memory implementation of the amv1.RegistryCredentialServer
+// interface.
+type registryCredentialServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.RegistryCredentialServer interface.
+func (s *registryCredentialServer) Get(ctx context.Context,
+	request *amv1.RegistryCredentialGetServerRequest,
+	response *amv1.RegistryCredentialGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, credential := range s.service.registryCredentials {
+		if credential.ID() == s.id {
+			response.Body(credential)
+			return nil
+		}
+	}
+	return notFound("Registry credential", s.id)
+}
+
+// subscriptionsServer is the in-memory implementation of the amv1.SubscriptionsServer interface.
+type subscriptionsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.SubscriptionsServer interface.
+func (s *subscriptionsServer) List(ctx context.Context,
+	request *amv1.SubscriptionsListServerRequest,
+	response *amv1.SubscriptionsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	subscriptions := s.service.subscriptions
+	indexes, total, err := selectPage(
+		len(subscriptions),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalSubscription(subscriptions[i].object, target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.SubscriptionBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = subscriptions[index].object.Builder()
+	}
+	list, err := amv1.NewSubscriptionList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Subscription is the implementation of the amv1.SubscriptionsServer interface.
+func (s *subscriptionsServer) Subscription(id string) amv1.SubscriptionServer {
+	return &subscriptionServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// subscriptionServer is the in-memory implementation of the amv1.SubscriptionServer interface.
+type subscriptionServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.SubscriptionServer interface.
+func (s *subscriptionServer) Get(ctx context.Context, request *amv1.SubscriptionGetServerRequest,
+	response *amv1.SubscriptionGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry := s.service.findSubscription(s.id)
+	if entry == nil {
+		return notFound("Subscription", s.id)
+	}
+	response.Body(entry.object)
+	return nil
+}
+
+// Delete is the implementation of the amv1.SubscriptionServer interface. The resources reserved
+// for the subscription are returned to the resource quotas they were taken from.
+func (s *subscriptionServer) Delete(ctx context.Context,
+	request *amv1.SubscriptionDeleteServerRequest,
+	response *amv1.SubscriptionDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, entry := range s.service.subscriptions {
+		if entry.object.ID() != s.id {
+			continue
+		}
+		for _, item := range entry.reservations {
+			err := s.service.reserve(item.quota, -item.count)
+			if err != nil {
+				return err
+			}
+		}
+		s.service.subscriptions = append(
+			s.service.subscriptions[:i],
+			s.service.subscriptions[i+1:]...,
+		)
+		return nil
+	}
+	return notFound("Subscription", s.id)
+}
+
+// findSubscription returns the subscription with the given identifier, or nil if it doesn't
+// exist. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) findSubscription(id string) *subscriptionEntry {
+	for _, entry := range s.subscriptions {
+		if entry.object.ID() == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+// findSubscriptionByCluster returns the subscription of the cluster with the given identifier, or
+// nil if it doesn't exist. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) findSubscriptionByCluster(cluster string) *subscriptionEntry {
+	for _, entry := range s.subscriptions {
+		if entry.object.ClusterID() == cluster {
+			return entry
+		}
+	}
+	return nil
+}
+
+// addSubscription creates a subscription for the given cluster, created by the given account and
+// holding the given reservations. It must be called with the mutex locked.
+func (s *AccountsMgmtServer) addSubscription(cluster string, account *amv1.Account,
+	reservations []*reservation) (entry *subscriptionEntry, err error) {
+	id := newID()
+	builder := amv1.NewSubscription().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/subscriptions/" + id).
+		ClusterID(cluster).
+		Creator(amv1.NewAccount().
+			Link(true).
+			ID(account.ID()).
+			HREF(account.HREF()))
+	organization, ok := account.GetOrganization()
+	if ok {
+		builder.OrganizationID(organization.ID())
+	}
+	object, err := builder.Build()
+	if err != nil {
+		return
+	}
+	entry = &subscriptionEntry{
+		object:       object,
+		reservations: reservations,
+	}
+	s.subscriptions = append(s.subscriptions, entry)
+	return
+}
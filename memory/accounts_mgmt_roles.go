@@ -0,0 +1,420 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementations of the servers that manage roles, role
+// bindings and permissions.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+)
+
+// rolesServer is the in-memory implementation of the amv1.RolesServer interface.
+type rolesServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.RolesServer interface.
+func (s *rolesServer) List(ctx context.Context, request *amv1.RolesListServerRequest,
+	response *amv1.RolesListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	roles := s.service.roles
+	indexes, total, err := selectPage(
+		len(roles),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalRole(roles[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.RoleBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = roles[index].Builder()
+	}
+	list, err := amv1.NewRoleList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.RolesServer interface. If the body of the request doesn't
+// contain an identifier a new one will be generated.
+func (s *rolesServer) Add(ctx context.Context, request *amv1.RolesAddServerRequest,
+	response *amv1.RolesAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	id := identifier(request.Body().ID())
+	if s.service.findRole(id) != nil {
+		return newError(http.StatusConflict, "Role '%s' already exists", id)
+	}
+	role, err := request.Body().Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/roles/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.roles = append(s.service.roles, role)
+	response.Body(role)
+	return nil
+}
+
+// Role is the implementation of the amv1.RolesServer interface.
+func (s *rolesServer) Role(id string) amv1.RoleServer {
+	return &roleServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// roleServer is the in-memory implementation of the amv1.RoleServer interface.
+type roleServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.RoleServer interface.
+func (s *roleServer) Get(ctx context.Context, request *amv1.RoleGetServerRequest,
+	response *amv1.RoleGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	role := s.service.findRole(s.id)
+	if role == nil {
+		return notFound("Role", s.id)
+	}
+	response.Body(role)
+	return nil
+}
+
+// Update is the implementation of the amv1.RoleServer interface.
+func (s *roleServer) Update(ctx context.Context, request *amv1.RoleUpdateServerRequest,
+	response *amv1.RoleUpdateServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, role := range s.service.roles {
+		if role.ID() != s.id {
+			continue
+		}
+		var updated *amv1.Role
+		err := mergeObjects(
+			func(target interface{}) error {
+				return amv1.MarshalRole(role, target)
+			},
+			func(target interface{}) error {
+				return amv1.MarshalRole(request.Body(), target)
+			},
+			func(source interface{}) (err error) {
+				updated, err = amv1.UnmarshalRole(source)
+				return
+			},
+			"kind", "id", "href",
+		)
+		if err != nil {
+			return err
+		}
+		s.service.roles[i] = updated
+		response.Body(updated)
+		return nil
+	}
+	return notFound("Role", s.id)
+}
+
+// Delete is the implementation of the amv1.RoleServer interface. Roles that are used by role
+// bindings can't be deleted.
+func (s *roleServer) Delete(ctx context.Context, request *amv1.RoleDeleteServerRequest,
+	response *amv1.RoleDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, binding := range s.service.roleBindings {
+		if binding.Role().ID() == s.id {
+			return newError(
+				http.StatusConflict,
+				"Role '%s' is used by role binding '%s'",
+				s.id, binding.ID(),
+			)
+		}
+	}
+	for i, role := range s.service.roles {
+		if role.ID() == s.id {
+			s.service.roles = append(s.service.roles[:i], s.service.roles[i+1:]...)
+			return nil
+		}
+	}
+	return notFound("Role", s.id)
+}
+
+// findRole returns the role with the given identifier, or nil if it doesn't exist. It must be
+// called with the mutex locked.
+func (s *AccountsMgmtServer) findRole(id string) *amv1.Role {
+	for _, role := range s.roles {
+		if role.ID() == id {
+			return role
+		}
+	}
+	return nil
+}
+
+// roleBindingsServer is the in-memory implementation of the amv1.RoleBindingsServer interface.
+type roleBindingsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.RoleBindingsServer interface.
+func (s *roleBindingsServer) List(ctx context.Context, request *amv1.RoleBindingsListServerRequest,
+	response *amv1.RoleBindingsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	bindings := s.service.roleBindings
+	indexes, total, err := selectPage(
+		len(bindings),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalRoleBinding(bindings[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.RoleBindingBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = bindings[index].Builder()
+	}
+	list, err := amv1.NewRoleBindingList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.RoleBindingsServer interface. The role is mandatory, and
+// all the referenced objects must exist.
+func (s *roleBindingsServer) Add(ctx context.Context, request *amv1.RoleBindingsAddServerRequest,
+	response *amv1.RoleBindingsAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	body := request.Body()
+	role, ok := body.GetRole()
+	if !ok {
+		return newError(http.StatusBadRequest, "Role of role binding is mandatory")
+	}
+	if s.service.findRole(role.ID()) == nil {
+		return newError(http.StatusBadRequest, "Role '%s' doesn't exist", role.ID())
+	}
+	account, ok := body.GetAccount()
+	if ok && s.service.findAccount(account.ID()) == nil {
+		return newError(http.StatusBadRequest, "Account '%s' doesn't exist", account.ID())
+	}
+	organization, ok := body.GetOrganization()
+	if ok && s.service.findOrganization(organization.ID()) == nil {
+		return newError(
+			http.StatusBadRequest,
+			"Organization '%s' doesn't exist",
+			organization.ID(),
+		)
+	}
+	subscription, ok := body.GetSubscription()
+	if ok && s.service.findSubscription(subscription.ID()) == nil {
+		return newError(
+			http.StatusBadRequest,
+			"Subscription '%s' doesn't exist",
+			subscription.ID(),
+		)
+	}
+	id := newID()
+	binding, err := body.Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/role_bindings/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.roleBindings = append(s.service.roleBindings, binding)
+	response.Body(binding)
+	return nil
+}
+
+// RoleBinding is the implementation of the amv1.RoleBindingsServer interface.
+func (s *roleBindingsServer) RoleBinding(id string) amv1.RoleBindingServer {
+	return &roleBindingServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// roleBindingServer is the in-memory implementation of the amv1.RoleBindingServer interface.
+type roleBindingServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.RoleBindingServer interface.
+func (s *roleBindingServer) Get(ctx context.Context, request *amv1.RoleBindingGetServerRequest,
+	response *amv1.RoleBindingGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, binding := range s.service.roleBindings {
+		if binding.ID() == s.id {
+			response.Body(binding)
+			return nil
+		}
+	}
+	return notFound("Role binding", s.id)
+}
+
+// Delete is the implementation of the amv1.RoleBindingServer interface.
+func (s *roleBindingServer) Delete(ctx context.Context,
+	request *amv1.RoleBindingDeleteServerRequest,
+	response *amv1.RoleBindingDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, binding := range s.service.roleBindings {
+		if binding.ID() == s.id {
+			s.service.roleBindings = append(
+				s.service.roleBindings[:i],
+				s.service.roleBindings[i+1:]...,
+			)
+			return nil
+		}
+	}
+	return notFound("Role binding", s.id)
+}
+
+// permissionsServer is the in-memory implementation of the amv1.PermissionsServer interface.
+type permissionsServer struct {
+	service *AccountsMgmtServer
+}
+
+// List is the implementation of the amv1.PermissionsServer interface.
+func (s *permissionsServer) List(ctx context.Context, request *amv1.PermissionsListServerRequest,
+	response *amv1.PermissionsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	permissions := s.service.permissions
+	indexes, total, err := selectPage(
+		len(permissions),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return amv1.MarshalPermission(permissions[i], target)
+			})
+		},
+		"", request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*amv1.PermissionBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = permissions[index].Builder()
+	}
+	list, err := amv1.NewPermissionList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the amv1.PermissionsServer interface. If the permission references
+// a role, that role must exist.
+func (s *permissionsServer) Add(ctx context.Context, request *amv1.PermissionsAddServerRequest,
+	response *amv1.PermissionsAddServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	body := request.Body()
+	role, ok := body.GetRoleID()
+	if ok && s.service.findRole(role) == nil {
+		return newError(http.StatusBadRequest, "Role '%s' doesn't exist", role)
+	}
+	id := newID()
+	permission, err := body.Builder().
+		ID(id).
+		HREF(accountsMgmtPrefix + "/permissions/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.permissions = append(s.service.permissions, permission)
+	response.Body(permission)
+	return nil
+}
+
+// Permission is the implementation of the amv1.PermissionsServer interface.
+func (s *permissionsServer) Permission(id string) amv1.PermissionServer {
+	return &permissionServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// permissionServer is the in-memory implementation of the amv1.PermissionServer interface.
+type permissionServer struct {
+	service *AccountsMgmtServer
+	id      string
+}
+
+// Get is the implementation of the amv1.PermissionServer interface.
+func (s *permissionServer) Get(ctx context.Context, request *amv1.PermissionGetServerRequest,
+	response *amv1.PermissionGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, permission := range s.service.permissions {
+		if permission.ID() == s.id {
+			response.Body(permission)
+			return nil
+		}
+	}
+	return notFound("Permission", s.id)
+}
+
+// Delete is the implementation of the amv1.PermissionServer interface.
+func (s *permissionServer) Delete(ctx context.Context, request *amv1.PermissionDeleteServerRequest,
+	response *amv1.PermissionDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for i, permission := range s.service.permissions {
+		if permission.ID() == s.id {
+			s.service.permissions = append(
+				s.service.permissions[:i],
+				s.service.permissions[i+1:]...,
+			)
+			return nil
+		}
+	}
+	return notFound("Permission", s.id)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the clocks used by the in-memory services to simulate the passing of time.
+
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the interface of the objects that the in-memory services use to get the current time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// realClock is the clock that returns the real time.
+type realClock struct {
+}
+
+// Now is the implementation of the Clock interface.
+func (c realClock) Now() time.Time {
+	return time.Now()
+}
+
+// ManualClock is a clock whose time only changes when explicitly requested, useful to test state
+// transitions without actually waiting. Don't create instances of this type directly, use the
+// NewManualClock function instead.
+type ManualClock struct {
+	mutex *sync.Mutex
+	now   time.Time
+}
+
+// NewManualClock creates a new manual clock that initially returns the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{
+		mutex: &sync.Mutex{},
+		now:   now,
+	}
+}
+
+// Now is the implementation of the Clock interface.
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Set changes the time returned by the clock.
+func (c *ManualClock) Set(now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = now
+}
+
+// Advance moves the time returned by the clock forward by the given duration.
+func (c *ManualClock) Advance(duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(duration)
+}
@@ -0,0 +1,708 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementation of the clusters management service and of the
+// servers that manage the clusters.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+// ClustersMgmtBuilder contains the configuration and logic needed to create an in-memory
+// implementation of the clusters management service. Don't create instances of this type
+// directly, use the NewClustersMgmtBuilder function instead.
+type ClustersMgmtBuilder struct {
+	clock                Clock
+	pendingDuration      time.Duration
+	installingDuration   time.Duration
+	uninstallingDuration time.Duration
+	dashboards           []*cmv1.Dashboard
+	flavours             []*cmv1.Flavour
+	versions             []*cmv1.Version
+}
+
+// ClustersMgmtServer is an in-memory implementation of the clusters management service. It
+// implements the cmv1.RootServer interface, so it can be passed to the ClustersMgmtV1 method of
+// the server builder. Don't create instances of this type directly, use the builder instead.
+type ClustersMgmtServer struct {
+	mutex                *sync.Mutex
+	clock                Clock
+	pendingDuration      time.Duration
+	installingDuration   time.Duration
+	uninstallingDuration time.Duration
+	clusters             []*clusterEntry
+	dashboards           []*cmv1.Dashboard
+	flavours             []*cmv1.Flavour
+	versions             []*cmv1.Version
+}
+
+// clusterEntry contains the data of a cluster stored in memory.
+type clusterEntry struct {
+	object            *cmv1.Cluster
+	created           time.Time
+	deleted           time.Time
+	credentials       *cmv1.ClusterCredentials
+	groups            []*groupEntry
+	identityProviders []*cmv1.IdentityProvider
+}
+
+// Prefix of the paths of all the objects of the clusters management service:
+const clustersMgmtPrefix = "/api/clusters_mgmt/v1"
+
+// Names of the groups that are created for each cluster:
+var clusterGroupNames = []string{
+	"dedicated-admins",
+	"cluster-admins",
+}
+
+// NewClustersMgmtBuilder creates a builder that knows how to create in-memory implementations of
+// the clusters management service.
+func NewClustersMgmtBuilder() *ClustersMgmtBuilder {
+	return &ClustersMgmtBuilder{
+		pendingDuration:      1 * time.Minute,
+		installingDuration:   30 * time.Minute,
+		uninstallingDuration: 10 * time.Minute,
+	}
+}
+
+// Clock sets the clock that will be used to calculate the state of the clusters. The default is to
+// use the real time. Use a manual clock to control the transitions explicitly.
+func (b *ClustersMgmtBuilder) Clock(value Clock) *ClustersMgmtBuilder {
+	b.clock = value
+	return b
+}
+
+// PendingDuration sets the time that clusters stay in the `pending` state after they are created.
+// The default is one minute.
+func (b *ClustersMgmtBuilder) PendingDuration(value time.Duration) *ClustersMgmtBuilder {
+	b.pendingDuration = value
+	return b
+}
+
+// InstallingDuration sets the time that clusters stay in the `installing` state before they are
+// `ready`. The default is 30 minutes.
+func (b *ClustersMgmtBuilder) InstallingDuration(value time.Duration) *ClustersMgmtBuilder {
+	b.installingDuration = value
+	return b
+}
+
+// UninstallingDuration sets the time that clusters stay in the `uninstalling` state after they are
+// deleted. When this time passes the cluster is removed completely. The default is 10 minutes.
+func (b *ClustersMgmtBuilder) UninstallingDuration(value time.Duration) *ClustersMgmtBuilder {
+	b.uninstallingDuration = value
+	return b
+}
+
+// Dashboards adds dashboards that will be initially available in the service.
+func (b *ClustersMgmtBuilder) Dashboards(values ...*cmv1.Dashboard) *ClustersMgmtBuilder {
+	b.dashboards = append(b.dashboards, values...)
+	return b
+}
+
+// Flavours adds flavours that will be initially available in the service.
+func (b *ClustersMgmtBuilder) Flavours(values ...*cmv1.Flavour) *ClustersMgmtBuilder {
+	b.flavours = append(b.flavours, values...)
+	return b
+}
+
+// Versions adds versions that will be initially available in the service.
+func (b *ClustersMgmtBuilder) Versions(values ...*cmv1.Version) *ClustersMgmtBuilder {
+	b.versions = append(b.versions, values...)
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new in-memory implementation of
+// the clusters management service.
+func (b *ClustersMgmtBuilder) Build() (result *ClustersMgmtServer, err error) {
+	// Check the parameters:
+	if b.pendingDuration < 0 || b.installingDuration < 0 || b.uninstallingDuration < 0 {
+		err = fmt.Errorf("durations of the cluster states can't be negative")
+		return
+	}
+
+	// Set the default clock, if needed:
+	clock := b.clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	// Create the server:
+	result = &ClustersMgmtServer{
+		mutex:                &sync.Mutex{},
+		clock:                clock,
+		pendingDuration:      b.pendingDuration,
+		installingDuration:   b.installingDuration,
+		uninstallingDuration: b.uninstallingDuration,
+	}
+
+	// Add the initial objects, generating the identifiers and links that are missing:
+	for _, dashboard := range b.dashboards {
+		id := identifier(dashboard.ID())
+		dashboard, err = dashboard.Builder().
+			ID(id).
+			HREF(clustersMgmtPrefix + "/dashboards/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.dashboards = append(result.dashboards, dashboard)
+	}
+	for _, flavour := range b.flavours {
+		id := identifier(flavour.ID())
+		flavour, err = flavour.Builder().
+			ID(id).
+			HREF(clustersMgmtPrefix + "/flavours/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.flavours = append(result.flavours, flavour)
+	}
+	for _, version := range b.versions {
+		id := identifier(version.ID())
+		version, err = version.Builder().
+			ID(id).
+			HREF(clustersMgmtPrefix + "/versions/" + id).
+			Build()
+		if err != nil {
+			return
+		}
+		result.versions = append(result.versions, version)
+	}
+
+	return
+}
+
+// Clusters is the implementation of the cmv1.RootServer interface.
+func (s *ClustersMgmtServer) Clusters() cmv1.ClustersServer {
+	return &clustersServer{
+		service: s,
+	}
+}
+
+// Dashboards is the implementation of the cmv1.RootServer interface.
+func (s *ClustersMgmtServer) Dashboards() cmv1.DashboardsServer {
+	return &dashboardsServer{
+		service: s,
+	}
+}
+
+// Flavours is the implementation of the cmv1.RootServer interface.
+func (s *ClustersMgmtServer) Flavours() cmv1.FlavoursServer {
+	return &flavoursServer{
+		service: s,
+	}
+}
+
+// Versions is the implementation of the cmv1.RootServer interface.
+func (s *ClustersMgmtServer) Versions() cmv1.VersionsServer {
+	return &versionsServer{
+		service: s,
+	}
+}
+
+// purge removes the clusters that have finished uninstalling. It must be called with the mutex
+// locked.
+func (s *ClustersMgmtServer) purge() {
+	now := s.clock.Now()
+	clusters := s.clusters[:0]
+	for _, entry := range s.clusters {
+		if entry.deleted.IsZero() || now.Before(entry.deleted.Add(s.uninstallingDuration)) {
+			clusters = append(clusters, entry)
+		}
+	}
+	for i := len(clusters); i < len(s.clusters); i++ {
+		s.clusters[i] = nil
+	}
+	s.clusters = clusters
+}
+
+// lookup returns the entry of the cluster with the given identifier, or a not found error if it
+// doesn't exist. It must be called with the mutex locked.
+func (s *ClustersMgmtServer) lookup(id string) (entry *clusterEntry, err error) {
+	s.purge()
+	for _, current := range s.clusters {
+		if current.object.ID() == id {
+			entry = current
+			return
+		}
+	}
+	err = notFound("Cluster", id)
+	return
+}
+
+// state calculates the current state of the given cluster, according to the time that passed
+// since it was created or deleted. It must be called with the mutex locked.
+func (s *ClustersMgmtServer) state(entry *clusterEntry) cmv1.ClusterState {
+	if !entry.deleted.IsZero() {
+		return cmv1.ClusterStateUninstalling
+	}
+	elapsed := s.clock.Now().Sub(entry.created)
+	switch {
+	case elapsed < s.pendingDuration:
+		return cmv1.ClusterStatePending
+	case elapsed < s.pendingDuration+s.installingDuration:
+		return cmv1.ClusterStateInstalling
+	default:
+		return cmv1.ClusterStateReady
+	}
+}
+
+// render returns the representation of the given cluster that is sent to clients, including the
+// current state. It must be called with the mutex locked.
+func (s *ClustersMgmtServer) render(entry *clusterEntry) (*cmv1.Cluster, error) {
+	return entry.object.Builder().
+		State(s.state(entry)).
+		Build()
+}
+
+// clustersServer is the in-memory implementation of the cmv1.ClustersServer interface.
+type clustersServer struct {
+	service *ClustersMgmtServer
+}
+
+// List is the implementation of the cmv1.ClustersServer interface.
+func (s *clustersServer) List(ctx context.Context, request *cmv1.ClustersListServerRequest,
+	response *cmv1.ClustersListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	s.service.purge()
+	clusters := make([]*cmv1.Cluster, len(s.service.clusters))
+	for i, entry := range s.service.clusters {
+		cluster, err := s.service.render(entry)
+		if err != nil {
+			return err
+		}
+		clusters[i] = cluster
+	}
+	indexes, total, err := selectPage(
+		len(clusters),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return cmv1.MarshalCluster(clusters[i], target)
+			})
+		},
+		request.Search(), request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.ClusterBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = clusters[index].Builder()
+	}
+	list, err := cmv1.NewClusterList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the cmv1.ClustersServer interface.
+func (s *clustersServer) Add(ctx context.Context, request *cmv1.ClustersAddServerRequest,
+	response *cmv1.ClustersAddServerResponse) error {
+	err := request.Body().Validate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	s.service.purge()
+
+	// Check the request:
+	body := request.Body()
+	name := body.Name()
+	if name == "" {
+		return newError(http.StatusBadRequest, "Cluster name is mandatory")
+	}
+	for _, entry := range s.service.clusters {
+		if entry.object.Name() == name {
+			return newError(http.StatusConflict, "Cluster with name '%s' already exists", name)
+		}
+	}
+
+	// Create the cluster and the objects that depend on it:
+	now := s.service.clock.Now()
+	id := newID()
+	href := clustersMgmtPrefix + "/clusters/" + id
+	object, err := body.Builder().
+		ID(id).
+		HREF(href).
+		CreationTimestamp(now).
+		Build()
+	if err != nil {
+		return err
+	}
+	credentials, err := cmv1.NewClusterCredentials().
+		ID(id).
+		HREF(href + "/credentials").
+		Kubeconfig(fmt.Sprintf("# Kubeconfig for cluster '%s'\n", name)).
+		Admin(cmv1.NewAdminCredentials().
+			User("kubeadmin").
+			Password(newID())).
+		Build()
+	if err != nil {
+		return err
+	}
+	entry := &clusterEntry{
+		object:      object,
+		created:     now,
+		credentials: credentials,
+	}
+	for _, group := range clusterGroupNames {
+		entry.groups = append(entry.groups, &groupEntry{
+			id: group,
+		})
+	}
+	s.service.clusters = append(s.service.clusters, entry)
+
+	// Send the result:
+	cluster, err := s.service.render(entry)
+	if err != nil {
+		return err
+	}
+	response.Body(cluster)
+	return nil
+}
+
+// Cluster is the implementation of the cmv1.ClustersServer interface.
+func (s *clustersServer) Cluster(id string) cmv1.ClusterServer {
+	return &clusterServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// clusterServer is the in-memory implementation of the cmv1.ClusterServer interface.
+type clusterServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) Get(ctx context.Context, request *cmv1.ClusterGetServerRequest,
+	response *cmv1.ClusterGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	cluster, err := s.service.render(entry)
+	if err != nil {
+		return err
+	}
+	response.Body(cluster)
+	return nil
+}
+
+// Update is the implementation of the cmv1.ClusterServer interface. The attributes present in the
+// body of the request replace the current values, except the identifier, the link, the state and
+// the creation timestamp, which can't be changed.
+func (s *clusterServer) Update(ctx context.Context, request *cmv1.ClusterUpdateServerRequest,
+	response *cmv1.ClusterUpdateServerResponse) error {
+	err := request.Body().ValidateUpdate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	var object *cmv1.Cluster
+	err = mergeObjects(
+		func(target interface{}) error {
+			return cmv1.MarshalCluster(entry.object, target)
+		},
+		func(target interface{}) error {
+			return cmv1.MarshalCluster(request.Body(), target)
+		},
+		func(source interface{}) error {
+			object, err = cmv1.UnmarshalCluster(source)
+			return err
+		},
+		"kind", "id", "href", "state", "creation_timestamp",
+	)
+	if err != nil {
+		return err
+	}
+	name := object.Name()
+	if name == "" {
+		return newError(http.StatusBadRequest, "Cluster name is mandatory")
+	}
+	for _, other := range s.service.clusters {
+		if other != entry && other.object.Name() == name {
+			return newError(http.StatusConflict, "Cluster with name '%s' already exists", name)
+		}
+	}
+	entry.object = object
+	cluster, err := s.service.render(entry)
+	if err != nil {
+		return err
+	}
+	response.Body(cluster)
+	return nil
+}
+
+// Delete is the implementation of the cmv1.ClusterServer interface. The cluster moves to the
+// `uninstalling` state, and is removed when the uninstalling duration passes.
+func (s *clusterServer) Delete(ctx context.Context, request *cmv1.ClusterDeleteServerRequest,
+	response *cmv1.ClusterDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	if entry.deleted.IsZero() {
+		entry.deleted = s.service.clock.Now()
+	}
+	return nil
+}
+
+// Status is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) Status() cmv1.ClusterStatusServer {
+	return &clusterStatusServer{
+		service: s.service,
+		id:      s.id,
+	}
+}
+
+// Credentials is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) Credentials() cmv1.CredentialsServer {
+	return &credentialsServer{
+		service: s.service,
+		id:      s.id,
+	}
+}
+
+// Logs is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) Logs() cmv1.LogsServer {
+	return &logsServer{
+		service: s.service,
+		id:      s.id,
+	}
+}
+
+// Groups is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) Groups() cmv1.GroupsServer {
+	return &groupsServer{
+		service: s.service,
+		id:      s.id,
+	}
+}
+
+// IdentityProviders is the implementation of the cmv1.ClusterServer interface.
+func (s *clusterServer) IdentityProviders() cmv1.IdentityProvidersServer {
+	return &identityProvidersServer{
+		service: s.service,
+		id:      s.id,
+	}
+}
+
+// clusterStatusServer is the in-memory implementation of the cmv1.ClusterStatusServer interface.
+type clusterStatusServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.ClusterStatusServer interface.
+func (s *clusterStatusServer) Get(ctx context.Context, request *cmv1.ClusterStatusGetServerRequest,
+	response *cmv1.ClusterStatusGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	state := s.service.state(entry)
+	status, err := cmv1.NewClusterStatus().
+		ID(s.id).
+		HREF(entry.object.HREF() + "/status").
+		State(state).
+		Description(fmt.Sprintf("Cluster is %s", state)).
+		Build()
+	if err != nil {
+		return err
+	}
+	response.Status_(status)
+	return nil
+}
+
+// credentialsServer is the in-memory implementation of the cmv1.CredentialsServer interface.
+type credentialsServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.CredentialsServer interface. The credentials are only
+// available when the cluster is ready.
+func (s *credentialsServer) Get(ctx context.Context, request *cmv1.CredentialsGetServerRequest,
+	response *cmv1.CredentialsGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	if s.service.state(entry) != cmv1.ClusterStateReady {
+		return newError(
+			http.StatusNotFound,
+			"Credentials for cluster '%s' aren't available because it isn't ready",
+			s.id,
+		)
+	}
+	response.Body(entry.credentials)
+	return nil
+}
+
+// logsServer is the in-memory implementation of the cmv1.LogsServer interface.
+type logsServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// List is the implementation of the cmv1.LogsServer interface.
+func (s *logsServer) List(ctx context.Context, request *cmv1.LogsListServerRequest,
+	response *cmv1.LogsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	logs := s.service.logs(entry)
+	items := make([]*cmv1.LogBuilder, len(logs))
+	for i, log := range logs {
+		items[i] = log.Builder()
+	}
+	list, err := cmv1.NewLogList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(1)
+	response.Size(len(logs))
+	response.Total(len(logs))
+	response.Items(list)
+	return nil
+}
+
+// Log is the implementation of the cmv1.LogsServer interface.
+func (s *logsServer) Log(id string) cmv1.LogServer {
+	return &logServer{
+		service: s.service,
+		cluster: s.id,
+		id:      id,
+	}
+}
+
+// logServer is the in-memory implementation of the cmv1.LogServer interface.
+type logServer struct {
+	service *ClustersMgmtServer
+	cluster string
+	id      string
+}
+
+// Get is the implementation of the cmv1.LogServer interface.
+func (s *logServer) Get(ctx context.Context, request *cmv1.LogGetServerRequest,
+	response *cmv1.LogGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.cluster)
+	if err != nil {
+		return err
+	}
+	for _, log := range s.service.logs(entry) {
+		if log.ID() == s.id {
+			response.Body(log)
+			return nil
+		}
+	}
+	return notFound("Log", s.id)
+}
+
+// logs generates the logs of the given cluster. There is an `install` log that describes the
+// progress of the installation, and an `uninstall` log once the cluster has been deleted. It must
+// be called with the mutex locked.
+func (s *ClustersMgmtServer) logs(entry *clusterEntry) []*cmv1.Log {
+	name := entry.object.Name()
+	state := s.state(entry)
+	install := fmt.Sprintf("Cluster '%s' created\n", name)
+	if state != cmv1.ClusterStatePending {
+		install += fmt.Sprintf("Installing cluster '%s'\n", name)
+	}
+	if state == cmv1.ClusterStateReady || state == cmv1.ClusterStateUninstalling {
+		install += fmt.Sprintf("Cluster '%s' is ready\n", name)
+	}
+	contents := map[string]string{
+		"install": install,
+	}
+	ids := []string{"install"}
+	if state == cmv1.ClusterStateUninstalling {
+		contents["uninstall"] = fmt.Sprintf("Uninstalling cluster '%s'\n", name)
+		ids = append(ids, "uninstall")
+	}
+	var result []*cmv1.Log
+	for _, id := range ids {
+		log, err := cmv1.NewLog().
+			ID(id).
+			HREF(entry.object.HREF() + "/logs/" + id).
+			Content(contents[id]).
+			Build()
+		if err != nil {
+			continue
+		}
+		result = append(result, log)
+	}
+	return result
+}
+
+// Make sure that the in-memory servers implement the interfaces:
+var (
+	_ cmv1.RootServer              = &ClustersMgmtServer{}
+	_ cmv1.ClustersServer          = &clustersServer{}
+	_ cmv1.ClusterServer           = &clusterServer{}
+	_ cmv1.ClusterStatusServer     = &clusterStatusServer{}
+	_ cmv1.CredentialsServer       = &credentialsServer{}
+	_ cmv1.LogsServer              = &logsServer{}
+	_ cmv1.LogServer               = &logServer{}
+	_ cmv1.GroupsServer            = &groupsServer{}
+	_ cmv1.GroupServer             = &groupServer{}
+	_ cmv1.UsersServer             = &usersServer{}
+	_ cmv1.UserServer              = &userServer{}
+	_ cmv1.IdentityProvidersServer = &identityProvidersServer{}
+	_ cmv1.IdentityProviderServer  = &identityProviderServer{}
+	_ cmv1.DashboardsServer        = &dashboardsServer{}
+	_ cmv1.DashboardServer         = &dashboardServer{}
+	_ cmv1.FlavoursServer          = &flavoursServer{}
+	_ cmv1.FlavourServer           = &flavourServer{}
+	_ cmv1.VersionsServer          = &versionsServer{}
+	_ cmv1.VersionServer           = &versionServer{}
+)
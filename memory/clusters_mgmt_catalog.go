@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementations of the servers that manage the dashboards,
+// flavours and versions.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+// dashboardsServer is the in-memory implementation of the cmv1.DashboardsServer interface.
+type dashboardsServer struct {
+	service *ClustersMgmtServer
+}
+
+// List is the implementation of the cmv1.DashboardsServer interface.
+func (s *dashboardsServer) List(ctx context.Context, request *cmv1.DashboardsListServerRequest,
+	response *cmv1.DashboardsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	dashboards := s.service.dashboards
+	indexes, total, err := selectPage(
+		len(dashboards),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return cmv1.MarshalDashboard(dashboards[i], target)
+			})
+		},
+		request.Search(), request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.DashboardBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = dashboards[index].Builder()
+	}
+	list, err := cmv1.NewDashboardList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Dashboard is the implementation of the cmv1.DashboardsServer interface.
+func (s *dashboardsServer) Dashboard(id string) cmv1.DashboardServer {
+	return &dashboardServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// dashboardServer is the in-memory implementation of the cmv1.DashboardServer interface.
+type dashboardServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.DashboardServer interface.
+func (s *dashboardServer) Get(ctx context.Context, request *cmv1.DashboardGetServerRequest,
+	response *cmv1.DashboardGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, dashboard := range s.service.dashboards {
+		if dashboard.ID() == s.id {
+			response.Body(dashboard)
+			return nil
+		}
+	}
+	return notFound("Dashboard", s.id)
+}
+
+// flavoursServer is the in-memory implementation of the cmv1.FlavoursServer interface.
+type flavoursServer struct {
+	service *ClustersMgmtServer
+}
+
+// List is the implementation of the cmv1.FlavoursServer interface.
+func (s *flavoursServer) List(ctx context.Context, request *cmv1.FlavoursListServerRequest,
+	response *cmv1.FlavoursListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	flavours := s.service.flavours
+	indexes, total, err := selectPage(
+		len(flavours),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return cmv1.MarshalFlavour(flavours[i], target)
+			})
+		},
+		request.Search(), request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.FlavourBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = flavours[index].Builder()
+	}
+	list, err := cmv1.NewFlavourList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the cmv1.FlavoursServer interface. If the body of the request
+// doesn't contain an identifier a new one will be generated.
+func (s *flavoursServer) Add(ctx context.Context, request *cmv1.FlavoursAddServerRequest,
+	response *cmv1.FlavoursAddServerResponse) error {
+	err := request.Body().Validate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	id := identifier(request.Body().ID())
+	for _, flavour := range s.service.flavours {
+		if flavour.ID() == id {
+			return newError(http.StatusConflict, "Flavour '%s' already exists", id)
+		}
+	}
+	flavour, err := request.Body().Builder().
+		ID(id).
+		HREF(clustersMgmtPrefix + "/flavours/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	s.service.flavours = append(s.service.flavours, flavour)
+	response.Body(flavour)
+	return nil
+}
+
+// Flavour is the implementation of the cmv1.FlavoursServer interface.
+func (s *flavoursServer) Flavour(id string) cmv1.FlavourServer {
+	return &flavourServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// flavourServer is the in-memory implementation of the cmv1.FlavourServer interface.
+type flavourServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.FlavourServer interface.
+func (s *flavourServer) Get(ctx context.Context, request *cmv1.FlavourGetServerRequest,
+	response *cmv1.FlavourGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, flavour := range s.service.flavours {
+		if flavour.ID() == s.id {
+			response.Body(flavour)
+			return nil
+		}
+	}
+	return notFound("Flavour", s.id)
+}
+
+// versionsServer is the in-memory implementation of the cmv1.VersionsServer interface.
+type versionsServer struct {
+	service *ClustersMgmtServer
+}
+
+// List is the implementation of the cmv1.VersionsServer interface.
+func (s *versionsServer) List(ctx context.Context, request *cmv1.VersionsListServerRequest,
+	response *cmv1.VersionsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	versions := s.service.versions
+	indexes, total, err := selectPage(
+		len(versions),
+		func(i int) (map[string]interface{}, error) {
+			return toDocument(func(target interface{}) error {
+				return cmv1.MarshalVersion(versions[i], target)
+			})
+		},
+		request.Search(), request.Order(), request.Page(), request.Size(),
+	)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.VersionBuilder, len(indexes))
+	for i, index := range indexes {
+		items[i] = versions[index].Builder()
+	}
+	list, err := cmv1.NewVersionList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(request.Page())
+	response.Size(len(indexes))
+	response.Total(total)
+	response.Items(list)
+	return nil
+}
+
+// Version is the implementation of the cmv1.VersionsServer interface.
+func (s *versionsServer) Version(id string) cmv1.VersionServer {
+	return &versionServer{
+		service: s.service,
+		id:      id,
+	}
+}
+
+// versionServer is the in-memory implementation of the cmv1.VersionServer interface.
+type versionServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// Get is the implementation of the cmv1.VersionServer interface.
+func (s *versionServer) Get(ctx context.Context, request *cmv1.VersionGetServerRequest,
+	response *cmv1.VersionGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	for _, version := range s.service.versions {
+		if version.ID() == s.id {
+			response.Body(version)
+			return nil
+		}
+	}
+	return notFound("Version", s.id)
+}
@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the in-memory implementations of the servers that manage the groups, users
+// and identity providers of clusters.
+
+package memory
+
+import (
+	"context"
+	"net/http"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+// groupEntry contains the data of a group of a cluster stored in memory.
+type groupEntry struct {
+	id    string
+	users []*cmv1.User
+}
+
+// groupsServer is the in-memory implementation of the cmv1.GroupsServer interface.
+type groupsServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// List is the implementation of the cmv1.GroupsServer interface.
+func (s *groupsServer) List(ctx context.Context, request *cmv1.GroupsListServerRequest,
+	response *cmv1.GroupsListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.GroupBuilder, len(entry.groups))
+	for i, group := range entry.groups {
+		object, err := renderGroup(entry, group)
+		if err != nil {
+			return err
+		}
+		items[i] = object.Builder()
+	}
+	list, err := cmv1.NewGroupList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(1)
+	response.Size(len(items))
+	response.Total(len(items))
+	response.Items(list)
+	return nil
+}
+
+// Group is the implementation of the cmv1.GroupsServer interface.
+func (s *groupsServer) Group(id string) cmv1.GroupServer {
+	return &groupServer{
+		service: s.service,
+		cluster: s.id,
+		id:      id,
+	}
+}
+
+// groupServer is the in-memory implementation of the cmv1.GroupServer interface.
+type groupServer struct {
+	service *ClustersMgmtServer
+	cluster string
+	id      string
+}
+
+// Get is the implementation of the cmv1.GroupServer interface.
+func (s *groupServer) Get(ctx context.Context, request *cmv1.GroupGetServerRequest,
+	response *cmv1.GroupGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, group, err := s.lookup()
+	if err != nil {
+		return err
+	}
+	object, err := renderGroup(entry, group)
+	if err != nil {
+		return err
+	}
+	response.Body(object)
+	return nil
+}
+
+// Users is the implementation of the cmv1.GroupServer interface.
+func (s *groupServer) Users() cmv1.UsersServer {
+	return &usersServer{
+		group: s,
+	}
+}
+
+// lookup returns the entries of the cluster and the group, or a not found error if any of them
+// doesn't exist. It must be called with the mutex locked.
+func (s *groupServer) lookup() (entry *clusterEntry, group *groupEntry, err error) {
+	entry, err = s.service.lookup(s.cluster)
+	if err != nil {
+		return
+	}
+	for _, current := range entry.groups {
+		if current.id == s.id {
+			group = current
+			return
+		}
+	}
+	err = notFound("Group", s.id)
+	return
+}
+
+// renderGroup returns the representation of the given group that is sent to clients.
+func renderGroup(entry *clusterEntry, group *groupEntry) (*cmv1.Group, error) {
+	users := make([]*cmv1.UserBuilder, len(group.users))
+	for i, user := range group.users {
+		users[i] = user.Builder()
+	}
+	return cmv1.NewGroup().
+		ID(group.id).
+		HREF(entry.object.HREF() + "/groups/" + group.id).
+		Users(users...).
+		Build()
+}
+
+// usersServer is the in-memory implementation of the cmv1.UsersServer interface.
+type usersServer struct {
+	group *groupServer
+}
+
+// List is the implementation of the cmv1.UsersServer interface.
+func (s *usersServer) List(ctx context.Context, request *cmv1.UsersListServerRequest,
+	response *cmv1.UsersListServerResponse) error {
+	s.group.service.mutex.Lock()
+	defer s.group.service.mutex.Unlock()
+	_, group, err := s.group.lookup()
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.UserBuilder, len(group.users))
+	for i, user := range group.users {
+		items[i] = user.Builder()
+	}
+	list, err := cmv1.NewUserList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(1)
+	response.Size(len(items))
+	response.Total(len(items))
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the cmv1.UsersServer interface. The identifier of the user is its
+// user name, so it is mandatory.
+func (s *usersServer) Add(ctx context.Context, request *cmv1.UsersAddServerRequest,
+	response *cmv1.UsersAddServerResponse) error {
+	s.group.service.mutex.Lock()
+	defer s.group.service.mutex.Unlock()
+	entry, group, err := s.group.lookup()
+	if err != nil {
+		return err
+	}
+	id := request.Body().ID()
+	if id == "" {
+		return newError(http.StatusBadRequest, "User identifier is mandatory")
+	}
+	for _, user := range group.users {
+		if user.ID() == id {
+			return newError(
+				http.StatusConflict,
+				"User '%s' already exists in group '%s'",
+				id, group.id,
+			)
+		}
+	}
+	user, err := request.Body().Builder().
+		HREF(entry.object.HREF() + "/groups/" + group.id + "/users/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	group.users = append(group.users, user)
+	response.Body(user)
+	return nil
+}
+
+// User is the implementation of the cmv1.UsersServer interface.
+func (s *usersServer) User(id string) cmv1.UserServer {
+	return &userServer{
+		group: s.group,
+		id:    id,
+	}
+}
+
+// userServer is the in-memory implementation of the cmv1.UserServer interface.
+type userServer struct {
+	group *groupServer
+	id    string
+}
+
+// Get is the implementation of the cmv1.UserServer interface.
+func (s *userServer) Get(ctx context.Context, request *cmv1.UserGetServerRequest,
+	response *cmv1.UserGetServerResponse) error {
+	s.group.service.mutex.Lock()
+	defer s.group.service.mutex.Unlock()
+	_, group, err := s.group.lookup()
+	if err != nil {
+		return err
+	}
+	for _, user := range group.users {
+		if user.ID() == s.id {
+			response.Body(user)
+			return nil
+		}
+	}
+	return notFound("User", s.id)
+}
+
+// Delete is the implementation of the cmv1.UserServer interface.
+func (s *userServer) Delete(ctx context.Context, request *cmv1.UserDeleteServerRequest,
+	response *cmv1.UserDeleteServerResponse) error {
+	s.group.service.mutex.Lock()
+	defer s.group.service.mutex.Unlock()
+	_, group, err := s.group.lookup()
+	if err != nil {
+		return err
+	}
+	for i, user := range group.users {
+		if user.ID() == s.id {
+			group.users = append(group.users[:i], group.users[i+1:]...)
+			return nil
+		}
+	}
+	return notFound("User", s.id)
+}
+
+// identityProvidersServer is the in-memory implementation of the cmv1.IdentityProvidersServer
+// interface.
+type identityProvidersServer struct {
+	service *ClustersMgmtServer
+	id      string
+}
+
+// List is the implementation of the cmv1.IdentityProvidersServer interface.
+func (s *identityProvidersServer) List(ctx context.Context,
+	request *cmv1.IdentityProvidersListServerRequest,
+	response *cmv1.IdentityProvidersListServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	items := make([]*cmv1.IdentityProviderBuilder, len(entry.identityProviders))
+	for i, provider := range entry.identityProviders {
+		items[i] = provider.Builder()
+	}
+	list, err := cmv1.NewIdentityProviderList().Items(items...).Build()
+	if err != nil {
+		return err
+	}
+	response.Page(1)
+	response.Size(len(items))
+	response.Total(len(items))
+	response.Items(list)
+	return nil
+}
+
+// Add is the implementation of the cmv1.IdentityProvidersServer interface.
+func (s *identityProvidersServer) Add(ctx context.Context,
+	request *cmv1.IdentityProvidersAddServerRequest,
+	response *cmv1.IdentityProvidersAddServerResponse) error {
+	err := request.Body().Validate()
+	if err != nil {
+		return err
+	}
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.id)
+	if err != nil {
+		return err
+	}
+	id := newID()
+	provider, err := request.Body().Builder().
+		ID(id).
+		HREF(entry.object.HREF() + "/identity_providers/" + id).
+		Build()
+	if err != nil {
+		return err
+	}
+	entry.identityProviders = append(entry.identityProviders, provider)
+	response.Body(provider)
+	return nil
+}
+
+// IdentityProvider is the implementation of the cmv1.IdentityProvidersServer interface.
+func (s *identityProvidersServer) IdentityProvider(id string) cmv1.IdentityProviderServer {
+	return &identityProviderServer{
+		service: s.service,
+		cluster: s.id,
+		id:      id,
+	}
+}
+
+// identityProviderServer is the in-memory implementation of the cmv1.IdentityProviderServer
+// interface.
+type identityProviderServer struct {
+	service *ClustersMgmtServer
+	cluster string
+	id      string
+}
+
+// Get is the implementation of the cmv1.IdentityProviderServer interface.
+func (s *identityProviderServer) Get(ctx context.Context,
+	request *cmv1.IdentityProviderGetServerRequest,
+	response *cmv1.IdentityProviderGetServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.cluster)
+	if err != nil {
+		return err
+	}
+	for _, provider := range entry.identityProviders {
+		if provider.ID() == s.id {
+			response.Body(provider)
+			return nil
+		}
+	}
+	return notFound("Identity provider", s.id)
+}
+
+// Delete is the implementation of the cmv1.IdentityProviderServer interface.
+func (s *identityProviderServer) Delete(ctx context.Context,
+	request *cmv1.IdentityProviderDeleteServerRequest,
+	response *cmv1.IdentityProviderDeleteServerResponse) error {
+	s.service.mutex.Lock()
+	defer s.service.mutex.Unlock()
+	entry, err := s.service.lookup(s.cluster)
+	if err != nil {
+		return err
+	}
+	for i, provider := range entry.identityProviders {
+		if provider.ID() == s.id {
+			entry.identityProviders = append(
+				entry.identityProviders[:i],
+				entry.identityProviders[i+1:]...,
+			)
+			return nil
+		}
+	}
+	return notFound("Identity provider", s.id)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package memory contains in-memory implementations of the services, intended for tests. For
+// example, to run a controller against a fake clusters management service:
+//
+//	clock := memory.NewManualClock(time.Now())
+//	service, err := memory.NewClustersMgmtBuilder().
+//		Clock(clock).
+//		Build()
+//	if err != nil {
+//		...
+//	}
+//	handler, err := sdk.NewServerBuilder().
+//		ClustersMgmtV1(service).
+//		Build()
+//	if err != nil {
+//		...
+//	}
+//	server := httptest.NewServer(handler)
+//	defer server.Close()
+//
+// The accounts management service is created in the same way, with the NewAccountsMgmtBuilder
+// function. It enforces the resource quotas of the organizations when clusters are authorized.
+//
+// The state is kept in memory and discarded when the objects are garbage collected.
+package memory
@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used by the implementations of the different services.
+
+package memory
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+	"github.com/openshift-online/uhc-sdk-go/search"
+)
+
+// newError creates an error with the given status code and a reason formatted using the given
+// format and arguments. The generated server adapters send these errors to the client as they
+// are.
+func newError(status int, format string, args ...interface{}) error {
+	result, err := errors.NewError().
+		ID(strconv.Itoa(status)).
+		Reason(fmt.Sprintf(format, args...)).
+		Status(status).
+		Build()
+	if err != nil {
+		return err
+	}
+	return result
+}
+
+// notFound creates the error used when an object doesn't exist.
+func notFound(kind, id string) error {
+	return newError(http.StatusNotFound, "%s '%s' doesn't exist", kind, id)
+}
+
+// newID generates a new random identifier.
+func newID() string {
+	data := make([]byte, 16)
+	_, err := rand.Read(data)
+	if err != nil {
+		panic(err)
+	}
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	return strings.ToLower(encoding.EncodeToString(data))
+}
+
+// identifier returns the given identifier, or a new one if it is empty.
+func identifier(id string) string {
+	if id == "" {
+		id = newID()
+	}
+	return id
+}
+
+// toDocument converts an object into a generic JSON document, calling the given function to
+// marshal it. This is used to evaluate search expressions and to merge updates.
+func toDocument(marshal func(target interface{}) error) (result map[string]interface{}, err error) {
+	buffer := new(bytes.Buffer)
+	err = marshal(buffer)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(buffer.Bytes(), &result)
+	return
+}
+
+// fromDocument converts a generic JSON document into an object, calling the given function to
+// unmarshal it.
+func fromDocument(document map[string]interface{}, unmarshal func(source interface{}) error) error {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	return unmarshal(data)
+}
+
+// mergeDocuments copies into the target document the attributes of the patch document. Attributes
+// that are documents themselves are merged recursively, the rest are replaced.
+func mergeDocuments(target, patch map[string]interface{}) {
+	for name, value := range patch {
+		patchObject, ok := value.(map[string]interface{})
+		if ok {
+			targetObject, ok := target[name].(map[string]interface{})
+			if ok {
+				mergeDocuments(targetObject, patchObject)
+				continue
+			}
+		}
+		target[name] = value
+	}
+}
+
+// mergeObjects merges the attributes of a patch into the current value of an object, using the
+// given functions to marshal the current value and the patch, and to unmarshal the result. The
+// protected attributes of the patch are ignored.
+func mergeObjects(current, patch func(target interface{}) error, result func(source interface{}) error,
+	protected ...string) error {
+	currentDocument, err := toDocument(current)
+	if err != nil {
+		return err
+	}
+	patchDocument, err := toDocument(patch)
+	if err != nil {
+		return err
+	}
+	for _, name := range protected {
+		delete(patchDocument, name)
+	}
+	mergeDocuments(currentDocument, patchDocument)
+	return fromDocument(currentDocument, result)
+}
+
+// selectPage applies the given search expression and order to the given number of items, calling
+// the given function to get the JSON document for each item, and returns the indexes of the items
+// that are in the requested page, together with the total number of items that match the
+// expression.
+func selectPage(count int, document func(i int) (map[string]interface{}, error), text string,
+	order []*helpers.OrderItem, page, size int) (indexes []int, total int, err error) {
+	expression, err := search.Parse(text)
+	if err != nil {
+		err = newError(http.StatusBadRequest, "Can't parse search expression: %v", err)
+		return
+	}
+	if page < 1 {
+		err = newError(http.StatusBadRequest, "Page number %d isn't valid", page)
+		return
+	}
+	if size < 0 {
+		err = newError(http.StatusBadRequest, "Page size %d isn't valid", size)
+		return
+	}
+
+	// Find the items that match the search expression, and their documents, as they are also
+	// needed to sort them:
+	type candidate struct {
+		index    int
+		document map[string]interface{}
+	}
+	var candidates []candidate
+	for i := 0; i < count; i++ {
+		var data map[string]interface{}
+		if expression != nil || len(order) > 0 {
+			data, err = document(i)
+			if err != nil {
+				return
+			}
+		}
+		if expression != nil {
+			var matches bool
+			matches, err = search.Match(expression, data)
+			if err != nil {
+				err = newError(http.StatusBadRequest, "Can't evaluate search expression: %v", err)
+				return
+			}
+			if !matches {
+				continue
+			}
+		}
+		candidates = append(candidates, candidate{
+			index:    i,
+			document: data,
+		})
+	}
+
+	// Sort the items:
+	if len(order) > 0 {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if err != nil {
+				return false
+			}
+			var result int
+			result, err = search.Compare(order, candidates[i].document, candidates[j].document)
+			return result < 0
+		})
+		if err != nil {
+			err = newError(http.StatusBadRequest, "Can't sort items: %v", err)
+			return
+		}
+	}
+
+	// Select the requested page. Note that the page number and size are checked against the
+	// total before multiplying or adding them, as they come from the request and the results
+	// could overflow:
+	total = len(candidates)
+	if size == 0 || page-1 > total/size {
+		return
+	}
+	first := (page - 1) * size
+	for i := first; i < total && i-first < size; i++ {
+		indexes = append(indexes, candidates[i].index)
+	}
+	return
+}
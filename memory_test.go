@@ -0,0 +1,472 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the in-memory implementations of the services, using them through
+// a real connection.
+
+package sdk
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/memory"
+)
+
+var _ = Describe("In-memory clusters management", func() {
+	var clock *memory.ManualClock
+	var apiServer *httptest.Server
+	var connection *Connection
+	var clusters *cmv1.ClustersClient
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the service:
+		clock = memory.NewManualClock(time.Now())
+		flavour, err := cmv1.NewFlavour().ID("osd-4").Name("OSD 4").Build()
+		Expect(err).ToNot(HaveOccurred())
+		service, err := memory.NewClustersMgmtBuilder().
+			Clock(clock).
+			PendingDuration(time.Minute).
+			InstallingDuration(10 * time.Minute).
+			UninstallingDuration(5 * time.Minute).
+			Flavours(flavour).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		handler, err := NewServerBuilder().
+			Logger(logger).
+			ClustersMgmtV1(service).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = httptest.NewServer(handler)
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(apiServer.URL).
+			Tokens(DefaultToken("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		clusters = connection.ClustersMgmt().V1().Clusters()
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer.Close()
+	})
+
+	// add creates a cluster with the given name and returns it.
+	add := func(name string) *cmv1.Cluster {
+		body, err := cmv1.NewCluster().
+			Name(name).
+			Region(cmv1.NewCloudRegion().ID("us-east-1")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := clusters.Add().Body(body).Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusCreated))
+		return response.Body()
+	}
+
+	// state returns the current state of the cluster with the given identifier.
+	state := func(id string) cmv1.ClusterState {
+		response, err := clusters.Cluster(id).Status().Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		return response.Status_().State()
+	}
+
+	It("Generates identifier and link", func() {
+		cluster := add("mycluster")
+		Expect(cluster.ID()).ToNot(BeEmpty())
+		Expect(cluster.HREF()).To(Equal("/api/clusters_mgmt/v1/clusters/" + cluster.ID()))
+		Expect(cluster.Name()).To(Equal("mycluster"))
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStatePending))
+	})
+
+	It("Rejects cluster without name", func() {
+		body, err := cmv1.NewCluster().Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := clusters.Add().Body(body).Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Returns 404 for cluster that doesn't exist", func() {
+		response, err := clusters.Cluster("junk").Get().Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusNotFound))
+		_, ok := err.(*errors.Error)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("Simulates the cluster life cycle", func() {
+		id := add("mycluster").ID()
+		Expect(state(id)).To(Equal(cmv1.ClusterStatePending))
+		_, err := clusters.Cluster(id).Credentials().Get().Send()
+		Expect(err).To(HaveOccurred())
+
+		clock.Advance(2 * time.Minute)
+		Expect(state(id)).To(Equal(cmv1.ClusterStateInstalling))
+
+		clock.Advance(10 * time.Minute)
+		Expect(state(id)).To(Equal(cmv1.ClusterStateReady))
+		credentials, err := clusters.Cluster(id).Credentials().Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.Body().Admin().User()).To(Equal("kubeadmin"))
+
+		deleted, err := clusters.Cluster(id).Delete().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted.Status()).To(Equal(http.StatusNoContent))
+		Expect(state(id)).To(Equal(cmv1.ClusterStateUninstalling))
+		logs, err := clusters.Cluster(id).Logs().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logs.Items().Len()).To(Equal(2))
+
+		clock.Advance(5 * time.Minute)
+		_, err = clusters.Cluster(id).Get().Send()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Updates cluster", func() {
+		id := add("mycluster").ID()
+		patch, err := cmv1.NewCluster().DisplayName("My cluster").Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = clusters.Cluster(id).Update().Body(patch).Send()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := clusters.Cluster(id).Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Name()).To(Equal("mycluster"))
+		Expect(response.Body().DisplayName()).To(Equal("My cluster"))
+	})
+
+	It("Rejects update to the name of another cluster", func() {
+		add("a1")
+		id := add("a2").ID()
+		patch, err := cmv1.NewCluster().Name("a1").Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := clusters.Cluster(id).Update().Body(patch).Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusConflict))
+
+		// Keeping the same name should still be possible:
+		patch, err = cmv1.NewCluster().Name("a2").DisplayName("A2").Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = clusters.Cluster(id).Update().Body(patch).Send()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Supports paging, search and total", func() {
+		add("a1")
+		add("a2")
+		add("a3")
+		add("b1")
+		response, err := clusters.List().
+			Search("name like 'a%'").
+			Page(2).
+			Size(2).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Page()).To(Equal(2))
+		Expect(response.Size()).To(Equal(1))
+		Expect(response.Total()).To(Equal(3))
+		Expect(response.Items().Slice()[0].Name()).To(Equal("a3"))
+	})
+
+	It("Accepts very large page numbers and sizes", func() {
+		add("a1")
+		add("a2")
+		response, err := clusters.List().
+			Page(math.MaxInt64).
+			Size(math.MaxInt64).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Size()).To(Equal(0))
+		Expect(response.Total()).To(Equal(2))
+		response, err = clusters.List().
+			Page(1).
+			Size(math.MaxInt64).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Size()).To(Equal(2))
+		response, err = clusters.List().
+			Page(math.MaxInt64 / 2).
+			Size(4).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Size()).To(Equal(0))
+	})
+
+	It("Sorts using the order parameter", func() {
+		add("b")
+		add("c")
+		add("a")
+		response, err := clusters.List().
+			Order("name desc").
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		items := response.Items().Slice()
+		Expect(items).To(HaveLen(3))
+		Expect(items[0].Name()).To(Equal("c"))
+		Expect(items[1].Name()).To(Equal("b"))
+		Expect(items[2].Name()).To(Equal("a"))
+	})
+
+	It("Rejects order by attribute that isn't allowed", func() {
+		response, err := clusters.List().Parameter("order", "junk asc").Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Checks order before sending the request", func() {
+		response, err := clusters.List().Order("junk asc").Send()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("attribute 'junk'"))
+		Expect(response).To(BeNil())
+	})
+
+	It("Rejects invalid search", func() {
+		response, err := clusters.List().Search("name like").Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Manages users of groups", func() {
+		id := add("mycluster").ID()
+		users := clusters.Cluster(id).Groups().Group("dedicated-admins").Users()
+		user, err := cmv1.NewUser().ID("alice").Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = users.Add().Body(user).Send()
+		Expect(err).ToNot(HaveOccurred())
+		list, err := users.List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Items().Len()).To(Equal(1))
+		_, err = users.User("alice").Delete().Send()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = users.User("alice").Get().Send()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Serves the initial flavours", func() {
+		response, err := connection.ClustersMgmt().V1().Flavours().Flavour("osd-4").Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Name()).To(Equal("OSD 4"))
+	})
+})
+
+var _ = Describe("In-memory accounts management", func() {
+	var apiServer *httptest.Server
+	var connection *Connection
+	var client *amv1.RootClient
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the service, with an organization that has quota for two clusters:
+		organization, err := amv1.NewOrganization().ID("myorg").Name("My org").Build()
+		Expect(err).ToNot(HaveOccurred())
+		account, err := amv1.NewAccount().
+			Username("alice").
+			Email("alice@example.com").
+			Organization(amv1.NewOrganization().ID("myorg")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		quota, err := amv1.NewResourceQuota().
+			OrganizationID("myorg").
+			SKU("MW00530").
+			ResourceName("cluster").
+			ResourceType("cluster.aws").
+			AvailabilityZoneType("single").
+			Allowed(2).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		service, err := memory.NewAccountsMgmtBuilder().
+			Organizations(organization).
+			Accounts(account).
+			ResourceQuotas(quota).
+			CurrentAccount("alice").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		handler, err := NewServerBuilder().
+			Logger(logger).
+			AccountsMgmtV1(service).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = httptest.NewServer(handler)
+
+		// Create the connection:
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(apiServer.URL).
+			Tokens(DefaultToken("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		client = connection.AccountsMgmt().V1()
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer.Close()
+	})
+
+	// authorize requests authorization for a managed cluster with the given number of single zone
+	// clusters, and returns the response.
+	authorize := func(cluster string, count int, reserve bool) *amv1.ClusterAuthorizationResponse {
+		request, err := amv1.NewClusterAuthorizationRequest().
+			ClusterID(cluster).
+			AccountUsername("alice").
+			Managed(true).
+			Reserve(reserve).
+			Resources(amv1.NewReservedResource().
+				ResourceName("cluster").
+				ResourceType("cluster.aws").
+				AvailabilityZoneType("single").
+				Count(count)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := client.ClusterAuthorizations().Post().Request(request).Send()
+		Expect(err).ToNot(HaveOccurred())
+		return response.Response()
+	}
+
+	// reserved returns the reserved amount of the quota summary of the organization.
+	reserved := func() int {
+		response, err := client.Organizations().Organization("myorg").QuotaSummary().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Items().Len()).To(Equal(1))
+		return response.Items().Slice()[0].Reserved()
+	}
+
+	It("Returns the current account", func() {
+		response, err := client.CurrentAccount().Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body().Username()).To(Equal("alice"))
+		Expect(response.Body().HREF()).To(HavePrefix("/api/accounts_mgmt/v1/accounts/"))
+	})
+
+	It("Rejects duplicated user name", func() {
+		body, err := amv1.NewAccount().Username("alice").Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := client.Accounts().Add().Body(body).Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusConflict))
+	})
+
+	It("Returns excess resources when quota is exceeded", func() {
+		result := authorize("mycluster", 3, true)
+		Expect(result.Allowed()).To(BeFalse())
+		Expect(result.ExcessResources().Len()).To(Equal(1))
+		excess := result.ExcessResources().Slice()[0]
+		Expect(excess.ResourceName()).To(Equal("cluster"))
+		Expect(excess.Count()).To(Equal(1))
+		Expect(reserved()).To(Equal(0))
+	})
+
+	It("Doesn't reserve quota unless requested", func() {
+		result := authorize("mycluster", 2, false)
+		Expect(result.Allowed()).To(BeTrue())
+		_, ok := result.GetSubscription()
+		Expect(ok).To(BeFalse())
+		Expect(reserved()).To(Equal(0))
+	})
+
+	It("Reserves quota and releases it when the subscription is deleted", func() {
+		result := authorize("mycluster", 2, true)
+		Expect(result.Allowed()).To(BeTrue())
+		subscription := result.Subscription()
+		Expect(subscription.ClusterID()).To(Equal("mycluster"))
+		Expect(subscription.OrganizationID()).To(Equal("myorg"))
+		Expect(reserved()).To(Equal(2))
+
+		// There is no quota left for other clusters:
+		result = authorize("yourcluster", 1, true)
+		Expect(result.Allowed()).To(BeFalse())
+
+		// Deleting the subscription releases the quota:
+		_, err := client.Subscriptions().Subscription(subscription.ID()).Delete().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reserved()).To(Equal(0))
+		result = authorize("yourcluster", 1, true)
+		Expect(result.Allowed()).To(BeTrue())
+	})
+
+	It("Aggregates quotas of the same kind in the summary", func() {
+		body, err := amv1.NewResourceQuota().
+			OrganizationID("myorg").
+			SKU("MW00530").
+			ResourceName("cluster").
+			ResourceType("cluster.aws").
+			AvailabilityZoneType("single").
+			Allowed(3).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		quotas := client.Organizations().Organization("myorg").ResourceQuota()
+		_, err = quotas.Add().Body(body).Send()
+		Expect(err).ToNot(HaveOccurred())
+		result := authorize("mycluster", 4, true)
+		Expect(result.Allowed()).To(BeTrue())
+		response, err := client.Organizations().Organization("myorg").QuotaSummary().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Items().Len()).To(Equal(1))
+		summary := response.Items().Slice()[0]
+		Expect(summary.Allowed()).To(Equal(5))
+		Expect(summary.Reserved()).To(Equal(4))
+	})
+
+	It("Registers clusters with the token of a registry credential", func() {
+		current, err := client.CurrentAccount().Get().Send()
+		Expect(err).ToNot(HaveOccurred())
+		body, err := amv1.NewRegistryCredential().
+			Account(amv1.NewAccount().ID(current.Body().ID())).
+			Registry(amv1.NewRegistry().ID("cloud.openshift.com")).
+			Token("mytoken").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.RegistryCredentials().Add().Body(body).Send()
+		Expect(err).ToNot(HaveOccurred())
+		request, err := amv1.NewClusterRegistrationRequest().
+			ClusterID("mycluster").
+			AuthorizationToken("mytoken").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		response, err := client.ClusterRegistrations().Post().Request(request).Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Response().ClusterID()).To(Equal("mycluster"))
+		list, err := client.Subscriptions().List().Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Total()).To(Equal(1))
+	})
+})
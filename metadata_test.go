@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the metadata of the model types and of the resources.
+
+package sdk
+
+import (
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Metadata", func() {
+	It("Describes the attributes of the type", func() {
+		metadata := new(cmv1.Cluster).Metadata()
+		Expect(metadata.Name).To(Equal("Cluster"))
+		Expect(metadata.Kind).To(Equal(cmv1.ClusterKind))
+		attribute := metadata.Attribute("display_name")
+		Expect(attribute).ToNot(BeNil())
+		Expect(attribute.Name).To(Equal("DisplayName"))
+		Expect(attribute.JSONName).To(Equal("display_name"))
+		Expect(attribute.Type).To(Equal("string"))
+		Expect(attribute.Element).To(BeNil())
+		Expect(attribute.Link).To(BeFalse())
+		Expect(attribute.List).To(BeFalse())
+		Expect(attribute.Sensitive).To(BeFalse())
+		Expect(metadata.Attribute("DisplayName")).To(BeIdenticalTo(attribute))
+		Expect(metadata.Attribute("junk")).To(BeNil())
+	})
+
+	It("Returns the values of the attributes", func() {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("mycluster").
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		metadata := cluster.Metadata()
+		value, ok := metadata.Attribute("id").Get(cluster)
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal("123"))
+		value, ok = metadata.Attribute("name").Get(cluster)
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal("mycluster"))
+		value, ok = metadata.Attribute("nodes").Get(cluster)
+		Expect(ok).To(BeTrue())
+		nodes := value.(*cmv1.ClusterNodes)
+		value, ok = metadata.Attribute("nodes").Element.Attribute("compute").Get(nodes)
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(3))
+		_, ok = metadata.Attribute("display_name").Get(cluster)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Describes links and lists", func() {
+		metadata := new(cmv1.Cluster).Metadata()
+		flavour := metadata.Attribute("flavour")
+		Expect(flavour.Type).To(Equal("*Flavour"))
+		Expect(flavour.Link).To(BeTrue())
+		Expect(flavour.List).To(BeFalse())
+		Expect(flavour.Element).To(BeIdenticalTo(new(cmv1.Flavour).Metadata()))
+		groups := metadata.Attribute("groups")
+		Expect(groups.Type).To(Equal("*GroupList"))
+		Expect(groups.Link).To(BeTrue())
+		Expect(groups.List).To(BeTrue())
+		Expect(groups.Element.Name).To(Equal("Group"))
+		Expect(groups.Element.Attribute("users").Element.Name).To(Equal("User"))
+		nodes := metadata.Attribute("nodes")
+		Expect(nodes.Link).To(BeFalse())
+	})
+
+	DescribeTable(
+		"Marks sensitive attributes",
+		func(metadata *helpers.TypeMetadata, name string) {
+			attribute := metadata.Attribute(name)
+			Expect(attribute).ToNot(BeNil())
+			Expect(attribute.Sensitive).To(BeTrue())
+		},
+		Entry("AWS secret key", new(cmv1.AWS).Metadata(), "secret_access_key"),
+		Entry("Admin password", new(cmv1.AdminCredentials).Metadata(), "password"),
+		Entry("LDAP password", new(cmv1.LdapidentityProvider).Metadata(), "bind_password"),
+		Entry("SSH private key", new(cmv1.Sshcredentials).Metadata(), "private_key"),
+		Entry("Registry token", new(amv1.RegistryCredential).Metadata(), "token"),
+	)
+
+	It("Lists the types of the package", func() {
+		types := cmv1.Types()
+		Expect(types).ToNot(BeEmpty())
+		for i := 1; i < len(types); i++ {
+			Expect(types[i-1].Name < types[i].Name).To(BeTrue())
+		}
+		Expect(types).To(ContainElement(new(cmv1.Cluster).Metadata()))
+	})
+
+	It("Navigates the tree of resources", func() {
+		client := cmv1.NewRootClient(nil, "/api/clusters_mgmt/v1", "")
+		root := client.Metadata()
+		Expect(root.Name).To(BeEmpty())
+		Expect(root.Type).To(BeNil())
+		clusters := root.Resource("clusters")
+		Expect(clusters).ToNot(BeNil())
+		Expect(clusters.Collection).To(BeTrue())
+		Expect(clusters.Type.Name).To(Equal("Cluster"))
+		users := clusters.Item.Resource("groups").Item.Resource("users")
+		Expect(users.Collection).To(BeTrue())
+		Expect(users.Type).To(BeIdenticalTo(new(cmv1.User).Metadata()))
+		Expect(client.Clusters().Cluster("123").Groups().Group("456").Users().Metadata()).To(
+			BeIdenticalTo(users),
+		)
+		Expect(clusters.Item.Resource("credentials").Type.Name).To(Equal("ClusterCredentials"))
+		Expect(root.Resource("junk")).To(BeNil())
+	})
+})
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the generation of update requests from the differences between
+// objects.
+
+package sdk
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	// nolint
+	. "github.com/onsi/gomega/ghttp"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Patches", func() {
+	var original *cmv1.Cluster
+	var modified *cmv1.Cluster
+
+	BeforeEach(func() {
+		var err error
+		original, err = cmv1.UnmarshalCluster(`{
+			"kind": "Cluster",
+			"id": "123",
+			"name": "mycluster",
+			"display_name": "My cluster",
+			"nodes": {
+				"compute": 3,
+				"master": 3
+			},
+			"properties": {
+				"owner": "me"
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		modified, err = original.Builder().
+			Nodes(original.Nodes().Builder().Compute(10)).
+			DisplayName("").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Calculates the changed attributes", func() {
+		changes := original.Changes(modified)
+		buffer := new(bytes.Buffer)
+		err := cmv1.MarshalCluster(changes, buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`{
+			"kind": "Cluster",
+			"display_name": "",
+			"nodes": {
+				"compute": 10
+			}
+		}`))
+	})
+
+	It("Calculates empty changes for equal objects", func() {
+		changes := original.Changes(original.Copy())
+		Expect(changes.Empty()).To(BeTrue())
+	})
+
+	It("Calculates merge patch", func() {
+		patch, err := helpers.MergePatch(
+			[]byte(`{"a": 1, "b": {"c": 2, "d": 3}, "e": [1, 2]}`),
+			[]byte(`{"a": 1, "b": {"c": 4}, "e": [1], "f": "x"}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patch).To(MatchJSON(`{"b": {"c": 4, "d": null}, "e": [1], "f": "x"}`))
+	})
+
+	Describe("Requests", func() {
+		// Servers used during the tests:
+		var oidServer *Server
+		var apiServer *Server
+
+		// Connection used during the tests:
+		var connection *Connection
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the tokens:
+			accessToken := DefaultToken("Bearer", 5*time.Minute)
+			refreshToken := DefaultToken("Refresh", 10*time.Hour)
+
+			// Create the OpenID server:
+			oidServer = NewServer()
+			oidServer.AppendHandlers(
+				CombineHandlers(
+					RespondWithTokens(accessToken, refreshToken),
+				),
+			)
+
+			// Create the API server:
+			apiServer = NewServer()
+
+			// Create the connection:
+			logger, err := NewStdLoggerBuilder().
+				Streams(GinkgoWriter, GinkgoWriter).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			connection, err = NewConnectionBuilder().
+				Logger(logger).
+				TokenURL(oidServer.URL()).
+				URL(apiServer.URL()).
+				Tokens(refreshToken).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			// Stop the servers:
+			oidServer.Close()
+			apiServer.Close()
+
+			// Close the connection:
+			err := connection.Close()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Sends only the changed attributes", func() {
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					VerifyContentType("application/json"),
+					VerifyJSON(`{
+						"kind": "Cluster",
+						"display_name": "",
+						"nodes": {
+							"compute": 10
+						}
+					}`),
+					RespondWithJSONTemplate(http.StatusOK, `{}`),
+				),
+			)
+			_, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Update().
+				Changes(original, modified).
+				Send()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Sends merge patch", func() {
+			modified, err := original.Builder().
+				Properties(map[string]string{
+					"color": "blue",
+				}).
+				Name("yourcluster").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					VerifyContentType(helpers.MergePatchContentType),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(body).To(MatchJSON(`{
+							"name": "yourcluster",
+							"properties": {
+								"owner": null,
+								"color": "blue"
+							}
+						}`))
+					},
+					RespondWithJSONTemplate(http.StatusOK, `{}`),
+				),
+			)
+			_, err = connection.ClustersMgmt().V1().Clusters().Cluster("123").Update().
+				MergePatch(original, modified).
+				Send()
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
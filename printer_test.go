@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the printing of the model types.
+
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Printer", func() {
+	// Object used by most of the tests:
+	var aws *cmv1.AWS
+
+	BeforeEach(func() {
+		var err error
+		aws, err = cmv1.NewAWS().
+			AccessKeyID("myid").
+			SecretAccessKey("mysecret").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable(
+		"Masks sensitive attributes",
+		func(format string, expected string) {
+			Expect(fmt.Sprintf(format, aws)).To(Equal(expected))
+		},
+		Entry("%v", "%v", `AWS{AccessKeyID: "myid", SecretAccessKey: ***}`),
+		Entry("%+v", "%+v", `AWS{AccessKeyID: "myid", SecretAccessKey: ***}`),
+		Entry("%s", "%s", `AWS{AccessKeyID: "myid", SecretAccessKey: ***}`),
+		Entry("%#v", "%#v", `&v1.AWS{AccessKeyID: "myid", SecretAccessKey: ***}`),
+		Entry("%q", "%q", `"AWS{AccessKeyID: \"myid\", SecretAccessKey: ***}"`),
+		Entry("%d", "%d", `%!d(AWS{AccessKeyID: "myid", SecretAccessKey: ***})`),
+	)
+
+	It("Masks sensitive attributes in String and GoString", func() {
+		Expect(aws.String()).To(Equal(`AWS{AccessKeyID: "myid", SecretAccessKey: ***}`))
+		Expect(aws.GoString()).To(Equal(`&v1.AWS{AccessKeyID: "myid", SecretAccessKey: ***}`))
+	})
+
+	It("Reveals sensitive attributes when requested", func() {
+		revealed := aws.Reveal()
+		expected := `AWS{AccessKeyID: "myid", SecretAccessKey: "mysecret"}`
+		Expect(revealed.String()).To(Equal(expected))
+		Expect(fmt.Sprintf("%v", revealed)).To(Equal(expected))
+		Expect(fmt.Sprintf("%#v", revealed)).To(Equal(
+			`&v1.AWS{AccessKeyID: "myid", SecretAccessKey: "mysecret"}`,
+		))
+	})
+
+	It("Masks sensitive attributes of nested objects", func() {
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			Name("mycluster").
+			AWS(cmv1.NewAWS().SecretAccessKey("mysecret")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		text := fmt.Sprintf("%+v", cluster)
+		Expect(text).ToNot(ContainSubstring("mysecret"))
+		Expect(text).To(Equal(`Cluster{ID: "123", Name: "mycluster", AWS: AWS{SecretAccessKey: ***}}`))
+		Expect(cluster.Reveal().String()).To(ContainSubstring(`"mysecret"`))
+	})
+
+	It("Prints all the kinds of attributes", func() {
+		timestamp := time.Date(2019, time.July, 1, 10, 20, 30, 0, time.UTC)
+		cluster, err := cmv1.NewCluster().
+			ID("123").
+			HREF("/api/clusters_mgmt/v1/clusters/123").
+			Flavour(cmv1.NewFlavour().ID("osd-4")).
+			MultiAZ(true).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Properties(map[string]string{"b": "y", "a": "x"}).
+			State(cmv1.ClusterStateReady).
+			CreationTimestamp(timestamp).
+			Groups(cmv1.NewGroup().ID("a"), cmv1.NewGroup().ID("b")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.String()).To(Equal(`Cluster{` +
+			`ID: "123", ` +
+			`HREF: "/api/clusters_mgmt/v1/clusters/123", ` +
+			`Flavour: Flavour{ID: "osd-4"}, ` +
+			`MultiAZ: true, ` +
+			`Nodes: ClusterNodes{Compute: 3}, ` +
+			`Properties: {"a": "x", "b": "y"}, ` +
+			`State: ready, ` +
+			`CreationTimestamp: 2019-07-01T10:20:30Z, ` +
+			`Groups: GroupList{Items: [Group{ID: "a"}, Group{ID: "b"}]}` +
+			`}`))
+	})
+
+	It("Prints nil objects", func() {
+		var cluster *cmv1.Cluster
+		Expect(cluster.String()).To(Equal("nil"))
+		Expect(fmt.Sprintf("%v", cluster)).To(Equal("nil"))
+	})
+
+	It("Prints lists", func() {
+		list, err := cmv1.NewAWSList().
+			Items(
+				cmv1.NewAWS().AccessKeyID("a").SecretAccessKey("x"),
+				cmv1.NewAWS().AccessKeyID("b"),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fmt.Sprintf("%v", list)).To(Equal(
+			`[AWS{AccessKeyID: "a", SecretAccessKey: ***}, AWS{AccessKeyID: "b"}]`,
+		))
+	})
+
+	It("Masks sensitive attributes of other packages", func() {
+		credential, err := amv1.NewRegistryCredential().
+			ID("123").
+			Username("myuser").
+			Token("mytoken").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credential.String()).To(Equal(
+			`RegistryCredential{ID: "123", Username: "myuser", Token: ***}`,
+		))
+	})
+})
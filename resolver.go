@@ -0,0 +1,347 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the resolver that retrieves in batches the objects that
+// links point to.
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Link is the interface implemented by the model types that can be links, for example the flavour
+// of a cluster or the creator of a subscription.
+type Link interface {
+	Link() bool
+	HREF() string
+}
+
+// ResolverCache is the interface of the caches used by resolvers to store the objects that they
+// retrieve. The key is the link and the value is the JSON document returned by the server.
+// Implementations must be safe for concurrent use.
+type ResolverCache interface {
+	Get(href string) (data []byte, ok bool)
+	Put(href string, data []byte)
+}
+
+// ResolverBuilder contains the configuration and logic needed to create a resolver. Don't create
+// instances of this type directly, use the NewResolverBuilder function instead.
+type ResolverBuilder struct {
+	transport   http.RoundTripper
+	cache       ResolverCache
+	concurrency int
+}
+
+// Resolver retrieves the objects that links point to. The Fetch method retrieves in parallel all
+// the given links, sending only one request for each distinct link, and stores the results in the
+// cache. The resolver is also an HTTP transport that serves the stored objects from the cache, so
+// it can be passed to the Resolve methods of the links to get the complete objects without sending
+// more requests. For example, to get the names of the flavours of a list of clusters:
+//
+//	resolver, err := sdk.NewResolverBuilder().
+//		Transport(connection).
+//		Build()
+//	if err != nil {
+//		return err
+//	}
+//	links := make([]sdk.Link, len(clusters))
+//	for i, cluster := range clusters {
+//		links[i] = cluster.Flavour()
+//	}
+//	err = resolver.Fetch(ctx, links...)
+//	if err != nil {
+//		return err
+//	}
+//	for _, cluster := range clusters {
+//		flavour, err := cluster.Flavour().Resolve(ctx, resolver)
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Printf("%s %s\n", cluster.Name(), flavour.Name())
+//	}
+//
+// Links to objects managed by other services, like the subscriptions of clusters, are resolved
+// with the Subscription method of the resolver.
+//
+// Don't create instances of this type directly, use the builder instead.
+type Resolver struct {
+	transport   http.RoundTripper
+	cache       ResolverCache
+	concurrency int
+}
+
+// NewResolverBuilder creates a builder that knows how to create resolvers.
+func NewResolverBuilder() *ResolverBuilder {
+	return &ResolverBuilder{
+		concurrency: 10,
+	}
+}
+
+// Transport sets the transport that will be used to send the requests. Usually this will be the
+// connection. This is mandatory.
+func (b *ResolverBuilder) Transport(value http.RoundTripper) *ResolverBuilder {
+	b.transport = value
+	return b
+}
+
+// Cache sets the cache that will be used to store the retrieved objects. This is optional, by
+// default each resolver stores the objects in a private cache that never expires, so it should
+// only be used for the duration of a single task. Use the NewResolverCache function to create a
+// cache that can be shared by multiple resolvers and that discards the objects after some time.
+func (b *ResolverBuilder) Cache(value ResolverCache) *ResolverBuilder {
+	b.cache = value
+	return b
+}
+
+// Concurrency sets the maximum number of requests that will be sent in parallel. The default is
+// ten.
+func (b *ResolverBuilder) Concurrency(value int) *ResolverBuilder {
+	b.concurrency = value
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new resolver.
+func (b *ResolverBuilder) Build() (result *Resolver, err error) {
+	// Check the parameters:
+	if b.transport == nil {
+		err = fmt.Errorf("transport is mandatory")
+		return
+	}
+	if b.concurrency < 1 {
+		err = fmt.Errorf("concurrency %d isn't valid, it should be at least one", b.concurrency)
+		return
+	}
+
+	// Create the default cache, if needed:
+	cache := b.cache
+	if cache == nil {
+		cache = NewResolverCache(0)
+	}
+
+	// Create and populate the object:
+	result = &Resolver{
+		transport:   b.transport,
+		cache:       cache,
+		concurrency: b.concurrency,
+	}
+
+	return
+}
+
+// Fetch retrieves the objects that the given links point to and stores them in the cache. Nil
+// values, objects that aren't links and links that are already in the cache are ignored, and links
+// that appear multiple times are retrieved only once. The requests are sent in parallel. If any of
+// them fails the rest are still completed, and the first error is returned.
+func (r *Resolver) Fetch(ctx context.Context, links ...Link) error {
+	// Find the distinct links that aren't in the cache yet:
+	var hrefs []string
+	seen := map[string]bool{}
+	for _, link := range links {
+		if link == nil || !link.Link() {
+			continue
+		}
+		href := link.HREF()
+		if href == "" || seen[href] {
+			continue
+		}
+		seen[href] = true
+		_, ok := r.cache.Get(href)
+		if ok {
+			continue
+		}
+		hrefs = append(hrefs, href)
+	}
+
+	// Start the workers and send them the links:
+	queue := make(chan string)
+	failures := make(chan error, len(hrefs))
+	workers := r.concurrency
+	if workers > len(hrefs) {
+		workers = len(hrefs)
+	}
+	group := new(sync.WaitGroup)
+	group.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer group.Done()
+			for href := range queue {
+				err := r.fetch(ctx, href)
+				if err != nil {
+					failures <- err
+				}
+			}
+		}()
+	}
+	for _, href := range hrefs {
+		queue <- href
+	}
+	close(queue)
+	group.Wait()
+	close(failures)
+
+	return <-failures
+}
+
+// fetch retrieves the object that the given link points to and stores it in the cache.
+func (r *Resolver) fetch(ctx context.Context, href string) error {
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL: &url.URL{
+			Path: href,
+		},
+		Header: helpers.SetHeader(nil, helpers.MetricPath(href)),
+	}
+	if ctx != nil {
+		request = request.WithContext(ctx)
+	}
+	response, err := r.transport.RoundTrip(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 400 {
+		failure, err := errors.ReadError(response)
+		if err != nil {
+			return err
+		}
+		return failure
+	}
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	r.cache.Put(href, data)
+	return nil
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface. Requests to retrieve objects
+// that are in the cache are answered without sending them to the server. The rest of the requests
+// are sent using the transport of the resolver.
+func (r *Resolver) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	if request.Method == http.MethodGet && request.URL.RawQuery == "" {
+		data, ok := r.cache.Get(request.URL.Path)
+		if ok {
+			response = &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header: http.Header{
+					"Content-Type": []string{"application/json"},
+				},
+				Body:          ioutil.NopCloser(bytes.NewReader(data)),
+				ContentLength: int64(len(data)),
+				Request:       request,
+			}
+			return
+		}
+	}
+	return r.transport.RoundTrip(request)
+}
+
+// Subscription retrieves the complete subscription that the given link points to, for example the
+// subscription of a cluster. Subscriptions are managed by the accounts management service, so the
+// link is routed to the typed client of that service, and the result contains all the attributes
+// of the subscription, like the plan and the creator. Objects in the cache are returned without
+// sending requests. If the link is nil the result is nil.
+func (r *Resolver) Subscription(ctx context.Context, link Link) (result *amv1.Subscription, err error) {
+	if link == nil {
+		return
+	}
+	href := link.HREF()
+	if href == "" {
+		err = fmt.Errorf("can't resolve link to subscription because it doesn't have an HREF")
+		return
+	}
+	target, _, err := route(r, href)
+	if err != nil {
+		return
+	}
+	client, ok := target.(*amv1.SubscriptionClient)
+	if !ok {
+		err = fmt.Errorf("link '%s' doesn't point to a subscription", href)
+		return
+	}
+	response, err := client.Get().SendContext(ctx)
+	if err != nil {
+		return
+	}
+	result = response.Body()
+	return
+}
+
+// resolverCache is the in-memory implementation of the ResolverCache interface.
+type resolverCache struct {
+	ttl     time.Duration
+	mutex   sync.Mutex
+	entries map[string]*resolverCacheEntry
+}
+
+// resolverCacheEntry is an object stored in the in-memory cache.
+type resolverCacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewResolverCache creates an in-memory cache that can be shared by multiple resolvers. Objects
+// are discarded when they have been in the cache for longer than the given time to live. If the
+// time to live is zero objects are never discarded.
+func NewResolverCache(ttl time.Duration) ResolverCache {
+	return &resolverCache{
+		ttl:     ttl,
+		entries: map[string]*resolverCacheEntry{},
+	}
+}
+
+// Get is the implementation of the ResolverCache interface.
+func (c *resolverCache) Get(href string) (data []byte, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[href]
+	if !ok {
+		return
+	}
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		delete(c.entries, href)
+		ok = false
+		return
+	}
+	data = entry.data
+	return
+}
+
+// Put is the implementation of the ResolverCache interface.
+func (c *resolverCache) Put(href string, data []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[href] = &resolverCacheEntry{
+		data:    data,
+		expires: time.Now().Add(c.ttl),
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the resolution of links.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Resolver", func() {
+	var apiServer *ghttp.Server
+	var connection *Connection
+	var ctx context.Context
+
+	// Paths of the objects returned by the server:
+	const flavourPath = "/api/clusters_mgmt/v1/flavours/osd-4"
+	const accountPath = "/api/accounts_mgmt/v1/accounts/123"
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the API server:
+		apiServer = ghttp.NewServer()
+		apiServer.RouteToHandler(
+			http.MethodGet,
+			flavourPath,
+			RespondWithJSONTemplate(http.StatusOK, `{
+				"kind": "Flavour",
+				"id": "osd-4",
+				"href": "/api/clusters_mgmt/v1/flavours/osd-4",
+				"name": "OSD 4"
+			}`),
+		)
+		apiServer.RouteToHandler(
+			http.MethodGet,
+			accountPath,
+			RespondWithJSONTemplate(http.StatusOK, `{
+				"kind": "Account",
+				"id": "123",
+				"href": "/api/accounts_mgmt/v1/accounts/123",
+				"username": "alice"
+			}`),
+		)
+
+		// Create the connection:
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			URL(apiServer.URL()).
+			Tokens(DefaultToken("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer.Close()
+	})
+
+	// flavourLink creates a link to the flavour returned by the server.
+	flavourLink := func() *cmv1.Flavour {
+		link, err := cmv1.NewFlavour().
+			Link(true).
+			ID("osd-4").
+			HREF(flavourPath).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return link
+	}
+
+	It("Resolves a link using the typed client", func() {
+		flavour, err := flavourLink().Resolve(ctx, connection)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(flavour.Link()).To(BeFalse())
+		Expect(flavour.Name()).To(Equal("OSD 4"))
+	})
+
+	It("Resolves the subscription of a cluster with the accounts management client", func() {
+		apiServer.RouteToHandler(
+			http.MethodGet,
+			"/api/accounts_mgmt/v1/subscriptions/789",
+			RespondWithJSONTemplate(http.StatusOK, `{
+				"kind": "Subscription",
+				"id": "789",
+				"href": "/api/accounts_mgmt/v1/subscriptions/789",
+				"plan": {
+					"kind": "PlanLink",
+					"id": "MOSD",
+					"href": "/api/accounts_mgmt/v1/plans/MOSD"
+				},
+				"creator": {
+					"kind": "AccountLink",
+					"id": "123",
+					"href": "/api/accounts_mgmt/v1/accounts/123"
+				}
+			}`),
+		)
+		resolver, err := NewResolverBuilder().
+			Transport(connection).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		link, err := cmv1.NewSubscription().
+			Link(true).
+			ID("789").
+			HREF("/api/accounts_mgmt/v1/subscriptions/789").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = resolver.Fetch(ctx, link)
+		Expect(err).ToNot(HaveOccurred())
+		subscription, err := resolver.Subscription(ctx, link)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subscription.ID()).To(Equal("789"))
+		Expect(subscription.Plan().ID()).To(Equal("MOSD"))
+		Expect(subscription.Creator().HREF()).To(Equal(accountPath))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+
+		// The creator can then be resolved with its own typed client:
+		creator, err := subscription.Creator().Resolve(ctx, resolver)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creator.Username()).To(Equal("alice"))
+	})
+
+	It("Rejects subscription links that point to other resources", func() {
+		resolver, err := NewResolverBuilder().
+			Transport(connection).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = resolver.Subscription(ctx, flavourLink())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("doesn't point to a subscription"))
+		Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Returns objects that aren't links without sending requests", func() {
+		object, err := cmv1.NewFlavour().ID("osd-4").Name("Local").Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := object.Resolve(ctx, connection)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeIdenticalTo(object))
+		Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Returns the error sent by the server", func() {
+		link, err := amv1.NewAccount().
+			Link(true).
+			ID("456").
+			HREF("/api/accounts_mgmt/v1/accounts/456").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer.RouteToHandler(
+			http.MethodGet,
+			"/api/accounts_mgmt/v1/accounts/456",
+			RespondWithJSONTemplate(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"reason": "Account '456' not found"
+			}`),
+		)
+		_, err = link.Resolve(ctx, connection)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Account '456' not found"))
+	})
+
+	It("Fetches each distinct link only once", func() {
+		resolver, err := NewResolverBuilder().
+			Transport(connection).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		creator, err := amv1.NewAccount().
+			Link(true).
+			ID("123").
+			HREF(accountPath).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = resolver.Fetch(ctx, flavourLink(), creator, flavourLink(), nil, creator)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+
+		// Resolving the links now shouldn't send more requests:
+		flavour, err := flavourLink().Resolve(ctx, resolver)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(flavour.Name()).To(Equal("OSD 4"))
+		account, err := creator.Resolve(ctx, resolver)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(account.Username()).To(Equal("alice"))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Shares the cache between resolvers", func() {
+		cache := NewResolverCache(time.Minute)
+		for i := 0; i < 2; i++ {
+			resolver, err := NewResolverBuilder().
+				Transport(connection).
+				Cache(cache).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = resolver.Fetch(ctx, flavourLink())
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Calculates metrics paths without identifiers", func() {
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/clusters/123/groups/admins")).To(
+			Equal("/api/clusters_mgmt/v1/clusters/-/groups/-"),
+		)
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/clusters/123/status")).To(
+			Equal("/api/clusters_mgmt/v1/clusters/-/status"),
+		)
+		Expect(helpers.MetricPath("/junk")).To(Equal("/-"))
+	})
+})
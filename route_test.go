@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the method that finds the typed client that corresponds to a link.
+
+package sdk
+
+import (
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/uhc-sdk-go/accountsmgmt"
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Route", func() {
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+		connection, err = NewConnectionBuilder().
+			Tokens(DefaultToken("Bearer", 5*time.Minute)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable(
+		"Returns the typed client",
+		func(href string, expected interface{}, ids []string) {
+			client, actual, err := connection.Route(href)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client).To(BeAssignableToTypeOf(expected))
+			Expect(actual).To(Equal(ids))
+		},
+		Entry(
+			"Service",
+			"/api/accounts_mgmt",
+			&accountsmgmt.Client{}, nil,
+		),
+		Entry(
+			"Version",
+			"/api/clusters_mgmt/v1",
+			&cmv1.RootClient{}, nil,
+		),
+		Entry(
+			"Collection",
+			"/api/clusters_mgmt/v1/clusters",
+			&cmv1.ClustersClient{}, nil,
+		),
+		Entry(
+			"Item",
+			"/api/clusters_mgmt/v1/clusters/123",
+			&cmv1.ClusterClient{}, []string{"123"},
+		),
+		Entry(
+			"Nested item",
+			"/api/clusters_mgmt/v1/clusters/123/identity_providers/abc",
+			&cmv1.IdentityProviderClient{}, []string{"123", "abc"},
+		),
+		Entry(
+			"Singleton",
+			"/api/clusters_mgmt/v1/clusters/123/status",
+			&cmv1.ClusterStatusClient{}, []string{"123"},
+		),
+		Entry(
+			"Complete URL",
+			"https://api.openshift.com/api/accounts_mgmt/v1/subscriptions/456?page=1",
+			&amv1.SubscriptionClient{}, []string{"456"},
+		),
+		Entry(
+			"Trailing slash",
+			"/api/accounts_mgmt/v1/current_account/",
+			&amv1.CurrentAccountClient{}, nil,
+		),
+	)
+
+	It("Returns a client that uses the path of the link", func() {
+		client, _, err := connection.Route("/api/clusters_mgmt/v1/clusters/123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client).To(Equal(connection.ClustersMgmt().V1().Clusters().Cluster("123")))
+	})
+
+	DescribeTable(
+		"Rejects unknown links",
+		func(href string, message string) {
+			client, ids, err := connection.Route(href)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+			Expect(client).To(BeNil())
+			Expect(ids).To(BeNil())
+		},
+		Entry(
+			"Without prefix",
+			"/clusters/123",
+			"doesn't start with the '/api' prefix",
+		),
+		Entry(
+			"Unknown service",
+			"/api/junk_mgmt/v1",
+			"can't find service 'junk_mgmt'",
+		),
+		Entry(
+			"Unknown version",
+			"/api/clusters_mgmt/v42/clusters",
+			"can't find version 'v42'",
+		),
+		Entry(
+			"Unknown resource",
+			"/api/clusters_mgmt/v1/clusters/123/junk",
+			"can't find resource 'junk' inside '/api/clusters_mgmt/v1/clusters/123'",
+		),
+		Entry(
+			"Error",
+			"/api/clusters_mgmt/v1/errors/404",
+			"can't find resource 'errors'",
+		),
+		Entry(
+			"Empty segment",
+			"/api/clusters_mgmt/v1//clusters",
+			"empty path segments",
+		),
+	)
+})
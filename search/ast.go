@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types of the abstract syntax tree of search expressions.
+
+package search
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Expression is a node of the abstract syntax tree of a search expression. The concrete type will
+// be one of *And, *Or, *Not, *Comparison or *In.
+type Expression interface {
+	// String generates the text of the expression, using the syntax of the search language.
+	String() string
+
+	// expression is used to make sure that the interface can only be implemented by the types
+	// of this package.
+	expression()
+}
+
+// And is the logical conjunction of two expressions.
+type And struct {
+	Left  Expression
+	Right Expression
+}
+
+// Or is the logical disjunction of two expressions.
+type Or struct {
+	Left  Expression
+	Right Expression
+}
+
+// Not is the logical negation of an expression.
+type Not struct {
+	Operand Expression
+}
+
+// Operator is the operator of a comparison.
+type Operator string
+
+// Operators supported in comparisons:
+const (
+	Equal          Operator = "="
+	NotEqual       Operator = "<>"
+	Less           Operator = "<"
+	LessOrEqual    Operator = "<="
+	Greater        Operator = ">"
+	GreaterOrEqual Operator = ">="
+	Like           Operator = "like"
+)
+
+// Comparison compares the value of an attribute with a literal.
+type Comparison struct {
+	// Path contains the names of the attribute and of the attributes that contain it. For
+	// example, for `region.id` it will contain `region` and `id`.
+	Path []string
+
+	Operator Operator
+	Value    *Literal
+
+	// pattern is the regular expression equivalent to the pattern of a `like` comparison. It
+	// is calculated by the parser so that it is compiled only once.
+	pattern *regexp.Regexp
+}
+
+// In checks if the value of an attribute is one of a set of literals.
+type In struct {
+	// Path contains the names of the attribute and of the attributes that contain it.
+	Path []string
+
+	Values []*Literal
+}
+
+// Literal is a literal value. The Value field contains a string, a float64 or a bool.
+type Literal struct {
+	Value interface{}
+}
+
+// String is the implementation of the Expression interface.
+func (e *And) String() string {
+	return group(e.Left, e) + " and " + group(e.Right, e)
+}
+
+// String is the implementation of the Expression interface.
+func (e *Or) String() string {
+	return group(e.Left, e) + " or " + group(e.Right, e)
+}
+
+// String is the implementation of the Expression interface.
+func (e *Not) String() string {
+	return "not " + group(e.Operand, e)
+}
+
+// String is the implementation of the Expression interface.
+func (e *Comparison) String() string {
+	return strings.Join(e.Path, ".") + " " + string(e.Operator) + " " + e.Value.String()
+}
+
+// String is the implementation of the Expression interface.
+func (e *In) String() string {
+	values := make([]string, len(e.Values))
+	for i, value := range e.Values {
+		values[i] = value.String()
+	}
+	return strings.Join(e.Path, ".") + " in (" + strings.Join(values, ", ") + ")"
+}
+
+// String generates the text of the literal, using the syntax of the search language.
+func (l *Literal) String() string {
+	switch value := l.Value.(type) {
+	case string:
+		return "'" + strings.Replace(value, "'", "''", -1) + "'"
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return "null"
+	}
+}
+
+// group returns the text of the given operand, surrounded by parentheses if it has less precedence
+// than the operator that contains it.
+func group(operand, operator Expression) string {
+	if precedence(operand) < precedence(operator) {
+		return "(" + operand.String() + ")"
+	}
+	return operand.String()
+}
+
+// precedence returns the precedence of the given expression. Higher numbers bind tighter.
+func precedence(expression Expression) int {
+	switch expression.(type) {
+	case *Or:
+		return 1
+	case *And:
+		return 2
+	case *Not:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func (e *And) expression()        {}
+func (e *Or) expression()         {}
+func (e *Not) expression()        {}
+func (e *Comparison) expression() {}
+func (e *In) expression()         {}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package search contains a parser for the SQL like language used in the `search` parameter of
+// the list methods, an evaluator that checks if objects match the parsed expressions, and a
+// translator that converts them into SQL `WHERE` clauses. For example, a server implementation
+// can use it like this:
+//
+//	func (s *myClustersServer) List(ctx context.Context, request *cmv1.ClustersListServerRequest,
+//		response *cmv1.ClustersListServerResponse) error {
+//		expression, err := search.Parse(request.Search())
+//		if err != nil {
+//			return err
+//		}
+//		var items []*cmv1.ClusterBuilder
+//		for _, cluster := range s.clusters {
+//			matches, err := search.Match(expression, cluster)
+//			if err != nil {
+//				return err
+//			}
+//			if matches {
+//				items = append(items, cluster.Builder())
+//			}
+//		}
+//		...
+//	}
+//
+// The language supports the comparison operators `=`, `<>`, `!=`, `<`, `<=`, `>` and `>=`, the
+// `like` and `in` operators, optionally preceded by `not`, and the `and`, `or` and `not` logical
+// operators, with the usual precedence. Parentheses can be used to group expressions. The left
+// side of a comparison is the path of an attribute, using dots to separate the names of nested
+// attributes, and the right side is a literal: a string in single quotes, a number or one of the
+// boolean values `true` and `false`. For example:
+//
+//	region.id = 'us-east-1' and (name like 'my%' or nodes.compute >= 10)
+//
+// The Sort and Compare functions, and the TranslateOrder method of the SQL translator, implement
+// the `order` parameter of the list methods, so that objects are sorted in the same way when
+// sorted in memory and when sorted by a database.
+package search
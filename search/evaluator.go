@@ -0,0 +1,391 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the evaluator that checks if objects match search expressions.
+
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// truth is the result of evaluating an expression. As in SQL, comparisons with attributes that
+// don't have a value have an unknown result, and that propagates through the logical operators.
+type truth int
+
+const (
+	truthUnknown truth = iota
+	truthFalse
+	truthTrue
+)
+
+// Match checks if the given object matches the search expression. The object can be one of the
+// generated model objects, for example a *cmv1.Cluster, or a JSON document represented as a
+// map[string]interface{}. The attributes of model objects are obtained calling the `Get...`
+// methods, so the names used in the expression are the same that are used in the JSON
+// representation, for example `region.id` or `creation_timestamp`.
+//
+// Comparisons with attributes that don't have a value are neither true nor false, like the null
+// values of SQL, so an object matches only if the result of the complete expression is true. A
+// nil expression matches all the objects.
+func Match(expression Expression, object interface{}) (result bool, err error) {
+	if expression == nil {
+		result = true
+		return
+	}
+	value, err := evaluate(expression, object)
+	if err != nil {
+		return
+	}
+	result = value == truthTrue
+	return
+}
+
+// evaluate calculates the truth value of the given expression for the given object.
+func evaluate(expression Expression, object interface{}) (result truth, err error) {
+	switch typed := expression.(type) {
+	case *And:
+		var left, right truth
+		left, err = evaluate(typed.Left, object)
+		if err != nil || left == truthFalse {
+			result = left
+			return
+		}
+		right, err = evaluate(typed.Right, object)
+		if err != nil {
+			return
+		}
+		switch {
+		case right == truthFalse:
+			result = truthFalse
+		case left == truthUnknown || right == truthUnknown:
+			result = truthUnknown
+		default:
+			result = truthTrue
+		}
+	case *Or:
+		var left, right truth
+		left, err = evaluate(typed.Left, object)
+		if err != nil || left == truthTrue {
+			result = left
+			return
+		}
+		right, err = evaluate(typed.Right, object)
+		if err != nil {
+			return
+		}
+		switch {
+		case right == truthTrue:
+			result = truthTrue
+		case left == truthUnknown || right == truthUnknown:
+			result = truthUnknown
+		default:
+			result = truthFalse
+		}
+	case *Not:
+		result, err = evaluate(typed.Operand, object)
+		switch result {
+		case truthTrue:
+			result = truthFalse
+		case truthFalse:
+			result = truthTrue
+		}
+	case *Comparison:
+		result, err = evaluateComparison(typed, object)
+	case *In:
+		result, err = evaluateIn(typed, object)
+	default:
+		err = fmt.Errorf("don't know how to evaluate expression of type '%T'", expression)
+	}
+	return
+}
+
+// evaluateComparison calculates the truth value of a comparison for the given object.
+func evaluateComparison(comparison *Comparison, object interface{}) (result truth, err error) {
+	value, err := lookup(object, comparison.Path)
+	if err != nil || value == nil {
+		return
+	}
+	if comparison.Operator == Like {
+		text, ok := value.(string)
+		if !ok {
+			err = fmt.Errorf(
+				"can't use 'like' with attribute '%s' because it isn't a string",
+				strings.Join(comparison.Path, "."),
+			)
+			return
+		}
+		pattern := comparison.pattern
+		if pattern == nil {
+			pattern, err = compileLike(fmt.Sprintf("%v", comparison.Value.Value))
+			if err != nil {
+				return
+			}
+		}
+		result = truthOf(pattern.MatchString(text))
+		return
+	}
+	order, err := compare(comparison.Path, value, comparison.Value)
+	if err != nil {
+		return
+	}
+	switch comparison.Operator {
+	case Equal:
+		result = truthOf(order == 0)
+	case NotEqual:
+		result = truthOf(order != 0)
+	case Less:
+		result = truthOf(order < 0)
+	case LessOrEqual:
+		result = truthOf(order <= 0)
+	case Greater:
+		result = truthOf(order > 0)
+	case GreaterOrEqual:
+		result = truthOf(order >= 0)
+	default:
+		err = fmt.Errorf("unknown operator '%s'", comparison.Operator)
+	}
+	return
+}
+
+// evaluateIn calculates the truth value of an `in` operator for the given object.
+func evaluateIn(in *In, object interface{}) (result truth, err error) {
+	value, err := lookup(object, in.Path)
+	if err != nil || value == nil {
+		return
+	}
+	result = truthFalse
+	for _, literal := range in.Values {
+		var order int
+		order, err = compare(in.Path, value, literal)
+		if err != nil {
+			return
+		}
+		if order == 0 {
+			result = truthTrue
+			return
+		}
+	}
+	return
+}
+
+// compare compares the value of an attribute with a literal, and returns a negative number if the
+// value is less than the literal, zero if they are equal and a positive number if the value is
+// greater than the literal.
+func compare(path []string, value interface{}, literal *Literal) (result int, err error) {
+	switch typed := value.(type) {
+	case string:
+		text, ok := literal.Value.(string)
+		if ok {
+			result = strings.Compare(typed, text)
+			return
+		}
+	case float64:
+		number, ok := literal.Value.(float64)
+		if ok {
+			switch {
+			case typed < number:
+				result = -1
+			case typed > number:
+				result = 1
+			}
+			return
+		}
+	case bool:
+		flag, ok := literal.Value.(bool)
+		if ok {
+			switch {
+			case !typed && flag:
+				result = -1
+			case typed && !flag:
+				result = 1
+			}
+			return
+		}
+	case time.Time:
+		text, ok := literal.Value.(string)
+		if ok {
+			var instant time.Time
+			instant, err = time.Parse(time.RFC3339, text)
+			if err != nil {
+				err = fmt.Errorf(
+					"can't compare attribute '%s' with '%s' because it isn't a valid "+
+						"RFC3339 time",
+					strings.Join(path, "."), text,
+				)
+				return
+			}
+			switch {
+			case typed.Before(instant):
+				result = -1
+			case typed.After(instant):
+				result = 1
+			}
+			return
+		}
+	}
+	err = fmt.Errorf(
+		"can't compare attribute '%s' with literal %s because the types are different",
+		strings.Join(path, "."), literal,
+	)
+	return
+}
+
+// lookup returns the value of the attribute with the given path, or nil if the attribute doesn't
+// have a value. Numbers are always returned as float64 values, and strings, including
+// enumerated types, as string values.
+func lookup(object interface{}, path []string) (result interface{}, err error) {
+	current := reflect.ValueOf(object)
+	for i, name := range path {
+		// Skip pointers and interfaces, checking that they aren't nil:
+		for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return
+			}
+			if current.Kind() == reflect.Ptr && current.Elem().Kind() == reflect.Struct {
+				break
+			}
+			current = current.Elem()
+		}
+		switch current.Kind() {
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return
+			}
+			current = current.MapIndex(reflect.ValueOf(name).Convert(current.Type().Key()))
+			if !current.IsValid() {
+				return
+			}
+		case reflect.Ptr:
+			index, ok := getters(current.Type())[name]
+			if !ok {
+				err = fmt.Errorf(
+					"attribute '%s' doesn't exist",
+					strings.Join(path[0:i+1], "."),
+				)
+				return
+			}
+			results := current.Method(index).Call(nil)
+			if !results[1].Bool() {
+				return
+			}
+			current = results[0]
+		default:
+			return
+		}
+	}
+
+	// Convert the value to one of the types supported by the comparisons:
+	for current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return
+		}
+		current = current.Elem()
+	}
+	if !current.IsValid() {
+		return
+	}
+	if instant, ok := current.Interface().(time.Time); ok {
+		result = instant
+		return
+	}
+	switch current.Kind() {
+	case reflect.String:
+		result = current.String()
+	case reflect.Bool:
+		result = current.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = float64(current.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result = float64(current.Uint())
+	case reflect.Float32, reflect.Float64:
+		result = current.Float()
+	default:
+		err = fmt.Errorf(
+			"attribute '%s' can't be used in comparisons because it isn't a scalar",
+			strings.Join(path, "."),
+		)
+	}
+	return
+}
+
+// getterCache stores the getters of the types that have already been used by the evaluator. The
+// keys are the types and the values are the maps returned by the getters function.
+var getterCache = &sync.Map{}
+
+// getters returns a map containing the indexes of the `Get...` methods of the given type. The keys
+// of the map are the names of the corresponding attributes, as used in the JSON representation.
+// Only methods that return a value and a boolean flag are included.
+func getters(typ reflect.Type) map[string]int {
+	cached, ok := getterCache.Load(typ)
+	if ok {
+		return cached.(map[string]int)
+	}
+	result := map[string]int{}
+	for i := 0; i < typ.NumMethod(); i++ {
+		method := typ.Method(i)
+		if !strings.HasPrefix(method.Name, "Get") || len(method.Name) == 3 {
+			continue
+		}
+		if method.Type.NumIn() != 1 || method.Type.NumOut() != 2 {
+			continue
+		}
+		if method.Type.Out(1).Kind() != reflect.Bool {
+			continue
+		}
+		result[attributeName(method.Name[3:])] = i
+	}
+	getterCache.Store(typ, result)
+	return result
+}
+
+// attributeRE is the regular expression used to split Go names into words, taking into account
+// that initialisms like `ID` or `HREF` are written in upper case.
+var attributeRE = regexp.MustCompile(`[A-Z]+[a-z0-9]*`)
+
+// attributeName converts the given Go name into the name used in the JSON representation. For
+// example, `CreationTimestamp` is converted into `creation_timestamp` and `ExternalClusterID` into
+// `external_cluster_id`.
+func attributeName(name string) string {
+	var words []string
+	for _, word := range attributeRE.FindAllString(name, -1) {
+		// When an initialism is followed by a capitalized word, like in `DNSReady`, the last
+		// upper case letter belongs to that word:
+		upper := strings.IndexFunc(word, func(char rune) bool {
+			return !unicode.IsUpper(char)
+		})
+		if upper > 1 {
+			words = append(words, word[0:upper-1])
+			word = word[upper-1:]
+		}
+		words = append(words, strings.ToLower(word))
+	}
+	return strings.ToLower(strings.Join(words, "_"))
+}
+
+// truthOf converts a boolean into a truth value.
+func truthOf(value bool) truth {
+	if value {
+		return truthTrue
+	}
+	return truthFalse
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that sort objects according to the `order` parameter of the
+// list methods.
+
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// Compare compares two objects using the given order, and returns a negative number if the first
+// object goes before the second, zero if they are equivalent and a positive number if the first
+// object goes after the second. The objects can be generated model objects or JSON documents, as
+// explained in the documentation of the Match function.
+//
+// Attributes that don't have a value are considered greater than any other value, so they go
+// last when the direction is ascending and first when it is descending. This is what PostgreSQL
+// does by default, so results sorted in memory are the same as results sorted by the database.
+func Compare(order []*helpers.OrderItem, a, b interface{}) (result int, err error) {
+	for _, item := range order {
+		path := strings.Split(item.Attribute, ".")
+		var x, y interface{}
+		x, err = lookup(a, path)
+		if err != nil {
+			return
+		}
+		y, err = lookup(b, path)
+		if err != nil {
+			return
+		}
+		result, err = compareValues(item.Attribute, x, y)
+		if err != nil {
+			return
+		}
+		if item.Descending {
+			result = -result
+		}
+		if result != 0 {
+			return
+		}
+	}
+	return
+}
+
+// Sort sorts the given slice of objects using the given order. The elements of the slice can be
+// generated model objects or JSON documents. The sort is stable, so objects that are equivalent
+// according to the order keep their original relative positions.
+func Sort(slice interface{}, order []*helpers.OrderItem) error {
+	if len(order) == 0 {
+		return nil
+	}
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice {
+		return fmt.Errorf("can't sort value of type '%T' because it isn't a slice", slice)
+	}
+	var err error
+	sort.SliceStable(slice, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var result int
+		result, err = Compare(order, value.Index(i).Interface(), value.Index(j).Interface())
+		return result < 0
+	})
+	return err
+}
+
+// compareValues compares two values returned by the lookup function.
+func compareValues(attribute string, x, y interface{}) (result int, err error) {
+	switch {
+	case x == nil && y == nil:
+		return
+	case x == nil:
+		result = 1
+		return
+	case y == nil:
+		result = -1
+		return
+	}
+	switch typed := x.(type) {
+	case string:
+		other, ok := y.(string)
+		if ok {
+			result = strings.Compare(typed, other)
+			return
+		}
+	case float64:
+		other, ok := y.(float64)
+		if ok {
+			switch {
+			case typed < other:
+				result = -1
+			case typed > other:
+				result = 1
+			}
+			return
+		}
+	case bool:
+		other, ok := y.(bool)
+		if ok {
+			switch {
+			case !typed && other:
+				result = -1
+			case typed && !other:
+				result = 1
+			}
+			return
+		}
+	case time.Time:
+		other, ok := y.(time.Time)
+		if ok {
+			switch {
+			case typed.Before(other):
+				result = -1
+			case typed.After(other):
+				result = 1
+			}
+			return
+		}
+	}
+	err = fmt.Errorf(
+		"can't sort by attribute '%s' because it has values of different types",
+		attribute,
+	)
+	return
+}
@@ -0,0 +1,472 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the parser of search expressions.
+
+package search
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tokenKind is the kind of a token of a search expression.
+type tokenKind int
+
+const (
+	endToken tokenKind = iota
+	identifierToken
+	stringToken
+	numberToken
+	symbolToken
+)
+
+// token is a token of a search expression.
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// parser contains the state of the parser while it processes a search expression.
+type parser struct {
+	text   string
+	tokens []token
+	next   int
+}
+
+// identifierRE is the regular expression used to check the names of attributes.
+var identifierRE = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Parse parses the given search expression and returns the root of the abstract syntax tree. If
+// the text is empty, or contains only spaces, the result will be nil, which is interpreted by the
+// evaluator and by the SQL translator as an expression that matches all the objects.
+func Parse(text string) (result Expression, err error) {
+	p := &parser{
+		text: text,
+	}
+	p.tokens, err = p.tokenize()
+	if err != nil {
+		return
+	}
+	if p.peek().kind == endToken {
+		return
+	}
+	result, err = p.parseOr()
+	if err != nil {
+		return
+	}
+	if p.peek().kind != endToken {
+		err = p.unexpected(p.peek())
+		result = nil
+	}
+	return
+}
+
+// parseOr parses a sequence of expressions separated by the `or` operator.
+func (p *parser) parseOr() (result Expression, err error) {
+	result, err = p.parseAnd()
+	if err != nil {
+		return
+	}
+	for p.keyword("or") {
+		var right Expression
+		right, err = p.parseAnd()
+		if err != nil {
+			return
+		}
+		result = &Or{
+			Left:  result,
+			Right: right,
+		}
+	}
+	return
+}
+
+// parseAnd parses a sequence of expressions separated by the `and` operator.
+func (p *parser) parseAnd() (result Expression, err error) {
+	result, err = p.parseNot()
+	if err != nil {
+		return
+	}
+	for p.keyword("and") {
+		var right Expression
+		right, err = p.parseNot()
+		if err != nil {
+			return
+		}
+		result = &And{
+			Left:  result,
+			Right: right,
+		}
+	}
+	return
+}
+
+// parseNot parses an expression optionally preceded by the `not` operator.
+func (p *parser) parseNot() (result Expression, err error) {
+	if p.keyword("not") {
+		var operand Expression
+		operand, err = p.parseNot()
+		if err != nil {
+			return
+		}
+		result = &Not{
+			Operand: operand,
+		}
+		return
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses an expression in parentheses or a comparison.
+func (p *parser) parsePrimary() (result Expression, err error) {
+	if p.symbol("(") {
+		result, err = p.parseOr()
+		if err != nil {
+			return
+		}
+		if !p.symbol(")") {
+			err = p.expected("')'")
+			result = nil
+		}
+		return
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses a comparison, including the `like` and `in` operators.
+func (p *parser) parseComparison() (result Expression, err error) {
+	// The left side must be the path of an attribute:
+	current := p.peek()
+	if current.kind != identifierToken || isKeyword(current.text) {
+		err = p.expected("attribute name")
+		return
+	}
+	p.next++
+	path := strings.Split(current.text, ".")
+	for _, name := range path {
+		if !identifierRE.MatchString(name) {
+			err = p.errorf(current, "'%s' isn't a valid attribute name", current.text)
+			return
+		}
+	}
+
+	// The `like` and `in` operators can be negated:
+	negated := p.keyword("not")
+	switch {
+	case p.keyword("like"):
+		result, err = p.parseLike(path)
+	case p.keyword("in"):
+		result, err = p.parseIn(path)
+	case negated:
+		err = p.expected("'like' or 'in'")
+	default:
+		result, err = p.parseOperator(path)
+	}
+	if err != nil {
+		result = nil
+		return
+	}
+	if negated {
+		result = &Not{
+			Operand: result,
+		}
+	}
+	return
+}
+
+// parseLike parses the pattern of a `like` comparison.
+func (p *parser) parseLike(path []string) (result Expression, err error) {
+	current := p.peek()
+	if current.kind != stringToken {
+		err = p.expected("string pattern")
+		return
+	}
+	value, err := p.parseLiteral()
+	if err != nil {
+		return
+	}
+	pattern, err := compileLike(value.Value.(string))
+	if err != nil {
+		err = p.errorf(current, "%v", err)
+		return
+	}
+	result = &Comparison{
+		Path:     path,
+		Operator: Like,
+		Value:    value,
+		pattern:  pattern,
+	}
+	return
+}
+
+// parseIn parses the list of values of an `in` operator.
+func (p *parser) parseIn(path []string) (result Expression, err error) {
+	if !p.symbol("(") {
+		err = p.expected("'('")
+		return
+	}
+	var values []*Literal
+	for {
+		var value *Literal
+		value, err = p.parseLiteral()
+		if err != nil {
+			return
+		}
+		values = append(values, value)
+		if p.symbol(")") {
+			break
+		}
+		if !p.symbol(",") {
+			err = p.expected("',' or ')'")
+			return
+		}
+	}
+	result = &In{
+		Path:   path,
+		Values: values,
+	}
+	return
+}
+
+// parseOperator parses the operator and the value of a comparison.
+func (p *parser) parseOperator(path []string) (result Expression, err error) {
+	current := p.peek()
+	if current.kind != symbolToken {
+		err = p.expected("comparison operator")
+		return
+	}
+	var operator Operator
+	switch current.text {
+	case "=":
+		operator = Equal
+	case "<>", "!=":
+		operator = NotEqual
+	case "<":
+		operator = Less
+	case "<=":
+		operator = LessOrEqual
+	case ">":
+		operator = Greater
+	case ">=":
+		operator = GreaterOrEqual
+	default:
+		err = p.expected("comparison operator")
+		return
+	}
+	p.next++
+	value, err := p.parseLiteral()
+	if err != nil {
+		return
+	}
+	result = &Comparison{
+		Path:     path,
+		Operator: operator,
+		Value:    value,
+	}
+	return
+}
+
+// parseLiteral parses a string, number or boolean literal.
+func (p *parser) parseLiteral() (result *Literal, err error) {
+	current := p.peek()
+	switch {
+	case current.kind == stringToken:
+		text := current.text[1 : len(current.text)-1]
+		text = strings.Replace(text, "''", "'", -1)
+		result = &Literal{
+			Value: text,
+		}
+	case current.kind == numberToken:
+		var number float64
+		number, err = strconv.ParseFloat(current.text, 64)
+		if err != nil {
+			err = p.errorf(current, "'%s' isn't a valid number", current.text)
+			return
+		}
+		result = &Literal{
+			Value: number,
+		}
+	case current.kind == identifierToken && strings.EqualFold(current.text, "true"):
+		result = &Literal{
+			Value: true,
+		}
+	case current.kind == identifierToken && strings.EqualFold(current.text, "false"):
+		result = &Literal{
+			Value: false,
+		}
+	default:
+		err = p.expected("literal value")
+		return
+	}
+	p.next++
+	return
+}
+
+// peek returns the next token, without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+// keyword consumes the next token and returns true if it is the given keyword. Keywords aren't
+// case sensitive.
+func (p *parser) keyword(name string) bool {
+	current := p.peek()
+	if current.kind == identifierToken && strings.EqualFold(current.text, name) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+// symbol consumes the next token and returns true if it is the given symbol.
+func (p *parser) symbol(text string) bool {
+	current := p.peek()
+	if current.kind == symbolToken && current.text == text {
+		p.next++
+		return true
+	}
+	return false
+}
+
+// expected creates an error indicating that the next token isn't what was expected.
+func (p *parser) expected(what string) error {
+	current := p.peek()
+	if current.kind == endToken {
+		return p.errorf(current, "expected %s but found end of expression", what)
+	}
+	return p.errorf(current, "expected %s but found '%s'", what, current.text)
+}
+
+// unexpected creates an error indicating that the given token wasn't expected.
+func (p *parser) unexpected(current token) error {
+	return p.errorf(current, "unexpected '%s'", current.text)
+}
+
+// errorf creates an error that includes the position of the given token and the text of the
+// search expression.
+func (p *parser) errorf(current token, format string, args ...interface{}) error {
+	return fmt.Errorf(
+		"%s at position %d of search expression '%s'",
+		fmt.Sprintf(format, args...), current.position+1, p.text,
+	)
+}
+
+// tokenize splits the text of the search expression into tokens. The last token is always an end
+// token.
+func (p *parser) tokenize() (tokens []token, err error) {
+	text := p.text
+	i := 0
+	for i < len(text) {
+		char := text[i]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			i++
+		case char == '\'':
+			j := i + 1
+			for {
+				if j >= len(text) {
+					err = p.errorf(token{position: i}, "unterminated string")
+					return
+				}
+				if text[j] == '\'' {
+					if j+1 < len(text) && text[j+1] == '\'' {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			tokens = append(tokens, token{kind: stringToken, text: text[i : j+1], position: i})
+			i = j + 1
+		case char == '(' || char == ')' || char == ',':
+			tokens = append(tokens, token{kind: symbolToken, text: text[i : i+1], position: i})
+			i++
+		case char == '=' || char == '<' || char == '>' || char == '!':
+			j := i + 1
+			if j < len(text) && (text[j] == '=' || char == '<' && text[j] == '>') {
+				j++
+			}
+			if text[i:j] == "!" {
+				err = p.errorf(token{position: i}, "unexpected '!'")
+				return
+			}
+			tokens = append(tokens, token{kind: symbolToken, text: text[i:j], position: i})
+			i = j
+		case char == '-' || char == '+' || char == '.' || isDigit(char):
+			j := i + 1
+			for j < len(text) && (isDigit(text[j]) || strings.IndexByte(".eE+-", text[j]) >= 0) {
+				j++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: text[i:j], position: i})
+			i = j
+		case isLetter(char):
+			j := i + 1
+			for j < len(text) && (isLetter(text[j]) || isDigit(text[j]) || text[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: identifierToken, text: text[i:j], position: i})
+			i = j
+		default:
+			err = p.errorf(token{position: i}, "unexpected '%c'", char)
+			return
+		}
+	}
+	tokens = append(tokens, token{kind: endToken, position: len(text)})
+	return
+}
+
+// isKeyword checks if the given identifier is one of the reserved words of the language.
+func isKeyword(text string) bool {
+	switch strings.ToLower(text) {
+	case "and", "or", "not", "like", "in", "true", "false":
+		return true
+	default:
+		return false
+	}
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
+
+func isLetter(char byte) bool {
+	return char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char == '_'
+}
+
+// compileLike converts the pattern of a `like` comparison into a regular expression. The `%`
+// character matches any sequence of characters and the `_` character matches any single
+// character.
+func compileLike(pattern string) (result *regexp.Regexp, err error) {
+	buffer := new(strings.Builder)
+	buffer.WriteString("(?s)^")
+	for _, char := range pattern {
+		switch char {
+		case '%':
+			buffer.WriteString(".*")
+		case '_':
+			buffer.WriteString(".")
+		default:
+			buffer.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+	buffer.WriteString("$")
+	return regexp.Compile(buffer.String())
+}
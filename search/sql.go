@@ -0,0 +1,301 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the translator that converts search expressions into SQL.
+
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// PlaceholderStyle determines how the parameters are written in the generated SQL.
+type PlaceholderStyle int
+
+const (
+	// QuestionPlaceholders writes all the parameters as `?`, as used by MySQL and SQLite.
+	QuestionPlaceholders PlaceholderStyle = iota
+
+	// DollarPlaceholders writes the parameters as `$1`, `$2`, etc, as used by PostgreSQL.
+	DollarPlaceholders
+)
+
+// SQLDialect determines the syntax used for the parts of the generated SQL that aren't supported
+// in the same way by all databases.
+type SQLDialect int
+
+const (
+	// GenericDialect writes the null ordering of the `ORDER BY` clauses as additional `IS NULL`
+	// items, which works with any database, including MySQL, that doesn't support `NULLS FIRST`
+	// and `NULLS LAST`.
+	GenericDialect SQLDialect = iota
+
+	// StandardDialect writes the null ordering of the `ORDER BY` clauses using `NULLS FIRST` and
+	// `NULLS LAST`, as supported by PostgreSQL and SQLite.
+	StandardDialect
+)
+
+// SQLTranslatorBuilder contains the configuration and logic needed to create an SQL translator.
+// Don't create instances of this type directly, use the NewSQLTranslatorBuilder function instead.
+type SQLTranslatorBuilder struct {
+	columns      map[string]string
+	placeholders PlaceholderStyle
+	dialect      SQLDialect
+}
+
+// SQLTranslator converts search expressions into parameterized SQL `WHERE` clauses, and the values
+// of the `order` parameter into `ORDER BY` clauses. Only the attributes that have been explicitly
+// mapped to columns can be used, so the generated SQL never contains text provided by the user.
+// Don't create instances of this type directly, use the builder instead.
+type SQLTranslator struct {
+	columns      map[string]string
+	placeholders PlaceholderStyle
+	dialect      SQLDialect
+}
+
+// NewSQLTranslatorBuilder creates a builder that knows how to create SQL translators.
+func NewSQLTranslatorBuilder() *SQLTranslatorBuilder {
+	return &SQLTranslatorBuilder{
+		columns: map[string]string{},
+	}
+}
+
+// Column adds an attribute to the list of attributes that can be used in search expressions. The
+// attribute is the path used in the search expression, for example `region.id`, and the column is
+// the SQL that will be generated for it, for example `clusters.region_id`. The column is copied
+// verbatim into the generated SQL, so it must never come from user input.
+func (b *SQLTranslatorBuilder) Column(attribute, column string) *SQLTranslatorBuilder {
+	b.columns[attribute] = column
+	return b
+}
+
+// Placeholders sets the style used to write the parameters. The default is to use `?`.
+func (b *SQLTranslatorBuilder) Placeholders(value PlaceholderStyle) *SQLTranslatorBuilder {
+	b.placeholders = value
+	return b
+}
+
+// Dialect sets the SQL dialect used for the parts of the generated SQL that aren't supported in the
+// same way by all databases. The default is the generic dialect, that works with any database.
+func (b *SQLTranslatorBuilder) Dialect(value SQLDialect) *SQLTranslatorBuilder {
+	b.dialect = value
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new SQL translator.
+func (b *SQLTranslatorBuilder) Build() (result *SQLTranslator, err error) {
+	// Check the parameters:
+	if len(b.columns) == 0 {
+		err = fmt.Errorf("at least one column is required")
+		return
+	}
+	columns := make(map[string]string, len(b.columns))
+	for attribute, column := range b.columns {
+		for _, name := range strings.Split(attribute, ".") {
+			if !identifierRE.MatchString(name) {
+				err = fmt.Errorf("attribute '%s' isn't valid", attribute)
+				return
+			}
+		}
+		if column == "" {
+			err = fmt.Errorf("column for attribute '%s' is empty", attribute)
+			return
+		}
+		columns[attribute] = column
+	}
+	switch b.placeholders {
+	case QuestionPlaceholders, DollarPlaceholders:
+	default:
+		err = fmt.Errorf("unknown placeholder style %d", b.placeholders)
+		return
+	}
+	switch b.dialect {
+	case GenericDialect, StandardDialect:
+	default:
+		err = fmt.Errorf("unknown SQL dialect %d", b.dialect)
+		return
+	}
+
+	// Create and populate the object:
+	result = &SQLTranslator{
+		columns:      columns,
+		placeholders: b.placeholders,
+		dialect:      b.dialect,
+	}
+
+	return
+}
+
+// Translate converts the given search expression into an SQL condition suitable for a `WHERE`
+// clause, and returns it together with the values of the parameters. For example, the expression
+// `name like 'my%' and region.id = 'us-east-1'` could be translated into `clusters.name LIKE ? AND
+// clusters.region_id = ?` with parameters `my%` and `us-east-1`. A nil expression is translated
+// into an empty condition. An error is returned if the expression uses attributes that haven't
+// been mapped to columns.
+func (t *SQLTranslator) Translate(expression Expression) (condition string, args []interface{},
+	err error) {
+	if expression == nil {
+		return
+	}
+	buffer := new(strings.Builder)
+	args, err = t.translate(buffer, expression, nil)
+	if err != nil {
+		args = nil
+		return
+	}
+	condition = buffer.String()
+	return
+}
+
+// TranslateOrder converts the given order into an SQL list suitable for an `ORDER BY` clause. For
+// example, `creation_timestamp desc, name asc` could be translated into
+// `clusters.creation_timestamp IS NULL DESC, clusters.creation_timestamp DESC, clusters.name IS
+// NULL, clusters.name ASC`. The null ordering is explicit so that the results are the same as those
+// of the Sort function regardless of the database. With the standard dialect it is written using
+// `NULLS FIRST` and `NULLS LAST` instead, for example `clusters.name ASC NULLS LAST`. A nil order
+// is translated into an empty list. An error is returned if the order uses attributes that haven't
+// been mapped to columns.
+func (t *SQLTranslator) TranslateOrder(order []*helpers.OrderItem) (result string, err error) {
+	items := make([]string, len(order))
+	for i, item := range order {
+		var column string
+		column, err = t.column(strings.Split(item.Attribute, "."))
+		if err != nil {
+			return
+		}
+		switch {
+		case t.dialect == StandardDialect && item.Descending:
+			items[i] = column + " DESC NULLS FIRST"
+		case t.dialect == StandardDialect:
+			items[i] = column + " ASC NULLS LAST"
+		case item.Descending:
+			items[i] = column + " IS NULL DESC, " + column + " DESC"
+		default:
+			items[i] = column + " IS NULL, " + column + " ASC"
+		}
+	}
+	result = strings.Join(items, ", ")
+	return
+}
+
+// translate writes the SQL for the given expression to the buffer, appending the values of the
+// parameters to the given slice.
+func (t *SQLTranslator) translate(buffer *strings.Builder, expression Expression,
+	args []interface{}) (result []interface{}, err error) {
+	result = args
+	switch typed := expression.(type) {
+	case *And:
+		result, err = t.translateGroup(buffer, typed.Left, expression, result)
+		if err != nil {
+			return
+		}
+		buffer.WriteString(" AND ")
+		result, err = t.translateGroup(buffer, typed.Right, expression, result)
+	case *Or:
+		result, err = t.translateGroup(buffer, typed.Left, expression, result)
+		if err != nil {
+			return
+		}
+		buffer.WriteString(" OR ")
+		result, err = t.translateGroup(buffer, typed.Right, expression, result)
+	case *Not:
+		buffer.WriteString("NOT (")
+		result, err = t.translate(buffer, typed.Operand, result)
+		buffer.WriteString(")")
+	case *Comparison:
+		var column string
+		column, err = t.column(typed.Path)
+		if err != nil {
+			return
+		}
+		buffer.WriteString(column)
+		buffer.WriteString(" ")
+		switch typed.Operator {
+		case Equal, NotEqual, Less, LessOrEqual, Greater, GreaterOrEqual:
+			buffer.WriteString(string(typed.Operator))
+		case Like:
+			buffer.WriteString("LIKE")
+		default:
+			err = fmt.Errorf("unknown operator '%s'", typed.Operator)
+			return
+		}
+		buffer.WriteString(" ")
+		result = t.parameter(buffer, typed.Value, result)
+	case *In:
+		var column string
+		column, err = t.column(typed.Path)
+		if err != nil {
+			return
+		}
+		buffer.WriteString(column)
+		buffer.WriteString(" IN (")
+		for i, value := range typed.Values {
+			if i > 0 {
+				buffer.WriteString(", ")
+			}
+			result = t.parameter(buffer, value, result)
+		}
+		buffer.WriteString(")")
+	default:
+		err = fmt.Errorf("don't know how to translate expression of type '%T'", expression)
+	}
+	return
+}
+
+// translateGroup writes the SQL for the given operand, surrounded by parentheses if it has less
+// precedence than the operator that contains it.
+func (t *SQLTranslator) translateGroup(buffer *strings.Builder, operand, operator Expression,
+	args []interface{}) (result []interface{}, err error) {
+	parenthesize := precedence(operand) < precedence(operator)
+	if parenthesize {
+		buffer.WriteString("(")
+	}
+	result, err = t.translate(buffer, operand, args)
+	if parenthesize {
+		buffer.WriteString(")")
+	}
+	return
+}
+
+// column returns the column that corresponds to the given attribute path, or an error if the
+// attribute hasn't been mapped.
+func (t *SQLTranslator) column(path []string) (result string, err error) {
+	attribute := strings.Join(path, ".")
+	result, ok := t.columns[attribute]
+	if !ok {
+		err = fmt.Errorf("attribute '%s' can't be used in search expressions", attribute)
+	}
+	return
+}
+
+// parameter writes the placeholder for the given literal to the buffer, and appends its value to
+// the given slice of parameters.
+func (t *SQLTranslator) parameter(buffer *strings.Builder, literal *Literal,
+	args []interface{}) []interface{} {
+	args = append(args, literal.Value)
+	switch t.placeholders {
+	case DollarPlaceholders:
+		buffer.WriteString("$")
+		buffer.WriteString(strconv.Itoa(len(args)))
+	default:
+		buffer.WriteString("?")
+	}
+	return args
+}
@@ -0,0 +1,397 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the parser, evaluator and SQL translator of search expressions.
+
+package sdk
+
+import (
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+	"github.com/openshift-online/uhc-sdk-go/search"
+)
+
+var _ = Describe("Search parser", func() {
+	It("Returns nil for empty expression", func() {
+		expression, err := search.Parse("  ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expression).To(BeNil())
+	})
+
+	It("Parses dotted attribute path", func() {
+		expression, err := search.Parse("region.id = 'us-east-1'")
+		Expect(err).ToNot(HaveOccurred())
+		comparison, ok := expression.(*search.Comparison)
+		Expect(ok).To(BeTrue())
+		Expect(comparison.Path).To(Equal([]string{"region", "id"}))
+		Expect(comparison.Operator).To(Equal(search.Equal))
+		Expect(comparison.Value.Value).To(Equal("us-east-1"))
+	})
+
+	It("Gives 'and' more precedence than 'or'", func() {
+		expression, err := search.Parse("a = 1 or b = 2 and c = 3")
+		Expect(err).ToNot(HaveOccurred())
+		or, ok := expression.(*search.Or)
+		Expect(ok).To(BeTrue())
+		_, ok = or.Right.(*search.And)
+		Expect(ok).To(BeTrue())
+	})
+
+	DescribeTable(
+		"Generates text",
+		func(text, expected string) {
+			expression, err := search.Parse(text)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expression.String()).To(Equal(expected))
+		},
+		Entry("Equal", "name='a'", "name = 'a'"),
+		Entry("Not equal", "name != 'a'", "name <> 'a'"),
+		Entry("Quote", "name = 'it''s'", "name = 'it''s'"),
+		Entry("Number", "nodes.compute >= 10", "nodes.compute >= 10"),
+		Entry("Boolean", "managed = TRUE", "managed = true"),
+		Entry("Like", "name LIKE 'my%'", "name like 'my%'"),
+		Entry("Not like", "name not like 'my%'", "not name like 'my%'"),
+		Entry("In", "state in ('ready','error')", "state in ('ready', 'error')"),
+		Entry("Not in", "state not in ('ready')", "not state in ('ready')"),
+		Entry("Parentheses", "(a = 1 or b = 2) and c = 3", "(a = 1 or b = 2) and c = 3"),
+		Entry("Redundant parentheses", "(a = 1) and (b = 2)", "a = 1 and b = 2"),
+		Entry("Not", "not (a = 1 and b = 2)", "not (a = 1 and b = 2)"),
+	)
+
+	DescribeTable(
+		"Rejects invalid expression",
+		func(text, message string) {
+			expression, err := search.Parse(text)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+			Expect(expression).To(BeNil())
+		},
+		Entry("Missing pattern", "name like", "expected string pattern but found end"),
+		Entry("Missing value", "name =", "expected literal value"),
+		Entry("Missing operator", "name 'a'", "expected comparison operator"),
+		Entry("Unbalanced parenthesis", "(name = 'a'", "expected ')'"),
+		Entry("Keyword as attribute", "and = 'a'", "expected attribute name"),
+		Entry("Unterminated string", "name = 'a", "unterminated string at position 8"),
+		Entry("Trailing text", "name = 'a' 'b'", "unexpected ''b''"),
+		Entry("Invalid path", "region..id = 'a'", "isn't a valid attribute name"),
+		Entry("Invalid character", "name = @", "unexpected '@'"),
+	)
+})
+
+var _ = Describe("Search evaluator", func() {
+	var cluster *cmv1.Cluster
+
+	BeforeEach(func() {
+		var err error
+		cluster, err = cmv1.NewCluster().
+			ID("123").
+			Name("mycluster").
+			State(cmv1.ClusterStateReady).
+			Managed(true).
+			MultiAZ(true).
+			Region(cmv1.NewCloudRegion().ID("us-east-1")).
+			Nodes(cmv1.NewClusterNodes().Compute(10)).
+			Properties(map[string]string{"owner": "alice"}).
+			CreationTimestamp(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable(
+		"Matches model object",
+		func(text string, expected bool) {
+			expression, err := search.Parse(text)
+			Expect(err).ToNot(HaveOccurred())
+			result, err := search.Match(expression, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("Equal", "name = 'mycluster'", true),
+		Entry("Not equal", "name <> 'mycluster'", false),
+		Entry("Like", "name like 'my%'", true),
+		Entry("Like single character", "name like 'mycluste_'", true),
+		Entry("Not like", "name not like 'your%'", true),
+		Entry("Nested attribute", "region.id = 'us-east-1'", true),
+		Entry("Enumerated type", "state in ('installing', 'ready')", true),
+		Entry("Number", "nodes.compute >= 10 and nodes.compute < 11", true),
+		Entry("Boolean", "managed = true", true),
+		Entry("Initialism", "multi_az = true", true),
+		Entry("Time", "creation_timestamp > '2019-01-01T00:00:00Z'", true),
+		Entry("Map", "properties.owner = 'alice'", true),
+		Entry("Or", "name = 'other' or region.id = 'us-east-1'", true),
+		Entry("Not", "not (name = 'other' or region.id = 'us-east-1')", false),
+		Entry("Missing attribute", "display_name = 'My cluster'", false),
+		Entry("Negated missing attribute", "not display_name = 'My cluster'", false),
+		Entry("Missing attribute in 'or'", "display_name = 'x' or name = 'mycluster'", true),
+	)
+
+	It("Matches nil expression", func() {
+		result, err := search.Match(nil, cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("Matches JSON document", func() {
+		document := map[string]interface{}{
+			"name": "mycluster",
+			"region": map[string]interface{}{
+				"id": "us-east-1",
+			},
+			"nodes": map[string]interface{}{
+				"compute": 10.0,
+			},
+		}
+		expression, err := search.Parse("region.id = 'us-east-1' and nodes.compute > 5")
+		Expect(err).ToNot(HaveOccurred())
+		result, err := search.Match(expression, document)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("Rejects attribute that doesn't exist", func() {
+		expression, err := search.Parse("region.junk = 'a'")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = search.Match(expression, cluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("attribute 'region.junk' doesn't exist"))
+	})
+
+	It("Rejects comparison of different types", func() {
+		expression, err := search.Parse("nodes.compute = 'ten'")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = search.Match(expression, cluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("types are different"))
+	})
+})
+
+var _ = Describe("Search SQL translator", func() {
+	var translator *search.SQLTranslator
+
+	BeforeEach(func() {
+		var err error
+		translator, err = search.NewSQLTranslatorBuilder().
+			Column("name", "clusters.name").
+			Column("region.id", "clusters.region_id").
+			Column("state", "clusters.state").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Translates expression with parameters", func() {
+		expression, err := search.Parse(
+			"(name like 'my%' or region.id = 'us-east-1') and state not in ('error', 'uninstalling')",
+		)
+		Expect(err).ToNot(HaveOccurred())
+		condition, args, err := translator.Translate(expression)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition).To(Equal(
+			"(clusters.name LIKE ? OR clusters.region_id = ?) AND " +
+				"NOT (clusters.state IN (?, ?))",
+		))
+		Expect(args).To(Equal([]interface{}{"my%", "us-east-1", "error", "uninstalling"}))
+	})
+
+	It("Uses numbered placeholders", func() {
+		translator, err := search.NewSQLTranslatorBuilder().
+			Column("name", "name").
+			Placeholders(search.DollarPlaceholders).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		expression, err := search.Parse("name = 'a' or name = 'b'")
+		Expect(err).ToNot(HaveOccurred())
+		condition, args, err := translator.Translate(expression)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition).To(Equal("name = $1 OR name = $2"))
+		Expect(args).To(Equal([]interface{}{"a", "b"}))
+	})
+
+	It("Never copies literals into the SQL", func() {
+		expression, err := search.Parse("name = 'x''; drop table clusters; --'")
+		Expect(err).ToNot(HaveOccurred())
+		condition, args, err := translator.Translate(expression)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition).To(Equal("clusters.name = ?"))
+		Expect(args).To(Equal([]interface{}{"x'; drop table clusters; --"}))
+	})
+
+	It("Rejects attribute that isn't allowed", func() {
+		expression, err := search.Parse("name = 'a' and creator = 'b'")
+		Expect(err).ToNot(HaveOccurred())
+		condition, args, err := translator.Translate(expression)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'creator' can't be used"))
+		Expect(condition).To(BeEmpty())
+		Expect(args).To(BeNil())
+	})
+
+	It("Translates nil expression into empty condition", func() {
+		condition, args, err := translator.Translate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition).To(BeEmpty())
+		Expect(args).To(BeEmpty())
+	})
+
+	It("Translates order", func() {
+		result, err := translator.TranslateOrder([]*helpers.OrderItem{
+			{Attribute: "region.id", Descending: true},
+			{Attribute: "name"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(
+			"clusters.region_id IS NULL DESC, clusters.region_id DESC, " +
+				"clusters.name IS NULL, clusters.name ASC",
+		))
+	})
+
+	It("Translates order with the standard dialect", func() {
+		translator, err := search.NewSQLTranslatorBuilder().
+			Column("name", "clusters.name").
+			Column("region.id", "clusters.region_id").
+			Dialect(search.StandardDialect).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := translator.TranslateOrder([]*helpers.OrderItem{
+			{Attribute: "region.id", Descending: true},
+			{Attribute: "name"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal("clusters.region_id DESC NULLS FIRST, clusters.name ASC NULLS LAST"))
+	})
+
+	It("Doesn't choose the dialect from the placeholder style", func() {
+		translator, err := search.NewSQLTranslatorBuilder().
+			Column("name", "clusters.name").
+			Placeholders(search.DollarPlaceholders).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		result, err := translator.TranslateOrder([]*helpers.OrderItem{
+			{Attribute: "name"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal("clusters.name IS NULL, clusters.name ASC"))
+	})
+
+	It("Rejects unknown dialect", func() {
+		_, err := search.NewSQLTranslatorBuilder().
+			Column("name", "clusters.name").
+			Dialect(search.SQLDialect(42)).
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown SQL dialect"))
+	})
+
+	It("Rejects order by attribute that isn't allowed", func() {
+		_, err := translator.TranslateOrder([]*helpers.OrderItem{
+			{Attribute: "creator"},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'creator' can't be used"))
+	})
+})
+
+var _ = Describe("Search order", func() {
+	var clusters []*cmv1.Cluster
+
+	BeforeEach(func() {
+		clusters = nil
+		for _, item := range []struct {
+			name    string
+			region  string
+			compute int
+		}{
+			{"b", "us-east-1", 3},
+			{"a", "us-west-1", 3},
+			{"c", "", 1},
+			{"d", "us-east-1", 2},
+		} {
+			builder := cmv1.NewCluster().
+				Name(item.name).
+				Nodes(cmv1.NewClusterNodes().Compute(item.compute))
+			if item.region != "" {
+				builder.Region(cmv1.NewCloudRegion().ID(item.region))
+			}
+			cluster, err := builder.Build()
+			Expect(err).ToNot(HaveOccurred())
+			clusters = append(clusters, cluster)
+		}
+	})
+
+	names := func() []string {
+		result := make([]string, len(clusters))
+		for i, cluster := range clusters {
+			result[i] = cluster.Name()
+		}
+		return result
+	}
+
+	It("Sorts by several attributes", func() {
+		order, err := helpers.ParseOrder(
+			map[string][]string{"order": {"nodes.compute desc, name"}},
+			"order",
+			map[string]bool{"name": true, "nodes.compute": true},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		err = search.Sort(clusters, order)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names()).To(Equal([]string{"a", "b", "d", "c"}))
+	})
+
+	It("Puts missing values last when ascending", func() {
+		err := search.Sort(clusters, []*helpers.OrderItem{
+			{Attribute: "region.id"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names()).To(Equal([]string{"b", "d", "a", "c"}))
+	})
+
+	It("Puts missing values first when descending", func() {
+		err := search.Sort(clusters, []*helpers.OrderItem{
+			{Attribute: "region.id", Descending: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names()).To(Equal([]string{"c", "a", "b", "d"}))
+	})
+
+	It("Compares JSON documents", func() {
+		result, err := search.Compare(
+			[]*helpers.OrderItem{{Attribute: "name"}},
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNumerically("<", 0))
+	})
+
+	It("Rejects unknown attribute in order parameter", func() {
+		_, err := helpers.ParseOrder(
+			map[string][]string{"order": {"junk"}},
+			"order",
+			map[string]bool{"name": true},
+		)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("junk"))
+	})
+})
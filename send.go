@@ -138,7 +138,9 @@ func (c *Connection) send(ctx context.Context, request *http.Request) (response
 	}
 	switch request.Method {
 	case http.MethodPost, http.MethodPatch:
-		request.Header.Set("Content-Type", "application/json")
+		if request.Header.Get("Content-Type") == "" {
+			request.Header.Set("Content-Type", "application/json")
+		}
 	}
 	request.Header.Set("Accept", "application/json")
 
@@ -0,0 +1,254 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementations of the server builder and server objects, the server side
+// equivalent of the connection.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+)
+
+// Middleware is a function that wraps an HTTP handler in order to add some behaviour, for example
+// authentication, logging or metrics.
+type Middleware func(handler http.Handler) http.Handler
+
+// ServerBuilder contains the configuration and logic needed to create a server that handles the
+// requests for all the services, mounted under the `/api` prefix. Don't create instances of this
+// type directly, use the NewServerBuilder function instead.
+type ServerBuilder struct {
+	// Basic attributes:
+	logger  Logger
+	version string
+
+	// Implementations of the services:
+	accountsMgmtV1 amv1.RootServer
+	clustersMgmtV1 cmv1.RootServer
+
+	// Middleware:
+	authentication Middleware
+	logging        Middleware
+	metrics        Middleware
+	middleware     []Middleware
+}
+
+// apiServer is the HTTP handler created by the server builder. It dispatches the requests to the
+// implementations of the services.
+type apiServer struct {
+	logger  Logger
+	router  *mux.Router
+	handler http.Handler
+}
+
+// NewServerBuilder creates a builder that knows how to create servers.
+func NewServerBuilder() *ServerBuilder {
+	return new(ServerBuilder)
+}
+
+// Logger sets the logger that will be used by the server. By default it uses the Go `log` package,
+// with the debug level disabled and the rest enabled.
+func (b *ServerBuilder) Logger(logger Logger) *ServerBuilder {
+	b.logger = logger
+	return b
+}
+
+// Version sets the version of the server that will be returned in the `server_version` attribute
+// of the metadata of the services.
+func (b *ServerBuilder) Version(value string) *ServerBuilder {
+	b.version = value
+	return b
+}
+
+// AccountsMgmtV1 sets the implementation of version 1 of the accounts management service. It will
+// be mounted at `/api/accounts_mgmt/v1`.
+func (b *ServerBuilder) AccountsMgmtV1(server amv1.RootServer) *ServerBuilder {
+	b.accountsMgmtV1 = server
+	return b
+}
+
+// ClustersMgmtV1 sets the implementation of version 1 of the clusters management service. It will
+// be mounted at `/api/clusters_mgmt/v1`.
+func (b *ServerBuilder) ClustersMgmtV1(server cmv1.RootServer) *ServerBuilder {
+	b.clustersMgmtV1 = server
+	return b
+}
+
+// Authentication sets the middleware that will be used to authenticate and authorize requests. It
+// will be called after the logging and metrics middleware, so that rejected requests are also
+// logged and counted.
+func (b *ServerBuilder) Authentication(value Middleware) *ServerBuilder {
+	b.authentication = value
+	return b
+}
+
+// Logging sets the middleware that will be used to write the access log. Use the AccessLogBuilder
+// type to create it.
+func (b *ServerBuilder) Logging(value Middleware) *ServerBuilder {
+	b.logging = value
+	return b
+}
+
+// Metrics sets the middleware that will be used to generate metrics. Use the ServerMetricsBuilder
+// type to create it.
+func (b *ServerBuilder) Metrics(value Middleware) *ServerBuilder {
+	b.metrics = value
+	return b
+}
+
+// Middleware adds middleware that will be called after the authentication middleware and before
+// the implementation of the service. The middleware will be called in the order they are added.
+func (b *ServerBuilder) Middleware(values ...Middleware) *ServerBuilder {
+	b.middleware = append(b.middleware, values...)
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new server. The result is an
+// HTTP handler that serves all the services under the `/api` path.
+func (b *ServerBuilder) Build() (result http.Handler, err error) {
+	// Check that at least one service has been provided:
+	if b.accountsMgmtV1 == nil && b.clustersMgmtV1 == nil {
+		err = fmt.Errorf("at least one service implementation is necessary, but none has been provided")
+		return
+	}
+
+	// Create the default logger, if needed:
+	logger := b.logger
+	if logger == nil {
+		logger, err = NewGoLoggerBuilder().
+			Debug(false).
+			Info(true).
+			Warn(true).
+			Error(true).
+			Build()
+		if err != nil {
+			err = fmt.Errorf("can't create default logger: %v", err)
+			return
+		}
+		logger.Debug(context.Background(), "Logger wasn't provided, will use Go log")
+	}
+
+	// Allocate the server:
+	server := &apiServer{
+		logger: logger,
+		router: mux.NewRouter(),
+	}
+
+	// Mount the services:
+	if b.accountsMgmtV1 != nil {
+		prefix := server.mount("accounts_mgmt", "v1", b.version)
+		amv1.NewRootServerAdapter(b.accountsMgmtV1, prefix)
+	}
+	if b.clustersMgmtV1 != nil {
+		prefix := server.mount("clusters_mgmt", "v1", b.version)
+		cmv1.NewRootServerAdapter(b.clustersMgmtV1, prefix)
+	}
+	server.router.NotFoundHandler = http.HandlerFunc(server.notFound)
+
+	// Wrap the router with the middleware. Note that the middleware that is applied last is the
+	// first to be called, so the order is the reverse of the order of calls:
+	server.handler = server.router
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		server.handler = b.middleware[i](server.handler)
+	}
+	for _, middleware := range []Middleware{b.authentication, b.logging, b.metrics} {
+		if middleware != nil {
+			server.handler = middleware(server.handler)
+		}
+	}
+
+	result = server
+	return
+}
+
+// ServeHTTP is the implementation of the http.Handler interface.
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.handler.ServeHTTP(w, r)
+}
+
+// mount adds the routes for the metadata of the given service and version, and returns the router
+// where the implementation of the service should be mounted.
+func (s *apiServer) mount(service, version, serverVersion string) *mux.Router {
+	servicePath := "/api/" + service
+	versionPath := servicePath + "/" + version
+	s.router.Path(servicePath).Methods(http.MethodGet).HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			s.sendMetadata(w, r, &serviceMetadata{
+				Kind: "API",
+				ID:   service,
+				HREF: servicePath,
+				Versions: []*versionMetadata{
+					{
+						Kind: "APIVersionLink",
+						ID:   version,
+						HREF: versionPath,
+					},
+				},
+			})
+		},
+	)
+	s.router.Path(versionPath).Methods(http.MethodGet).HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			s.sendMetadata(w, r, &versionMetadata{
+				Kind:          "APIVersion",
+				ID:            version,
+				HREF:          versionPath,
+				ServerVersion: serverVersion,
+			})
+		},
+	)
+	return s.router.PathPrefix(versionPath).Subrouter()
+}
+
+// sendMetadata sends the given metadata to the client.
+func (s *apiServer) sendMetadata(w http.ResponseWriter, r *http.Request, metadata interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(metadata)
+	if err != nil {
+		s.logger.Error(r.Context(), "Can't send metadata for path '%s': %v", r.URL.Path, err)
+	}
+}
+
+// notFound sends the error response for requests that don't correspond to any service.
+func (s *apiServer) notFound(w http.ResponseWriter, r *http.Request) {
+	errors.SendNotFound(w, r)
+}
+
+// serviceMetadata is the structure used to send the metadata of a service.
+type serviceMetadata struct {
+	Kind     string             `json:"kind"`
+	ID       string             `json:"id"`
+	HREF     string             `json:"href"`
+	Versions []*versionMetadata `json:"versions"`
+}
+
+// versionMetadata is the structure used to send the metadata of a version of a service.
+type versionMetadata struct {
+	Kind          string `json:"kind"`
+	ID            string `json:"id"`
+	HREF          string `json:"href"`
+	ServerVersion string `json:"server_version,omitempty"`
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the middleware that generates Prometheus metrics for
+// the requests received by the server.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// ServerMetricsBuilder contains the configuration and logic needed to create the middleware that
+// generates metrics for the requests received by the server. Don't create instances of this type
+// directly, use the NewServerMetricsBuilder function instead.
+type ServerMetricsBuilder struct {
+	subsystem  string
+	registerer prometheus.Registerer
+}
+
+// serverMetricsHandler is the HTTP handler that implements the metrics middleware.
+type serverMetricsHandler struct {
+	next           http.Handler
+	countMetric    *prometheus.CounterVec
+	durationMetric *prometheus.HistogramVec
+}
+
+// NewServerMetricsBuilder creates a builder that knows how to create metrics middleware.
+func NewServerMetricsBuilder() *ServerMetricsBuilder {
+	return new(ServerMetricsBuilder)
+}
+
+// Subsystem sets the name of the subsystem that will be used to register the metrics with
+// Prometheus. This is mandatory. For example, if the value is `api_inbound` then the following
+// metrics will be registered:
+//
+//	api_inbound_request_count - Number of API requests received.
+//	api_inbound_request_duration_sum - Total time to process API requests, in seconds.
+//	api_inbound_request_duration_count - Total number of API requests measured.
+//	api_inbound_request_duration_bucket - Number of API requests organized in buckets.
+//
+// The metrics have the same labels than the metrics generated by the connection:
+//
+//	method - Name of the HTTP method, for example GET or POST.
+//	path - Request path, for example /api/clusters_mgmt/v1/clusters.
+//	code - HTTP response code, for example 200 or 500.
+//
+// As in the connection, the path label contains the template of the route of the resource, where
+// the identifiers of the objects are replaced by `-` in order to reduce the cardinality of the
+// metrics. For example, if the original path is /api/clusters_mgmt/v1/clusters/123/logs/install
+// then it will be replaced by /api/clusters_mgmt/v1/clusters/-/logs/-. The template is taken from
+// the route that the server adapter dispatched the request to, so it starts with the prefix where
+// the adapter is mounted, even if it isn't the default one. Requests rejected with a 405 status
+// code because the resource doesn't support the method keep the template of the resource. Paths
+// that don't correspond to any resource, like the ones of requests rejected with a 404 status
+// code, are all replaced by `/-`.
+func (b *ServerMetricsBuilder) Subsystem(value string) *ServerMetricsBuilder {
+	b.subsystem = value
+	return b
+}
+
+// Registerer sets the Prometheus registerer that will be used to register the metrics. The default
+// is to use the default registerer of the Prometheus library.
+func (b *ServerMetricsBuilder) Registerer(value prometheus.Registerer) *ServerMetricsBuilder {
+	b.registerer = value
+	return b
+}
+
+// Build uses the configuration stored in the builder to create the metrics middleware. The result
+// can be passed to the Metrics method of the server builder, or used directly to wrap any other
+// handler, for example the root server adapter of a specific service:
+//
+//	adapter := cmv1.NewRootServerAdapter(server, router)
+//	handler := metrics(adapter)
+func (b *ServerMetricsBuilder) Build() (result Middleware, err error) {
+	// Check the parameters:
+	if b.subsystem == "" {
+		err = fmt.Errorf("subsystem is mandatory")
+		return
+	}
+
+	// Set the default registerer, if needed:
+	registerer := b.registerer
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	// Register the request count metric:
+	countMetric := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: b.subsystem,
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		},
+		callMetricsLabels,
+	)
+	err = registerer.Register(countMetric)
+	if err != nil {
+		registered, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			return
+		}
+		countMetric = registered.ExistingCollector.(*prometheus.CounterVec)
+		err = nil
+	}
+
+	// Register the request duration metric:
+	durationMetric := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: b.subsystem,
+			Name:      "request_duration",
+			Help:      "Request duration in seconds.",
+			Buckets: []float64{
+				0.1,
+				1.0,
+				10.0,
+				30.0,
+			},
+		},
+		callMetricsLabels,
+	)
+	err = registerer.Register(durationMetric)
+	if err != nil {
+		registered, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			return
+		}
+		durationMetric = registered.ExistingCollector.(*prometheus.HistogramVec)
+		err = nil
+	}
+
+	result = func(next http.Handler) http.Handler {
+		return &serverMetricsHandler{
+			next:           next,
+			countMetric:    countMetric,
+			durationMetric: durationMetric,
+		}
+	}
+	return
+}
+
+// ServeHTTP is the implementation of the http.Handler interface.
+func (h *serverMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	recorder := newResponseRecorder(w)
+	r, route := helpers.TrackRoute(r)
+	defer func() {
+		path := route()
+		if path == "" {
+			path = helpers.MetricPath(r.URL.Path)
+		}
+		labels := map[string]string{
+			metricsCodeLabel:   strconv.Itoa(recorder.code),
+			metricsMethodLabel: r.Method,
+			metricsPathLabel:   path,
+		}
+		h.countMetric.With(labels).Inc()
+		h.durationMetric.With(labels).Observe(time.Since(start).Seconds())
+	}()
+	h.next.ServeHTTP(recorder, r)
+}
+
+// responseRecorder is a response writer that remembers the status code and the number of bytes
+// written, so that they can be used by the metrics and logging middleware.
+type responseRecorder struct {
+	http.ResponseWriter
+	code  int
+	bytes int64
+}
+
+// newResponseRecorder creates a new recorder that wraps the given response writer.
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		code:           http.StatusOK,
+	}
+}
+
+// WriteHeader is the implementation of the http.ResponseWriter interface.
+func (r *responseRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write is the implementation of the http.ResponseWriter interface.
+func (r *responseRecorder) Write(data []byte) (n int, err error) {
+	n, err = r.ResponseWriter.Write(data)
+	r.bytes += int64(n)
+	return
+}
+
+// Flush is the implementation of the http.Flusher interface. It does nothing if the wrapped
+// response writer doesn't support flushing.
+func (r *responseRecorder) Flush() {
+	flusher, ok := r.ResponseWriter.(http.Flusher)
+	if ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the metrics and access log middleware of the server.
+
+package sdk
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Server metrics", func() {
+	var clusters *testClustersServer
+	var registry *prometheus.Registry
+	var logs *bytes.Buffer
+	var server http.Handler
+
+	BeforeEach(func() {
+		var err error
+		clusters = new(testClustersServer)
+		registry = prometheus.NewRegistry()
+		logs = new(bytes.Buffer)
+		logger, err := NewStdLoggerBuilder().
+			Streams(logs, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		metrics, err := NewServerMetricsBuilder().
+			Subsystem("api_inbound").
+			Registerer(registry).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		logging, err := NewAccessLogBuilder().
+			Logger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		server, err = NewServerBuilder().
+			Logger(logger).
+			ClustersMgmtV1(&testClustersMgmtServer{
+				clusters: clusters,
+			}).
+			Metrics(metrics).
+			Logging(logging).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// send sends the given request to the server and returns the response code.
+	send := func(method, target string) int {
+		request := httptest.NewRequest(method, target, nil)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	It("Requires the subsystem", func() {
+		_, err := NewServerMetricsBuilder().
+			Registerer(registry).
+			Build()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Can be created twice with the same registerer", func() {
+		_, err := NewServerMetricsBuilder().
+			Subsystem("api_inbound").
+			Registerer(registry).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Counts requests with anonymized path", func() {
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(http.StatusOK))
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/456")).To(Equal(http.StatusOK))
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters")).To(Equal(http.StatusOK))
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters?page=junk")).To(Equal(http.StatusBadRequest))
+		expected := bytes.NewBufferString(`
+			# HELP api_inbound_request_count Number of requests received.
+			# TYPE api_inbound_request_count counter
+			api_inbound_request_count{code="200",method="GET",path="/api/clusters_mgmt/v1/clusters"} 1
+			api_inbound_request_count{code="200",method="GET",path="/api/clusters_mgmt/v1/clusters/-"} 2
+			api_inbound_request_count{code="400",method="GET",path="/api/clusters_mgmt/v1/clusters"} 1
+		`)
+		err := testutil.GatherAndCompare(registry, expected, "api_inbound_request_count")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses the same path for all the requests that don't match a resource", func() {
+		Expect(send(http.MethodGet, "/api/junk")).To(Equal(http.StatusNotFound))
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/junk")).To(Equal(http.StatusNotFound))
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/junk")).To(Equal(
+			http.StatusNotFound,
+		))
+		expected := bytes.NewBufferString(`
+			# HELP api_inbound_request_count Number of requests received.
+			# TYPE api_inbound_request_count counter
+			api_inbound_request_count{code="404",method="GET",path="/-"} 3
+		`)
+		err := testutil.GatherAndCompare(registry, expected, "api_inbound_request_count")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses the path of the resource for methods that aren't allowed", func() {
+		Expect(send(http.MethodDelete, "/api/clusters_mgmt/v1/clusters")).To(Equal(
+			http.StatusMethodNotAllowed,
+		))
+		expected := bytes.NewBufferString(`
+			# HELP api_inbound_request_count Number of requests received.
+			# TYPE api_inbound_request_count counter
+			api_inbound_request_count{code="405",method="DELETE",path="/api/clusters_mgmt/v1/clusters"} 1
+		`)
+		err := testutil.GatherAndCompare(registry, expected, "api_inbound_request_count")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses the prefix where the adapter is mounted", func() {
+		metrics, err := NewServerMetricsBuilder().
+			Subsystem("custom_inbound").
+			Registerer(registry).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		router := mux.NewRouter()
+		adapter := cmv1.NewRootServerAdapter(
+			&testClustersMgmtServer{
+				clusters: clusters,
+			},
+			router.PathPrefix("/custom/{version}").Subrouter(),
+		)
+		handler := metrics(adapter)
+		for _, target := range []string{"/custom/v1/clusters/123", "/custom/v2/clusters/456"} {
+			request := httptest.NewRequest(http.MethodGet, target, nil)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		}
+		expected := bytes.NewBufferString(`
+			# HELP custom_inbound_request_count Number of requests received.
+			# TYPE custom_inbound_request_count counter
+			custom_inbound_request_count{code="200",method="GET",path="/custom/{version}/clusters/-"} 2
+		`)
+		err = testutil.GatherAndCompare(registry, expected, "custom_inbound_request_count")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Measures request duration", func() {
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(http.StatusOK))
+		families, err := registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+		var count uint64
+		for _, family := range families {
+			if family.GetName() == "api_inbound_request_duration" {
+				for _, metric := range family.GetMetric() {
+					count += metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+		Expect(count).To(BeNumerically("==", 1))
+	})
+
+	It("Writes the access log", func() {
+		Expect(send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(http.StatusOK))
+		Expect(logs.String()).To(ContainSubstring(
+			"method=GET path=/api/clusters_mgmt/v1/clusters/123 code=200",
+		))
+	})
+
+	It("Anonymizes paths", func() {
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1")).To(Equal("/api/clusters_mgmt/v1"))
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/clusters")).To(Equal(
+			"/api/clusters_mgmt/v1/clusters",
+		))
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/clusters/123/logs/install")).To(Equal(
+			"/api/clusters_mgmt/v1/clusters/-/logs/-",
+		))
+		Expect(helpers.MetricPath("/api/accounts_mgmt/v1/current_account")).To(Equal(
+			"/api/accounts_mgmt/v1/current_account",
+		))
+		Expect(helpers.MetricPath("/api")).To(Equal("/api"))
+		Expect(helpers.MetricPath("/api/clusters_mgmt")).To(Equal("/api/clusters_mgmt"))
+		Expect(helpers.MetricPath("/junk/123")).To(Equal("/-"))
+		Expect(helpers.MetricPath("/api/junk/v1/clusters")).To(Equal("/-"))
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/junk")).To(Equal("/-"))
+		Expect(helpers.MetricPath("/api/clusters_mgmt/v1/clusters/123/junk")).To(Equal("/-"))
+	})
+})
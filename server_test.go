@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the server that mounts all the services.
+
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+// testClustersMgmtServer is an implementation of the clusters management service that only
+// supports the collection of clusters.
+type testClustersMgmtServer struct {
+	clusters *testClustersServer
+}
+
+func (s *testClustersMgmtServer) Clusters() cmv1.ClustersServer {
+	return s.clusters
+}
+
+func (s *testClustersMgmtServer) Dashboards() cmv1.DashboardsServer {
+	return nil
+}
+
+func (s *testClustersMgmtServer) Flavours() cmv1.FlavoursServer {
+	return nil
+}
+
+func (s *testClustersMgmtServer) Versions() cmv1.VersionsServer {
+	return nil
+}
+
+var _ = Describe("Server", func() {
+	var clusters *testClustersServer
+	var server http.Handler
+
+	BeforeEach(func() {
+		var err error
+		clusters = new(testClustersServer)
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		server, err = NewServerBuilder().
+			Logger(logger).
+			Version("1.2.3").
+			ClustersMgmtV1(&testClustersMgmtServer{
+				clusters: clusters,
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// send sends the given request to the server and returns the recorded response.
+	send := func(handler http.Handler, method, target string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	It("Can't be created without services", func() {
+		_, err := NewServerBuilder().Build()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Dispatches requests to the service", func() {
+		recorder := send(server, http.MethodGet, "/api/clusters_mgmt/v1/clusters?page=2")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(clusters.listRequest).ToNot(BeNil())
+		Expect(clusters.listRequest.Page()).To(Equal(2))
+	})
+
+	It("Serves the metadata of the service", func() {
+		recorder := send(server, http.MethodGet, "/api/clusters_mgmt")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{
+			"kind": "API",
+			"id": "clusters_mgmt",
+			"href": "/api/clusters_mgmt",
+			"versions": [
+				{
+					"kind": "APIVersionLink",
+					"id": "v1",
+					"href": "/api/clusters_mgmt/v1"
+				}
+			]
+		}`))
+	})
+
+	It("Serves the metadata of the version", func() {
+		recorder := send(server, http.MethodGet, "/api/clusters_mgmt/v1")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{
+			"kind": "APIVersion",
+			"id": "v1",
+			"href": "/api/clusters_mgmt/v1",
+			"server_version": "1.2.3"
+		}`))
+	})
+
+	It("Returns 404 for services that aren't mounted", func() {
+		recorder := send(server, http.MethodGet, "/api/accounts_mgmt/v1/accounts")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("Dispatches requests to sub-resources", func() {
+		recorder := send(server, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{
+			"kind": "Cluster",
+			"id": "123"
+		}`))
+	})
+
+	It("Returns 404 for sub-resources that don't exist", func() {
+		recorder := send(server, http.MethodGet, "/api/clusters_mgmt/v1/dashboards")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		recorder = send(server, http.MethodGet, "/api/clusters_mgmt/v1/junk")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("Returns 405 for methods that aren't supported", func() {
+		recorder := send(server, http.MethodPut, "/api/clusters_mgmt/v1/clusters/123")
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("Doesn't allocate more as more requests are processed", func() {
+		run := func() {
+			send(server, http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		}
+		before := testing.AllocsPerRun(100, run)
+		for i := 0; i < 1000; i++ {
+			run()
+		}
+		after := testing.AllocsPerRun(100, run)
+		Expect(after).To(Equal(before))
+	})
+
+	It("Calls the middleware in order", func() {
+		var calls []string
+		middleware := func(name string) Middleware {
+			return func(handler http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					calls = append(calls, name)
+					handler.ServeHTTP(w, r)
+				})
+			}
+		}
+		handler, err := NewServerBuilder().
+			ClustersMgmtV1(&testClustersMgmtServer{
+				clusters: clusters,
+			}).
+			Authentication(middleware("authentication")).
+			Logging(middleware("logging")).
+			Metrics(middleware("metrics")).
+			Middleware(middleware("first"), middleware("second")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		recorder := send(handler, http.MethodGet, "/api/clusters_mgmt/v1/clusters")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(calls).To(Equal([]string{
+			"metrics",
+			"logging",
+			"authentication",
+			"first",
+			"second",
+		}))
+	})
+})
+
+func BenchmarkServer(b *testing.B) {
+	server, err := NewServerBuilder().
+		ClustersMgmtV1(&testClustersMgmtServer{
+			clusters: new(testClustersServer),
+		}).
+		Build()
+	if err != nil {
+		b.Fatal(err)
+	}
+	request := httptest.NewRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		server.ServeHTTP(httptest.NewRecorder(), request)
+	}
+}
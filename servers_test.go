@@ -0,0 +1,301 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the generated server adapters.
+
+package sdk
+
+import (
+	"context"
+	goerrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// testClustersServer is an implementation of the clusters server that remembers the last request
+// received.
+type testClustersServer struct {
+	listRequest *cmv1.ClustersListServerRequest
+	addRequest  *cmv1.ClustersAddServerRequest
+
+	// Error that will be returned by the List method, and flag indicating if it should panic:
+	listError error
+	listPanic bool
+
+	// Flag indicating if the Add method should validate the body of the request:
+	addValidate bool
+}
+
+func (s *testClustersServer) List(ctx context.Context, request *cmv1.ClustersListServerRequest,
+	response *cmv1.ClustersListServerResponse) error {
+	s.listRequest = request
+	if s.listPanic {
+		panic("list failed")
+	}
+	return s.listError
+}
+
+func (s *testClustersServer) Add(ctx context.Context, request *cmv1.ClustersAddServerRequest,
+	response *cmv1.ClustersAddServerResponse) error {
+	s.addRequest = request
+	if s.addValidate {
+		err := request.Body().Validate()
+		if err != nil {
+			return err
+		}
+	}
+	response.Body(request.Body())
+	return nil
+}
+
+func (s *testClustersServer) Cluster(id string) cmv1.ClusterServer {
+	return &testClusterServer{
+		id: id,
+	}
+}
+
+// testClusterServer is an implementation of the cluster server that only supports the Get method.
+type testClusterServer struct {
+	cmv1.ClusterServer
+	id string
+}
+
+func (s *testClusterServer) Get(ctx context.Context, request *cmv1.ClusterGetServerRequest,
+	response *cmv1.ClusterGetServerResponse) error {
+	body, err := cmv1.NewCluster().ID(s.id).Build()
+	if err != nil {
+		return err
+	}
+	response.Body(body)
+	return nil
+}
+
+var _ = Describe("Server adapters", func() {
+	var server *testClustersServer
+	var adapter http.Handler
+
+	BeforeEach(func() {
+		server = new(testClustersServer)
+		adapter = cmv1.NewClustersServerAdapter(server, mux.NewRouter())
+	})
+
+	// send sends the given request to the adapter and returns the recorded response.
+	send := func(method, target, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		adapter.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	// readError reads the error contained in the body of the given response.
+	readError := func(recorder *httptest.ResponseRecorder) *errors.Error {
+		err, readErr := errors.UnmarshalError(recorder.Body)
+		Expect(readErr).ToNot(HaveOccurred())
+		return err
+	}
+
+	It("Decodes query parameters", func() {
+		recorder := send(http.MethodGet, "/?page=2&size=10&search=name+like+'my%25'", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(server.listRequest).ToNot(BeNil())
+		Expect(server.listRequest.Page()).To(Equal(2))
+		Expect(server.listRequest.Size()).To(Equal(10))
+		Expect(server.listRequest.Search()).To(Equal("name like 'my%'"))
+		_, ok := server.listRequest.GetTotal()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Applies default values", func() {
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(server.listRequest.Page()).To(Equal(1))
+		Expect(server.listRequest.Size()).To(Equal(100))
+		_, ok := server.listRequest.GetSearch()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Rejects invalid integer parameter", func() {
+		recorder := send(http.MethodGet, "/?page=abc", "")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(server.listRequest).To(BeNil())
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("400"))
+		Expect(err.Reason()).To(ContainSubstring("'page'"))
+		Expect(err.Reason()).To(ContainSubstring("'abc'"))
+	})
+
+	DescribeTable(
+		"Rejects invalid page or size",
+		func(query string, name string) {
+			recorder := send(http.MethodGet, "/?"+query, "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(server.listRequest).To(BeNil())
+			err := readError(recorder)
+			Expect(err.ID()).To(Equal("400"))
+			Expect(err.Reason()).To(ContainSubstring("'" + name + "'"))
+		},
+		Entry("Zero page", "page=0", "page"),
+		Entry("Negative page", "page=-5", "page"),
+		Entry("Negative size", "size=-1", "size"),
+	)
+
+	It("Accepts zero size", func() {
+		recorder := send(http.MethodGet, "/?size=0", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(server.listRequest.Size()).To(Equal(0))
+	})
+
+	It("Decodes request body", func() {
+		recorder := send(http.MethodPost, "/", `{"name": "mycluster", "region": {"id": "us-east-1"}}`)
+		Expect(recorder.Code).To(Equal(http.StatusCreated))
+		Expect(server.addRequest.Body().Name()).To(Equal("mycluster"))
+	})
+
+	It("Rejects malformed request body", func() {
+		recorder := send(http.MethodPost, "/", `{"name": `)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(server.addRequest).To(BeNil())
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("400"))
+	})
+
+	It("Leaves the validation of the request body to the server", func() {
+		recorder := send(http.MethodPost, "/", `{"name": "MyCluster"}`)
+		Expect(recorder.Code).To(Equal(http.StatusCreated))
+		Expect(server.addRequest).ToNot(BeNil())
+		Expect(server.addRequest.Body().Name()).To(Equal("MyCluster"))
+	})
+
+	It("Sends validation error returned by the server", func() {
+		server.addValidate = true
+		recorder := send(http.MethodPost, "/", `{"name": "MyCluster"}`)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("400"))
+		Expect(err.Reason()).To(ContainSubstring("name: 'MyCluster' isn't a valid DNS label"))
+		Expect(err.Reason()).To(ContainSubstring("region: is required"))
+	})
+
+	It("Uses default status codes", func() {
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		recorder = send(http.MethodPost, "/", `{"name": "mycluster", "region": {"id": "us-east-1"}}`)
+		Expect(recorder.Code).To(Equal(http.StatusCreated))
+	})
+
+	It("Sends API error returned by the server", func() {
+		server.listError, _ = errors.NewError().
+			ID("CLUSTERS-MGMT-404").
+			Status(http.StatusNotFound).
+			Reason("Clusters not found").
+			Build()
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("CLUSTERS-MGMT-404"))
+		Expect(err.Reason()).To(Equal("Clusters not found"))
+	})
+
+	It("Sends validation errors as bad requests", func() {
+		server.listError = new(cmv1.Cluster).Validate()
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("400"))
+		Expect(err.Reason()).To(ContainSubstring("name: is required"))
+		Expect(err.Reason()).To(ContainSubstring("region: is required"))
+	})
+
+	It("Sends other errors as internal server errors", func() {
+		server.listError = goerrors.New("database down")
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		Expect(strings.Count(recorder.Body.String(), `"reason"`)).To(Equal(1))
+		err := readError(recorder)
+		Expect(err.Reason()).To(ContainSubstring("database down"))
+	})
+
+	It("Recovers from panics", func() {
+		server.listPanic = true
+		recorder := send(http.MethodGet, "/", "")
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		err := readError(recorder)
+		Expect(err.ID()).To(Equal("1000"))
+	})
+})
+
+var _ = Describe("Server adapter mounted with path template", func() {
+	It("Removes the variables of the prefix", func() {
+		router := mux.NewRouter()
+		subrouter := router.PathPrefix("/api/{service}/v1/clusters").Subrouter()
+		cmv1.NewClustersServerAdapter(new(testClustersServer), subrouter)
+		request := httptest.NewRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", nil)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		cluster, err := cmv1.UnmarshalCluster(recorder.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.ID()).To(Equal("123"))
+	})
+})
+
+var _ = DescribeTable(
+	"Path segments",
+	func(path, prefix string, expected []string, ok bool) {
+		segments, matched := helpers.Segments(path, prefix)
+		Expect(matched).To(Equal(ok))
+		Expect(segments).To(Equal(expected))
+	},
+	Entry("Prefix only", "/api/clusters_mgmt/v1", "/api/clusters_mgmt/v1", nil, true),
+	Entry("Trailing slash", "/api/clusters_mgmt/v1/", "/api/clusters_mgmt/v1", nil, true),
+	Entry(
+		"Nested resource",
+		"/api/clusters_mgmt/v1/clusters/123", "/api/clusters_mgmt/v1",
+		[]string{"clusters", "123"}, true,
+	),
+	Entry("Different prefix", "/api/accounts_mgmt/v1", "/api/clusters_mgmt/v1", nil, false),
+	Entry("Partial segment", "/api/clusters_mgmt/v10", "/api/clusters_mgmt/v1", nil, false),
+	Entry(
+		"Empty segment",
+		"/api/clusters_mgmt/v1/clusters//status", "/api/clusters_mgmt/v1",
+		nil, false,
+	),
+	Entry(
+		"Variable in prefix",
+		"/api/clusters_mgmt/v1/clusters", "/api/{service}/v1",
+		[]string{"clusters"}, true,
+	),
+	Entry(
+		"Variable with pattern in prefix",
+		"/api/clusters_mgmt/v1/clusters", "/api/{service:[a-z_]+}/v1",
+		[]string{"clusters"}, true,
+	),
+)
@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the streaming of the items of list responses.
+
+package sdk
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Stream", func() {
+	// Template for list responses, the items are passed as a JSON array:
+	const listTemplate = `{
+		"kind": "{{ .Kind }}",
+		"page": 1,
+		"size": {{ .Size }},
+		"total": {{ .Size }},
+		"items": {{ .Items }}
+	}`
+
+	// Servers used during the tests:
+	var oidServer *ghttp.Server
+	var apiServer *ghttp.Server
+
+	// Connection used during the tests:
+	var connection *Connection
+
+	BeforeEach(func() {
+		var err error
+
+		// Create the tokens:
+		accessToken := DefaultToken("Bearer", 5*time.Minute)
+		refreshToken := DefaultToken("Refresh", 10*time.Hour)
+
+		// Create the OpenID server:
+		oidServer = ghttp.NewServer()
+		oidServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				RespondWithTokens(accessToken, refreshToken),
+			),
+		)
+
+		// Create the API server:
+		apiServer = ghttp.NewServer()
+
+		// Create the connection:
+		logger, err := NewStdLoggerBuilder().
+			Streams(GinkgoWriter, GinkgoWriter).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		connection, err = NewConnectionBuilder().
+			Logger(logger).
+			TokenURL(oidServer.URL()).
+			URL(apiServer.URL()).
+			Tokens(refreshToken).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// Stop the servers:
+		oidServer.Close()
+		apiServer.Close()
+
+		// Close the connection:
+		err := connection.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Calls the function for each item in order", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusOK,
+				listTemplate,
+				"Kind", "ClusterList",
+				"Size", 5,
+				"Items", string(benchmarkClusterList(5)),
+			),
+		)
+		var names []string
+		response, err := connection.ClustersMgmt().V1().Clusters().List().
+			Stream(func(item *cmv1.Cluster) bool {
+				names = append(names, item.Name())
+				return true
+			}).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names).To(Equal([]string{
+			"mycluster-0",
+			"mycluster-1",
+			"mycluster-2",
+			"mycluster-3",
+			"mycluster-4",
+		}))
+		Expect(response.Items()).To(BeNil())
+		Expect(response.Page()).To(Equal(1))
+		Expect(response.Total()).To(Equal(5))
+	})
+
+	It("Stops when the function returns false", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusOK,
+				listTemplate,
+				"Kind", "ClusterList",
+				"Size", 5,
+				"Items", string(benchmarkClusterList(5)),
+			),
+		)
+		count := 0
+		_, err := connection.ClustersMgmt().V1().Clusters().List().
+			Stream(func(item *cmv1.Cluster) bool {
+				count++
+				return count < 2
+			}).
+			Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+	})
+
+	It("Sends the items to the channel", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusOK,
+				listTemplate,
+				"Kind", "SubscriptionList",
+				"Size", 2,
+				"Items", `[
+					{"kind": "Subscription", "id": "123"},
+					{"kind": "Subscription", "id": "456"}
+				]`,
+			),
+		)
+		channel := make(chan *amv1.Subscription)
+		ids := make(chan []string)
+		go func() {
+			var result []string
+			for item := range channel {
+				result = append(result, item.ID())
+			}
+			ids <- result
+		}()
+		_, err := connection.AccountsMgmt().V1().Subscriptions().List().
+			StreamTo(channel).
+			Send()
+		close(channel)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-ids).To(Equal([]string{"123", "456"}))
+	})
+
+	It("Returns the context error if the channel blocks", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusOK,
+				listTemplate,
+				"Kind", "LogList",
+				"Size", 1,
+				"Items", `[{"kind": "Log", "id": "install", "content": "..."}]`,
+			),
+		)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		channel := make(chan *cmv1.Log)
+		_, err := connection.ClustersMgmt().V1().Clusters().Cluster("123").Logs().List().
+			StreamTo(channel).
+			SendContext(ctx)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+
+	It("Doesn't return the context error if all the items were sent", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// The body is split so that the fields that follow the items are only read after the
+		// context has been cancelled:
+		transport := transportFunc(func(request *http.Request) (*http.Response, error) {
+			body := io.MultiReader(
+				strings.NewReader(`{
+					"kind": "SubscriptionList",
+					"items": [{"kind": "Subscription", "id": "123"}],`,
+				),
+				contextReader{ctx: ctx},
+				strings.NewReader(`
+					"page": 1,
+					"size": 1,
+					"total": 1
+				}`),
+			)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header: http.Header{
+					"Content-Type": []string{"application/json"},
+				},
+				Body: ioutil.NopCloser(body),
+			}, nil
+		})
+		channel := make(chan *amv1.Subscription)
+		go func() {
+			<-channel
+			cancel()
+		}()
+		response, err := amv1.NewSubscriptionsClient(transport, "/api/accounts_mgmt/v1/subscriptions", "").
+			List().
+			StreamTo(channel).
+			SendContext(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Total()).To(Equal(1))
+	})
+
+	It("Doesn't call the function for items that can't be decoded", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSONTemplate(
+				http.StatusOK,
+				listTemplate,
+				"Kind", "ClusterList",
+				"Size", 2,
+				"Items", `[{"id": "123"}, {"id": 456}]`,
+			),
+		)
+		var ids []string
+		_, err := connection.ClustersMgmt().V1().Clusters().List().
+			Stream(func(item *cmv1.Cluster) bool {
+				ids = append(ids, item.ID())
+				return true
+			}).
+			Send()
+		Expect(err).To(HaveOccurred())
+		Expect(ids).To(Equal([]string{"123"}))
+	})
+})
+
+// transportFunc is an adapter that allows the use of ordinary functions as HTTP transports.
+type transportFunc func(request *http.Request) (*http.Response, error)
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (f transportFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// contextReader is a reader that waits till the context is done and then returns the end of file.
+type contextReader struct {
+	ctx context.Context
+}
+
+// Read is the implementation of the io.Reader interface.
+func (r contextReader) Read(data []byte) (int, error) {
+	<-r.ctx.Done()
+	return 0, io.EOF
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the function that reads objects of any of the types supported by the SDK,
+// selecting the type according to the value of the `kind` field.
+
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// kindPackage contains the information about one of the packages that contain model types.
+type kindPackage struct {
+	prefix    string
+	kinds     func() []string
+	unmarshal func(kind string, source interface{}, options ...helpers.DecodingOptions) (interface{},
+		error)
+}
+
+// kindPackages contains the packages that contain model types. When the same kind is supported by
+// multiple packages the first one is preferred, unless the `href` of the object says otherwise.
+var kindPackages = []*kindPackage{
+	{
+		prefix:    "/api/accounts_mgmt/v1/",
+		kinds:     amv1.Kinds,
+		unmarshal: amv1.UnmarshalKind,
+	},
+	{
+		prefix:    "/api/clusters_mgmt/v1/",
+		kinds:     cmv1.Kinds,
+		unmarshal: cmv1.UnmarshalKind,
+	},
+}
+
+// kindRegistry contains, for each kind, the packages that support it.
+var kindRegistry = map[string][]*kindPackage{}
+
+func init() {
+	for _, pkg := range kindPackages {
+		for _, kind := range pkg.kinds() {
+			kindRegistry[kind] = append(kindRegistry[kind], pkg)
+		}
+	}
+}
+
+// Unmarshal reads an object of any of the types supported by the SDK from the given source, which
+// can be a slice of bytes, a string, an io.Reader or a json.Decoder. The type is selected according
+// to the value of the `kind` field. For example, if the kind is `Cluster` or `ClusterLink` the
+// result will be a *v1.Cluster, if the kind is `ClusterList` or `ClusterListLink` the result will
+// be a *v1.ClusterList, and if the kind is `Error` the result will be an *errors.Error.
+//
+// Some kinds, like `Subscription`, are supported by more than one service. In that case the
+// service is selected using the `href` field of the object, and if there is no `href` the accounts
+// management service is preferred.
+//
+// The optional decoding options control how JSON fields that don't correspond to attributes of the
+// type are handled.
+func Unmarshal(source interface{}, options ...helpers.DecodingOptions) (object interface{},
+	err error) {
+	// Read the complete document, as it needs to be parsed twice:
+	var data []byte
+	switch typed := source.(type) {
+	case []byte:
+		data = typed
+	case string:
+		data = []byte(typed)
+	case io.Reader:
+		data, err = ioutil.ReadAll(typed)
+		if err != nil {
+			return
+		}
+	case *json.Decoder:
+		var raw json.RawMessage
+		err = typed.Decode(&raw)
+		if err != nil {
+			return
+		}
+		data = raw
+	default:
+		err = fmt.Errorf("expected bytes, string, reader or JSON decoder, but got %T", source)
+		return
+	}
+
+	// Extract the kind and the link:
+	var header struct {
+		Kind *string `json:"kind"`
+		HREF *string `json:"href"`
+	}
+	err = json.Unmarshal(data, &header)
+	if err != nil {
+		err = fmt.Errorf("can't parse object: %v", err)
+		return
+	}
+	if header.Kind == nil || *header.Kind == "" {
+		err = fmt.Errorf("object doesn't have a kind")
+		return
+	}
+	kind := *header.Kind
+
+	// Errors aren't part of any of the services:
+	if kind == errors.ErrorKind {
+		object, err = errors.UnmarshalError(data)
+		if err != nil {
+			object = nil
+		}
+		return
+	}
+
+	// Select the package:
+	candidates := kindRegistry[kind]
+	if len(candidates) == 0 {
+		err = fmt.Errorf("kind '%s' isn't supported", kind)
+		return
+	}
+	selected := candidates[0]
+	if header.HREF != nil {
+		for _, candidate := range candidates {
+			if strings.HasPrefix(*header.HREF, candidate.prefix) {
+				selected = candidate
+				break
+			}
+		}
+	}
+
+	object, err = selected.unmarshal(kind, data, options...)
+	return
+}
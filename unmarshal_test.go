@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the function that reads objects selecting the type according to
+// the kind.
+
+package sdk
+
+import (
+	"bytes"
+
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/errors"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+var _ = Describe("Unmarshal", func() {
+	DescribeTable(
+		"Selects the type according to the kind",
+		func(source string, expected interface{}) {
+			object, err := Unmarshal(source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(object).To(BeAssignableToTypeOf(expected))
+		},
+		Entry(
+			"Object",
+			`{"kind": "Cluster", "id": "123", "name": "mycluster"}`,
+			&cmv1.Cluster{},
+		),
+		Entry(
+			"Link",
+			`{"kind": "FlavourLink", "id": "osd-4", "href": "/api/clusters_mgmt/v1/flavours/osd-4"}`,
+			&cmv1.Flavour{},
+		),
+		Entry(
+			"List",
+			`{"kind": "RoleList", "page": 1, "size": 1, "total": 1, "items": [{"id": "admin"}]}`,
+			&amv1.RoleList{},
+		),
+		Entry(
+			"Error",
+			`{"kind": "Error", "id": "404", "reason": "Not found"}`,
+			&errors.Error{},
+		),
+		Entry(
+			"Ambiguous kind without link",
+			`{"kind": "Subscription", "id": "456"}`,
+			&amv1.Subscription{},
+		),
+		Entry(
+			"Ambiguous kind with link",
+			`{"kind": "SubscriptionLink", "id": "456", "href": "/api/clusters_mgmt/v1/subscriptions/456"}`,
+			&cmv1.Subscription{},
+		),
+	)
+
+	It("Reads the attributes of the object", func() {
+		object, err := Unmarshal(bytes.NewBufferString(`{
+			"kind": "ClusterLink",
+			"id": "123",
+			"href": "/api/clusters_mgmt/v1/clusters/123"
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		cluster := object.(*cmv1.Cluster)
+		Expect(cluster.Link()).To(BeTrue())
+		Expect(cluster.ID()).To(Equal("123"))
+		Expect(cluster.HREF()).To(Equal("/api/clusters_mgmt/v1/clusters/123"))
+	})
+
+	It("Reads the items of a list", func() {
+		object, err := Unmarshal(`{
+			"kind": "ClusterListLink",
+			"href": "/api/clusters_mgmt/v1/clusters",
+			"items": [
+				{"kind": "Cluster", "id": "123"},
+				{"kind": "Cluster", "id": "456"}
+			]
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		list := object.(*cmv1.ClusterList)
+		Expect(list.Link()).To(BeTrue())
+		Expect(list.Len()).To(Equal(2))
+		Expect(list.Get(1).ID()).To(Equal("456"))
+	})
+
+	It("Honours the decoding options", func() {
+		_, err := Unmarshal(
+			`{"kind": "Cluster", "id": "123", "color": "blue"}`,
+			helpers.DecodingOptions{
+				Mode: helpers.StrictDecoding,
+			},
+		)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("color"))
+	})
+
+	DescribeTable(
+		"Rejects invalid objects",
+		func(source string, message string) {
+			object, err := Unmarshal(source)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+			Expect(object).To(BeNil())
+		},
+		Entry("Without kind", `{"id": "123"}`, "doesn't have a kind"),
+		Entry("Unknown kind", `{"kind": "Junk"}`, "kind 'Junk' isn't supported"),
+		Entry("Invalid JSON", `{`, "can't parse object"),
+		Entry("Wrong attribute type", `{"kind": "Cluster", "name": 123}`, "cannot unmarshal"),
+	)
+})
@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains tests for the validation of the model types.
+
+package sdk
+
+import (
+	// nolint
+	. "github.com/onsi/ginkgo"
+	// nolint
+	. "github.com/onsi/ginkgo/extensions/table"
+	// nolint
+	. "github.com/onsi/gomega"
+
+	amv1 "github.com/openshift-online/uhc-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/uhc-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/uhc-sdk-go/helpers"
+)
+
+// validCluster returns a builder for a cluster that passes the validation, so that tests can
+// change it to violate one rule at a time.
+func validCluster() *cmv1.ClusterBuilder {
+	return cmv1.NewCluster().
+		Name("mycluster").
+		Region(cmv1.NewCloudRegion().ID("us-east-1")).
+		AWS(cmv1.NewAWS().AccessKeyID("myid").SecretAccessKey("mysecret")).
+		Network(cmv1.NewNetwork().
+			MachineCIDR("10.0.0.0/16").
+			ServiceCIDR("172.30.0.0/16").
+			PodCIDR("10.128.0.0/14")).
+		MultiAZ(true).
+		Nodes(cmv1.NewClusterNodes().Compute(9))
+}
+
+// fieldErrors returns the violations contained in the given error, which must be a validation
+// error.
+func fieldErrors(err error) []helpers.FieldError {
+	Expect(err).To(HaveOccurred())
+	Expect(err).To(BeAssignableToTypeOf(&helpers.ValidationError{}))
+	var result []helpers.FieldError
+	for _, field := range err.(*helpers.ValidationError).Errors {
+		result = append(result, *field)
+	}
+	return result
+}
+
+var _ = Describe("Validation", func() {
+	It("Accepts valid cluster", func() {
+		cluster, err := validCluster().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Validate()).To(Succeed())
+	})
+
+	DescribeTable(
+		"Rejects invalid cluster",
+		func(builder *cmv1.ClusterBuilder, path string, reason string) {
+			cluster, err := builder.Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fieldErrors(cluster.Validate())).To(ConsistOf(helpers.FieldError{
+				Path:   path,
+				Reason: reason,
+			}))
+		},
+		Entry(
+			"Missing name",
+			validCluster().Name(""),
+			"name",
+			"is required",
+		),
+		Entry(
+			"Name with upper case letters",
+			validCluster().Name("MyCluster"),
+			"name",
+			"'MyCluster' isn't a valid DNS label, it should contain at most 63 lower case "+
+				"letters, digits or dashes, and start and end with a letter or digit",
+		),
+		Entry(
+			"Name ending with dash",
+			validCluster().Name("mycluster-"),
+			"name",
+			"'mycluster-' isn't a valid DNS label, it should contain at most 63 lower case "+
+				"letters, digits or dashes, and start and end with a letter or digit",
+		),
+		Entry(
+			"Missing region identifier",
+			validCluster().Region(cmv1.NewCloudRegion()),
+			"region.id",
+			"is required",
+		),
+		Entry(
+			"AWS without access key",
+			validCluster().AWS(cmv1.NewAWS().SecretAccessKey("mysecret")),
+			"aws.access_key_id",
+			"is required",
+		),
+		Entry(
+			"Invalid CIDR",
+			validCluster().Network(cmv1.NewNetwork().PodCIDR("10.128.0.0")),
+			"network.pod_cidr",
+			"'10.128.0.0' isn't a valid CIDR block",
+		),
+		Entry(
+			"Overlapping CIDRs",
+			validCluster().Network(cmv1.NewNetwork().
+				MachineCIDR("10.0.0.0/16").
+				PodCIDR("10.0.128.0/17")),
+			"network.pod_cidr",
+			"'10.0.128.0/17' overlaps with '10.0.0.0/16' of 'machine_cidr'",
+		),
+		Entry(
+			"Compute nodes not multiple of three",
+			validCluster().Nodes(cmv1.NewClusterNodes().Compute(4)),
+			"nodes.compute",
+			"4 isn't a multiple of 3, as required when 'multi_az' is true",
+		),
+		Entry(
+			"Negative compute nodes",
+			validCluster().MultiAZ(false).Nodes(cmv1.NewClusterNodes().Compute(-1)),
+			"nodes.compute",
+			"-1 is negative",
+		),
+		Entry(
+			"Invalid identity provider",
+			validCluster().IdentityProviders(
+				cmv1.NewIdentityProvider().
+					Name("myidp").
+					Type(cmv1.IdentityProviderTypeGithub),
+			),
+			"identity_providers[0].github",
+			"is required when 'type' is 'github'",
+		),
+	)
+
+	It("Accepts compute nodes not multiple of three without multiple zones", func() {
+		cluster, err := validCluster().
+			MultiAZ(false).
+			Nodes(cmv1.NewClusterNodes().Compute(4)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Validate()).To(Succeed())
+	})
+
+	It("Collects all the violations", func() {
+		cluster, err := cmv1.NewCluster().
+			AWS(cmv1.NewAWS()).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		err = cluster.Validate()
+		Expect(fieldErrors(err)).To(Equal([]helpers.FieldError{
+			{Path: "name", Reason: "is required"},
+			{Path: "region", Reason: "is required"},
+			{Path: "aws.access_key_id", Reason: "is required"},
+			{Path: "aws.secret_access_key", Reason: "is required"},
+		}))
+		Expect(err.Error()).To(Equal(
+			"name: is required; " +
+				"region: is required; " +
+				"aws.access_key_id: is required; " +
+				"aws.secret_access_key: is required",
+		))
+	})
+
+	It("Accepts partial cluster for update", func() {
+		cluster, err := cmv1.NewCluster().
+			DisplayName("My cluster").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.ValidateUpdate()).To(Succeed())
+	})
+
+	It("Checks attributes present in partial cluster for update", func() {
+		cluster, err := cmv1.NewCluster().
+			Name("").
+			Nodes(cmv1.NewClusterNodes().Compute(-1)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fieldErrors(cluster.ValidateUpdate())).To(Equal([]helpers.FieldError{
+			{Path: "name", Reason: "is required"},
+			{Path: "nodes.compute", Reason: "-1 is negative"},
+		}))
+	})
+
+	It("Rejects nil cluster", func() {
+		var cluster *cmv1.Cluster
+		Expect(fieldErrors(cluster.Validate())).To(ConsistOf(helpers.FieldError{
+			Reason: "cluster is required",
+		}))
+	})
+
+	It("Validates identity providers", func() {
+		provider, err := cmv1.NewIdentityProvider().
+			Type(cmv1.IdentityProviderTypeGitlab).
+			Gitlab(cmv1.NewGitlabIdentityProvider().ClientID("myclient")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fieldErrors(provider.Validate())).To(Equal([]helpers.FieldError{
+			{Path: "name", Reason: "is required"},
+			{Path: "gitlab.client_secret", Reason: "is required"},
+			{Path: "gitlab.url", Reason: "is required"},
+		}))
+	})
+
+	It("Validates flavours", func() {
+		flavour, err := cmv1.NewFlavour().
+			ID("osd-4").
+			Network(cmv1.NewNetwork().ServiceCIDR("172.30.0.0/16").PodCIDR("172.30.0.0/24")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fieldErrors(flavour.Validate())).To(Equal([]helpers.FieldError{
+			{Path: "name", Reason: "is required"},
+			{
+				Path:   "network.pod_cidr",
+				Reason: "'172.30.0.0/24' overlaps with '172.30.0.0/16' of 'service_cidr'",
+			},
+		}))
+	})
+
+	It("Validates resource quotas", func() {
+		quota, err := amv1.NewResourceQuota().
+			OrganizationID("123").
+			SKU("MW00530").
+			ResourceName("c5.xlarge").
+			ResourceType("compute.node").
+			Allowed(2).
+			Reserved(3).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fieldErrors(quota.Validate())).To(ConsistOf(helpers.FieldError{
+			Path:   "reserved",
+			Reason: "3 is greater than the value of 'allowed', which is 2",
+		}))
+	})
+})